@@ -0,0 +1,4359 @@
+// DO NOT EDIT.
+// swift-format-ignore-file
+// swiftlint:disable all
+//
+// Generated by the gRPC Swift generator plugin for the protocol buffer compiler.
+// Source: protos/admin.proto
+//
+// For information on using the generated types, please see the documentation:
+//   https://github.com/grpc/grpc-swift
+
+import GRPCCore
+import GRPCProtobuf
+
+// MARK: - routeguide.Admin
+
+/// Namespace containing generated types for the "routeguide.Admin" service.
+@available(macOS 15.0, iOS 18.0, watchOS 11.0, tvOS 18.0, visionOS 2.0, *)
+public enum Routeguide_Admin {
+    /// Service descriptor for the "routeguide.Admin" service.
+    public static let descriptor = GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.Admin")
+    /// Namespace for method metadata.
+    public enum Method {
+        /// Namespace for "DebugDump" metadata.
+        public enum DebugDump {
+            /// Request type for "DebugDump".
+            public typealias Input = Routeguide_DebugDumpRequest
+            /// Response type for "DebugDump".
+            public typealias Output = Routeguide_DebugDumpResponse
+            /// Descriptor for "DebugDump".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.Admin"),
+                method: "DebugDump"
+            )
+        }
+        /// Namespace for "GetServerInfo" metadata.
+        public enum GetServerInfo {
+            /// Request type for "GetServerInfo".
+            public typealias Input = Routeguide_ServerInfoRequest
+            /// Response type for "GetServerInfo".
+            public typealias Output = Routeguide_ServerInfo
+            /// Descriptor for "GetServerInfo".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.Admin"),
+                method: "GetServerInfo"
+            )
+        }
+        /// Namespace for "SetReadOnly" metadata.
+        public enum SetReadOnly {
+            /// Request type for "SetReadOnly".
+            public typealias Input = Routeguide_SetReadOnlyRequest
+            /// Response type for "SetReadOnly".
+            public typealias Output = Routeguide_ServerInfo
+            /// Descriptor for "SetReadOnly".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.Admin"),
+                method: "SetReadOnly"
+            )
+        }
+        /// Namespace for "TailLogs" metadata.
+        public enum TailLogs {
+            /// Request type for "TailLogs".
+            public typealias Input = Routeguide_TailLogsRequest
+            /// Response type for "TailLogs".
+            public typealias Output = Routeguide_LogEntry
+            /// Descriptor for "TailLogs".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.Admin"),
+                method: "TailLogs"
+            )
+        }
+        /// Namespace for "ReplayRoute" metadata.
+        public enum ReplayRoute {
+            /// Request type for "ReplayRoute".
+            public typealias Input = Routeguide_ReplayRouteRequest
+            /// Response type for "ReplayRoute".
+            public typealias Output = Routeguide_RouteSummary
+            /// Descriptor for "ReplayRoute".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.Admin"),
+                method: "ReplayRoute"
+            )
+        }
+        /// Namespace for "ListPendingFeatures" metadata.
+        public enum ListPendingFeatures {
+            /// Request type for "ListPendingFeatures".
+            public typealias Input = Routeguide_ListPendingFeaturesRequest
+            /// Response type for "ListPendingFeatures".
+            public typealias Output = Routeguide_ListPendingFeaturesResponse
+            /// Descriptor for "ListPendingFeatures".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.Admin"),
+                method: "ListPendingFeatures"
+            )
+        }
+        /// Namespace for "ApproveFeature" metadata.
+        public enum ApproveFeature {
+            /// Request type for "ApproveFeature".
+            public typealias Input = Routeguide_ReviewFeatureRequest
+            /// Response type for "ApproveFeature".
+            public typealias Output = Routeguide_FeatureSubmission
+            /// Descriptor for "ApproveFeature".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.Admin"),
+                method: "ApproveFeature"
+            )
+        }
+        /// Namespace for "RejectFeature" metadata.
+        public enum RejectFeature {
+            /// Request type for "RejectFeature".
+            public typealias Input = Routeguide_ReviewFeatureRequest
+            /// Response type for "RejectFeature".
+            public typealias Output = Routeguide_FeatureSubmission
+            /// Descriptor for "RejectFeature".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.Admin"),
+                method: "RejectFeature"
+            )
+        }
+        /// Namespace for "DiffDatasets" metadata.
+        public enum DiffDatasets {
+            /// Request type for "DiffDatasets".
+            public typealias Input = Routeguide_DiffDatasetsRequest
+            /// Response type for "DiffDatasets".
+            public typealias Output = Routeguide_DatasetDiff
+            /// Descriptor for "DiffDatasets".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.Admin"),
+                method: "DiffDatasets"
+            )
+        }
+        /// Namespace for "ListConnections" metadata.
+        public enum ListConnections {
+            /// Request type for "ListConnections".
+            public typealias Input = Routeguide_ListConnectionsRequest
+            /// Response type for "ListConnections".
+            public typealias Output = Routeguide_ListConnectionsResponse
+            /// Descriptor for "ListConnections".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.Admin"),
+                method: "ListConnections"
+            )
+        }
+        /// Namespace for "TerminateStream" metadata.
+        public enum TerminateStream {
+            /// Request type for "TerminateStream".
+            public typealias Input = Routeguide_TerminateStreamRequest
+            /// Response type for "TerminateStream".
+            public typealias Output = Routeguide_StreamInfo
+            /// Descriptor for "TerminateStream".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.Admin"),
+                method: "TerminateStream"
+            )
+        }
+        /// Namespace for "ReloadFeatures" metadata.
+        public enum ReloadFeatures {
+            /// Request type for "ReloadFeatures".
+            public typealias Input = Routeguide_ReloadFeaturesRequest
+            /// Response type for "ReloadFeatures".
+            public typealias Output = Routeguide_ReloadFeaturesResponse
+            /// Descriptor for "ReloadFeatures".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.Admin"),
+                method: "ReloadFeatures"
+            )
+        }
+        /// Namespace for "DumpRouteNotes" metadata.
+        public enum DumpRouteNotes {
+            /// Request type for "DumpRouteNotes".
+            public typealias Input = Routeguide_DumpRouteNotesRequest
+            /// Response type for "DumpRouteNotes".
+            public typealias Output = Routeguide_RouteNote
+            /// Descriptor for "DumpRouteNotes".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.Admin"),
+                method: "DumpRouteNotes"
+            )
+        }
+        /// Namespace for "ClearRouteNotes" metadata.
+        public enum ClearRouteNotes {
+            /// Request type for "ClearRouteNotes".
+            public typealias Input = Routeguide_ClearRouteNotesRequest
+            /// Response type for "ClearRouteNotes".
+            public typealias Output = Routeguide_ClearRouteNotesResponse
+            /// Descriptor for "ClearRouteNotes".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.Admin"),
+                method: "ClearRouteNotes"
+            )
+        }
+        /// Namespace for "CompactRouteNotes" metadata.
+        public enum CompactRouteNotes {
+            /// Request type for "CompactRouteNotes".
+            public typealias Input = Routeguide_CompactRouteNotesRequest
+            /// Response type for "CompactRouteNotes".
+            public typealias Output = Routeguide_CompactRouteNotesResponse
+            /// Descriptor for "CompactRouteNotes".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.Admin"),
+                method: "CompactRouteNotes"
+            )
+        }
+        /// Namespace for "RebuildIndexes" metadata.
+        public enum RebuildIndexes {
+            /// Request type for "RebuildIndexes".
+            public typealias Input = Routeguide_RebuildIndexesRequest
+            /// Response type for "RebuildIndexes".
+            public typealias Output = Routeguide_RebuildIndexesResponse
+            /// Descriptor for "RebuildIndexes".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.Admin"),
+                method: "RebuildIndexes"
+            )
+        }
+        /// Namespace for "ListJobs" metadata.
+        public enum ListJobs {
+            /// Request type for "ListJobs".
+            public typealias Input = Routeguide_ListJobsRequest
+            /// Response type for "ListJobs".
+            public typealias Output = Routeguide_ListJobsResponse
+            /// Descriptor for "ListJobs".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.Admin"),
+                method: "ListJobs"
+            )
+        }
+        /// Namespace for "RunJob" metadata.
+        public enum RunJob {
+            /// Request type for "RunJob".
+            public typealias Input = Routeguide_RunJobRequest
+            /// Response type for "RunJob".
+            public typealias Output = Routeguide_JobStatus
+            /// Descriptor for "RunJob".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.Admin"),
+                method: "RunJob"
+            )
+        }
+        /// Descriptors for all methods in the "routeguide.Admin" service.
+        public static let descriptors: [GRPCCore.MethodDescriptor] = [
+            DebugDump.descriptor,
+            GetServerInfo.descriptor,
+            SetReadOnly.descriptor,
+            TailLogs.descriptor,
+            ReplayRoute.descriptor,
+            ListPendingFeatures.descriptor,
+            ApproveFeature.descriptor,
+            RejectFeature.descriptor,
+            DiffDatasets.descriptor,
+            ListConnections.descriptor,
+            TerminateStream.descriptor,
+            ReloadFeatures.descriptor,
+            DumpRouteNotes.descriptor,
+            ClearRouteNotes.descriptor,
+            CompactRouteNotes.descriptor,
+            RebuildIndexes.descriptor,
+            ListJobs.descriptor,
+            RunJob.descriptor
+        ]
+    }
+}
+
+@available(macOS 15.0, iOS 18.0, watchOS 11.0, tvOS 18.0, visionOS 2.0, *)
+extension GRPCCore.ServiceDescriptor {
+    /// Service descriptor for the "routeguide.Admin" service.
+    public static let routeguide_Admin = GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.Admin")
+}
+
+// MARK: routeguide.Admin (server)
+
+@available(macOS 15.0, iOS 18.0, watchOS 11.0, tvOS 18.0, visionOS 2.0, *)
+extension Routeguide_Admin {
+    /// Streaming variant of the service protocol for the "routeguide.Admin" service.
+    ///
+    /// This protocol is the lowest-level of the service protocols generated for this service
+    /// giving you the most flexibility over the implementation of your service. This comes at
+    /// the cost of more verbose and less strict APIs. Each RPC requires you to implement it in
+    /// terms of a request stream and response stream. Where only a single request or response
+    /// message is expected, you are responsible for enforcing this invariant is maintained.
+    ///
+    /// Where possible, prefer using the stricter, less-verbose ``ServiceProtocol``
+    /// or ``SimpleServiceProtocol`` instead.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Operator-only interface exported by the server.
+    /// > 
+    /// > Every method requires a caller with the admin role, such as one sending
+    /// > the admin token as "authorization: Bearer <token>" metadata.
+    public protocol StreamingServiceProtocol: GRPCCore.RegistrableRPCService {
+        /// Handle the "DebugDump" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Returns a dump of all goroutine stacks and, optionally, a heap profile.
+        /// > 
+        /// > Intended for diagnosing stuck streams in deployments without shell
+        /// > access.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_DebugDumpRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_DebugDumpResponse` messages.
+        func debugDump(
+            request: GRPCCore.StreamingServerRequest<Routeguide_DebugDumpRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_DebugDumpResponse>
+
+        /// Handle the "GetServerInfo" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Returns the server's state.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_ServerInfoRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_ServerInfo` messages.
+        func getServerInfo(
+            request: GRPCCore.StreamingServerRequest<Routeguide_ServerInfoRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_ServerInfo>
+
+        /// Handle the "SetReadOnly" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Puts the dataset in or out of read-only mode. While read-only, dataset
+        /// > changes (refreshes and feature edits) are rejected with
+        /// > FAILED_PRECONDITION, and the "routeguide.RouteGuide/writes" health
+        /// > service reports NOT_SERVING. Queries are unaffected.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_SetReadOnlyRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_ServerInfo` messages.
+        func setReadOnly(
+            request: GRPCCore.StreamingServerRequest<Routeguide_SetReadOnlyRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_ServerInfo>
+
+        /// Handle the "TailLogs" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Streams the server's log entries as they are written, optionally
+        /// > preceded by the most recent ones. Intended for watching server-side
+        /// > events live while debugging a client.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_TailLogsRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_LogEntry` messages.
+        func tailLogs(
+            request: GRPCCore.StreamingServerRequest<Routeguide_TailLogsRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_LogEntry>
+
+        /// Handle the "ReplayRoute" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Replays a stored route file through the RecordRoute logic and returns
+        /// > its summary, for computing statistics from historical data without a
+        /// > live stream. The route is stored like a recorded one, so it can be used
+        /// > with CompareRoutes and EstimateTravelTime.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_ReplayRouteRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_RouteSummary` messages.
+        func replayRoute(
+            request: GRPCCore.StreamingServerRequest<Routeguide_ReplayRouteRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_RouteSummary>
+
+        /// Handle the "ListPendingFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Lists the feature submissions waiting for review, oldest first.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_ListPendingFeaturesRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_ListPendingFeaturesResponse` messages.
+        func listPendingFeatures(
+            request: GRPCCore.StreamingServerRequest<Routeguide_ListPendingFeaturesRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_ListPendingFeaturesResponse>
+
+        /// Handle the "ApproveFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Approves a pending feature submission, applying it to the dataset.
+        /// > Fails with NOT_FOUND if no pending submission has the ID, e.g. because it
+        /// > was already reviewed, and with FAILED_PRECONDITION while the dataset is
+        /// > read-only.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_ReviewFeatureRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_FeatureSubmission` messages.
+        func approveFeature(
+            request: GRPCCore.StreamingServerRequest<Routeguide_ReviewFeatureRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_FeatureSubmission>
+
+        /// Handle the "RejectFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Rejects a pending feature submission. Fails with NOT_FOUND if no pending
+        /// > submission has the ID.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_ReviewFeatureRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_FeatureSubmission` messages.
+        func rejectFeature(
+            request: GRPCCore.StreamingServerRequest<Routeguide_ReviewFeatureRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_FeatureSubmission>
+
+        /// Handle the "DiffDatasets" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Compares the dataset being served with the one it replaced, to check
+        /// > that a reload did what was expected. Features are matched by location.
+        /// > Fails with FAILED_PRECONDITION if the dataset was never replaced.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_DiffDatasetsRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_DatasetDiff` messages.
+        func diffDatasets(
+            request: GRPCCore.StreamingServerRequest<Routeguide_DiffDatasetsRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_DatasetDiff>
+
+        /// Handle the "ListConnections" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Lists the open client connections and the streams open on them, with
+        /// > their callers and message counts, to find misbehaving clients.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_ListConnectionsRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_ListConnectionsResponse` messages.
+        func listConnections(
+            request: GRPCCore.StreamingServerRequest<Routeguide_ListConnectionsRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_ListConnectionsResponse>
+
+        /// Handle the "TerminateStream" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Ends an open stream, which fails with ABORTED on the client, e.g. one a
+        /// > misbehaving client keeps open. Fails with NOT_FOUND if no open stream
+        /// > has the ID.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_TerminateStreamRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_StreamInfo` messages.
+        func terminateStream(
+            request: GRPCCore.StreamingServerRequest<Routeguide_TerminateStreamRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_StreamInfo>
+
+        /// Handle the "ReloadFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Reads the features file again (--features) and swaps it in if it
+        /// > changed, reporting what was loaded. Malformed features are skipped and
+        /// > listed, or fail the reload with INVALID_ARGUMENT under --strict-load.
+        /// > Fails with FAILED_PRECONDITION while the dataset is read-only.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_ReloadFeaturesRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_ReloadFeaturesResponse` messages.
+        func reloadFeatures(
+            request: GRPCCore.StreamingServerRequest<Routeguide_ReloadFeaturesRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_ReloadFeaturesResponse>
+
+        /// Handle the "DumpRouteNotes" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Streams every stored route note, live and archived, grouped by location
+        /// > and oldest first within a location.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_DumpRouteNotesRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_RouteNote` messages.
+        func dumpRouteNotes(
+            request: GRPCCore.StreamingServerRequest<Routeguide_DumpRouteNotesRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_RouteNote>
+
+        /// Handle the "ClearRouteNotes" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Deletes the route notes stored at a location, or everywhere, resetting
+        /// > chat state without a restart. Streams already open keep running.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_ClearRouteNotesRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_ClearRouteNotesResponse` messages.
+        func clearRouteNotes(
+            request: GRPCCore.StreamingServerRequest<Routeguide_ClearRouteNotesRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_ClearRouteNotesResponse>
+
+        /// Handle the "CompactRouteNotes" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Deletes the notes evicted from the notes database since it was last
+        /// > compacted and copies it to a file without the pages they freed, like the
+        /// > compaction job. Fails with FAILED_PRECONDITION without --notes-db or
+        /// > while the notes are read-only.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_CompactRouteNotesRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_CompactRouteNotesResponse` messages.
+        func compactRouteNotes(
+            request: GRPCCore.StreamingServerRequest<Routeguide_CompactRouteNotesRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_CompactRouteNotesResponse>
+
+        /// Handle the "RebuildIndexes" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Rebuilds the spatial and hash indexes of the served datasets, then
+        /// > empties the tile and ListFeatures caches. The new indexes are built aside
+        /// > and swapped in, so queries keep running on the old ones meanwhile. Meant
+        /// > for after large bulk imports.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_RebuildIndexesRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_RebuildIndexesResponse` messages.
+        func rebuildIndexes(
+            request: GRPCCore.StreamingServerRequest<Routeguide_RebuildIndexesRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_RebuildIndexesResponse>
+
+        /// Handle the "ListJobs" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Lists the background jobs, scheduled or not, with their last run.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_ListJobsRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_ListJobsResponse` messages.
+        func listJobs(
+            request: GRPCCore.StreamingServerRequest<Routeguide_ListJobsRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_ListJobsResponse>
+
+        /// Handle the "RunJob" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Runs a background job now, whether or not it is scheduled, and returns
+        /// > its status once it finished. Fails with NOT_FOUND for an unknown job and
+        /// > ABORTED while the job is already running.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_RunJobRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_JobStatus` messages.
+        func runJob(
+            request: GRPCCore.StreamingServerRequest<Routeguide_RunJobRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_JobStatus>
+    }
+
+    /// Service protocol for the "routeguide.Admin" service.
+    ///
+    /// This protocol is higher level than ``StreamingServiceProtocol`` but lower level than
+    /// the ``SimpleServiceProtocol``, it provides access to request and response metadata and
+    /// trailing response metadata. If you don't need these then consider using
+    /// the ``SimpleServiceProtocol``. If you need fine grained control over your RPCs then
+    /// use ``StreamingServiceProtocol``.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Operator-only interface exported by the server.
+    /// > 
+    /// > Every method requires a caller with the admin role, such as one sending
+    /// > the admin token as "authorization: Bearer <token>" metadata.
+    public protocol ServiceProtocol: Routeguide_Admin.StreamingServiceProtocol {
+        /// Handle the "DebugDump" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Returns a dump of all goroutine stacks and, optionally, a heap profile.
+        /// > 
+        /// > Intended for diagnosing stuck streams in deployments without shell
+        /// > access.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_DebugDumpRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_DebugDumpResponse` message.
+        func debugDump(
+            request: GRPCCore.ServerRequest<Routeguide_DebugDumpRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_DebugDumpResponse>
+
+        /// Handle the "GetServerInfo" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Returns the server's state.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ServerInfoRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_ServerInfo` message.
+        func getServerInfo(
+            request: GRPCCore.ServerRequest<Routeguide_ServerInfoRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_ServerInfo>
+
+        /// Handle the "SetReadOnly" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Puts the dataset in or out of read-only mode. While read-only, dataset
+        /// > changes (refreshes and feature edits) are rejected with
+        /// > FAILED_PRECONDITION, and the "routeguide.RouteGuide/writes" health
+        /// > service reports NOT_SERVING. Queries are unaffected.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_SetReadOnlyRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_ServerInfo` message.
+        func setReadOnly(
+            request: GRPCCore.ServerRequest<Routeguide_SetReadOnlyRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_ServerInfo>
+
+        /// Handle the "TailLogs" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Streams the server's log entries as they are written, optionally
+        /// > preceded by the most recent ones. Intended for watching server-side
+        /// > events live while debugging a client.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_TailLogsRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_LogEntry` messages.
+        func tailLogs(
+            request: GRPCCore.ServerRequest<Routeguide_TailLogsRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_LogEntry>
+
+        /// Handle the "ReplayRoute" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Replays a stored route file through the RecordRoute logic and returns
+        /// > its summary, for computing statistics from historical data without a
+        /// > live stream. The route is stored like a recorded one, so it can be used
+        /// > with CompareRoutes and EstimateTravelTime.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ReplayRouteRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_RouteSummary` message.
+        func replayRoute(
+            request: GRPCCore.ServerRequest<Routeguide_ReplayRouteRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_RouteSummary>
+
+        /// Handle the "ListPendingFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Lists the feature submissions waiting for review, oldest first.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ListPendingFeaturesRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_ListPendingFeaturesResponse` message.
+        func listPendingFeatures(
+            request: GRPCCore.ServerRequest<Routeguide_ListPendingFeaturesRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_ListPendingFeaturesResponse>
+
+        /// Handle the "ApproveFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Approves a pending feature submission, applying it to the dataset.
+        /// > Fails with NOT_FOUND if no pending submission has the ID, e.g. because it
+        /// > was already reviewed, and with FAILED_PRECONDITION while the dataset is
+        /// > read-only.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ReviewFeatureRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_FeatureSubmission` message.
+        func approveFeature(
+            request: GRPCCore.ServerRequest<Routeguide_ReviewFeatureRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_FeatureSubmission>
+
+        /// Handle the "RejectFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Rejects a pending feature submission. Fails with NOT_FOUND if no pending
+        /// > submission has the ID.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ReviewFeatureRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_FeatureSubmission` message.
+        func rejectFeature(
+            request: GRPCCore.ServerRequest<Routeguide_ReviewFeatureRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_FeatureSubmission>
+
+        /// Handle the "DiffDatasets" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Compares the dataset being served with the one it replaced, to check
+        /// > that a reload did what was expected. Features are matched by location.
+        /// > Fails with FAILED_PRECONDITION if the dataset was never replaced.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_DiffDatasetsRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_DatasetDiff` message.
+        func diffDatasets(
+            request: GRPCCore.ServerRequest<Routeguide_DiffDatasetsRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_DatasetDiff>
+
+        /// Handle the "ListConnections" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Lists the open client connections and the streams open on them, with
+        /// > their callers and message counts, to find misbehaving clients.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ListConnectionsRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_ListConnectionsResponse` message.
+        func listConnections(
+            request: GRPCCore.ServerRequest<Routeguide_ListConnectionsRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_ListConnectionsResponse>
+
+        /// Handle the "TerminateStream" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Ends an open stream, which fails with ABORTED on the client, e.g. one a
+        /// > misbehaving client keeps open. Fails with NOT_FOUND if no open stream
+        /// > has the ID.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_TerminateStreamRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_StreamInfo` message.
+        func terminateStream(
+            request: GRPCCore.ServerRequest<Routeguide_TerminateStreamRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_StreamInfo>
+
+        /// Handle the "ReloadFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Reads the features file again (--features) and swaps it in if it
+        /// > changed, reporting what was loaded. Malformed features are skipped and
+        /// > listed, or fail the reload with INVALID_ARGUMENT under --strict-load.
+        /// > Fails with FAILED_PRECONDITION while the dataset is read-only.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ReloadFeaturesRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_ReloadFeaturesResponse` message.
+        func reloadFeatures(
+            request: GRPCCore.ServerRequest<Routeguide_ReloadFeaturesRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_ReloadFeaturesResponse>
+
+        /// Handle the "DumpRouteNotes" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Streams every stored route note, live and archived, grouped by location
+        /// > and oldest first within a location.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_DumpRouteNotesRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_RouteNote` messages.
+        func dumpRouteNotes(
+            request: GRPCCore.ServerRequest<Routeguide_DumpRouteNotesRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_RouteNote>
+
+        /// Handle the "ClearRouteNotes" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Deletes the route notes stored at a location, or everywhere, resetting
+        /// > chat state without a restart. Streams already open keep running.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ClearRouteNotesRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_ClearRouteNotesResponse` message.
+        func clearRouteNotes(
+            request: GRPCCore.ServerRequest<Routeguide_ClearRouteNotesRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_ClearRouteNotesResponse>
+
+        /// Handle the "CompactRouteNotes" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Deletes the notes evicted from the notes database since it was last
+        /// > compacted and copies it to a file without the pages they freed, like the
+        /// > compaction job. Fails with FAILED_PRECONDITION without --notes-db or
+        /// > while the notes are read-only.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_CompactRouteNotesRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_CompactRouteNotesResponse` message.
+        func compactRouteNotes(
+            request: GRPCCore.ServerRequest<Routeguide_CompactRouteNotesRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_CompactRouteNotesResponse>
+
+        /// Handle the "RebuildIndexes" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Rebuilds the spatial and hash indexes of the served datasets, then
+        /// > empties the tile and ListFeatures caches. The new indexes are built aside
+        /// > and swapped in, so queries keep running on the old ones meanwhile. Meant
+        /// > for after large bulk imports.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_RebuildIndexesRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_RebuildIndexesResponse` message.
+        func rebuildIndexes(
+            request: GRPCCore.ServerRequest<Routeguide_RebuildIndexesRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_RebuildIndexesResponse>
+
+        /// Handle the "ListJobs" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Lists the background jobs, scheduled or not, with their last run.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ListJobsRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_ListJobsResponse` message.
+        func listJobs(
+            request: GRPCCore.ServerRequest<Routeguide_ListJobsRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_ListJobsResponse>
+
+        /// Handle the "RunJob" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Runs a background job now, whether or not it is scheduled, and returns
+        /// > its status once it finished. Fails with NOT_FOUND for an unknown job and
+        /// > ABORTED while the job is already running.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_RunJobRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_JobStatus` message.
+        func runJob(
+            request: GRPCCore.ServerRequest<Routeguide_RunJobRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_JobStatus>
+    }
+
+    /// Simple service protocol for the "routeguide.Admin" service.
+    ///
+    /// This is the highest level protocol for the service. The API is the easiest to use but
+    /// doesn't provide access to request or response metadata. If you need access to these
+    /// then use ``ServiceProtocol`` instead.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Operator-only interface exported by the server.
+    /// > 
+    /// > Every method requires a caller with the admin role, such as one sending
+    /// > the admin token as "authorization: Bearer <token>" metadata.
+    public protocol SimpleServiceProtocol: Routeguide_Admin.ServiceProtocol {
+        /// Handle the "DebugDump" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Returns a dump of all goroutine stacks and, optionally, a heap profile.
+        /// > 
+        /// > Intended for diagnosing stuck streams in deployments without shell
+        /// > access.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_DebugDumpRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_DebugDumpResponse` to respond with.
+        func debugDump(
+            request: Routeguide_DebugDumpRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_DebugDumpResponse
+
+        /// Handle the "GetServerInfo" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Returns the server's state.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_ServerInfoRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_ServerInfo` to respond with.
+        func getServerInfo(
+            request: Routeguide_ServerInfoRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_ServerInfo
+
+        /// Handle the "SetReadOnly" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Puts the dataset in or out of read-only mode. While read-only, dataset
+        /// > changes (refreshes and feature edits) are rejected with
+        /// > FAILED_PRECONDITION, and the "routeguide.RouteGuide/writes" health
+        /// > service reports NOT_SERVING. Queries are unaffected.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_SetReadOnlyRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_ServerInfo` to respond with.
+        func setReadOnly(
+            request: Routeguide_SetReadOnlyRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_ServerInfo
+
+        /// Handle the "TailLogs" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Streams the server's log entries as they are written, optionally
+        /// > preceded by the most recent ones. Intended for watching server-side
+        /// > events live while debugging a client.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_TailLogsRequest` message.
+        ///   - response: A response stream of `Routeguide_LogEntry` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        func tailLogs(
+            request: Routeguide_TailLogsRequest,
+            response: GRPCCore.RPCWriter<Routeguide_LogEntry>,
+            context: GRPCCore.ServerContext
+        ) async throws
+
+        /// Handle the "ReplayRoute" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Replays a stored route file through the RecordRoute logic and returns
+        /// > its summary, for computing statistics from historical data without a
+        /// > live stream. The route is stored like a recorded one, so it can be used
+        /// > with CompareRoutes and EstimateTravelTime.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_ReplayRouteRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_RouteSummary` to respond with.
+        func replayRoute(
+            request: Routeguide_ReplayRouteRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_RouteSummary
+
+        /// Handle the "ListPendingFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Lists the feature submissions waiting for review, oldest first.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_ListPendingFeaturesRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_ListPendingFeaturesResponse` to respond with.
+        func listPendingFeatures(
+            request: Routeguide_ListPendingFeaturesRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_ListPendingFeaturesResponse
+
+        /// Handle the "ApproveFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Approves a pending feature submission, applying it to the dataset.
+        /// > Fails with NOT_FOUND if no pending submission has the ID, e.g. because it
+        /// > was already reviewed, and with FAILED_PRECONDITION while the dataset is
+        /// > read-only.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_ReviewFeatureRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_FeatureSubmission` to respond with.
+        func approveFeature(
+            request: Routeguide_ReviewFeatureRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_FeatureSubmission
+
+        /// Handle the "RejectFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Rejects a pending feature submission. Fails with NOT_FOUND if no pending
+        /// > submission has the ID.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_ReviewFeatureRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_FeatureSubmission` to respond with.
+        func rejectFeature(
+            request: Routeguide_ReviewFeatureRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_FeatureSubmission
+
+        /// Handle the "DiffDatasets" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Compares the dataset being served with the one it replaced, to check
+        /// > that a reload did what was expected. Features are matched by location.
+        /// > Fails with FAILED_PRECONDITION if the dataset was never replaced.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_DiffDatasetsRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_DatasetDiff` to respond with.
+        func diffDatasets(
+            request: Routeguide_DiffDatasetsRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_DatasetDiff
+
+        /// Handle the "ListConnections" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Lists the open client connections and the streams open on them, with
+        /// > their callers and message counts, to find misbehaving clients.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_ListConnectionsRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_ListConnectionsResponse` to respond with.
+        func listConnections(
+            request: Routeguide_ListConnectionsRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_ListConnectionsResponse
+
+        /// Handle the "TerminateStream" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Ends an open stream, which fails with ABORTED on the client, e.g. one a
+        /// > misbehaving client keeps open. Fails with NOT_FOUND if no open stream
+        /// > has the ID.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_TerminateStreamRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_StreamInfo` to respond with.
+        func terminateStream(
+            request: Routeguide_TerminateStreamRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_StreamInfo
+
+        /// Handle the "ReloadFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Reads the features file again (--features) and swaps it in if it
+        /// > changed, reporting what was loaded. Malformed features are skipped and
+        /// > listed, or fail the reload with INVALID_ARGUMENT under --strict-load.
+        /// > Fails with FAILED_PRECONDITION while the dataset is read-only.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_ReloadFeaturesRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_ReloadFeaturesResponse` to respond with.
+        func reloadFeatures(
+            request: Routeguide_ReloadFeaturesRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_ReloadFeaturesResponse
+
+        /// Handle the "DumpRouteNotes" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Streams every stored route note, live and archived, grouped by location
+        /// > and oldest first within a location.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_DumpRouteNotesRequest` message.
+        ///   - response: A response stream of `Routeguide_RouteNote` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        func dumpRouteNotes(
+            request: Routeguide_DumpRouteNotesRequest,
+            response: GRPCCore.RPCWriter<Routeguide_RouteNote>,
+            context: GRPCCore.ServerContext
+        ) async throws
+
+        /// Handle the "ClearRouteNotes" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Deletes the route notes stored at a location, or everywhere, resetting
+        /// > chat state without a restart. Streams already open keep running.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_ClearRouteNotesRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_ClearRouteNotesResponse` to respond with.
+        func clearRouteNotes(
+            request: Routeguide_ClearRouteNotesRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_ClearRouteNotesResponse
+
+        /// Handle the "CompactRouteNotes" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Deletes the notes evicted from the notes database since it was last
+        /// > compacted and copies it to a file without the pages they freed, like the
+        /// > compaction job. Fails with FAILED_PRECONDITION without --notes-db or
+        /// > while the notes are read-only.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_CompactRouteNotesRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_CompactRouteNotesResponse` to respond with.
+        func compactRouteNotes(
+            request: Routeguide_CompactRouteNotesRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_CompactRouteNotesResponse
+
+        /// Handle the "RebuildIndexes" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Rebuilds the spatial and hash indexes of the served datasets, then
+        /// > empties the tile and ListFeatures caches. The new indexes are built aside
+        /// > and swapped in, so queries keep running on the old ones meanwhile. Meant
+        /// > for after large bulk imports.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_RebuildIndexesRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_RebuildIndexesResponse` to respond with.
+        func rebuildIndexes(
+            request: Routeguide_RebuildIndexesRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_RebuildIndexesResponse
+
+        /// Handle the "ListJobs" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Lists the background jobs, scheduled or not, with their last run.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_ListJobsRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_ListJobsResponse` to respond with.
+        func listJobs(
+            request: Routeguide_ListJobsRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_ListJobsResponse
+
+        /// Handle the "RunJob" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Runs a background job now, whether or not it is scheduled, and returns
+        /// > its status once it finished. Fails with NOT_FOUND for an unknown job and
+        /// > ABORTED while the job is already running.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_RunJobRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_JobStatus` to respond with.
+        func runJob(
+            request: Routeguide_RunJobRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_JobStatus
+    }
+}
+
+// Default implementation of 'registerMethods(with:)'.
+@available(macOS 15.0, iOS 18.0, watchOS 11.0, tvOS 18.0, visionOS 2.0, *)
+extension Routeguide_Admin.StreamingServiceProtocol {
+    public func registerMethods<Transport>(with router: inout GRPCCore.RPCRouter<Transport>) where Transport: GRPCCore.ServerTransport {
+        router.registerHandler(
+            forMethod: Routeguide_Admin.Method.DebugDump.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_DebugDumpRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_DebugDumpResponse>(),
+            handler: { request, context in
+                try await self.debugDump(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_Admin.Method.GetServerInfo.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_ServerInfoRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_ServerInfo>(),
+            handler: { request, context in
+                try await self.getServerInfo(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_Admin.Method.SetReadOnly.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_SetReadOnlyRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_ServerInfo>(),
+            handler: { request, context in
+                try await self.setReadOnly(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_Admin.Method.TailLogs.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_TailLogsRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_LogEntry>(),
+            handler: { request, context in
+                try await self.tailLogs(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_Admin.Method.ReplayRoute.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_ReplayRouteRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_RouteSummary>(),
+            handler: { request, context in
+                try await self.replayRoute(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_Admin.Method.ListPendingFeatures.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_ListPendingFeaturesRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_ListPendingFeaturesResponse>(),
+            handler: { request, context in
+                try await self.listPendingFeatures(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_Admin.Method.ApproveFeature.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_ReviewFeatureRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_FeatureSubmission>(),
+            handler: { request, context in
+                try await self.approveFeature(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_Admin.Method.RejectFeature.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_ReviewFeatureRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_FeatureSubmission>(),
+            handler: { request, context in
+                try await self.rejectFeature(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_Admin.Method.DiffDatasets.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_DiffDatasetsRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_DatasetDiff>(),
+            handler: { request, context in
+                try await self.diffDatasets(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_Admin.Method.ListConnections.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_ListConnectionsRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_ListConnectionsResponse>(),
+            handler: { request, context in
+                try await self.listConnections(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_Admin.Method.TerminateStream.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_TerminateStreamRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_StreamInfo>(),
+            handler: { request, context in
+                try await self.terminateStream(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_Admin.Method.ReloadFeatures.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_ReloadFeaturesRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_ReloadFeaturesResponse>(),
+            handler: { request, context in
+                try await self.reloadFeatures(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_Admin.Method.DumpRouteNotes.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_DumpRouteNotesRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_RouteNote>(),
+            handler: { request, context in
+                try await self.dumpRouteNotes(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_Admin.Method.ClearRouteNotes.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_ClearRouteNotesRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_ClearRouteNotesResponse>(),
+            handler: { request, context in
+                try await self.clearRouteNotes(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_Admin.Method.CompactRouteNotes.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_CompactRouteNotesRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_CompactRouteNotesResponse>(),
+            handler: { request, context in
+                try await self.compactRouteNotes(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_Admin.Method.RebuildIndexes.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_RebuildIndexesRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_RebuildIndexesResponse>(),
+            handler: { request, context in
+                try await self.rebuildIndexes(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_Admin.Method.ListJobs.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_ListJobsRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_ListJobsResponse>(),
+            handler: { request, context in
+                try await self.listJobs(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_Admin.Method.RunJob.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_RunJobRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_JobStatus>(),
+            handler: { request, context in
+                try await self.runJob(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+    }
+}
+
+// Default implementation of streaming methods from 'StreamingServiceProtocol'.
+@available(macOS 15.0, iOS 18.0, watchOS 11.0, tvOS 18.0, visionOS 2.0, *)
+extension Routeguide_Admin.ServiceProtocol {
+    public func debugDump(
+        request: GRPCCore.StreamingServerRequest<Routeguide_DebugDumpRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_DebugDumpResponse> {
+        let response = try await self.debugDump(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func getServerInfo(
+        request: GRPCCore.StreamingServerRequest<Routeguide_ServerInfoRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_ServerInfo> {
+        let response = try await self.getServerInfo(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func setReadOnly(
+        request: GRPCCore.StreamingServerRequest<Routeguide_SetReadOnlyRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_ServerInfo> {
+        let response = try await self.setReadOnly(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func tailLogs(
+        request: GRPCCore.StreamingServerRequest<Routeguide_TailLogsRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_LogEntry> {
+        let response = try await self.tailLogs(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return response
+    }
+
+    public func replayRoute(
+        request: GRPCCore.StreamingServerRequest<Routeguide_ReplayRouteRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_RouteSummary> {
+        let response = try await self.replayRoute(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func listPendingFeatures(
+        request: GRPCCore.StreamingServerRequest<Routeguide_ListPendingFeaturesRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_ListPendingFeaturesResponse> {
+        let response = try await self.listPendingFeatures(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func approveFeature(
+        request: GRPCCore.StreamingServerRequest<Routeguide_ReviewFeatureRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_FeatureSubmission> {
+        let response = try await self.approveFeature(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func rejectFeature(
+        request: GRPCCore.StreamingServerRequest<Routeguide_ReviewFeatureRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_FeatureSubmission> {
+        let response = try await self.rejectFeature(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func diffDatasets(
+        request: GRPCCore.StreamingServerRequest<Routeguide_DiffDatasetsRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_DatasetDiff> {
+        let response = try await self.diffDatasets(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func listConnections(
+        request: GRPCCore.StreamingServerRequest<Routeguide_ListConnectionsRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_ListConnectionsResponse> {
+        let response = try await self.listConnections(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func terminateStream(
+        request: GRPCCore.StreamingServerRequest<Routeguide_TerminateStreamRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_StreamInfo> {
+        let response = try await self.terminateStream(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func reloadFeatures(
+        request: GRPCCore.StreamingServerRequest<Routeguide_ReloadFeaturesRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_ReloadFeaturesResponse> {
+        let response = try await self.reloadFeatures(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func dumpRouteNotes(
+        request: GRPCCore.StreamingServerRequest<Routeguide_DumpRouteNotesRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_RouteNote> {
+        let response = try await self.dumpRouteNotes(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return response
+    }
+
+    public func clearRouteNotes(
+        request: GRPCCore.StreamingServerRequest<Routeguide_ClearRouteNotesRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_ClearRouteNotesResponse> {
+        let response = try await self.clearRouteNotes(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func compactRouteNotes(
+        request: GRPCCore.StreamingServerRequest<Routeguide_CompactRouteNotesRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_CompactRouteNotesResponse> {
+        let response = try await self.compactRouteNotes(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func rebuildIndexes(
+        request: GRPCCore.StreamingServerRequest<Routeguide_RebuildIndexesRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_RebuildIndexesResponse> {
+        let response = try await self.rebuildIndexes(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func listJobs(
+        request: GRPCCore.StreamingServerRequest<Routeguide_ListJobsRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_ListJobsResponse> {
+        let response = try await self.listJobs(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func runJob(
+        request: GRPCCore.StreamingServerRequest<Routeguide_RunJobRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_JobStatus> {
+        let response = try await self.runJob(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+}
+
+// Default implementation of methods from 'ServiceProtocol'.
+@available(macOS 15.0, iOS 18.0, watchOS 11.0, tvOS 18.0, visionOS 2.0, *)
+extension Routeguide_Admin.SimpleServiceProtocol {
+    public func debugDump(
+        request: GRPCCore.ServerRequest<Routeguide_DebugDumpRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_DebugDumpResponse> {
+        return GRPCCore.ServerResponse<Routeguide_DebugDumpResponse>(
+            message: try await self.debugDump(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func getServerInfo(
+        request: GRPCCore.ServerRequest<Routeguide_ServerInfoRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_ServerInfo> {
+        return GRPCCore.ServerResponse<Routeguide_ServerInfo>(
+            message: try await self.getServerInfo(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func setReadOnly(
+        request: GRPCCore.ServerRequest<Routeguide_SetReadOnlyRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_ServerInfo> {
+        return GRPCCore.ServerResponse<Routeguide_ServerInfo>(
+            message: try await self.setReadOnly(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func tailLogs(
+        request: GRPCCore.ServerRequest<Routeguide_TailLogsRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_LogEntry> {
+        return GRPCCore.StreamingServerResponse<Routeguide_LogEntry>(
+            metadata: [:],
+            producer: { writer in
+                try await self.tailLogs(
+                    request: request.message,
+                    response: writer,
+                    context: context
+                )
+                return [:]
+            }
+        )
+    }
+
+    public func replayRoute(
+        request: GRPCCore.ServerRequest<Routeguide_ReplayRouteRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_RouteSummary> {
+        return GRPCCore.ServerResponse<Routeguide_RouteSummary>(
+            message: try await self.replayRoute(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func listPendingFeatures(
+        request: GRPCCore.ServerRequest<Routeguide_ListPendingFeaturesRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_ListPendingFeaturesResponse> {
+        return GRPCCore.ServerResponse<Routeguide_ListPendingFeaturesResponse>(
+            message: try await self.listPendingFeatures(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func approveFeature(
+        request: GRPCCore.ServerRequest<Routeguide_ReviewFeatureRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_FeatureSubmission> {
+        return GRPCCore.ServerResponse<Routeguide_FeatureSubmission>(
+            message: try await self.approveFeature(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func rejectFeature(
+        request: GRPCCore.ServerRequest<Routeguide_ReviewFeatureRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_FeatureSubmission> {
+        return GRPCCore.ServerResponse<Routeguide_FeatureSubmission>(
+            message: try await self.rejectFeature(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func diffDatasets(
+        request: GRPCCore.ServerRequest<Routeguide_DiffDatasetsRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_DatasetDiff> {
+        return GRPCCore.ServerResponse<Routeguide_DatasetDiff>(
+            message: try await self.diffDatasets(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func listConnections(
+        request: GRPCCore.ServerRequest<Routeguide_ListConnectionsRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_ListConnectionsResponse> {
+        return GRPCCore.ServerResponse<Routeguide_ListConnectionsResponse>(
+            message: try await self.listConnections(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func terminateStream(
+        request: GRPCCore.ServerRequest<Routeguide_TerminateStreamRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_StreamInfo> {
+        return GRPCCore.ServerResponse<Routeguide_StreamInfo>(
+            message: try await self.terminateStream(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func reloadFeatures(
+        request: GRPCCore.ServerRequest<Routeguide_ReloadFeaturesRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_ReloadFeaturesResponse> {
+        return GRPCCore.ServerResponse<Routeguide_ReloadFeaturesResponse>(
+            message: try await self.reloadFeatures(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func dumpRouteNotes(
+        request: GRPCCore.ServerRequest<Routeguide_DumpRouteNotesRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_RouteNote> {
+        return GRPCCore.StreamingServerResponse<Routeguide_RouteNote>(
+            metadata: [:],
+            producer: { writer in
+                try await self.dumpRouteNotes(
+                    request: request.message,
+                    response: writer,
+                    context: context
+                )
+                return [:]
+            }
+        )
+    }
+
+    public func clearRouteNotes(
+        request: GRPCCore.ServerRequest<Routeguide_ClearRouteNotesRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_ClearRouteNotesResponse> {
+        return GRPCCore.ServerResponse<Routeguide_ClearRouteNotesResponse>(
+            message: try await self.clearRouteNotes(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func compactRouteNotes(
+        request: GRPCCore.ServerRequest<Routeguide_CompactRouteNotesRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_CompactRouteNotesResponse> {
+        return GRPCCore.ServerResponse<Routeguide_CompactRouteNotesResponse>(
+            message: try await self.compactRouteNotes(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func rebuildIndexes(
+        request: GRPCCore.ServerRequest<Routeguide_RebuildIndexesRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_RebuildIndexesResponse> {
+        return GRPCCore.ServerResponse<Routeguide_RebuildIndexesResponse>(
+            message: try await self.rebuildIndexes(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func listJobs(
+        request: GRPCCore.ServerRequest<Routeguide_ListJobsRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_ListJobsResponse> {
+        return GRPCCore.ServerResponse<Routeguide_ListJobsResponse>(
+            message: try await self.listJobs(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func runJob(
+        request: GRPCCore.ServerRequest<Routeguide_RunJobRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_JobStatus> {
+        return GRPCCore.ServerResponse<Routeguide_JobStatus>(
+            message: try await self.runJob(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+}
+
+// MARK: routeguide.Admin (client)
+
+@available(macOS 15.0, iOS 18.0, watchOS 11.0, tvOS 18.0, visionOS 2.0, *)
+extension Routeguide_Admin {
+    /// Generated client protocol for the "routeguide.Admin" service.
+    ///
+    /// You don't need to implement this protocol directly, use the generated
+    /// implementation, ``Client``.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Operator-only interface exported by the server.
+    /// > 
+    /// > Every method requires a caller with the admin role, such as one sending
+    /// > the admin token as "authorization: Bearer <token>" metadata.
+    public protocol ClientProtocol: Sendable {
+        /// Call the "DebugDump" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Returns a dump of all goroutine stacks and, optionally, a heap profile.
+        /// > 
+        /// > Intended for diagnosing stuck streams in deployments without shell
+        /// > access.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_DebugDumpRequest` message.
+        ///   - serializer: A serializer for `Routeguide_DebugDumpRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_DebugDumpResponse` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func debugDump<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_DebugDumpRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_DebugDumpRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_DebugDumpResponse>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_DebugDumpResponse>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "GetServerInfo" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Returns the server's state.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ServerInfoRequest` message.
+        ///   - serializer: A serializer for `Routeguide_ServerInfoRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_ServerInfo` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func getServerInfo<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_ServerInfoRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_ServerInfoRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_ServerInfo>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ServerInfo>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "SetReadOnly" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Puts the dataset in or out of read-only mode. While read-only, dataset
+        /// > changes (refreshes and feature edits) are rejected with
+        /// > FAILED_PRECONDITION, and the "routeguide.RouteGuide/writes" health
+        /// > service reports NOT_SERVING. Queries are unaffected.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_SetReadOnlyRequest` message.
+        ///   - serializer: A serializer for `Routeguide_SetReadOnlyRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_ServerInfo` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func setReadOnly<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_SetReadOnlyRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_SetReadOnlyRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_ServerInfo>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ServerInfo>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "TailLogs" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Streams the server's log entries as they are written, optionally
+        /// > preceded by the most recent ones. Intended for watching server-side
+        /// > events live while debugging a client.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_TailLogsRequest` message.
+        ///   - serializer: A serializer for `Routeguide_TailLogsRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_LogEntry` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func tailLogs<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_TailLogsRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_TailLogsRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_LogEntry>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_LogEntry>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "ReplayRoute" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Replays a stored route file through the RecordRoute logic and returns
+        /// > its summary, for computing statistics from historical data without a
+        /// > live stream. The route is stored like a recorded one, so it can be used
+        /// > with CompareRoutes and EstimateTravelTime.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ReplayRouteRequest` message.
+        ///   - serializer: A serializer for `Routeguide_ReplayRouteRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_RouteSummary` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func replayRoute<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_ReplayRouteRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_ReplayRouteRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_RouteSummary>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_RouteSummary>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "ListPendingFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Lists the feature submissions waiting for review, oldest first.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ListPendingFeaturesRequest` message.
+        ///   - serializer: A serializer for `Routeguide_ListPendingFeaturesRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_ListPendingFeaturesResponse` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func listPendingFeatures<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_ListPendingFeaturesRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_ListPendingFeaturesRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_ListPendingFeaturesResponse>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ListPendingFeaturesResponse>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "ApproveFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Approves a pending feature submission, applying it to the dataset.
+        /// > Fails with NOT_FOUND if no pending submission has the ID, e.g. because it
+        /// > was already reviewed, and with FAILED_PRECONDITION while the dataset is
+        /// > read-only.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ReviewFeatureRequest` message.
+        ///   - serializer: A serializer for `Routeguide_ReviewFeatureRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_FeatureSubmission` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func approveFeature<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_ReviewFeatureRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_ReviewFeatureRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_FeatureSubmission>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeatureSubmission>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "RejectFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Rejects a pending feature submission. Fails with NOT_FOUND if no pending
+        /// > submission has the ID.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ReviewFeatureRequest` message.
+        ///   - serializer: A serializer for `Routeguide_ReviewFeatureRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_FeatureSubmission` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func rejectFeature<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_ReviewFeatureRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_ReviewFeatureRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_FeatureSubmission>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeatureSubmission>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "DiffDatasets" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Compares the dataset being served with the one it replaced, to check
+        /// > that a reload did what was expected. Features are matched by location.
+        /// > Fails with FAILED_PRECONDITION if the dataset was never replaced.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_DiffDatasetsRequest` message.
+        ///   - serializer: A serializer for `Routeguide_DiffDatasetsRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_DatasetDiff` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func diffDatasets<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_DiffDatasetsRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_DiffDatasetsRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_DatasetDiff>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_DatasetDiff>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "ListConnections" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Lists the open client connections and the streams open on them, with
+        /// > their callers and message counts, to find misbehaving clients.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ListConnectionsRequest` message.
+        ///   - serializer: A serializer for `Routeguide_ListConnectionsRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_ListConnectionsResponse` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func listConnections<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_ListConnectionsRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_ListConnectionsRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_ListConnectionsResponse>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ListConnectionsResponse>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "TerminateStream" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Ends an open stream, which fails with ABORTED on the client, e.g. one a
+        /// > misbehaving client keeps open. Fails with NOT_FOUND if no open stream
+        /// > has the ID.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_TerminateStreamRequest` message.
+        ///   - serializer: A serializer for `Routeguide_TerminateStreamRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_StreamInfo` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func terminateStream<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_TerminateStreamRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_TerminateStreamRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_StreamInfo>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_StreamInfo>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "ReloadFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Reads the features file again (--features) and swaps it in if it
+        /// > changed, reporting what was loaded. Malformed features are skipped and
+        /// > listed, or fail the reload with INVALID_ARGUMENT under --strict-load.
+        /// > Fails with FAILED_PRECONDITION while the dataset is read-only.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ReloadFeaturesRequest` message.
+        ///   - serializer: A serializer for `Routeguide_ReloadFeaturesRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_ReloadFeaturesResponse` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func reloadFeatures<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_ReloadFeaturesRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_ReloadFeaturesRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_ReloadFeaturesResponse>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ReloadFeaturesResponse>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "DumpRouteNotes" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Streams every stored route note, live and archived, grouped by location
+        /// > and oldest first within a location.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_DumpRouteNotesRequest` message.
+        ///   - serializer: A serializer for `Routeguide_DumpRouteNotesRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_RouteNote` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func dumpRouteNotes<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_DumpRouteNotesRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_DumpRouteNotesRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_RouteNote>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_RouteNote>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "ClearRouteNotes" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Deletes the route notes stored at a location, or everywhere, resetting
+        /// > chat state without a restart. Streams already open keep running.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ClearRouteNotesRequest` message.
+        ///   - serializer: A serializer for `Routeguide_ClearRouteNotesRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_ClearRouteNotesResponse` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func clearRouteNotes<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_ClearRouteNotesRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_ClearRouteNotesRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_ClearRouteNotesResponse>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ClearRouteNotesResponse>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "CompactRouteNotes" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Deletes the notes evicted from the notes database since it was last
+        /// > compacted and copies it to a file without the pages they freed, like the
+        /// > compaction job. Fails with FAILED_PRECONDITION without --notes-db or
+        /// > while the notes are read-only.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_CompactRouteNotesRequest` message.
+        ///   - serializer: A serializer for `Routeguide_CompactRouteNotesRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_CompactRouteNotesResponse` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func compactRouteNotes<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_CompactRouteNotesRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_CompactRouteNotesRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_CompactRouteNotesResponse>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_CompactRouteNotesResponse>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "RebuildIndexes" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Rebuilds the spatial and hash indexes of the served datasets, then
+        /// > empties the tile and ListFeatures caches. The new indexes are built aside
+        /// > and swapped in, so queries keep running on the old ones meanwhile. Meant
+        /// > for after large bulk imports.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_RebuildIndexesRequest` message.
+        ///   - serializer: A serializer for `Routeguide_RebuildIndexesRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_RebuildIndexesResponse` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func rebuildIndexes<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_RebuildIndexesRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_RebuildIndexesRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_RebuildIndexesResponse>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_RebuildIndexesResponse>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "ListJobs" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Lists the background jobs, scheduled or not, with their last run.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ListJobsRequest` message.
+        ///   - serializer: A serializer for `Routeguide_ListJobsRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_ListJobsResponse` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func listJobs<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_ListJobsRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_ListJobsRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_ListJobsResponse>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ListJobsResponse>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "RunJob" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Runs a background job now, whether or not it is scheduled, and returns
+        /// > its status once it finished. Fails with NOT_FOUND for an unknown job and
+        /// > ABORTED while the job is already running.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_RunJobRequest` message.
+        ///   - serializer: A serializer for `Routeguide_RunJobRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_JobStatus` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func runJob<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_RunJobRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_RunJobRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_JobStatus>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_JobStatus>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+    }
+
+    /// Generated client for the "routeguide.Admin" service.
+    ///
+    /// The ``Client`` provides an implementation of ``ClientProtocol`` which wraps
+    /// a `GRPCCore.GRPCCClient`. The underlying `GRPCClient` provides the long-lived
+    /// means of communication with the remote peer.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Operator-only interface exported by the server.
+    /// > 
+    /// > Every method requires a caller with the admin role, such as one sending
+    /// > the admin token as "authorization: Bearer <token>" metadata.
+    public struct Client<Transport>: ClientProtocol where Transport: GRPCCore.ClientTransport {
+        private let client: GRPCCore.GRPCClient<Transport>
+
+        /// Creates a new client wrapping the provided `GRPCCore.GRPCClient`.
+        ///
+        /// - Parameters:
+        ///   - client: A `GRPCCore.GRPCClient` providing a communication channel to the service.
+        public init(wrapping client: GRPCCore.GRPCClient<Transport>) {
+            self.client = client
+        }
+
+        /// Call the "DebugDump" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Returns a dump of all goroutine stacks and, optionally, a heap profile.
+        /// > 
+        /// > Intended for diagnosing stuck streams in deployments without shell
+        /// > access.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_DebugDumpRequest` message.
+        ///   - serializer: A serializer for `Routeguide_DebugDumpRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_DebugDumpResponse` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func debugDump<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_DebugDumpRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_DebugDumpRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_DebugDumpResponse>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_DebugDumpResponse>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_Admin.Method.DebugDump.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "GetServerInfo" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Returns the server's state.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ServerInfoRequest` message.
+        ///   - serializer: A serializer for `Routeguide_ServerInfoRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_ServerInfo` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func getServerInfo<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_ServerInfoRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_ServerInfoRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_ServerInfo>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ServerInfo>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_Admin.Method.GetServerInfo.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "SetReadOnly" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Puts the dataset in or out of read-only mode. While read-only, dataset
+        /// > changes (refreshes and feature edits) are rejected with
+        /// > FAILED_PRECONDITION, and the "routeguide.RouteGuide/writes" health
+        /// > service reports NOT_SERVING. Queries are unaffected.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_SetReadOnlyRequest` message.
+        ///   - serializer: A serializer for `Routeguide_SetReadOnlyRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_ServerInfo` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func setReadOnly<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_SetReadOnlyRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_SetReadOnlyRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_ServerInfo>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ServerInfo>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_Admin.Method.SetReadOnly.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "TailLogs" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Streams the server's log entries as they are written, optionally
+        /// > preceded by the most recent ones. Intended for watching server-side
+        /// > events live while debugging a client.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_TailLogsRequest` message.
+        ///   - serializer: A serializer for `Routeguide_TailLogsRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_LogEntry` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func tailLogs<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_TailLogsRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_TailLogsRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_LogEntry>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_LogEntry>) async throws -> Result
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.serverStreaming(
+                request: request,
+                descriptor: Routeguide_Admin.Method.TailLogs.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "ReplayRoute" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Replays a stored route file through the RecordRoute logic and returns
+        /// > its summary, for computing statistics from historical data without a
+        /// > live stream. The route is stored like a recorded one, so it can be used
+        /// > with CompareRoutes and EstimateTravelTime.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ReplayRouteRequest` message.
+        ///   - serializer: A serializer for `Routeguide_ReplayRouteRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_RouteSummary` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func replayRoute<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_ReplayRouteRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_ReplayRouteRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_RouteSummary>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_RouteSummary>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_Admin.Method.ReplayRoute.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "ListPendingFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Lists the feature submissions waiting for review, oldest first.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ListPendingFeaturesRequest` message.
+        ///   - serializer: A serializer for `Routeguide_ListPendingFeaturesRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_ListPendingFeaturesResponse` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func listPendingFeatures<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_ListPendingFeaturesRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_ListPendingFeaturesRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_ListPendingFeaturesResponse>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ListPendingFeaturesResponse>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_Admin.Method.ListPendingFeatures.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "ApproveFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Approves a pending feature submission, applying it to the dataset.
+        /// > Fails with NOT_FOUND if no pending submission has the ID, e.g. because it
+        /// > was already reviewed, and with FAILED_PRECONDITION while the dataset is
+        /// > read-only.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ReviewFeatureRequest` message.
+        ///   - serializer: A serializer for `Routeguide_ReviewFeatureRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_FeatureSubmission` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func approveFeature<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_ReviewFeatureRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_ReviewFeatureRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_FeatureSubmission>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeatureSubmission>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_Admin.Method.ApproveFeature.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "RejectFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Rejects a pending feature submission. Fails with NOT_FOUND if no pending
+        /// > submission has the ID.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ReviewFeatureRequest` message.
+        ///   - serializer: A serializer for `Routeguide_ReviewFeatureRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_FeatureSubmission` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func rejectFeature<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_ReviewFeatureRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_ReviewFeatureRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_FeatureSubmission>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeatureSubmission>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_Admin.Method.RejectFeature.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "DiffDatasets" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Compares the dataset being served with the one it replaced, to check
+        /// > that a reload did what was expected. Features are matched by location.
+        /// > Fails with FAILED_PRECONDITION if the dataset was never replaced.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_DiffDatasetsRequest` message.
+        ///   - serializer: A serializer for `Routeguide_DiffDatasetsRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_DatasetDiff` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func diffDatasets<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_DiffDatasetsRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_DiffDatasetsRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_DatasetDiff>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_DatasetDiff>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_Admin.Method.DiffDatasets.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "ListConnections" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Lists the open client connections and the streams open on them, with
+        /// > their callers and message counts, to find misbehaving clients.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ListConnectionsRequest` message.
+        ///   - serializer: A serializer for `Routeguide_ListConnectionsRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_ListConnectionsResponse` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func listConnections<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_ListConnectionsRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_ListConnectionsRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_ListConnectionsResponse>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ListConnectionsResponse>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_Admin.Method.ListConnections.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "TerminateStream" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Ends an open stream, which fails with ABORTED on the client, e.g. one a
+        /// > misbehaving client keeps open. Fails with NOT_FOUND if no open stream
+        /// > has the ID.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_TerminateStreamRequest` message.
+        ///   - serializer: A serializer for `Routeguide_TerminateStreamRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_StreamInfo` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func terminateStream<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_TerminateStreamRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_TerminateStreamRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_StreamInfo>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_StreamInfo>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_Admin.Method.TerminateStream.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "ReloadFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Reads the features file again (--features) and swaps it in if it
+        /// > changed, reporting what was loaded. Malformed features are skipped and
+        /// > listed, or fail the reload with INVALID_ARGUMENT under --strict-load.
+        /// > Fails with FAILED_PRECONDITION while the dataset is read-only.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ReloadFeaturesRequest` message.
+        ///   - serializer: A serializer for `Routeguide_ReloadFeaturesRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_ReloadFeaturesResponse` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func reloadFeatures<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_ReloadFeaturesRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_ReloadFeaturesRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_ReloadFeaturesResponse>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ReloadFeaturesResponse>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_Admin.Method.ReloadFeatures.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "DumpRouteNotes" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Streams every stored route note, live and archived, grouped by location
+        /// > and oldest first within a location.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_DumpRouteNotesRequest` message.
+        ///   - serializer: A serializer for `Routeguide_DumpRouteNotesRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_RouteNote` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func dumpRouteNotes<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_DumpRouteNotesRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_DumpRouteNotesRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_RouteNote>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_RouteNote>) async throws -> Result
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.serverStreaming(
+                request: request,
+                descriptor: Routeguide_Admin.Method.DumpRouteNotes.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "ClearRouteNotes" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Deletes the route notes stored at a location, or everywhere, resetting
+        /// > chat state without a restart. Streams already open keep running.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ClearRouteNotesRequest` message.
+        ///   - serializer: A serializer for `Routeguide_ClearRouteNotesRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_ClearRouteNotesResponse` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func clearRouteNotes<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_ClearRouteNotesRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_ClearRouteNotesRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_ClearRouteNotesResponse>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ClearRouteNotesResponse>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_Admin.Method.ClearRouteNotes.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "CompactRouteNotes" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Deletes the notes evicted from the notes database since it was last
+        /// > compacted and copies it to a file without the pages they freed, like the
+        /// > compaction job. Fails with FAILED_PRECONDITION without --notes-db or
+        /// > while the notes are read-only.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_CompactRouteNotesRequest` message.
+        ///   - serializer: A serializer for `Routeguide_CompactRouteNotesRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_CompactRouteNotesResponse` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func compactRouteNotes<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_CompactRouteNotesRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_CompactRouteNotesRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_CompactRouteNotesResponse>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_CompactRouteNotesResponse>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_Admin.Method.CompactRouteNotes.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "RebuildIndexes" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Rebuilds the spatial and hash indexes of the served datasets, then
+        /// > empties the tile and ListFeatures caches. The new indexes are built aside
+        /// > and swapped in, so queries keep running on the old ones meanwhile. Meant
+        /// > for after large bulk imports.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_RebuildIndexesRequest` message.
+        ///   - serializer: A serializer for `Routeguide_RebuildIndexesRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_RebuildIndexesResponse` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func rebuildIndexes<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_RebuildIndexesRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_RebuildIndexesRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_RebuildIndexesResponse>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_RebuildIndexesResponse>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_Admin.Method.RebuildIndexes.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "ListJobs" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Lists the background jobs, scheduled or not, with their last run.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ListJobsRequest` message.
+        ///   - serializer: A serializer for `Routeguide_ListJobsRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_ListJobsResponse` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func listJobs<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_ListJobsRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_ListJobsRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_ListJobsResponse>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ListJobsResponse>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_Admin.Method.ListJobs.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "RunJob" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > Runs a background job now, whether or not it is scheduled, and returns
+        /// > its status once it finished. Fails with NOT_FOUND for an unknown job and
+        /// > ABORTED while the job is already running.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_RunJobRequest` message.
+        ///   - serializer: A serializer for `Routeguide_RunJobRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_JobStatus` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func runJob<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_RunJobRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_RunJobRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_JobStatus>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_JobStatus>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_Admin.Method.RunJob.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+    }
+}
+
+// Helpers providing default arguments to 'ClientProtocol' methods.
+@available(macOS 15.0, iOS 18.0, watchOS 11.0, tvOS 18.0, visionOS 2.0, *)
+extension Routeguide_Admin.ClientProtocol {
+    /// Call the "DebugDump" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Returns a dump of all goroutine stacks and, optionally, a heap profile.
+    /// > 
+    /// > Intended for diagnosing stuck streams in deployments without shell
+    /// > access.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_DebugDumpRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func debugDump<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_DebugDumpRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_DebugDumpResponse>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.debugDump(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_DebugDumpRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_DebugDumpResponse>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "GetServerInfo" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Returns the server's state.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_ServerInfoRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func getServerInfo<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_ServerInfoRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ServerInfo>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.getServerInfo(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_ServerInfoRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_ServerInfo>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "SetReadOnly" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Puts the dataset in or out of read-only mode. While read-only, dataset
+    /// > changes (refreshes and feature edits) are rejected with
+    /// > FAILED_PRECONDITION, and the "routeguide.RouteGuide/writes" health
+    /// > service reports NOT_SERVING. Queries are unaffected.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_SetReadOnlyRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func setReadOnly<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_SetReadOnlyRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ServerInfo>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.setReadOnly(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_SetReadOnlyRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_ServerInfo>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "TailLogs" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Streams the server's log entries as they are written, optionally
+    /// > preceded by the most recent ones. Intended for watching server-side
+    /// > events live while debugging a client.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_TailLogsRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func tailLogs<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_TailLogsRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_LogEntry>) async throws -> Result
+    ) async throws -> Result where Result: Sendable {
+        try await self.tailLogs(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_TailLogsRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_LogEntry>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ReplayRoute" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Replays a stored route file through the RecordRoute logic and returns
+    /// > its summary, for computing statistics from historical data without a
+    /// > live stream. The route is stored like a recorded one, so it can be used
+    /// > with CompareRoutes and EstimateTravelTime.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_ReplayRouteRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func replayRoute<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_ReplayRouteRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_RouteSummary>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.replayRoute(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_ReplayRouteRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_RouteSummary>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ListPendingFeatures" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Lists the feature submissions waiting for review, oldest first.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_ListPendingFeaturesRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func listPendingFeatures<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_ListPendingFeaturesRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ListPendingFeaturesResponse>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.listPendingFeatures(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_ListPendingFeaturesRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_ListPendingFeaturesResponse>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ApproveFeature" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Approves a pending feature submission, applying it to the dataset.
+    /// > Fails with NOT_FOUND if no pending submission has the ID, e.g. because it
+    /// > was already reviewed, and with FAILED_PRECONDITION while the dataset is
+    /// > read-only.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_ReviewFeatureRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func approveFeature<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_ReviewFeatureRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeatureSubmission>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.approveFeature(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_ReviewFeatureRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_FeatureSubmission>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "RejectFeature" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Rejects a pending feature submission. Fails with NOT_FOUND if no pending
+    /// > submission has the ID.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_ReviewFeatureRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func rejectFeature<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_ReviewFeatureRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeatureSubmission>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.rejectFeature(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_ReviewFeatureRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_FeatureSubmission>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "DiffDatasets" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Compares the dataset being served with the one it replaced, to check
+    /// > that a reload did what was expected. Features are matched by location.
+    /// > Fails with FAILED_PRECONDITION if the dataset was never replaced.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_DiffDatasetsRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func diffDatasets<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_DiffDatasetsRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_DatasetDiff>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.diffDatasets(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_DiffDatasetsRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_DatasetDiff>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ListConnections" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Lists the open client connections and the streams open on them, with
+    /// > their callers and message counts, to find misbehaving clients.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_ListConnectionsRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func listConnections<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_ListConnectionsRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ListConnectionsResponse>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.listConnections(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_ListConnectionsRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_ListConnectionsResponse>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "TerminateStream" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Ends an open stream, which fails with ABORTED on the client, e.g. one a
+    /// > misbehaving client keeps open. Fails with NOT_FOUND if no open stream
+    /// > has the ID.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_TerminateStreamRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func terminateStream<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_TerminateStreamRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_StreamInfo>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.terminateStream(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_TerminateStreamRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_StreamInfo>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ReloadFeatures" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Reads the features file again (--features) and swaps it in if it
+    /// > changed, reporting what was loaded. Malformed features are skipped and
+    /// > listed, or fail the reload with INVALID_ARGUMENT under --strict-load.
+    /// > Fails with FAILED_PRECONDITION while the dataset is read-only.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_ReloadFeaturesRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func reloadFeatures<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_ReloadFeaturesRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ReloadFeaturesResponse>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.reloadFeatures(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_ReloadFeaturesRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_ReloadFeaturesResponse>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "DumpRouteNotes" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Streams every stored route note, live and archived, grouped by location
+    /// > and oldest first within a location.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_DumpRouteNotesRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func dumpRouteNotes<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_DumpRouteNotesRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_RouteNote>) async throws -> Result
+    ) async throws -> Result where Result: Sendable {
+        try await self.dumpRouteNotes(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_DumpRouteNotesRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_RouteNote>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ClearRouteNotes" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Deletes the route notes stored at a location, or everywhere, resetting
+    /// > chat state without a restart. Streams already open keep running.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_ClearRouteNotesRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func clearRouteNotes<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_ClearRouteNotesRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ClearRouteNotesResponse>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.clearRouteNotes(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_ClearRouteNotesRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_ClearRouteNotesResponse>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "CompactRouteNotes" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Deletes the notes evicted from the notes database since it was last
+    /// > compacted and copies it to a file without the pages they freed, like the
+    /// > compaction job. Fails with FAILED_PRECONDITION without --notes-db or
+    /// > while the notes are read-only.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_CompactRouteNotesRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func compactRouteNotes<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_CompactRouteNotesRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_CompactRouteNotesResponse>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.compactRouteNotes(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_CompactRouteNotesRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_CompactRouteNotesResponse>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "RebuildIndexes" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Rebuilds the spatial and hash indexes of the served datasets, then
+    /// > empties the tile and ListFeatures caches. The new indexes are built aside
+    /// > and swapped in, so queries keep running on the old ones meanwhile. Meant
+    /// > for after large bulk imports.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_RebuildIndexesRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func rebuildIndexes<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_RebuildIndexesRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_RebuildIndexesResponse>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.rebuildIndexes(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_RebuildIndexesRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_RebuildIndexesResponse>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ListJobs" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Lists the background jobs, scheduled or not, with their last run.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_ListJobsRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func listJobs<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_ListJobsRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ListJobsResponse>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.listJobs(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_ListJobsRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_ListJobsResponse>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "RunJob" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Runs a background job now, whether or not it is scheduled, and returns
+    /// > its status once it finished. Fails with NOT_FOUND for an unknown job and
+    /// > ABORTED while the job is already running.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_RunJobRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func runJob<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_RunJobRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_JobStatus>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.runJob(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_RunJobRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_JobStatus>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+}
+
+// Helpers providing sugared APIs for 'ClientProtocol' methods.
+@available(macOS 15.0, iOS 18.0, watchOS 11.0, tvOS 18.0, visionOS 2.0, *)
+extension Routeguide_Admin.ClientProtocol {
+    /// Call the "DebugDump" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Returns a dump of all goroutine stacks and, optionally, a heap profile.
+    /// > 
+    /// > Intended for diagnosing stuck streams in deployments without shell
+    /// > access.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func debugDump<Result>(
+        _ message: Routeguide_DebugDumpRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_DebugDumpResponse>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_DebugDumpRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.debugDump(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "GetServerInfo" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Returns the server's state.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func getServerInfo<Result>(
+        _ message: Routeguide_ServerInfoRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ServerInfo>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_ServerInfoRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.getServerInfo(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "SetReadOnly" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Puts the dataset in or out of read-only mode. While read-only, dataset
+    /// > changes (refreshes and feature edits) are rejected with
+    /// > FAILED_PRECONDITION, and the "routeguide.RouteGuide/writes" health
+    /// > service reports NOT_SERVING. Queries are unaffected.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func setReadOnly<Result>(
+        _ message: Routeguide_SetReadOnlyRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ServerInfo>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_SetReadOnlyRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.setReadOnly(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "TailLogs" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Streams the server's log entries as they are written, optionally
+    /// > preceded by the most recent ones. Intended for watching server-side
+    /// > events live while debugging a client.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func tailLogs<Result>(
+        _ message: Routeguide_TailLogsRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_LogEntry>) async throws -> Result
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_TailLogsRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.tailLogs(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ReplayRoute" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Replays a stored route file through the RecordRoute logic and returns
+    /// > its summary, for computing statistics from historical data without a
+    /// > live stream. The route is stored like a recorded one, so it can be used
+    /// > with CompareRoutes and EstimateTravelTime.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func replayRoute<Result>(
+        _ message: Routeguide_ReplayRouteRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_RouteSummary>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_ReplayRouteRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.replayRoute(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ListPendingFeatures" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Lists the feature submissions waiting for review, oldest first.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func listPendingFeatures<Result>(
+        _ message: Routeguide_ListPendingFeaturesRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ListPendingFeaturesResponse>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_ListPendingFeaturesRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.listPendingFeatures(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ApproveFeature" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Approves a pending feature submission, applying it to the dataset.
+    /// > Fails with NOT_FOUND if no pending submission has the ID, e.g. because it
+    /// > was already reviewed, and with FAILED_PRECONDITION while the dataset is
+    /// > read-only.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func approveFeature<Result>(
+        _ message: Routeguide_ReviewFeatureRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeatureSubmission>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_ReviewFeatureRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.approveFeature(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "RejectFeature" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Rejects a pending feature submission. Fails with NOT_FOUND if no pending
+    /// > submission has the ID.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func rejectFeature<Result>(
+        _ message: Routeguide_ReviewFeatureRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeatureSubmission>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_ReviewFeatureRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.rejectFeature(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "DiffDatasets" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Compares the dataset being served with the one it replaced, to check
+    /// > that a reload did what was expected. Features are matched by location.
+    /// > Fails with FAILED_PRECONDITION if the dataset was never replaced.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func diffDatasets<Result>(
+        _ message: Routeguide_DiffDatasetsRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_DatasetDiff>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_DiffDatasetsRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.diffDatasets(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ListConnections" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Lists the open client connections and the streams open on them, with
+    /// > their callers and message counts, to find misbehaving clients.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func listConnections<Result>(
+        _ message: Routeguide_ListConnectionsRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ListConnectionsResponse>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_ListConnectionsRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.listConnections(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "TerminateStream" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Ends an open stream, which fails with ABORTED on the client, e.g. one a
+    /// > misbehaving client keeps open. Fails with NOT_FOUND if no open stream
+    /// > has the ID.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func terminateStream<Result>(
+        _ message: Routeguide_TerminateStreamRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_StreamInfo>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_TerminateStreamRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.terminateStream(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ReloadFeatures" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Reads the features file again (--features) and swaps it in if it
+    /// > changed, reporting what was loaded. Malformed features are skipped and
+    /// > listed, or fail the reload with INVALID_ARGUMENT under --strict-load.
+    /// > Fails with FAILED_PRECONDITION while the dataset is read-only.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func reloadFeatures<Result>(
+        _ message: Routeguide_ReloadFeaturesRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ReloadFeaturesResponse>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_ReloadFeaturesRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.reloadFeatures(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "DumpRouteNotes" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Streams every stored route note, live and archived, grouped by location
+    /// > and oldest first within a location.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func dumpRouteNotes<Result>(
+        _ message: Routeguide_DumpRouteNotesRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_RouteNote>) async throws -> Result
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_DumpRouteNotesRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.dumpRouteNotes(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ClearRouteNotes" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Deletes the route notes stored at a location, or everywhere, resetting
+    /// > chat state without a restart. Streams already open keep running.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func clearRouteNotes<Result>(
+        _ message: Routeguide_ClearRouteNotesRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ClearRouteNotesResponse>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_ClearRouteNotesRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.clearRouteNotes(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "CompactRouteNotes" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Deletes the notes evicted from the notes database since it was last
+    /// > compacted and copies it to a file without the pages they freed, like the
+    /// > compaction job. Fails with FAILED_PRECONDITION without --notes-db or
+    /// > while the notes are read-only.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func compactRouteNotes<Result>(
+        _ message: Routeguide_CompactRouteNotesRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_CompactRouteNotesResponse>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_CompactRouteNotesRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.compactRouteNotes(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "RebuildIndexes" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Rebuilds the spatial and hash indexes of the served datasets, then
+    /// > empties the tile and ListFeatures caches. The new indexes are built aside
+    /// > and swapped in, so queries keep running on the old ones meanwhile. Meant
+    /// > for after large bulk imports.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func rebuildIndexes<Result>(
+        _ message: Routeguide_RebuildIndexesRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_RebuildIndexesResponse>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_RebuildIndexesRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.rebuildIndexes(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ListJobs" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Lists the background jobs, scheduled or not, with their last run.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func listJobs<Result>(
+        _ message: Routeguide_ListJobsRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ListJobsResponse>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_ListJobsRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.listJobs(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "RunJob" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Runs a background job now, whether or not it is scheduled, and returns
+    /// > its status once it finished. Fails with NOT_FOUND for an unknown job and
+    /// > ABORTED while the job is already running.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func runJob<Result>(
+        _ message: Routeguide_RunJobRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_JobStatus>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_RunJobRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.runJob(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+}
\ No newline at end of file
@@ -32,6 +32,18 @@ public enum Routeguide_RouteGuide {
                 method: "GetFeature"
             )
         }
+        /// Namespace for "GetFeatureFast" metadata.
+        public enum GetFeatureFast {
+            /// Request type for "GetFeatureFast".
+            public typealias Input = Routeguide_Point
+            /// Response type for "GetFeatureFast".
+            public typealias Output = Routeguide_Feature
+            /// Descriptor for "GetFeatureFast".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.RouteGuide"),
+                method: "GetFeatureFast"
+            )
+        }
         /// Namespace for "ListFeatures" metadata.
         public enum ListFeatures {
             /// Request type for "ListFeatures".
@@ -44,6 +56,18 @@ public enum Routeguide_RouteGuide {
                 method: "ListFeatures"
             )
         }
+        /// Namespace for "ListFeaturesPage" metadata.
+        public enum ListFeaturesPage {
+            /// Request type for "ListFeaturesPage".
+            public typealias Input = Routeguide_ListFeaturesPageRequest
+            /// Response type for "ListFeaturesPage".
+            public typealias Output = Routeguide_FeaturePage
+            /// Descriptor for "ListFeaturesPage".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.RouteGuide"),
+                method: "ListFeaturesPage"
+            )
+        }
         /// Namespace for "RecordRoute" metadata.
         public enum RecordRoute {
             /// Request type for "RecordRoute".
@@ -68,12 +92,248 @@ public enum Routeguide_RouteGuide {
                 method: "RouteChat"
             )
         }
+        /// Namespace for "ListNoteHistory" metadata.
+        public enum ListNoteHistory {
+            /// Request type for "ListNoteHistory".
+            public typealias Input = Routeguide_NoteHistoryRequest
+            /// Response type for "ListNoteHistory".
+            public typealias Output = Routeguide_NoteHistoryPage
+            /// Descriptor for "ListNoteHistory".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.RouteGuide"),
+                method: "ListNoteHistory"
+            )
+        }
+        /// Namespace for "ComputeIsochrone" metadata.
+        public enum ComputeIsochrone {
+            /// Request type for "ComputeIsochrone".
+            public typealias Input = Routeguide_IsochroneRequest
+            /// Response type for "ComputeIsochrone".
+            public typealias Output = Routeguide_IsochroneRing
+            /// Descriptor for "ComputeIsochrone".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.RouteGuide"),
+                method: "ComputeIsochrone"
+            )
+        }
+        /// Namespace for "CompareRoutes" metadata.
+        public enum CompareRoutes {
+            /// Request type for "CompareRoutes".
+            public typealias Input = Routeguide_CompareRoutesRequest
+            /// Response type for "CompareRoutes".
+            public typealias Output = Routeguide_RouteComparison
+            /// Descriptor for "CompareRoutes".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.RouteGuide"),
+                method: "CompareRoutes"
+            )
+        }
+        /// Namespace for "EstimateTravelTime" metadata.
+        public enum EstimateTravelTime {
+            /// Request type for "EstimateTravelTime".
+            public typealias Input = Routeguide_TravelTimeRequest
+            /// Response type for "EstimateTravelTime".
+            public typealias Output = Routeguide_TravelTimeEstimate
+            /// Descriptor for "EstimateTravelTime".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.RouteGuide"),
+                method: "EstimateTravelTime"
+            )
+        }
+        /// Namespace for "WatchFeatures" metadata.
+        public enum WatchFeatures {
+            /// Request type for "WatchFeatures".
+            public typealias Input = Routeguide_WatchFeaturesRequest
+            /// Response type for "WatchFeatures".
+            public typealias Output = Routeguide_FeatureEvent
+            /// Descriptor for "WatchFeatures".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.RouteGuide"),
+                method: "WatchFeatures"
+            )
+        }
+        /// Namespace for "SyncFeatures" metadata.
+        public enum SyncFeatures {
+            /// Request type for "SyncFeatures".
+            public typealias Input = Routeguide_SyncRequest
+            /// Response type for "SyncFeatures".
+            public typealias Output = Routeguide_SyncMessage
+            /// Descriptor for "SyncFeatures".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.RouteGuide"),
+                method: "SyncFeatures"
+            )
+        }
+        /// Namespace for "GetDatasetStats" metadata.
+        public enum GetDatasetStats {
+            /// Request type for "GetDatasetStats".
+            public typealias Input = Routeguide_DatasetStatsRequest
+            /// Response type for "GetDatasetStats".
+            public typealias Output = Routeguide_DatasetStats
+            /// Descriptor for "GetDatasetStats".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.RouteGuide"),
+                method: "GetDatasetStats"
+            )
+        }
+        /// Namespace for "GetChatActivity" metadata.
+        public enum GetChatActivity {
+            /// Request type for "GetChatActivity".
+            public typealias Input = Routeguide_ChatActivityRequest
+            /// Response type for "GetChatActivity".
+            public typealias Output = Routeguide_ChatActivity
+            /// Descriptor for "GetChatActivity".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.RouteGuide"),
+                method: "GetChatActivity"
+            )
+        }
+        /// Namespace for "DownloadRegionBundle" metadata.
+        public enum DownloadRegionBundle {
+            /// Request type for "DownloadRegionBundle".
+            public typealias Input = Routeguide_BundleRequest
+            /// Response type for "DownloadRegionBundle".
+            public typealias Output = Routeguide_BundleChunk
+            /// Descriptor for "DownloadRegionBundle".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.RouteGuide"),
+                method: "DownloadRegionBundle"
+            )
+        }
+        /// Namespace for "SnapToNearestFeature" metadata.
+        public enum SnapToNearestFeature {
+            /// Request type for "SnapToNearestFeature".
+            public typealias Input = Routeguide_SnapRequest
+            /// Response type for "SnapToNearestFeature".
+            public typealias Output = Routeguide_SnapResult
+            /// Descriptor for "SnapToNearestFeature".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.RouteGuide"),
+                method: "SnapToNearestFeature"
+            )
+        }
+        /// Namespace for "NearestFeatures" metadata.
+        public enum NearestFeatures {
+            /// Request type for "NearestFeatures".
+            public typealias Input = Routeguide_NearestFeaturesRequest
+            /// Response type for "NearestFeatures".
+            public typealias Output = Routeguide_NearestFeaturesResponse
+            /// Descriptor for "NearestFeatures".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.RouteGuide"),
+                method: "NearestFeatures"
+            )
+        }
+        /// Namespace for "ListFeaturesInRadius" metadata.
+        public enum ListFeaturesInRadius {
+            /// Request type for "ListFeaturesInRadius".
+            public typealias Input = Routeguide_RadiusRequest
+            /// Response type for "ListFeaturesInRadius".
+            public typealias Output = Routeguide_NearestFeature
+            /// Descriptor for "ListFeaturesInRadius".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.RouteGuide"),
+                method: "ListFeaturesInRadius"
+            )
+        }
+        /// Namespace for "ListFeaturesInPolygon" metadata.
+        public enum ListFeaturesInPolygon {
+            /// Request type for "ListFeaturesInPolygon".
+            public typealias Input = Routeguide_Polygon
+            /// Response type for "ListFeaturesInPolygon".
+            public typealias Output = Routeguide_Feature
+            /// Descriptor for "ListFeaturesInPolygon".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.RouteGuide"),
+                method: "ListFeaturesInPolygon"
+            )
+        }
+        /// Namespace for "SubmitFeature" metadata.
+        public enum SubmitFeature {
+            /// Request type for "SubmitFeature".
+            public typealias Input = Routeguide_SubmitFeatureRequest
+            /// Response type for "SubmitFeature".
+            public typealias Output = Routeguide_FeatureSubmission
+            /// Descriptor for "SubmitFeature".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.RouteGuide"),
+                method: "SubmitFeature"
+            )
+        }
+        /// Namespace for "CreateFeature" metadata.
+        public enum CreateFeature {
+            /// Request type for "CreateFeature".
+            public typealias Input = Routeguide_CreateFeatureRequest
+            /// Response type for "CreateFeature".
+            public typealias Output = Routeguide_FeatureMutation
+            /// Descriptor for "CreateFeature".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.RouteGuide"),
+                method: "CreateFeature"
+            )
+        }
+        /// Namespace for "UpdateFeature" metadata.
+        public enum UpdateFeature {
+            /// Request type for "UpdateFeature".
+            public typealias Input = Routeguide_UpdateFeatureRequest
+            /// Response type for "UpdateFeature".
+            public typealias Output = Routeguide_FeatureMutation
+            /// Descriptor for "UpdateFeature".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.RouteGuide"),
+                method: "UpdateFeature"
+            )
+        }
+        /// Namespace for "DeleteFeature" metadata.
+        public enum DeleteFeature {
+            /// Request type for "DeleteFeature".
+            public typealias Input = Routeguide_DeleteFeatureRequest
+            /// Response type for "DeleteFeature".
+            public typealias Output = Routeguide_FeatureMutation
+            /// Descriptor for "DeleteFeature".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.RouteGuide"),
+                method: "DeleteFeature"
+            )
+        }
+        /// Namespace for "GetClientConfig" metadata.
+        public enum GetClientConfig {
+            /// Request type for "GetClientConfig".
+            public typealias Input = Routeguide_GetClientConfigRequest
+            /// Response type for "GetClientConfig".
+            public typealias Output = Routeguide_ClientConfig
+            /// Descriptor for "GetClientConfig".
+            public static let descriptor = GRPCCore.MethodDescriptor(
+                service: GRPCCore.ServiceDescriptor(fullyQualifiedService: "routeguide.RouteGuide"),
+                method: "GetClientConfig"
+            )
+        }
         /// Descriptors for all methods in the "routeguide.RouteGuide" service.
         public static let descriptors: [GRPCCore.MethodDescriptor] = [
             GetFeature.descriptor,
+            GetFeatureFast.descriptor,
             ListFeatures.descriptor,
+            ListFeaturesPage.descriptor,
             RecordRoute.descriptor,
-            RouteChat.descriptor
+            RouteChat.descriptor,
+            ListNoteHistory.descriptor,
+            ComputeIsochrone.descriptor,
+            CompareRoutes.descriptor,
+            EstimateTravelTime.descriptor,
+            WatchFeatures.descriptor,
+            SyncFeatures.descriptor,
+            GetDatasetStats.descriptor,
+            GetChatActivity.descriptor,
+            DownloadRegionBundle.descriptor,
+            SnapToNearestFeature.descriptor,
+            NearestFeatures.descriptor,
+            ListFeaturesInRadius.descriptor,
+            ListFeaturesInPolygon.descriptor,
+            SubmitFeature.descriptor,
+            CreateFeature.descriptor,
+            UpdateFeature.descriptor,
+            DeleteFeature.descriptor,
+            GetClientConfig.descriptor
         ]
     }
 }
@@ -126,6 +386,28 @@ extension Routeguide_RouteGuide {
             context: GRPCCore.ServerContext
         ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_Feature>
 
+        /// Handle the "GetFeatureFast" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC with server-side hedging.
+        /// > 
+        /// > Behaves like GetFeature, but the lookup is fanned out to several storage
+        /// > replicas and the first successful response wins. Slow or failing replicas
+        /// > are cancelled once an answer is available.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_Point` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_Feature` messages.
+        func getFeatureFast(
+            request: GRPCCore.StreamingServerRequest<Routeguide_Point>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_Feature>
+
         /// Handle the "ListFeatures" method.
         ///
         /// > Source IDL Documentation:
@@ -135,7 +417,9 @@ extension Routeguide_RouteGuide {
         /// > Obtains the Features available within the given Rectangle.  Results are
         /// > streamed rather than returned at once (e.g. in a response message with a
         /// > repeated field), as the rectangle may cover a large area and contain a
-        /// > huge number of features.
+        /// > huge number of features. A stream sends the features of the dataset
+        /// > version current when it started, whatever changes meanwhile. The
+        /// > rectangle's name_filter narrows them down by name.
         ///
         /// - Parameters:
         ///   - request: A streaming request of `Routeguide_Rectangle` messages.
@@ -149,6 +433,28 @@ extension Routeguide_RouteGuide {
             context: GRPCCore.ServerContext
         ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_Feature>
 
+        /// Handle the "ListFeaturesPage" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Lists the features within a rectangle a page at a time, ordered by
+        /// > location. Page tokens remain valid when the dataset changes between
+        /// > pages: features present throughout are neither skipped nor repeated.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_ListFeaturesPageRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_FeaturePage` messages.
+        func listFeaturesPage(
+            request: GRPCCore.StreamingServerRequest<Routeguide_ListFeaturesPageRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_FeaturePage>
+
         /// Handle the "RecordRoute" method.
         ///
         /// > Source IDL Documentation:
@@ -190,506 +496,410 @@ extension Routeguide_RouteGuide {
             request: GRPCCore.StreamingServerRequest<Routeguide_RouteNote>,
             context: GRPCCore.ServerContext
         ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_RouteNote>
-    }
 
-    /// Service protocol for the "routeguide.RouteGuide" service.
-    ///
-    /// This protocol is higher level than ``StreamingServiceProtocol`` but lower level than
-    /// the ``SimpleServiceProtocol``, it provides access to request and response metadata and
-    /// trailing response metadata. If you don't need these then consider using
-    /// the ``SimpleServiceProtocol``. If you need fine grained control over your RPCs then
-    /// use ``StreamingServiceProtocol``.
-    ///
-    /// > Source IDL Documentation:
-    /// >
-    /// > Interface exported by the server.
-    public protocol ServiceProtocol: Routeguide_RouteGuide.StreamingServiceProtocol {
-        /// Handle the "GetFeature" method.
+        /// Handle the "ListNoteHistory" method.
         ///
         /// > Source IDL Documentation:
         /// >
         /// > A simple RPC.
         /// > 
-        /// > Obtains the feature at a given position.
-        /// > 
-        /// > A feature with an empty name is returned if there's no feature at the given
-        /// > position.
+        /// > Returns a page of the notes ever sent at a location, oldest first,
+        /// > including notes that are no longer replayed by RouteChat.
         ///
         /// - Parameters:
-        ///   - request: A request containing a single `Routeguide_Point` message.
+        ///   - request: A streaming request of `Routeguide_NoteHistoryRequest` messages.
         ///   - context: Context providing information about the RPC.
         /// - Throws: Any error which occurred during the processing of the request. Thrown errors
         ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
         ///     to an internal error.
-        /// - Returns: A response containing a single `Routeguide_Feature` message.
-        func getFeature(
-            request: GRPCCore.ServerRequest<Routeguide_Point>,
+        /// - Returns: A streaming response of `Routeguide_NoteHistoryPage` messages.
+        func listNoteHistory(
+            request: GRPCCore.StreamingServerRequest<Routeguide_NoteHistoryRequest>,
             context: GRPCCore.ServerContext
-        ) async throws -> GRPCCore.ServerResponse<Routeguide_Feature>
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_NoteHistoryPage>
 
-        /// Handle the "ListFeatures" method.
+        /// Handle the "ComputeIsochrone" method.
         ///
         /// > Source IDL Documentation:
         /// >
         /// > A server-to-client streaming RPC.
         /// > 
-        /// > Obtains the Features available within the given Rectangle.  Results are
-        /// > streamed rather than returned at once (e.g. in a response message with a
-        /// > repeated field), as the rectangle may cover a large area and contain a
-        /// > huge number of features.
+        /// > Computes the area reachable from a center point within a time budget,
+        /// > travelling straight lines between known features with the given profile.
+        /// > The area is streamed as closed polygon rings, one per time band, from the
+        /// > smallest band to the full duration.
         ///
         /// - Parameters:
-        ///   - request: A request containing a single `Routeguide_Rectangle` message.
+        ///   - request: A streaming request of `Routeguide_IsochroneRequest` messages.
         ///   - context: Context providing information about the RPC.
         /// - Throws: Any error which occurred during the processing of the request. Thrown errors
         ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
         ///     to an internal error.
-        /// - Returns: A streaming response of `Routeguide_Feature` messages.
-        func listFeatures(
-            request: GRPCCore.ServerRequest<Routeguide_Rectangle>,
+        /// - Returns: A streaming response of `Routeguide_IsochroneRing` messages.
+        func computeIsochrone(
+            request: GRPCCore.StreamingServerRequest<Routeguide_IsochroneRequest>,
             context: GRPCCore.ServerContext
-        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_Feature>
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_IsochroneRing>
 
-        /// Handle the "RecordRoute" method.
+        /// Handle the "CompareRoutes" method.
         ///
         /// > Source IDL Documentation:
         /// >
-        /// > A client-to-server streaming RPC.
+        /// > A simple RPC.
         /// > 
-        /// > Accepts a stream of Points on a route being traversed, returning a
-        /// > RouteSummary when traversal is completed.
+        /// > Compares two routes, each given either as the ID of a route previously
+        /// > recorded with RecordRoute or as an inline list of points.
         ///
         /// - Parameters:
-        ///   - request: A streaming request of `Routeguide_Point` messages.
+        ///   - request: A streaming request of `Routeguide_CompareRoutesRequest` messages.
         ///   - context: Context providing information about the RPC.
         /// - Throws: Any error which occurred during the processing of the request. Thrown errors
         ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
         ///     to an internal error.
-        /// - Returns: A response containing a single `Routeguide_RouteSummary` message.
-        func recordRoute(
-            request: GRPCCore.StreamingServerRequest<Routeguide_Point>,
+        /// - Returns: A streaming response of `Routeguide_RouteComparison` messages.
+        func compareRoutes(
+            request: GRPCCore.StreamingServerRequest<Routeguide_CompareRoutesRequest>,
             context: GRPCCore.ServerContext
-        ) async throws -> GRPCCore.ServerResponse<Routeguide_RouteSummary>
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_RouteComparison>
 
-        /// Handle the "RouteChat" method.
+        /// Handle the "EstimateTravelTime" method.
         ///
         /// > Source IDL Documentation:
         /// >
-        /// > A Bidirectional streaming RPC.
+        /// > A simple RPC.
         /// > 
-        /// > Accepts a stream of RouteNotes sent while a route is being traversed,
-        /// > while receiving other RouteNotes (e.g. from other users).
+        /// > Estimates how long it takes to travel between two points with a given
+        /// > profile, based on previously recorded routes passing near both points.
+        /// > Falls back to a straight-line heuristic when there is no history.
         ///
         /// - Parameters:
-        ///   - request: A streaming request of `Routeguide_RouteNote` messages.
+        ///   - request: A streaming request of `Routeguide_TravelTimeRequest` messages.
         ///   - context: Context providing information about the RPC.
         /// - Throws: Any error which occurred during the processing of the request. Thrown errors
         ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
         ///     to an internal error.
-        /// - Returns: A streaming response of `Routeguide_RouteNote` messages.
-        func routeChat(
-            request: GRPCCore.StreamingServerRequest<Routeguide_RouteNote>,
+        /// - Returns: A streaming response of `Routeguide_TravelTimeEstimate` messages.
+        func estimateTravelTime(
+            request: GRPCCore.StreamingServerRequest<Routeguide_TravelTimeRequest>,
             context: GRPCCore.ServerContext
-        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_RouteNote>
-    }
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_TravelTimeEstimate>
 
-    /// Simple service protocol for the "routeguide.RouteGuide" service.
-    ///
-    /// This is the highest level protocol for the service. The API is the easiest to use but
-    /// doesn't provide access to request or response metadata. If you need access to these
-    /// then use ``ServiceProtocol`` instead.
-    ///
-    /// > Source IDL Documentation:
-    /// >
-    /// > Interface exported by the server.
-    public protocol SimpleServiceProtocol: Routeguide_RouteGuide.ServiceProtocol {
-        /// Handle the "GetFeature" method.
+        /// Handle the "WatchFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Streams an event describing the current dataset, followed by an event
+        /// > every time the server swaps in a new dataset (e.g. after a scheduled
+        /// > refresh), so clients know when cached features are stale.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_WatchFeaturesRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_FeatureEvent` messages.
+        func watchFeatures(
+            request: GRPCCore.StreamingServerRequest<Routeguide_WatchFeaturesRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_FeatureEvent>
+
+        /// Handle the "SyncFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Keeps an offline copy of the features in sync: streams a paged snapshot
+        /// > of the dataset, then a delta every time it changes. Every message that
+        /// > leaves the copy consistent carries a resume token, so a reconnecting
+        /// > client only receives what it missed.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_SyncRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_SyncMessage` messages.
+        func syncFeatures(
+            request: GRPCCore.StreamingServerRequest<Routeguide_SyncRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_SyncMessage>
+
+        /// Handle the "GetDatasetStats" method.
         ///
         /// > Source IDL Documentation:
         /// >
         /// > A simple RPC.
         /// > 
-        /// > Obtains the feature at a given position.
+        /// > Summarizes the dataset being served (size, extent, density, duplicates
+        /// > and how long it took to load) so clients and operators can sanity-check
+        /// > what was loaded.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_DatasetStatsRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_DatasetStats` messages.
+        func getDatasetStats(
+            request: GRPCCore.StreamingServerRequest<Routeguide_DatasetStatsRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_DatasetStats>
+
+        /// Handle the "GetChatActivity" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
         /// > 
-        /// > A feature with an empty name is returned if there's no feature at the given
-        /// > position.
+        /// > Counts the notes recently posted with RouteChat in a region, clustered
+        /// > into the map tiles of a zoom level, so clients can render busy
+        /// > locations on a map.
         ///
         /// - Parameters:
-        ///   - request: A `Routeguide_Point` message.
+        ///   - request: A streaming request of `Routeguide_ChatActivityRequest` messages.
         ///   - context: Context providing information about the RPC.
         /// - Throws: Any error which occurred during the processing of the request. Thrown errors
         ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
         ///     to an internal error.
-        /// - Returns: A `Routeguide_Feature` to respond with.
-        func getFeature(
-            request: Routeguide_Point,
+        /// - Returns: A streaming response of `Routeguide_ChatActivity` messages.
+        func getChatActivity(
+            request: GRPCCore.StreamingServerRequest<Routeguide_ChatActivityRequest>,
             context: GRPCCore.ServerContext
-        ) async throws -> Routeguide_Feature
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_ChatActivity>
 
-        /// Handle the "ListFeatures" method.
+        /// Handle the "DownloadRegionBundle" method.
         ///
         /// > Source IDL Documentation:
         /// >
         /// > A server-to-client streaming RPC.
         /// > 
-        /// > Obtains the Features available within the given Rectangle.  Results are
-        /// > streamed rather than returned at once (e.g. in a response message with a
-        /// > repeated field), as the rectangle may cover a large area and contain a
-        /// > huge number of features.
+        /// > Streams a gzip-compressed tar archive of the features in a region, and
+        /// > optionally their vector tiles, for offline use. An interrupted download
+        /// > can be resumed from any offset as long as the bundle hasn't changed.
         ///
         /// - Parameters:
-        ///   - request: A `Routeguide_Rectangle` message.
-        ///   - response: A response stream of `Routeguide_Feature` messages.
+        ///   - request: A streaming request of `Routeguide_BundleRequest` messages.
         ///   - context: Context providing information about the RPC.
         /// - Throws: Any error which occurred during the processing of the request. Thrown errors
         ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
         ///     to an internal error.
-        func listFeatures(
-            request: Routeguide_Rectangle,
-            response: GRPCCore.RPCWriter<Routeguide_Feature>,
+        /// - Returns: A streaming response of `Routeguide_BundleChunk` messages.
+        func downloadRegionBundle(
+            request: GRPCCore.StreamingServerRequest<Routeguide_BundleRequest>,
             context: GRPCCore.ServerContext
-        ) async throws
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_BundleChunk>
 
-        /// Handle the "RecordRoute" method.
+        /// Handle the "SnapToNearestFeature" method.
         ///
         /// > Source IDL Documentation:
         /// >
-        /// > A client-to-server streaming RPC.
+        /// > A simple RPC.
         /// > 
-        /// > Accepts a stream of Points on a route being traversed, returning a
-        /// > RouteSummary when traversal is completed.
+        /// > Snaps a point to the nearest feature within a distance, so apps can
+        /// > anchor user pins to known places.
         ///
         /// - Parameters:
-        ///   - request: A stream of `Routeguide_Point` messages.
+        ///   - request: A streaming request of `Routeguide_SnapRequest` messages.
         ///   - context: Context providing information about the RPC.
         /// - Throws: Any error which occurred during the processing of the request. Thrown errors
         ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
         ///     to an internal error.
-        /// - Returns: A `Routeguide_RouteSummary` to respond with.
-        func recordRoute(
-            request: GRPCCore.RPCAsyncSequence<Routeguide_Point, any Swift.Error>,
+        /// - Returns: A streaming response of `Routeguide_SnapResult` messages.
+        func snapToNearestFeature(
+            request: GRPCCore.StreamingServerRequest<Routeguide_SnapRequest>,
             context: GRPCCore.ServerContext
-        ) async throws -> Routeguide_RouteSummary
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_SnapResult>
 
-        /// Handle the "RouteChat" method.
+        /// Handle the "NearestFeatures" method.
         ///
         /// > Source IDL Documentation:
         /// >
-        /// > A Bidirectional streaming RPC.
+        /// > A simple RPC.
         /// > 
-        /// > Accepts a stream of RouteNotes sent while a route is being traversed,
-        /// > while receiving other RouteNotes (e.g. from other users).
+        /// > Returns the named features nearest to a point, closest first, with their
+        /// > distances, wherever they are.
         ///
         /// - Parameters:
-        ///   - request: A stream of `Routeguide_RouteNote` messages.
-        ///   - response: A response stream of `Routeguide_RouteNote` messages.
+        ///   - request: A streaming request of `Routeguide_NearestFeaturesRequest` messages.
         ///   - context: Context providing information about the RPC.
         /// - Throws: Any error which occurred during the processing of the request. Thrown errors
         ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
         ///     to an internal error.
-        func routeChat(
-            request: GRPCCore.RPCAsyncSequence<Routeguide_RouteNote, any Swift.Error>,
-            response: GRPCCore.RPCWriter<Routeguide_RouteNote>,
+        /// - Returns: A streaming response of `Routeguide_NearestFeaturesResponse` messages.
+        func nearestFeatures(
+            request: GRPCCore.StreamingServerRequest<Routeguide_NearestFeaturesRequest>,
             context: GRPCCore.ServerContext
-        ) async throws
-    }
-}
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_NearestFeaturesResponse>
 
-// Default implementation of 'registerMethods(with:)'.
-@available(macOS 15.0, iOS 18.0, watchOS 11.0, tvOS 18.0, visionOS 2.0, *)
-extension Routeguide_RouteGuide.StreamingServiceProtocol {
-    public func registerMethods<Transport>(with router: inout GRPCCore.RPCRouter<Transport>) where Transport: GRPCCore.ServerTransport {
-        router.registerHandler(
-            forMethod: Routeguide_RouteGuide.Method.GetFeature.descriptor,
-            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_Point>(),
-            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_Feature>(),
-            handler: { request, context in
-                try await self.getFeature(
-                    request: request,
-                    context: context
-                )
-            }
-        )
-        router.registerHandler(
-            forMethod: Routeguide_RouteGuide.Method.ListFeatures.descriptor,
-            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_Rectangle>(),
-            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_Feature>(),
-            handler: { request, context in
-                try await self.listFeatures(
-                    request: request,
-                    context: context
-                )
-            }
-        )
-        router.registerHandler(
-            forMethod: Routeguide_RouteGuide.Method.RecordRoute.descriptor,
-            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_Point>(),
-            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_RouteSummary>(),
-            handler: { request, context in
-                try await self.recordRoute(
-                    request: request,
-                    context: context
-                )
-            }
-        )
-        router.registerHandler(
-            forMethod: Routeguide_RouteGuide.Method.RouteChat.descriptor,
-            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_RouteNote>(),
-            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_RouteNote>(),
-            handler: { request, context in
-                try await self.routeChat(
-                    request: request,
-                    context: context
-                )
-            }
-        )
-    }
-}
-
-// Default implementation of streaming methods from 'StreamingServiceProtocol'.
-@available(macOS 15.0, iOS 18.0, watchOS 11.0, tvOS 18.0, visionOS 2.0, *)
-extension Routeguide_RouteGuide.ServiceProtocol {
-    public func getFeature(
-        request: GRPCCore.StreamingServerRequest<Routeguide_Point>,
-        context: GRPCCore.ServerContext
-    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_Feature> {
-        let response = try await self.getFeature(
-            request: GRPCCore.ServerRequest(stream: request),
-            context: context
-        )
-        return GRPCCore.StreamingServerResponse(single: response)
-    }
-
-    public func listFeatures(
-        request: GRPCCore.StreamingServerRequest<Routeguide_Rectangle>,
-        context: GRPCCore.ServerContext
-    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_Feature> {
-        let response = try await self.listFeatures(
-            request: GRPCCore.ServerRequest(stream: request),
-            context: context
-        )
-        return response
-    }
-
-    public func recordRoute(
-        request: GRPCCore.StreamingServerRequest<Routeguide_Point>,
-        context: GRPCCore.ServerContext
-    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_RouteSummary> {
-        let response = try await self.recordRoute(
-            request: request,
-            context: context
-        )
-        return GRPCCore.StreamingServerResponse(single: response)
-    }
-}
-
-// Default implementation of methods from 'ServiceProtocol'.
-@available(macOS 15.0, iOS 18.0, watchOS 11.0, tvOS 18.0, visionOS 2.0, *)
-extension Routeguide_RouteGuide.SimpleServiceProtocol {
-    public func getFeature(
-        request: GRPCCore.ServerRequest<Routeguide_Point>,
-        context: GRPCCore.ServerContext
-    ) async throws -> GRPCCore.ServerResponse<Routeguide_Feature> {
-        return GRPCCore.ServerResponse<Routeguide_Feature>(
-            message: try await self.getFeature(
-                request: request.message,
-                context: context
-            ),
-            metadata: [:]
-        )
-    }
-
-    public func listFeatures(
-        request: GRPCCore.ServerRequest<Routeguide_Rectangle>,
-        context: GRPCCore.ServerContext
-    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_Feature> {
-        return GRPCCore.StreamingServerResponse<Routeguide_Feature>(
-            metadata: [:],
-            producer: { writer in
-                try await self.listFeatures(
-                    request: request.message,
-                    response: writer,
-                    context: context
-                )
-                return [:]
-            }
-        )
-    }
-
-    public func recordRoute(
-        request: GRPCCore.StreamingServerRequest<Routeguide_Point>,
-        context: GRPCCore.ServerContext
-    ) async throws -> GRPCCore.ServerResponse<Routeguide_RouteSummary> {
-        return GRPCCore.ServerResponse<Routeguide_RouteSummary>(
-            message: try await self.recordRoute(
-                request: request.messages,
-                context: context
-            ),
-            metadata: [:]
-        )
-    }
-
-    public func routeChat(
-        request: GRPCCore.StreamingServerRequest<Routeguide_RouteNote>,
-        context: GRPCCore.ServerContext
-    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_RouteNote> {
-        return GRPCCore.StreamingServerResponse<Routeguide_RouteNote>(
-            metadata: [:],
-            producer: { writer in
-                try await self.routeChat(
-                    request: request.messages,
-                    response: writer,
-                    context: context
-                )
-                return [:]
-            }
-        )
-    }
-}
-
-// MARK: routeguide.RouteGuide (client)
-
-@available(macOS 15.0, iOS 18.0, watchOS 11.0, tvOS 18.0, visionOS 2.0, *)
-extension Routeguide_RouteGuide {
-    /// Generated client protocol for the "routeguide.RouteGuide" service.
-    ///
-    /// You don't need to implement this protocol directly, use the generated
-    /// implementation, ``Client``.
-    ///
-    /// > Source IDL Documentation:
-    /// >
-    /// > Interface exported by the server.
-    public protocol ClientProtocol: Sendable {
-        /// Call the "GetFeature" method.
+        /// Handle the "ListFeaturesInRadius" method.
         ///
         /// > Source IDL Documentation:
         /// >
-        /// > A simple RPC.
-        /// > 
-        /// > Obtains the feature at a given position.
+        /// > A server-to-client streaming RPC.
         /// > 
-        /// > A feature with an empty name is returned if there's no feature at the given
-        /// > position.
+        /// > Obtains the features within a distance of a point, closest first, with
+        /// > their distances, e.g. to show the features near a user.
         ///
         /// - Parameters:
-        ///   - request: A request containing a single `Routeguide_Point` message.
-        ///   - serializer: A serializer for `Routeguide_Point` messages.
-        ///   - deserializer: A deserializer for `Routeguide_Feature` messages.
-        ///   - options: Options to apply to this RPC.
-        ///   - handleResponse: A closure which handles the response, the result of which is
-        ///       returned to the caller. Returning from the closure will cancel the RPC if it
-        ///       hasn't already finished.
-        /// - Returns: The result of `handleResponse`.
-        func getFeature<Result>(
-            request: GRPCCore.ClientRequest<Routeguide_Point>,
-            serializer: some GRPCCore.MessageSerializer<Routeguide_Point>,
-            deserializer: some GRPCCore.MessageDeserializer<Routeguide_Feature>,
-            options: GRPCCore.CallOptions,
-            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_Feature>) async throws -> Result
-        ) async throws -> Result where Result: Sendable
+        ///   - request: A streaming request of `Routeguide_RadiusRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_NearestFeature` messages.
+        func listFeaturesInRadius(
+            request: GRPCCore.StreamingServerRequest<Routeguide_RadiusRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_NearestFeature>
 
-        /// Call the "ListFeatures" method.
+        /// Handle the "ListFeaturesInPolygon" method.
         ///
         /// > Source IDL Documentation:
         /// >
         /// > A server-to-client streaming RPC.
         /// > 
-        /// > Obtains the Features available within the given Rectangle.  Results are
-        /// > streamed rather than returned at once (e.g. in a response message with a
-        /// > repeated field), as the rectangle may cover a large area and contain a
-        /// > huge number of features.
+        /// > Obtains the Features within the given Polygon, edges included, in the
+        /// > same order as ListFeatures. A polygon whose edges cross is rejected with
+        /// > INVALID_ARGUMENT.
         ///
         /// - Parameters:
-        ///   - request: A request containing a single `Routeguide_Rectangle` message.
-        ///   - serializer: A serializer for `Routeguide_Rectangle` messages.
-        ///   - deserializer: A deserializer for `Routeguide_Feature` messages.
-        ///   - options: Options to apply to this RPC.
-        ///   - handleResponse: A closure which handles the response, the result of which is
-        ///       returned to the caller. Returning from the closure will cancel the RPC if it
-        ///       hasn't already finished.
-        /// - Returns: The result of `handleResponse`.
-        func listFeatures<Result>(
-            request: GRPCCore.ClientRequest<Routeguide_Rectangle>,
-            serializer: some GRPCCore.MessageSerializer<Routeguide_Rectangle>,
-            deserializer: some GRPCCore.MessageDeserializer<Routeguide_Feature>,
-            options: GRPCCore.CallOptions,
-            onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_Feature>) async throws -> Result
-        ) async throws -> Result where Result: Sendable
+        ///   - request: A streaming request of `Routeguide_Polygon` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_Feature` messages.
+        func listFeaturesInPolygon(
+            request: GRPCCore.StreamingServerRequest<Routeguide_Polygon>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_Feature>
 
-        /// Call the "RecordRoute" method.
+        /// Handle the "SubmitFeature" method.
         ///
         /// > Source IDL Documentation:
         /// >
-        /// > A client-to-server streaming RPC.
+        /// > A simple RPC.
         /// > 
-        /// > Accepts a stream of Points on a route being traversed, returning a
-        /// > RouteSummary when traversal is completed.
+        /// > Submits a new or changed feature. Submissions from admins are applied
+        /// > right away; others are queued as PENDING until an admin approves or
+        /// > rejects them with the Admin service, and don't appear in queries
+        /// > meanwhile.
         ///
         /// - Parameters:
-        ///   - request: A streaming request producing `Routeguide_Point` messages.
-        ///   - serializer: A serializer for `Routeguide_Point` messages.
-        ///   - deserializer: A deserializer for `Routeguide_RouteSummary` messages.
-        ///   - options: Options to apply to this RPC.
-        ///   - handleResponse: A closure which handles the response, the result of which is
-        ///       returned to the caller. Returning from the closure will cancel the RPC if it
-        ///       hasn't already finished.
-        /// - Returns: The result of `handleResponse`.
-        func recordRoute<Result>(
-            request: GRPCCore.StreamingClientRequest<Routeguide_Point>,
-            serializer: some GRPCCore.MessageSerializer<Routeguide_Point>,
-            deserializer: some GRPCCore.MessageDeserializer<Routeguide_RouteSummary>,
-            options: GRPCCore.CallOptions,
-            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_RouteSummary>) async throws -> Result
-        ) async throws -> Result where Result: Sendable
+        ///   - request: A streaming request of `Routeguide_SubmitFeatureRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_FeatureSubmission` messages.
+        func submitFeature(
+            request: GRPCCore.StreamingServerRequest<Routeguide_SubmitFeatureRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_FeatureSubmission>
 
-        /// Call the "RouteChat" method.
+        /// Handle the "CreateFeature" method.
         ///
         /// > Source IDL Documentation:
         /// >
-        /// > A Bidirectional streaming RPC.
+        /// > A simple RPC.
         /// > 
-        /// > Accepts a stream of RouteNotes sent while a route is being traversed,
-        /// > while receiving other RouteNotes (e.g. from other users).
+        /// > Adds a feature at a location that has none. Admin only; the change is
+        /// > applied right away, without review.
         ///
         /// - Parameters:
-        ///   - request: A streaming request producing `Routeguide_RouteNote` messages.
-        ///   - serializer: A serializer for `Routeguide_RouteNote` messages.
-        ///   - deserializer: A deserializer for `Routeguide_RouteNote` messages.
-        ///   - options: Options to apply to this RPC.
-        ///   - handleResponse: A closure which handles the response, the result of which is
-        ///       returned to the caller. Returning from the closure will cancel the RPC if it
-        ///       hasn't already finished.
-        /// - Returns: The result of `handleResponse`.
-        func routeChat<Result>(
-            request: GRPCCore.StreamingClientRequest<Routeguide_RouteNote>,
-            serializer: some GRPCCore.MessageSerializer<Routeguide_RouteNote>,
-            deserializer: some GRPCCore.MessageDeserializer<Routeguide_RouteNote>,
-            options: GRPCCore.CallOptions,
-            onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_RouteNote>) async throws -> Result
-        ) async throws -> Result where Result: Sendable
+        ///   - request: A streaming request of `Routeguide_CreateFeatureRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_FeatureMutation` messages.
+        func createFeature(
+            request: GRPCCore.StreamingServerRequest<Routeguide_CreateFeatureRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_FeatureMutation>
+
+        /// Handle the "UpdateFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Replaces the feature at a location. Admin only.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_UpdateFeatureRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_FeatureMutation` messages.
+        func updateFeature(
+            request: GRPCCore.StreamingServerRequest<Routeguide_UpdateFeatureRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_FeatureMutation>
+
+        /// Handle the "DeleteFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Removes the feature at a location. Admin only.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_DeleteFeatureRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_FeatureMutation` messages.
+        func deleteFeature(
+            request: GRPCCore.StreamingServerRequest<Routeguide_DeleteFeatureRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_FeatureMutation>
+
+        /// Handle the "GetClientConfig" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Returns the settings the server recommends to clients, so they can be
+        /// > tuned centrally without an app release. Clients should fetch it at
+        /// > startup and again after its refresh_interval.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request of `Routeguide_GetClientConfigRequest` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_ClientConfig` messages.
+        func getClientConfig(
+            request: GRPCCore.StreamingServerRequest<Routeguide_GetClientConfigRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_ClientConfig>
     }
 
-    /// Generated client for the "routeguide.RouteGuide" service.
+    /// Service protocol for the "routeguide.RouteGuide" service.
     ///
-    /// The ``Client`` provides an implementation of ``ClientProtocol`` which wraps
-    /// a `GRPCCore.GRPCCClient`. The underlying `GRPCClient` provides the long-lived
-    /// means of communication with the remote peer.
+    /// This protocol is higher level than ``StreamingServiceProtocol`` but lower level than
+    /// the ``SimpleServiceProtocol``, it provides access to request and response metadata and
+    /// trailing response metadata. If you don't need these then consider using
+    /// the ``SimpleServiceProtocol``. If you need fine grained control over your RPCs then
+    /// use ``StreamingServiceProtocol``.
     ///
     /// > Source IDL Documentation:
     /// >
     /// > Interface exported by the server.
-    public struct Client<Transport>: ClientProtocol where Transport: GRPCCore.ClientTransport {
-        private let client: GRPCCore.GRPCClient<Transport>
-
-        /// Creates a new client wrapping the provided `GRPCCore.GRPCClient`.
-        ///
-        /// - Parameters:
-        ///   - client: A `GRPCCore.GRPCClient` providing a communication channel to the service.
-        public init(wrapping client: GRPCCore.GRPCClient<Transport>) {
-            self.client = client
-        }
-
-        /// Call the "GetFeature" method.
+    public protocol ServiceProtocol: Routeguide_RouteGuide.StreamingServiceProtocol {
+        /// Handle the "GetFeature" method.
         ///
         /// > Source IDL Documentation:
         /// >
@@ -702,33 +912,39 @@ extension Routeguide_RouteGuide {
         ///
         /// - Parameters:
         ///   - request: A request containing a single `Routeguide_Point` message.
-        ///   - serializer: A serializer for `Routeguide_Point` messages.
-        ///   - deserializer: A deserializer for `Routeguide_Feature` messages.
-        ///   - options: Options to apply to this RPC.
-        ///   - handleResponse: A closure which handles the response, the result of which is
-        ///       returned to the caller. Returning from the closure will cancel the RPC if it
-        ///       hasn't already finished.
-        /// - Returns: The result of `handleResponse`.
-        public func getFeature<Result>(
-            request: GRPCCore.ClientRequest<Routeguide_Point>,
-            serializer: some GRPCCore.MessageSerializer<Routeguide_Point>,
-            deserializer: some GRPCCore.MessageDeserializer<Routeguide_Feature>,
-            options: GRPCCore.CallOptions = .defaults,
-            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_Feature>) async throws -> Result = { response in
-                try response.message
-            }
-        ) async throws -> Result where Result: Sendable {
-            try await self.client.unary(
-                request: request,
-                descriptor: Routeguide_RouteGuide.Method.GetFeature.descriptor,
-                serializer: serializer,
-                deserializer: deserializer,
-                options: options,
-                onResponse: handleResponse
-            )
-        }
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_Feature` message.
+        func getFeature(
+            request: GRPCCore.ServerRequest<Routeguide_Point>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_Feature>
 
-        /// Call the "ListFeatures" method.
+        /// Handle the "GetFeatureFast" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC with server-side hedging.
+        /// > 
+        /// > Behaves like GetFeature, but the lookup is fanned out to several storage
+        /// > replicas and the first successful response wins. Slow or failing replicas
+        /// > are cancelled once an answer is available.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_Point` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_Feature` message.
+        func getFeatureFast(
+            request: GRPCCore.ServerRequest<Routeguide_Point>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_Feature>
+
+        /// Handle the "ListFeatures" method.
         ///
         /// > Source IDL Documentation:
         /// >
@@ -737,35 +953,45 @@ extension Routeguide_RouteGuide {
         /// > Obtains the Features available within the given Rectangle.  Results are
         /// > streamed rather than returned at once (e.g. in a response message with a
         /// > repeated field), as the rectangle may cover a large area and contain a
-        /// > huge number of features.
+        /// > huge number of features. A stream sends the features of the dataset
+        /// > version current when it started, whatever changes meanwhile. The
+        /// > rectangle's name_filter narrows them down by name.
         ///
         /// - Parameters:
         ///   - request: A request containing a single `Routeguide_Rectangle` message.
-        ///   - serializer: A serializer for `Routeguide_Rectangle` messages.
-        ///   - deserializer: A deserializer for `Routeguide_Feature` messages.
-        ///   - options: Options to apply to this RPC.
-        ///   - handleResponse: A closure which handles the response, the result of which is
-        ///       returned to the caller. Returning from the closure will cancel the RPC if it
-        ///       hasn't already finished.
-        /// - Returns: The result of `handleResponse`.
-        public func listFeatures<Result>(
-            request: GRPCCore.ClientRequest<Routeguide_Rectangle>,
-            serializer: some GRPCCore.MessageSerializer<Routeguide_Rectangle>,
-            deserializer: some GRPCCore.MessageDeserializer<Routeguide_Feature>,
-            options: GRPCCore.CallOptions = .defaults,
-            onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_Feature>) async throws -> Result
-        ) async throws -> Result where Result: Sendable {
-            try await self.client.serverStreaming(
-                request: request,
-                descriptor: Routeguide_RouteGuide.Method.ListFeatures.descriptor,
-                serializer: serializer,
-                deserializer: deserializer,
-                options: options,
-                onResponse: handleResponse
-            )
-        }
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_Feature` messages.
+        func listFeatures(
+            request: GRPCCore.ServerRequest<Routeguide_Rectangle>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_Feature>
 
-        /// Call the "RecordRoute" method.
+        /// Handle the "ListFeaturesPage" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Lists the features within a rectangle a page at a time, ordered by
+        /// > location. Page tokens remain valid when the dataset changes between
+        /// > pages: features present throughout are neither skipped nor repeated.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ListFeaturesPageRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_FeaturePage` message.
+        func listFeaturesPage(
+            request: GRPCCore.ServerRequest<Routeguide_ListFeaturesPageRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_FeaturePage>
+
+        /// Handle the "RecordRoute" method.
         ///
         /// > Source IDL Documentation:
         /// >
@@ -775,34 +1001,18 @@ extension Routeguide_RouteGuide {
         /// > RouteSummary when traversal is completed.
         ///
         /// - Parameters:
-        ///   - request: A streaming request producing `Routeguide_Point` messages.
-        ///   - serializer: A serializer for `Routeguide_Point` messages.
-        ///   - deserializer: A deserializer for `Routeguide_RouteSummary` messages.
-        ///   - options: Options to apply to this RPC.
-        ///   - handleResponse: A closure which handles the response, the result of which is
-        ///       returned to the caller. Returning from the closure will cancel the RPC if it
-        ///       hasn't already finished.
-        /// - Returns: The result of `handleResponse`.
-        public func recordRoute<Result>(
-            request: GRPCCore.StreamingClientRequest<Routeguide_Point>,
-            serializer: some GRPCCore.MessageSerializer<Routeguide_Point>,
-            deserializer: some GRPCCore.MessageDeserializer<Routeguide_RouteSummary>,
-            options: GRPCCore.CallOptions = .defaults,
-            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_RouteSummary>) async throws -> Result = { response in
-                try response.message
-            }
-        ) async throws -> Result where Result: Sendable {
-            try await self.client.clientStreaming(
-                request: request,
-                descriptor: Routeguide_RouteGuide.Method.RecordRoute.descriptor,
-                serializer: serializer,
-                deserializer: deserializer,
-                options: options,
-                onResponse: handleResponse
-            )
-        }
+        ///   - request: A streaming request of `Routeguide_Point` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_RouteSummary` message.
+        func recordRoute(
+            request: GRPCCore.StreamingServerRequest<Routeguide_Point>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_RouteSummary>
 
-        /// Call the "RouteChat" method.
+        /// Handle the "RouteChat" method.
         ///
         /// > Source IDL Documentation:
         /// >
@@ -812,178 +1022,4908 @@ extension Routeguide_RouteGuide {
         /// > while receiving other RouteNotes (e.g. from other users).
         ///
         /// - Parameters:
-        ///   - request: A streaming request producing `Routeguide_RouteNote` messages.
-        ///   - serializer: A serializer for `Routeguide_RouteNote` messages.
-        ///   - deserializer: A deserializer for `Routeguide_RouteNote` messages.
-        ///   - options: Options to apply to this RPC.
-        ///   - handleResponse: A closure which handles the response, the result of which is
-        ///       returned to the caller. Returning from the closure will cancel the RPC if it
-        ///       hasn't already finished.
-        /// - Returns: The result of `handleResponse`.
-        public func routeChat<Result>(
-            request: GRPCCore.StreamingClientRequest<Routeguide_RouteNote>,
-            serializer: some GRPCCore.MessageSerializer<Routeguide_RouteNote>,
-            deserializer: some GRPCCore.MessageDeserializer<Routeguide_RouteNote>,
-            options: GRPCCore.CallOptions = .defaults,
-            onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_RouteNote>) async throws -> Result
-        ) async throws -> Result where Result: Sendable {
-            try await self.client.bidirectionalStreaming(
-                request: request,
-                descriptor: Routeguide_RouteGuide.Method.RouteChat.descriptor,
-                serializer: serializer,
-                deserializer: deserializer,
-                options: options,
-                onResponse: handleResponse
-            )
-        }
+        ///   - request: A streaming request of `Routeguide_RouteNote` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_RouteNote` messages.
+        func routeChat(
+            request: GRPCCore.StreamingServerRequest<Routeguide_RouteNote>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_RouteNote>
+
+        /// Handle the "ListNoteHistory" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Returns a page of the notes ever sent at a location, oldest first,
+        /// > including notes that are no longer replayed by RouteChat.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_NoteHistoryRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_NoteHistoryPage` message.
+        func listNoteHistory(
+            request: GRPCCore.ServerRequest<Routeguide_NoteHistoryRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_NoteHistoryPage>
+
+        /// Handle the "ComputeIsochrone" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Computes the area reachable from a center point within a time budget,
+        /// > travelling straight lines between known features with the given profile.
+        /// > The area is streamed as closed polygon rings, one per time band, from the
+        /// > smallest band to the full duration.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_IsochroneRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_IsochroneRing` messages.
+        func computeIsochrone(
+            request: GRPCCore.ServerRequest<Routeguide_IsochroneRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_IsochroneRing>
+
+        /// Handle the "CompareRoutes" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Compares two routes, each given either as the ID of a route previously
+        /// > recorded with RecordRoute or as an inline list of points.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_CompareRoutesRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_RouteComparison` message.
+        func compareRoutes(
+            request: GRPCCore.ServerRequest<Routeguide_CompareRoutesRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_RouteComparison>
+
+        /// Handle the "EstimateTravelTime" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Estimates how long it takes to travel between two points with a given
+        /// > profile, based on previously recorded routes passing near both points.
+        /// > Falls back to a straight-line heuristic when there is no history.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_TravelTimeRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_TravelTimeEstimate` message.
+        func estimateTravelTime(
+            request: GRPCCore.ServerRequest<Routeguide_TravelTimeRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_TravelTimeEstimate>
+
+        /// Handle the "WatchFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Streams an event describing the current dataset, followed by an event
+        /// > every time the server swaps in a new dataset (e.g. after a scheduled
+        /// > refresh), so clients know when cached features are stale.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_WatchFeaturesRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_FeatureEvent` messages.
+        func watchFeatures(
+            request: GRPCCore.ServerRequest<Routeguide_WatchFeaturesRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_FeatureEvent>
+
+        /// Handle the "SyncFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Keeps an offline copy of the features in sync: streams a paged snapshot
+        /// > of the dataset, then a delta every time it changes. Every message that
+        /// > leaves the copy consistent carries a resume token, so a reconnecting
+        /// > client only receives what it missed.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_SyncRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_SyncMessage` messages.
+        func syncFeatures(
+            request: GRPCCore.ServerRequest<Routeguide_SyncRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_SyncMessage>
+
+        /// Handle the "GetDatasetStats" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Summarizes the dataset being served (size, extent, density, duplicates
+        /// > and how long it took to load) so clients and operators can sanity-check
+        /// > what was loaded.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_DatasetStatsRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_DatasetStats` message.
+        func getDatasetStats(
+            request: GRPCCore.ServerRequest<Routeguide_DatasetStatsRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_DatasetStats>
+
+        /// Handle the "GetChatActivity" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Counts the notes recently posted with RouteChat in a region, clustered
+        /// > into the map tiles of a zoom level, so clients can render busy
+        /// > locations on a map.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ChatActivityRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_ChatActivity` message.
+        func getChatActivity(
+            request: GRPCCore.ServerRequest<Routeguide_ChatActivityRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_ChatActivity>
+
+        /// Handle the "DownloadRegionBundle" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Streams a gzip-compressed tar archive of the features in a region, and
+        /// > optionally their vector tiles, for offline use. An interrupted download
+        /// > can be resumed from any offset as long as the bundle hasn't changed.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_BundleRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_BundleChunk` messages.
+        func downloadRegionBundle(
+            request: GRPCCore.ServerRequest<Routeguide_BundleRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_BundleChunk>
+
+        /// Handle the "SnapToNearestFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Snaps a point to the nearest feature within a distance, so apps can
+        /// > anchor user pins to known places.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_SnapRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_SnapResult` message.
+        func snapToNearestFeature(
+            request: GRPCCore.ServerRequest<Routeguide_SnapRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_SnapResult>
+
+        /// Handle the "NearestFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Returns the named features nearest to a point, closest first, with their
+        /// > distances, wherever they are.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_NearestFeaturesRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_NearestFeaturesResponse` message.
+        func nearestFeatures(
+            request: GRPCCore.ServerRequest<Routeguide_NearestFeaturesRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_NearestFeaturesResponse>
+
+        /// Handle the "ListFeaturesInRadius" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Obtains the features within a distance of a point, closest first, with
+        /// > their distances, e.g. to show the features near a user.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_RadiusRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_NearestFeature` messages.
+        func listFeaturesInRadius(
+            request: GRPCCore.ServerRequest<Routeguide_RadiusRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_NearestFeature>
+
+        /// Handle the "ListFeaturesInPolygon" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Obtains the Features within the given Polygon, edges included, in the
+        /// > same order as ListFeatures. A polygon whose edges cross is rejected with
+        /// > INVALID_ARGUMENT.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_Polygon` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A streaming response of `Routeguide_Feature` messages.
+        func listFeaturesInPolygon(
+            request: GRPCCore.ServerRequest<Routeguide_Polygon>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_Feature>
+
+        /// Handle the "SubmitFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Submits a new or changed feature. Submissions from admins are applied
+        /// > right away; others are queued as PENDING until an admin approves or
+        /// > rejects them with the Admin service, and don't appear in queries
+        /// > meanwhile.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_SubmitFeatureRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_FeatureSubmission` message.
+        func submitFeature(
+            request: GRPCCore.ServerRequest<Routeguide_SubmitFeatureRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_FeatureSubmission>
+
+        /// Handle the "CreateFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Adds a feature at a location that has none. Admin only; the change is
+        /// > applied right away, without review.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_CreateFeatureRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_FeatureMutation` message.
+        func createFeature(
+            request: GRPCCore.ServerRequest<Routeguide_CreateFeatureRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_FeatureMutation>
+
+        /// Handle the "UpdateFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Replaces the feature at a location. Admin only.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_UpdateFeatureRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_FeatureMutation` message.
+        func updateFeature(
+            request: GRPCCore.ServerRequest<Routeguide_UpdateFeatureRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_FeatureMutation>
+
+        /// Handle the "DeleteFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Removes the feature at a location. Admin only.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_DeleteFeatureRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_FeatureMutation` message.
+        func deleteFeature(
+            request: GRPCCore.ServerRequest<Routeguide_DeleteFeatureRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_FeatureMutation>
+
+        /// Handle the "GetClientConfig" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Returns the settings the server recommends to clients, so they can be
+        /// > tuned centrally without an app release. Clients should fetch it at
+        /// > startup and again after its refresh_interval.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_GetClientConfigRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A response containing a single `Routeguide_ClientConfig` message.
+        func getClientConfig(
+            request: GRPCCore.ServerRequest<Routeguide_GetClientConfigRequest>,
+            context: GRPCCore.ServerContext
+        ) async throws -> GRPCCore.ServerResponse<Routeguide_ClientConfig>
+    }
+
+    /// Simple service protocol for the "routeguide.RouteGuide" service.
+    ///
+    /// This is the highest level protocol for the service. The API is the easiest to use but
+    /// doesn't provide access to request or response metadata. If you need access to these
+    /// then use ``ServiceProtocol`` instead.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Interface exported by the server.
+    public protocol SimpleServiceProtocol: Routeguide_RouteGuide.ServiceProtocol {
+        /// Handle the "GetFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Obtains the feature at a given position.
+        /// > 
+        /// > A feature with an empty name is returned if there's no feature at the given
+        /// > position.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_Point` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_Feature` to respond with.
+        func getFeature(
+            request: Routeguide_Point,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_Feature
+
+        /// Handle the "GetFeatureFast" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC with server-side hedging.
+        /// > 
+        /// > Behaves like GetFeature, but the lookup is fanned out to several storage
+        /// > replicas and the first successful response wins. Slow or failing replicas
+        /// > are cancelled once an answer is available.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_Point` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_Feature` to respond with.
+        func getFeatureFast(
+            request: Routeguide_Point,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_Feature
+
+        /// Handle the "ListFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Obtains the Features available within the given Rectangle.  Results are
+        /// > streamed rather than returned at once (e.g. in a response message with a
+        /// > repeated field), as the rectangle may cover a large area and contain a
+        /// > huge number of features. A stream sends the features of the dataset
+        /// > version current when it started, whatever changes meanwhile. The
+        /// > rectangle's name_filter narrows them down by name.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_Rectangle` message.
+        ///   - response: A response stream of `Routeguide_Feature` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        func listFeatures(
+            request: Routeguide_Rectangle,
+            response: GRPCCore.RPCWriter<Routeguide_Feature>,
+            context: GRPCCore.ServerContext
+        ) async throws
+
+        /// Handle the "ListFeaturesPage" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Lists the features within a rectangle a page at a time, ordered by
+        /// > location. Page tokens remain valid when the dataset changes between
+        /// > pages: features present throughout are neither skipped nor repeated.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_ListFeaturesPageRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_FeaturePage` to respond with.
+        func listFeaturesPage(
+            request: Routeguide_ListFeaturesPageRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_FeaturePage
+
+        /// Handle the "RecordRoute" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A client-to-server streaming RPC.
+        /// > 
+        /// > Accepts a stream of Points on a route being traversed, returning a
+        /// > RouteSummary when traversal is completed.
+        ///
+        /// - Parameters:
+        ///   - request: A stream of `Routeguide_Point` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_RouteSummary` to respond with.
+        func recordRoute(
+            request: GRPCCore.RPCAsyncSequence<Routeguide_Point, any Swift.Error>,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_RouteSummary
+
+        /// Handle the "RouteChat" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A Bidirectional streaming RPC.
+        /// > 
+        /// > Accepts a stream of RouteNotes sent while a route is being traversed,
+        /// > while receiving other RouteNotes (e.g. from other users).
+        ///
+        /// - Parameters:
+        ///   - request: A stream of `Routeguide_RouteNote` messages.
+        ///   - response: A response stream of `Routeguide_RouteNote` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        func routeChat(
+            request: GRPCCore.RPCAsyncSequence<Routeguide_RouteNote, any Swift.Error>,
+            response: GRPCCore.RPCWriter<Routeguide_RouteNote>,
+            context: GRPCCore.ServerContext
+        ) async throws
+
+        /// Handle the "ListNoteHistory" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Returns a page of the notes ever sent at a location, oldest first,
+        /// > including notes that are no longer replayed by RouteChat.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_NoteHistoryRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_NoteHistoryPage` to respond with.
+        func listNoteHistory(
+            request: Routeguide_NoteHistoryRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_NoteHistoryPage
+
+        /// Handle the "ComputeIsochrone" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Computes the area reachable from a center point within a time budget,
+        /// > travelling straight lines between known features with the given profile.
+        /// > The area is streamed as closed polygon rings, one per time band, from the
+        /// > smallest band to the full duration.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_IsochroneRequest` message.
+        ///   - response: A response stream of `Routeguide_IsochroneRing` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        func computeIsochrone(
+            request: Routeguide_IsochroneRequest,
+            response: GRPCCore.RPCWriter<Routeguide_IsochroneRing>,
+            context: GRPCCore.ServerContext
+        ) async throws
+
+        /// Handle the "CompareRoutes" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Compares two routes, each given either as the ID of a route previously
+        /// > recorded with RecordRoute or as an inline list of points.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_CompareRoutesRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_RouteComparison` to respond with.
+        func compareRoutes(
+            request: Routeguide_CompareRoutesRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_RouteComparison
+
+        /// Handle the "EstimateTravelTime" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Estimates how long it takes to travel between two points with a given
+        /// > profile, based on previously recorded routes passing near both points.
+        /// > Falls back to a straight-line heuristic when there is no history.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_TravelTimeRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_TravelTimeEstimate` to respond with.
+        func estimateTravelTime(
+            request: Routeguide_TravelTimeRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_TravelTimeEstimate
+
+        /// Handle the "WatchFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Streams an event describing the current dataset, followed by an event
+        /// > every time the server swaps in a new dataset (e.g. after a scheduled
+        /// > refresh), so clients know when cached features are stale.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_WatchFeaturesRequest` message.
+        ///   - response: A response stream of `Routeguide_FeatureEvent` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        func watchFeatures(
+            request: Routeguide_WatchFeaturesRequest,
+            response: GRPCCore.RPCWriter<Routeguide_FeatureEvent>,
+            context: GRPCCore.ServerContext
+        ) async throws
+
+        /// Handle the "SyncFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Keeps an offline copy of the features in sync: streams a paged snapshot
+        /// > of the dataset, then a delta every time it changes. Every message that
+        /// > leaves the copy consistent carries a resume token, so a reconnecting
+        /// > client only receives what it missed.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_SyncRequest` message.
+        ///   - response: A response stream of `Routeguide_SyncMessage` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        func syncFeatures(
+            request: Routeguide_SyncRequest,
+            response: GRPCCore.RPCWriter<Routeguide_SyncMessage>,
+            context: GRPCCore.ServerContext
+        ) async throws
+
+        /// Handle the "GetDatasetStats" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Summarizes the dataset being served (size, extent, density, duplicates
+        /// > and how long it took to load) so clients and operators can sanity-check
+        /// > what was loaded.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_DatasetStatsRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_DatasetStats` to respond with.
+        func getDatasetStats(
+            request: Routeguide_DatasetStatsRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_DatasetStats
+
+        /// Handle the "GetChatActivity" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Counts the notes recently posted with RouteChat in a region, clustered
+        /// > into the map tiles of a zoom level, so clients can render busy
+        /// > locations on a map.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_ChatActivityRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_ChatActivity` to respond with.
+        func getChatActivity(
+            request: Routeguide_ChatActivityRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_ChatActivity
+
+        /// Handle the "DownloadRegionBundle" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Streams a gzip-compressed tar archive of the features in a region, and
+        /// > optionally their vector tiles, for offline use. An interrupted download
+        /// > can be resumed from any offset as long as the bundle hasn't changed.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_BundleRequest` message.
+        ///   - response: A response stream of `Routeguide_BundleChunk` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        func downloadRegionBundle(
+            request: Routeguide_BundleRequest,
+            response: GRPCCore.RPCWriter<Routeguide_BundleChunk>,
+            context: GRPCCore.ServerContext
+        ) async throws
+
+        /// Handle the "SnapToNearestFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Snaps a point to the nearest feature within a distance, so apps can
+        /// > anchor user pins to known places.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_SnapRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_SnapResult` to respond with.
+        func snapToNearestFeature(
+            request: Routeguide_SnapRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_SnapResult
+
+        /// Handle the "NearestFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Returns the named features nearest to a point, closest first, with their
+        /// > distances, wherever they are.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_NearestFeaturesRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_NearestFeaturesResponse` to respond with.
+        func nearestFeatures(
+            request: Routeguide_NearestFeaturesRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_NearestFeaturesResponse
+
+        /// Handle the "ListFeaturesInRadius" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Obtains the features within a distance of a point, closest first, with
+        /// > their distances, e.g. to show the features near a user.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_RadiusRequest` message.
+        ///   - response: A response stream of `Routeguide_NearestFeature` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        func listFeaturesInRadius(
+            request: Routeguide_RadiusRequest,
+            response: GRPCCore.RPCWriter<Routeguide_NearestFeature>,
+            context: GRPCCore.ServerContext
+        ) async throws
+
+        /// Handle the "ListFeaturesInPolygon" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Obtains the Features within the given Polygon, edges included, in the
+        /// > same order as ListFeatures. A polygon whose edges cross is rejected with
+        /// > INVALID_ARGUMENT.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_Polygon` message.
+        ///   - response: A response stream of `Routeguide_Feature` messages.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        func listFeaturesInPolygon(
+            request: Routeguide_Polygon,
+            response: GRPCCore.RPCWriter<Routeguide_Feature>,
+            context: GRPCCore.ServerContext
+        ) async throws
+
+        /// Handle the "SubmitFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Submits a new or changed feature. Submissions from admins are applied
+        /// > right away; others are queued as PENDING until an admin approves or
+        /// > rejects them with the Admin service, and don't appear in queries
+        /// > meanwhile.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_SubmitFeatureRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_FeatureSubmission` to respond with.
+        func submitFeature(
+            request: Routeguide_SubmitFeatureRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_FeatureSubmission
+
+        /// Handle the "CreateFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Adds a feature at a location that has none. Admin only; the change is
+        /// > applied right away, without review.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_CreateFeatureRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_FeatureMutation` to respond with.
+        func createFeature(
+            request: Routeguide_CreateFeatureRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_FeatureMutation
+
+        /// Handle the "UpdateFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Replaces the feature at a location. Admin only.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_UpdateFeatureRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_FeatureMutation` to respond with.
+        func updateFeature(
+            request: Routeguide_UpdateFeatureRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_FeatureMutation
+
+        /// Handle the "DeleteFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Removes the feature at a location. Admin only.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_DeleteFeatureRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_FeatureMutation` to respond with.
+        func deleteFeature(
+            request: Routeguide_DeleteFeatureRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_FeatureMutation
+
+        /// Handle the "GetClientConfig" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Returns the settings the server recommends to clients, so they can be
+        /// > tuned centrally without an app release. Clients should fetch it at
+        /// > startup and again after its refresh_interval.
+        ///
+        /// - Parameters:
+        ///   - request: A `Routeguide_GetClientConfigRequest` message.
+        ///   - context: Context providing information about the RPC.
+        /// - Throws: Any error which occurred during the processing of the request. Thrown errors
+        ///     of type `RPCError` are mapped to appropriate statuses. All other errors are converted
+        ///     to an internal error.
+        /// - Returns: A `Routeguide_ClientConfig` to respond with.
+        func getClientConfig(
+            request: Routeguide_GetClientConfigRequest,
+            context: GRPCCore.ServerContext
+        ) async throws -> Routeguide_ClientConfig
+    }
+}
+
+// Default implementation of 'registerMethods(with:)'.
+@available(macOS 15.0, iOS 18.0, watchOS 11.0, tvOS 18.0, visionOS 2.0, *)
+extension Routeguide_RouteGuide.StreamingServiceProtocol {
+    public func registerMethods<Transport>(with router: inout GRPCCore.RPCRouter<Transport>) where Transport: GRPCCore.ServerTransport {
+        router.registerHandler(
+            forMethod: Routeguide_RouteGuide.Method.GetFeature.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_Point>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_Feature>(),
+            handler: { request, context in
+                try await self.getFeature(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_RouteGuide.Method.GetFeatureFast.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_Point>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_Feature>(),
+            handler: { request, context in
+                try await self.getFeatureFast(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_RouteGuide.Method.ListFeatures.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_Rectangle>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_Feature>(),
+            handler: { request, context in
+                try await self.listFeatures(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_RouteGuide.Method.ListFeaturesPage.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_ListFeaturesPageRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_FeaturePage>(),
+            handler: { request, context in
+                try await self.listFeaturesPage(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_RouteGuide.Method.RecordRoute.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_Point>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_RouteSummary>(),
+            handler: { request, context in
+                try await self.recordRoute(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_RouteGuide.Method.RouteChat.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_RouteNote>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_RouteNote>(),
+            handler: { request, context in
+                try await self.routeChat(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_RouteGuide.Method.ListNoteHistory.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_NoteHistoryRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_NoteHistoryPage>(),
+            handler: { request, context in
+                try await self.listNoteHistory(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_RouteGuide.Method.ComputeIsochrone.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_IsochroneRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_IsochroneRing>(),
+            handler: { request, context in
+                try await self.computeIsochrone(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_RouteGuide.Method.CompareRoutes.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_CompareRoutesRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_RouteComparison>(),
+            handler: { request, context in
+                try await self.compareRoutes(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_RouteGuide.Method.EstimateTravelTime.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_TravelTimeRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_TravelTimeEstimate>(),
+            handler: { request, context in
+                try await self.estimateTravelTime(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_RouteGuide.Method.WatchFeatures.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_WatchFeaturesRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_FeatureEvent>(),
+            handler: { request, context in
+                try await self.watchFeatures(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_RouteGuide.Method.SyncFeatures.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_SyncRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_SyncMessage>(),
+            handler: { request, context in
+                try await self.syncFeatures(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_RouteGuide.Method.GetDatasetStats.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_DatasetStatsRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_DatasetStats>(),
+            handler: { request, context in
+                try await self.getDatasetStats(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_RouteGuide.Method.GetChatActivity.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_ChatActivityRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_ChatActivity>(),
+            handler: { request, context in
+                try await self.getChatActivity(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_RouteGuide.Method.DownloadRegionBundle.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_BundleRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_BundleChunk>(),
+            handler: { request, context in
+                try await self.downloadRegionBundle(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_RouteGuide.Method.SnapToNearestFeature.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_SnapRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_SnapResult>(),
+            handler: { request, context in
+                try await self.snapToNearestFeature(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_RouteGuide.Method.NearestFeatures.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_NearestFeaturesRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_NearestFeaturesResponse>(),
+            handler: { request, context in
+                try await self.nearestFeatures(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_RouteGuide.Method.ListFeaturesInRadius.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_RadiusRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_NearestFeature>(),
+            handler: { request, context in
+                try await self.listFeaturesInRadius(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_RouteGuide.Method.ListFeaturesInPolygon.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_Polygon>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_Feature>(),
+            handler: { request, context in
+                try await self.listFeaturesInPolygon(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_RouteGuide.Method.SubmitFeature.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_SubmitFeatureRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_FeatureSubmission>(),
+            handler: { request, context in
+                try await self.submitFeature(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_RouteGuide.Method.CreateFeature.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_CreateFeatureRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_FeatureMutation>(),
+            handler: { request, context in
+                try await self.createFeature(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_RouteGuide.Method.UpdateFeature.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_UpdateFeatureRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_FeatureMutation>(),
+            handler: { request, context in
+                try await self.updateFeature(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_RouteGuide.Method.DeleteFeature.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_DeleteFeatureRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_FeatureMutation>(),
+            handler: { request, context in
+                try await self.deleteFeature(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+        router.registerHandler(
+            forMethod: Routeguide_RouteGuide.Method.GetClientConfig.descriptor,
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_GetClientConfigRequest>(),
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_ClientConfig>(),
+            handler: { request, context in
+                try await self.getClientConfig(
+                    request: request,
+                    context: context
+                )
+            }
+        )
+    }
+}
+
+// Default implementation of streaming methods from 'StreamingServiceProtocol'.
+@available(macOS 15.0, iOS 18.0, watchOS 11.0, tvOS 18.0, visionOS 2.0, *)
+extension Routeguide_RouteGuide.ServiceProtocol {
+    public func getFeature(
+        request: GRPCCore.StreamingServerRequest<Routeguide_Point>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_Feature> {
+        let response = try await self.getFeature(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func getFeatureFast(
+        request: GRPCCore.StreamingServerRequest<Routeguide_Point>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_Feature> {
+        let response = try await self.getFeatureFast(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func listFeatures(
+        request: GRPCCore.StreamingServerRequest<Routeguide_Rectangle>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_Feature> {
+        let response = try await self.listFeatures(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return response
+    }
+
+    public func listFeaturesPage(
+        request: GRPCCore.StreamingServerRequest<Routeguide_ListFeaturesPageRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_FeaturePage> {
+        let response = try await self.listFeaturesPage(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func recordRoute(
+        request: GRPCCore.StreamingServerRequest<Routeguide_Point>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_RouteSummary> {
+        let response = try await self.recordRoute(
+            request: request,
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func listNoteHistory(
+        request: GRPCCore.StreamingServerRequest<Routeguide_NoteHistoryRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_NoteHistoryPage> {
+        let response = try await self.listNoteHistory(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func computeIsochrone(
+        request: GRPCCore.StreamingServerRequest<Routeguide_IsochroneRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_IsochroneRing> {
+        let response = try await self.computeIsochrone(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return response
+    }
+
+    public func compareRoutes(
+        request: GRPCCore.StreamingServerRequest<Routeguide_CompareRoutesRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_RouteComparison> {
+        let response = try await self.compareRoutes(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func estimateTravelTime(
+        request: GRPCCore.StreamingServerRequest<Routeguide_TravelTimeRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_TravelTimeEstimate> {
+        let response = try await self.estimateTravelTime(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func watchFeatures(
+        request: GRPCCore.StreamingServerRequest<Routeguide_WatchFeaturesRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_FeatureEvent> {
+        let response = try await self.watchFeatures(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return response
+    }
+
+    public func syncFeatures(
+        request: GRPCCore.StreamingServerRequest<Routeguide_SyncRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_SyncMessage> {
+        let response = try await self.syncFeatures(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return response
+    }
+
+    public func getDatasetStats(
+        request: GRPCCore.StreamingServerRequest<Routeguide_DatasetStatsRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_DatasetStats> {
+        let response = try await self.getDatasetStats(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func getChatActivity(
+        request: GRPCCore.StreamingServerRequest<Routeguide_ChatActivityRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_ChatActivity> {
+        let response = try await self.getChatActivity(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func downloadRegionBundle(
+        request: GRPCCore.StreamingServerRequest<Routeguide_BundleRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_BundleChunk> {
+        let response = try await self.downloadRegionBundle(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return response
+    }
+
+    public func snapToNearestFeature(
+        request: GRPCCore.StreamingServerRequest<Routeguide_SnapRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_SnapResult> {
+        let response = try await self.snapToNearestFeature(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func nearestFeatures(
+        request: GRPCCore.StreamingServerRequest<Routeguide_NearestFeaturesRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_NearestFeaturesResponse> {
+        let response = try await self.nearestFeatures(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func listFeaturesInRadius(
+        request: GRPCCore.StreamingServerRequest<Routeguide_RadiusRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_NearestFeature> {
+        let response = try await self.listFeaturesInRadius(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return response
+    }
+
+    public func listFeaturesInPolygon(
+        request: GRPCCore.StreamingServerRequest<Routeguide_Polygon>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_Feature> {
+        let response = try await self.listFeaturesInPolygon(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return response
+    }
+
+    public func submitFeature(
+        request: GRPCCore.StreamingServerRequest<Routeguide_SubmitFeatureRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_FeatureSubmission> {
+        let response = try await self.submitFeature(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func createFeature(
+        request: GRPCCore.StreamingServerRequest<Routeguide_CreateFeatureRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_FeatureMutation> {
+        let response = try await self.createFeature(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func updateFeature(
+        request: GRPCCore.StreamingServerRequest<Routeguide_UpdateFeatureRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_FeatureMutation> {
+        let response = try await self.updateFeature(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func deleteFeature(
+        request: GRPCCore.StreamingServerRequest<Routeguide_DeleteFeatureRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_FeatureMutation> {
+        let response = try await self.deleteFeature(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+
+    public func getClientConfig(
+        request: GRPCCore.StreamingServerRequest<Routeguide_GetClientConfigRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_ClientConfig> {
+        let response = try await self.getClientConfig(
+            request: GRPCCore.ServerRequest(stream: request),
+            context: context
+        )
+        return GRPCCore.StreamingServerResponse(single: response)
+    }
+}
+
+// Default implementation of methods from 'ServiceProtocol'.
+@available(macOS 15.0, iOS 18.0, watchOS 11.0, tvOS 18.0, visionOS 2.0, *)
+extension Routeguide_RouteGuide.SimpleServiceProtocol {
+    public func getFeature(
+        request: GRPCCore.ServerRequest<Routeguide_Point>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_Feature> {
+        return GRPCCore.ServerResponse<Routeguide_Feature>(
+            message: try await self.getFeature(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func getFeatureFast(
+        request: GRPCCore.ServerRequest<Routeguide_Point>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_Feature> {
+        return GRPCCore.ServerResponse<Routeguide_Feature>(
+            message: try await self.getFeatureFast(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func listFeatures(
+        request: GRPCCore.ServerRequest<Routeguide_Rectangle>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_Feature> {
+        return GRPCCore.StreamingServerResponse<Routeguide_Feature>(
+            metadata: [:],
+            producer: { writer in
+                try await self.listFeatures(
+                    request: request.message,
+                    response: writer,
+                    context: context
+                )
+                return [:]
+            }
+        )
+    }
+
+    public func listFeaturesPage(
+        request: GRPCCore.ServerRequest<Routeguide_ListFeaturesPageRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_FeaturePage> {
+        return GRPCCore.ServerResponse<Routeguide_FeaturePage>(
+            message: try await self.listFeaturesPage(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func recordRoute(
+        request: GRPCCore.StreamingServerRequest<Routeguide_Point>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_RouteSummary> {
+        return GRPCCore.ServerResponse<Routeguide_RouteSummary>(
+            message: try await self.recordRoute(
+                request: request.messages,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func routeChat(
+        request: GRPCCore.StreamingServerRequest<Routeguide_RouteNote>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_RouteNote> {
+        return GRPCCore.StreamingServerResponse<Routeguide_RouteNote>(
+            metadata: [:],
+            producer: { writer in
+                try await self.routeChat(
+                    request: request.messages,
+                    response: writer,
+                    context: context
+                )
+                return [:]
+            }
+        )
+    }
+
+    public func listNoteHistory(
+        request: GRPCCore.ServerRequest<Routeguide_NoteHistoryRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_NoteHistoryPage> {
+        return GRPCCore.ServerResponse<Routeguide_NoteHistoryPage>(
+            message: try await self.listNoteHistory(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func computeIsochrone(
+        request: GRPCCore.ServerRequest<Routeguide_IsochroneRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_IsochroneRing> {
+        return GRPCCore.StreamingServerResponse<Routeguide_IsochroneRing>(
+            metadata: [:],
+            producer: { writer in
+                try await self.computeIsochrone(
+                    request: request.message,
+                    response: writer,
+                    context: context
+                )
+                return [:]
+            }
+        )
+    }
+
+    public func compareRoutes(
+        request: GRPCCore.ServerRequest<Routeguide_CompareRoutesRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_RouteComparison> {
+        return GRPCCore.ServerResponse<Routeguide_RouteComparison>(
+            message: try await self.compareRoutes(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func estimateTravelTime(
+        request: GRPCCore.ServerRequest<Routeguide_TravelTimeRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_TravelTimeEstimate> {
+        return GRPCCore.ServerResponse<Routeguide_TravelTimeEstimate>(
+            message: try await self.estimateTravelTime(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func watchFeatures(
+        request: GRPCCore.ServerRequest<Routeguide_WatchFeaturesRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_FeatureEvent> {
+        return GRPCCore.StreamingServerResponse<Routeguide_FeatureEvent>(
+            metadata: [:],
+            producer: { writer in
+                try await self.watchFeatures(
+                    request: request.message,
+                    response: writer,
+                    context: context
+                )
+                return [:]
+            }
+        )
+    }
+
+    public func syncFeatures(
+        request: GRPCCore.ServerRequest<Routeguide_SyncRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_SyncMessage> {
+        return GRPCCore.StreamingServerResponse<Routeguide_SyncMessage>(
+            metadata: [:],
+            producer: { writer in
+                try await self.syncFeatures(
+                    request: request.message,
+                    response: writer,
+                    context: context
+                )
+                return [:]
+            }
+        )
+    }
+
+    public func getDatasetStats(
+        request: GRPCCore.ServerRequest<Routeguide_DatasetStatsRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_DatasetStats> {
+        return GRPCCore.ServerResponse<Routeguide_DatasetStats>(
+            message: try await self.getDatasetStats(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func getChatActivity(
+        request: GRPCCore.ServerRequest<Routeguide_ChatActivityRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_ChatActivity> {
+        return GRPCCore.ServerResponse<Routeguide_ChatActivity>(
+            message: try await self.getChatActivity(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func downloadRegionBundle(
+        request: GRPCCore.ServerRequest<Routeguide_BundleRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_BundleChunk> {
+        return GRPCCore.StreamingServerResponse<Routeguide_BundleChunk>(
+            metadata: [:],
+            producer: { writer in
+                try await self.downloadRegionBundle(
+                    request: request.message,
+                    response: writer,
+                    context: context
+                )
+                return [:]
+            }
+        )
+    }
+
+    public func snapToNearestFeature(
+        request: GRPCCore.ServerRequest<Routeguide_SnapRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_SnapResult> {
+        return GRPCCore.ServerResponse<Routeguide_SnapResult>(
+            message: try await self.snapToNearestFeature(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func nearestFeatures(
+        request: GRPCCore.ServerRequest<Routeguide_NearestFeaturesRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_NearestFeaturesResponse> {
+        return GRPCCore.ServerResponse<Routeguide_NearestFeaturesResponse>(
+            message: try await self.nearestFeatures(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func listFeaturesInRadius(
+        request: GRPCCore.ServerRequest<Routeguide_RadiusRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_NearestFeature> {
+        return GRPCCore.StreamingServerResponse<Routeguide_NearestFeature>(
+            metadata: [:],
+            producer: { writer in
+                try await self.listFeaturesInRadius(
+                    request: request.message,
+                    response: writer,
+                    context: context
+                )
+                return [:]
+            }
+        )
+    }
+
+    public func listFeaturesInPolygon(
+        request: GRPCCore.ServerRequest<Routeguide_Polygon>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.StreamingServerResponse<Routeguide_Feature> {
+        return GRPCCore.StreamingServerResponse<Routeguide_Feature>(
+            metadata: [:],
+            producer: { writer in
+                try await self.listFeaturesInPolygon(
+                    request: request.message,
+                    response: writer,
+                    context: context
+                )
+                return [:]
+            }
+        )
+    }
+
+    public func submitFeature(
+        request: GRPCCore.ServerRequest<Routeguide_SubmitFeatureRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_FeatureSubmission> {
+        return GRPCCore.ServerResponse<Routeguide_FeatureSubmission>(
+            message: try await self.submitFeature(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func createFeature(
+        request: GRPCCore.ServerRequest<Routeguide_CreateFeatureRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_FeatureMutation> {
+        return GRPCCore.ServerResponse<Routeguide_FeatureMutation>(
+            message: try await self.createFeature(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func updateFeature(
+        request: GRPCCore.ServerRequest<Routeguide_UpdateFeatureRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_FeatureMutation> {
+        return GRPCCore.ServerResponse<Routeguide_FeatureMutation>(
+            message: try await self.updateFeature(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func deleteFeature(
+        request: GRPCCore.ServerRequest<Routeguide_DeleteFeatureRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_FeatureMutation> {
+        return GRPCCore.ServerResponse<Routeguide_FeatureMutation>(
+            message: try await self.deleteFeature(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+
+    public func getClientConfig(
+        request: GRPCCore.ServerRequest<Routeguide_GetClientConfigRequest>,
+        context: GRPCCore.ServerContext
+    ) async throws -> GRPCCore.ServerResponse<Routeguide_ClientConfig> {
+        return GRPCCore.ServerResponse<Routeguide_ClientConfig>(
+            message: try await self.getClientConfig(
+                request: request.message,
+                context: context
+            ),
+            metadata: [:]
+        )
+    }
+}
+
+// MARK: routeguide.RouteGuide (client)
+
+@available(macOS 15.0, iOS 18.0, watchOS 11.0, tvOS 18.0, visionOS 2.0, *)
+extension Routeguide_RouteGuide {
+    /// Generated client protocol for the "routeguide.RouteGuide" service.
+    ///
+    /// You don't need to implement this protocol directly, use the generated
+    /// implementation, ``Client``.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Interface exported by the server.
+    public protocol ClientProtocol: Sendable {
+        /// Call the "GetFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Obtains the feature at a given position.
+        /// > 
+        /// > A feature with an empty name is returned if there's no feature at the given
+        /// > position.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_Point` message.
+        ///   - serializer: A serializer for `Routeguide_Point` messages.
+        ///   - deserializer: A deserializer for `Routeguide_Feature` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func getFeature<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_Point>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_Point>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_Feature>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_Feature>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "GetFeatureFast" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC with server-side hedging.
+        /// > 
+        /// > Behaves like GetFeature, but the lookup is fanned out to several storage
+        /// > replicas and the first successful response wins. Slow or failing replicas
+        /// > are cancelled once an answer is available.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_Point` message.
+        ///   - serializer: A serializer for `Routeguide_Point` messages.
+        ///   - deserializer: A deserializer for `Routeguide_Feature` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func getFeatureFast<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_Point>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_Point>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_Feature>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_Feature>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "ListFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Obtains the Features available within the given Rectangle.  Results are
+        /// > streamed rather than returned at once (e.g. in a response message with a
+        /// > repeated field), as the rectangle may cover a large area and contain a
+        /// > huge number of features. A stream sends the features of the dataset
+        /// > version current when it started, whatever changes meanwhile. The
+        /// > rectangle's name_filter narrows them down by name.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_Rectangle` message.
+        ///   - serializer: A serializer for `Routeguide_Rectangle` messages.
+        ///   - deserializer: A deserializer for `Routeguide_Feature` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func listFeatures<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_Rectangle>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_Rectangle>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_Feature>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_Feature>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "ListFeaturesPage" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Lists the features within a rectangle a page at a time, ordered by
+        /// > location. Page tokens remain valid when the dataset changes between
+        /// > pages: features present throughout are neither skipped nor repeated.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ListFeaturesPageRequest` message.
+        ///   - serializer: A serializer for `Routeguide_ListFeaturesPageRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_FeaturePage` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func listFeaturesPage<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_ListFeaturesPageRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_ListFeaturesPageRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_FeaturePage>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeaturePage>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "RecordRoute" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A client-to-server streaming RPC.
+        /// > 
+        /// > Accepts a stream of Points on a route being traversed, returning a
+        /// > RouteSummary when traversal is completed.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request producing `Routeguide_Point` messages.
+        ///   - serializer: A serializer for `Routeguide_Point` messages.
+        ///   - deserializer: A deserializer for `Routeguide_RouteSummary` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func recordRoute<Result>(
+            request: GRPCCore.StreamingClientRequest<Routeguide_Point>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_Point>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_RouteSummary>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_RouteSummary>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "RouteChat" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A Bidirectional streaming RPC.
+        /// > 
+        /// > Accepts a stream of RouteNotes sent while a route is being traversed,
+        /// > while receiving other RouteNotes (e.g. from other users).
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request producing `Routeguide_RouteNote` messages.
+        ///   - serializer: A serializer for `Routeguide_RouteNote` messages.
+        ///   - deserializer: A deserializer for `Routeguide_RouteNote` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func routeChat<Result>(
+            request: GRPCCore.StreamingClientRequest<Routeguide_RouteNote>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_RouteNote>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_RouteNote>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_RouteNote>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "ListNoteHistory" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Returns a page of the notes ever sent at a location, oldest first,
+        /// > including notes that are no longer replayed by RouteChat.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_NoteHistoryRequest` message.
+        ///   - serializer: A serializer for `Routeguide_NoteHistoryRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_NoteHistoryPage` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func listNoteHistory<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_NoteHistoryRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_NoteHistoryRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_NoteHistoryPage>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_NoteHistoryPage>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "ComputeIsochrone" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Computes the area reachable from a center point within a time budget,
+        /// > travelling straight lines between known features with the given profile.
+        /// > The area is streamed as closed polygon rings, one per time band, from the
+        /// > smallest band to the full duration.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_IsochroneRequest` message.
+        ///   - serializer: A serializer for `Routeguide_IsochroneRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_IsochroneRing` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func computeIsochrone<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_IsochroneRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_IsochroneRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_IsochroneRing>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_IsochroneRing>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "CompareRoutes" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Compares two routes, each given either as the ID of a route previously
+        /// > recorded with RecordRoute or as an inline list of points.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_CompareRoutesRequest` message.
+        ///   - serializer: A serializer for `Routeguide_CompareRoutesRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_RouteComparison` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func compareRoutes<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_CompareRoutesRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_CompareRoutesRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_RouteComparison>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_RouteComparison>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "EstimateTravelTime" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Estimates how long it takes to travel between two points with a given
+        /// > profile, based on previously recorded routes passing near both points.
+        /// > Falls back to a straight-line heuristic when there is no history.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_TravelTimeRequest` message.
+        ///   - serializer: A serializer for `Routeguide_TravelTimeRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_TravelTimeEstimate` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func estimateTravelTime<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_TravelTimeRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_TravelTimeRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_TravelTimeEstimate>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_TravelTimeEstimate>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "WatchFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Streams an event describing the current dataset, followed by an event
+        /// > every time the server swaps in a new dataset (e.g. after a scheduled
+        /// > refresh), so clients know when cached features are stale.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_WatchFeaturesRequest` message.
+        ///   - serializer: A serializer for `Routeguide_WatchFeaturesRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_FeatureEvent` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func watchFeatures<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_WatchFeaturesRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_WatchFeaturesRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_FeatureEvent>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_FeatureEvent>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "SyncFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Keeps an offline copy of the features in sync: streams a paged snapshot
+        /// > of the dataset, then a delta every time it changes. Every message that
+        /// > leaves the copy consistent carries a resume token, so a reconnecting
+        /// > client only receives what it missed.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_SyncRequest` message.
+        ///   - serializer: A serializer for `Routeguide_SyncRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_SyncMessage` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func syncFeatures<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_SyncRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_SyncRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_SyncMessage>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_SyncMessage>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "GetDatasetStats" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Summarizes the dataset being served (size, extent, density, duplicates
+        /// > and how long it took to load) so clients and operators can sanity-check
+        /// > what was loaded.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_DatasetStatsRequest` message.
+        ///   - serializer: A serializer for `Routeguide_DatasetStatsRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_DatasetStats` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func getDatasetStats<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_DatasetStatsRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_DatasetStatsRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_DatasetStats>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_DatasetStats>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "GetChatActivity" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Counts the notes recently posted with RouteChat in a region, clustered
+        /// > into the map tiles of a zoom level, so clients can render busy
+        /// > locations on a map.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ChatActivityRequest` message.
+        ///   - serializer: A serializer for `Routeguide_ChatActivityRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_ChatActivity` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func getChatActivity<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_ChatActivityRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_ChatActivityRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_ChatActivity>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ChatActivity>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "DownloadRegionBundle" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Streams a gzip-compressed tar archive of the features in a region, and
+        /// > optionally their vector tiles, for offline use. An interrupted download
+        /// > can be resumed from any offset as long as the bundle hasn't changed.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_BundleRequest` message.
+        ///   - serializer: A serializer for `Routeguide_BundleRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_BundleChunk` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func downloadRegionBundle<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_BundleRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_BundleRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_BundleChunk>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_BundleChunk>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "SnapToNearestFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Snaps a point to the nearest feature within a distance, so apps can
+        /// > anchor user pins to known places.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_SnapRequest` message.
+        ///   - serializer: A serializer for `Routeguide_SnapRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_SnapResult` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func snapToNearestFeature<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_SnapRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_SnapRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_SnapResult>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_SnapResult>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "NearestFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Returns the named features nearest to a point, closest first, with their
+        /// > distances, wherever they are.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_NearestFeaturesRequest` message.
+        ///   - serializer: A serializer for `Routeguide_NearestFeaturesRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_NearestFeaturesResponse` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func nearestFeatures<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_NearestFeaturesRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_NearestFeaturesRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_NearestFeaturesResponse>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_NearestFeaturesResponse>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "ListFeaturesInRadius" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Obtains the features within a distance of a point, closest first, with
+        /// > their distances, e.g. to show the features near a user.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_RadiusRequest` message.
+        ///   - serializer: A serializer for `Routeguide_RadiusRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_NearestFeature` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func listFeaturesInRadius<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_RadiusRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_RadiusRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_NearestFeature>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_NearestFeature>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "ListFeaturesInPolygon" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Obtains the Features within the given Polygon, edges included, in the
+        /// > same order as ListFeatures. A polygon whose edges cross is rejected with
+        /// > INVALID_ARGUMENT.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_Polygon` message.
+        ///   - serializer: A serializer for `Routeguide_Polygon` messages.
+        ///   - deserializer: A deserializer for `Routeguide_Feature` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func listFeaturesInPolygon<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_Polygon>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_Polygon>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_Feature>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_Feature>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "SubmitFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Submits a new or changed feature. Submissions from admins are applied
+        /// > right away; others are queued as PENDING until an admin approves or
+        /// > rejects them with the Admin service, and don't appear in queries
+        /// > meanwhile.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_SubmitFeatureRequest` message.
+        ///   - serializer: A serializer for `Routeguide_SubmitFeatureRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_FeatureSubmission` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func submitFeature<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_SubmitFeatureRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_SubmitFeatureRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_FeatureSubmission>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeatureSubmission>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "CreateFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Adds a feature at a location that has none. Admin only; the change is
+        /// > applied right away, without review.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_CreateFeatureRequest` message.
+        ///   - serializer: A serializer for `Routeguide_CreateFeatureRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_FeatureMutation` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func createFeature<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_CreateFeatureRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_CreateFeatureRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_FeatureMutation>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeatureMutation>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "UpdateFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Replaces the feature at a location. Admin only.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_UpdateFeatureRequest` message.
+        ///   - serializer: A serializer for `Routeguide_UpdateFeatureRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_FeatureMutation` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func updateFeature<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_UpdateFeatureRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_UpdateFeatureRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_FeatureMutation>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeatureMutation>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "DeleteFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Removes the feature at a location. Admin only.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_DeleteFeatureRequest` message.
+        ///   - serializer: A serializer for `Routeguide_DeleteFeatureRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_FeatureMutation` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func deleteFeature<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_DeleteFeatureRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_DeleteFeatureRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_FeatureMutation>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeatureMutation>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+
+        /// Call the "GetClientConfig" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Returns the settings the server recommends to clients, so they can be
+        /// > tuned centrally without an app release. Clients should fetch it at
+        /// > startup and again after its refresh_interval.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_GetClientConfigRequest` message.
+        ///   - serializer: A serializer for `Routeguide_GetClientConfigRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_ClientConfig` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        func getClientConfig<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_GetClientConfigRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_GetClientConfigRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_ClientConfig>,
+            options: GRPCCore.CallOptions,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ClientConfig>) async throws -> Result
+        ) async throws -> Result where Result: Sendable
+    }
+
+    /// Generated client for the "routeguide.RouteGuide" service.
+    ///
+    /// The ``Client`` provides an implementation of ``ClientProtocol`` which wraps
+    /// a `GRPCCore.GRPCCClient`. The underlying `GRPCClient` provides the long-lived
+    /// means of communication with the remote peer.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > Interface exported by the server.
+    public struct Client<Transport>: ClientProtocol where Transport: GRPCCore.ClientTransport {
+        private let client: GRPCCore.GRPCClient<Transport>
+
+        /// Creates a new client wrapping the provided `GRPCCore.GRPCClient`.
+        ///
+        /// - Parameters:
+        ///   - client: A `GRPCCore.GRPCClient` providing a communication channel to the service.
+        public init(wrapping client: GRPCCore.GRPCClient<Transport>) {
+            self.client = client
+        }
+
+        /// Call the "GetFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Obtains the feature at a given position.
+        /// > 
+        /// > A feature with an empty name is returned if there's no feature at the given
+        /// > position.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_Point` message.
+        ///   - serializer: A serializer for `Routeguide_Point` messages.
+        ///   - deserializer: A deserializer for `Routeguide_Feature` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func getFeature<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_Point>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_Point>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_Feature>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_Feature>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_RouteGuide.Method.GetFeature.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "GetFeatureFast" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC with server-side hedging.
+        /// > 
+        /// > Behaves like GetFeature, but the lookup is fanned out to several storage
+        /// > replicas and the first successful response wins. Slow or failing replicas
+        /// > are cancelled once an answer is available.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_Point` message.
+        ///   - serializer: A serializer for `Routeguide_Point` messages.
+        ///   - deserializer: A deserializer for `Routeguide_Feature` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func getFeatureFast<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_Point>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_Point>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_Feature>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_Feature>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_RouteGuide.Method.GetFeatureFast.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "ListFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Obtains the Features available within the given Rectangle.  Results are
+        /// > streamed rather than returned at once (e.g. in a response message with a
+        /// > repeated field), as the rectangle may cover a large area and contain a
+        /// > huge number of features. A stream sends the features of the dataset
+        /// > version current when it started, whatever changes meanwhile. The
+        /// > rectangle's name_filter narrows them down by name.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_Rectangle` message.
+        ///   - serializer: A serializer for `Routeguide_Rectangle` messages.
+        ///   - deserializer: A deserializer for `Routeguide_Feature` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func listFeatures<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_Rectangle>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_Rectangle>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_Feature>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_Feature>) async throws -> Result
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.serverStreaming(
+                request: request,
+                descriptor: Routeguide_RouteGuide.Method.ListFeatures.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "ListFeaturesPage" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Lists the features within a rectangle a page at a time, ordered by
+        /// > location. Page tokens remain valid when the dataset changes between
+        /// > pages: features present throughout are neither skipped nor repeated.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ListFeaturesPageRequest` message.
+        ///   - serializer: A serializer for `Routeguide_ListFeaturesPageRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_FeaturePage` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func listFeaturesPage<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_ListFeaturesPageRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_ListFeaturesPageRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_FeaturePage>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeaturePage>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_RouteGuide.Method.ListFeaturesPage.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "RecordRoute" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A client-to-server streaming RPC.
+        /// > 
+        /// > Accepts a stream of Points on a route being traversed, returning a
+        /// > RouteSummary when traversal is completed.
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request producing `Routeguide_Point` messages.
+        ///   - serializer: A serializer for `Routeguide_Point` messages.
+        ///   - deserializer: A deserializer for `Routeguide_RouteSummary` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func recordRoute<Result>(
+            request: GRPCCore.StreamingClientRequest<Routeguide_Point>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_Point>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_RouteSummary>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_RouteSummary>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.clientStreaming(
+                request: request,
+                descriptor: Routeguide_RouteGuide.Method.RecordRoute.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "RouteChat" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A Bidirectional streaming RPC.
+        /// > 
+        /// > Accepts a stream of RouteNotes sent while a route is being traversed,
+        /// > while receiving other RouteNotes (e.g. from other users).
+        ///
+        /// - Parameters:
+        ///   - request: A streaming request producing `Routeguide_RouteNote` messages.
+        ///   - serializer: A serializer for `Routeguide_RouteNote` messages.
+        ///   - deserializer: A deserializer for `Routeguide_RouteNote` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func routeChat<Result>(
+            request: GRPCCore.StreamingClientRequest<Routeguide_RouteNote>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_RouteNote>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_RouteNote>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_RouteNote>) async throws -> Result
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.bidirectionalStreaming(
+                request: request,
+                descriptor: Routeguide_RouteGuide.Method.RouteChat.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "ListNoteHistory" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Returns a page of the notes ever sent at a location, oldest first,
+        /// > including notes that are no longer replayed by RouteChat.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_NoteHistoryRequest` message.
+        ///   - serializer: A serializer for `Routeguide_NoteHistoryRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_NoteHistoryPage` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func listNoteHistory<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_NoteHistoryRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_NoteHistoryRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_NoteHistoryPage>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_NoteHistoryPage>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_RouteGuide.Method.ListNoteHistory.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "ComputeIsochrone" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Computes the area reachable from a center point within a time budget,
+        /// > travelling straight lines between known features with the given profile.
+        /// > The area is streamed as closed polygon rings, one per time band, from the
+        /// > smallest band to the full duration.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_IsochroneRequest` message.
+        ///   - serializer: A serializer for `Routeguide_IsochroneRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_IsochroneRing` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func computeIsochrone<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_IsochroneRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_IsochroneRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_IsochroneRing>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_IsochroneRing>) async throws -> Result
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.serverStreaming(
+                request: request,
+                descriptor: Routeguide_RouteGuide.Method.ComputeIsochrone.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "CompareRoutes" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Compares two routes, each given either as the ID of a route previously
+        /// > recorded with RecordRoute or as an inline list of points.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_CompareRoutesRequest` message.
+        ///   - serializer: A serializer for `Routeguide_CompareRoutesRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_RouteComparison` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func compareRoutes<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_CompareRoutesRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_CompareRoutesRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_RouteComparison>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_RouteComparison>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_RouteGuide.Method.CompareRoutes.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "EstimateTravelTime" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Estimates how long it takes to travel between two points with a given
+        /// > profile, based on previously recorded routes passing near both points.
+        /// > Falls back to a straight-line heuristic when there is no history.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_TravelTimeRequest` message.
+        ///   - serializer: A serializer for `Routeguide_TravelTimeRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_TravelTimeEstimate` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func estimateTravelTime<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_TravelTimeRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_TravelTimeRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_TravelTimeEstimate>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_TravelTimeEstimate>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_RouteGuide.Method.EstimateTravelTime.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "WatchFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Streams an event describing the current dataset, followed by an event
+        /// > every time the server swaps in a new dataset (e.g. after a scheduled
+        /// > refresh), so clients know when cached features are stale.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_WatchFeaturesRequest` message.
+        ///   - serializer: A serializer for `Routeguide_WatchFeaturesRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_FeatureEvent` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func watchFeatures<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_WatchFeaturesRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_WatchFeaturesRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_FeatureEvent>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_FeatureEvent>) async throws -> Result
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.serverStreaming(
+                request: request,
+                descriptor: Routeguide_RouteGuide.Method.WatchFeatures.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "SyncFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Keeps an offline copy of the features in sync: streams a paged snapshot
+        /// > of the dataset, then a delta every time it changes. Every message that
+        /// > leaves the copy consistent carries a resume token, so a reconnecting
+        /// > client only receives what it missed.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_SyncRequest` message.
+        ///   - serializer: A serializer for `Routeguide_SyncRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_SyncMessage` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func syncFeatures<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_SyncRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_SyncRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_SyncMessage>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_SyncMessage>) async throws -> Result
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.serverStreaming(
+                request: request,
+                descriptor: Routeguide_RouteGuide.Method.SyncFeatures.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "GetDatasetStats" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Summarizes the dataset being served (size, extent, density, duplicates
+        /// > and how long it took to load) so clients and operators can sanity-check
+        /// > what was loaded.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_DatasetStatsRequest` message.
+        ///   - serializer: A serializer for `Routeguide_DatasetStatsRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_DatasetStats` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func getDatasetStats<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_DatasetStatsRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_DatasetStatsRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_DatasetStats>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_DatasetStats>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_RouteGuide.Method.GetDatasetStats.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "GetChatActivity" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Counts the notes recently posted with RouteChat in a region, clustered
+        /// > into the map tiles of a zoom level, so clients can render busy
+        /// > locations on a map.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_ChatActivityRequest` message.
+        ///   - serializer: A serializer for `Routeguide_ChatActivityRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_ChatActivity` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func getChatActivity<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_ChatActivityRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_ChatActivityRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_ChatActivity>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ChatActivity>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_RouteGuide.Method.GetChatActivity.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "DownloadRegionBundle" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Streams a gzip-compressed tar archive of the features in a region, and
+        /// > optionally their vector tiles, for offline use. An interrupted download
+        /// > can be resumed from any offset as long as the bundle hasn't changed.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_BundleRequest` message.
+        ///   - serializer: A serializer for `Routeguide_BundleRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_BundleChunk` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func downloadRegionBundle<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_BundleRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_BundleRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_BundleChunk>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_BundleChunk>) async throws -> Result
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.serverStreaming(
+                request: request,
+                descriptor: Routeguide_RouteGuide.Method.DownloadRegionBundle.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "SnapToNearestFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Snaps a point to the nearest feature within a distance, so apps can
+        /// > anchor user pins to known places.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_SnapRequest` message.
+        ///   - serializer: A serializer for `Routeguide_SnapRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_SnapResult` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func snapToNearestFeature<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_SnapRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_SnapRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_SnapResult>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_SnapResult>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_RouteGuide.Method.SnapToNearestFeature.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "NearestFeatures" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Returns the named features nearest to a point, closest first, with their
+        /// > distances, wherever they are.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_NearestFeaturesRequest` message.
+        ///   - serializer: A serializer for `Routeguide_NearestFeaturesRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_NearestFeaturesResponse` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func nearestFeatures<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_NearestFeaturesRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_NearestFeaturesRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_NearestFeaturesResponse>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_NearestFeaturesResponse>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_RouteGuide.Method.NearestFeatures.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "ListFeaturesInRadius" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Obtains the features within a distance of a point, closest first, with
+        /// > their distances, e.g. to show the features near a user.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_RadiusRequest` message.
+        ///   - serializer: A serializer for `Routeguide_RadiusRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_NearestFeature` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func listFeaturesInRadius<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_RadiusRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_RadiusRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_NearestFeature>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_NearestFeature>) async throws -> Result
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.serverStreaming(
+                request: request,
+                descriptor: Routeguide_RouteGuide.Method.ListFeaturesInRadius.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "ListFeaturesInPolygon" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A server-to-client streaming RPC.
+        /// > 
+        /// > Obtains the Features within the given Polygon, edges included, in the
+        /// > same order as ListFeatures. A polygon whose edges cross is rejected with
+        /// > INVALID_ARGUMENT.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_Polygon` message.
+        ///   - serializer: A serializer for `Routeguide_Polygon` messages.
+        ///   - deserializer: A deserializer for `Routeguide_Feature` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func listFeaturesInPolygon<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_Polygon>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_Polygon>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_Feature>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_Feature>) async throws -> Result
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.serverStreaming(
+                request: request,
+                descriptor: Routeguide_RouteGuide.Method.ListFeaturesInPolygon.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "SubmitFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Submits a new or changed feature. Submissions from admins are applied
+        /// > right away; others are queued as PENDING until an admin approves or
+        /// > rejects them with the Admin service, and don't appear in queries
+        /// > meanwhile.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_SubmitFeatureRequest` message.
+        ///   - serializer: A serializer for `Routeguide_SubmitFeatureRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_FeatureSubmission` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func submitFeature<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_SubmitFeatureRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_SubmitFeatureRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_FeatureSubmission>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeatureSubmission>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_RouteGuide.Method.SubmitFeature.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "CreateFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Adds a feature at a location that has none. Admin only; the change is
+        /// > applied right away, without review.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_CreateFeatureRequest` message.
+        ///   - serializer: A serializer for `Routeguide_CreateFeatureRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_FeatureMutation` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func createFeature<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_CreateFeatureRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_CreateFeatureRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_FeatureMutation>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeatureMutation>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_RouteGuide.Method.CreateFeature.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "UpdateFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Replaces the feature at a location. Admin only.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_UpdateFeatureRequest` message.
+        ///   - serializer: A serializer for `Routeguide_UpdateFeatureRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_FeatureMutation` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func updateFeature<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_UpdateFeatureRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_UpdateFeatureRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_FeatureMutation>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeatureMutation>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_RouteGuide.Method.UpdateFeature.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "DeleteFeature" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Removes the feature at a location. Admin only.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_DeleteFeatureRequest` message.
+        ///   - serializer: A serializer for `Routeguide_DeleteFeatureRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_FeatureMutation` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func deleteFeature<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_DeleteFeatureRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_DeleteFeatureRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_FeatureMutation>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeatureMutation>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_RouteGuide.Method.DeleteFeature.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+
+        /// Call the "GetClientConfig" method.
+        ///
+        /// > Source IDL Documentation:
+        /// >
+        /// > A simple RPC.
+        /// > 
+        /// > Returns the settings the server recommends to clients, so they can be
+        /// > tuned centrally without an app release. Clients should fetch it at
+        /// > startup and again after its refresh_interval.
+        ///
+        /// - Parameters:
+        ///   - request: A request containing a single `Routeguide_GetClientConfigRequest` message.
+        ///   - serializer: A serializer for `Routeguide_GetClientConfigRequest` messages.
+        ///   - deserializer: A deserializer for `Routeguide_ClientConfig` messages.
+        ///   - options: Options to apply to this RPC.
+        ///   - handleResponse: A closure which handles the response, the result of which is
+        ///       returned to the caller. Returning from the closure will cancel the RPC if it
+        ///       hasn't already finished.
+        /// - Returns: The result of `handleResponse`.
+        public func getClientConfig<Result>(
+            request: GRPCCore.ClientRequest<Routeguide_GetClientConfigRequest>,
+            serializer: some GRPCCore.MessageSerializer<Routeguide_GetClientConfigRequest>,
+            deserializer: some GRPCCore.MessageDeserializer<Routeguide_ClientConfig>,
+            options: GRPCCore.CallOptions = .defaults,
+            onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ClientConfig>) async throws -> Result = { response in
+                try response.message
+            }
+        ) async throws -> Result where Result: Sendable {
+            try await self.client.unary(
+                request: request,
+                descriptor: Routeguide_RouteGuide.Method.GetClientConfig.descriptor,
+                serializer: serializer,
+                deserializer: deserializer,
+                options: options,
+                onResponse: handleResponse
+            )
+        }
+    }
+}
+
+// Helpers providing default arguments to 'ClientProtocol' methods.
+@available(macOS 15.0, iOS 18.0, watchOS 11.0, tvOS 18.0, visionOS 2.0, *)
+extension Routeguide_RouteGuide.ClientProtocol {
+    /// Call the "GetFeature" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A simple RPC.
+    /// > 
+    /// > Obtains the feature at a given position.
+    /// > 
+    /// > A feature with an empty name is returned if there's no feature at the given
+    /// > position.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_Point` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func getFeature<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_Point>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_Feature>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.getFeature(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_Point>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_Feature>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "GetFeatureFast" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A simple RPC with server-side hedging.
+    /// > 
+    /// > Behaves like GetFeature, but the lookup is fanned out to several storage
+    /// > replicas and the first successful response wins. Slow or failing replicas
+    /// > are cancelled once an answer is available.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_Point` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func getFeatureFast<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_Point>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_Feature>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.getFeatureFast(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_Point>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_Feature>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ListFeatures" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A server-to-client streaming RPC.
+    /// > 
+    /// > Obtains the Features available within the given Rectangle.  Results are
+    /// > streamed rather than returned at once (e.g. in a response message with a
+    /// > repeated field), as the rectangle may cover a large area and contain a
+    /// > huge number of features. A stream sends the features of the dataset
+    /// > version current when it started, whatever changes meanwhile. The
+    /// > rectangle's name_filter narrows them down by name.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_Rectangle` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func listFeatures<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_Rectangle>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_Feature>) async throws -> Result
+    ) async throws -> Result where Result: Sendable {
+        try await self.listFeatures(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_Rectangle>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_Feature>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ListFeaturesPage" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A simple RPC.
+    /// > 
+    /// > Lists the features within a rectangle a page at a time, ordered by
+    /// > location. Page tokens remain valid when the dataset changes between
+    /// > pages: features present throughout are neither skipped nor repeated.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_ListFeaturesPageRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func listFeaturesPage<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_ListFeaturesPageRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeaturePage>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.listFeaturesPage(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_ListFeaturesPageRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_FeaturePage>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "RecordRoute" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A client-to-server streaming RPC.
+    /// > 
+    /// > Accepts a stream of Points on a route being traversed, returning a
+    /// > RouteSummary when traversal is completed.
+    ///
+    /// - Parameters:
+    ///   - request: A streaming request producing `Routeguide_Point` messages.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func recordRoute<Result>(
+        request: GRPCCore.StreamingClientRequest<Routeguide_Point>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_RouteSummary>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.recordRoute(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_Point>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_RouteSummary>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "RouteChat" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A Bidirectional streaming RPC.
+    /// > 
+    /// > Accepts a stream of RouteNotes sent while a route is being traversed,
+    /// > while receiving other RouteNotes (e.g. from other users).
+    ///
+    /// - Parameters:
+    ///   - request: A streaming request producing `Routeguide_RouteNote` messages.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func routeChat<Result>(
+        request: GRPCCore.StreamingClientRequest<Routeguide_RouteNote>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_RouteNote>) async throws -> Result
+    ) async throws -> Result where Result: Sendable {
+        try await self.routeChat(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_RouteNote>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_RouteNote>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ListNoteHistory" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A simple RPC.
+    /// > 
+    /// > Returns a page of the notes ever sent at a location, oldest first,
+    /// > including notes that are no longer replayed by RouteChat.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_NoteHistoryRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func listNoteHistory<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_NoteHistoryRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_NoteHistoryPage>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.listNoteHistory(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_NoteHistoryRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_NoteHistoryPage>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ComputeIsochrone" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A server-to-client streaming RPC.
+    /// > 
+    /// > Computes the area reachable from a center point within a time budget,
+    /// > travelling straight lines between known features with the given profile.
+    /// > The area is streamed as closed polygon rings, one per time band, from the
+    /// > smallest band to the full duration.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_IsochroneRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func computeIsochrone<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_IsochroneRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_IsochroneRing>) async throws -> Result
+    ) async throws -> Result where Result: Sendable {
+        try await self.computeIsochrone(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_IsochroneRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_IsochroneRing>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "CompareRoutes" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A simple RPC.
+    /// > 
+    /// > Compares two routes, each given either as the ID of a route previously
+    /// > recorded with RecordRoute or as an inline list of points.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_CompareRoutesRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func compareRoutes<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_CompareRoutesRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_RouteComparison>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.compareRoutes(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_CompareRoutesRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_RouteComparison>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "EstimateTravelTime" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A simple RPC.
+    /// > 
+    /// > Estimates how long it takes to travel between two points with a given
+    /// > profile, based on previously recorded routes passing near both points.
+    /// > Falls back to a straight-line heuristic when there is no history.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_TravelTimeRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func estimateTravelTime<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_TravelTimeRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_TravelTimeEstimate>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.estimateTravelTime(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_TravelTimeRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_TravelTimeEstimate>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "WatchFeatures" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A server-to-client streaming RPC.
+    /// > 
+    /// > Streams an event describing the current dataset, followed by an event
+    /// > every time the server swaps in a new dataset (e.g. after a scheduled
+    /// > refresh), so clients know when cached features are stale.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_WatchFeaturesRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func watchFeatures<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_WatchFeaturesRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_FeatureEvent>) async throws -> Result
+    ) async throws -> Result where Result: Sendable {
+        try await self.watchFeatures(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_WatchFeaturesRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_FeatureEvent>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "SyncFeatures" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A server-to-client streaming RPC.
+    /// > 
+    /// > Keeps an offline copy of the features in sync: streams a paged snapshot
+    /// > of the dataset, then a delta every time it changes. Every message that
+    /// > leaves the copy consistent carries a resume token, so a reconnecting
+    /// > client only receives what it missed.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_SyncRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func syncFeatures<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_SyncRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_SyncMessage>) async throws -> Result
+    ) async throws -> Result where Result: Sendable {
+        try await self.syncFeatures(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_SyncRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_SyncMessage>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "GetDatasetStats" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A simple RPC.
+    /// > 
+    /// > Summarizes the dataset being served (size, extent, density, duplicates
+    /// > and how long it took to load) so clients and operators can sanity-check
+    /// > what was loaded.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_DatasetStatsRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func getDatasetStats<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_DatasetStatsRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_DatasetStats>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.getDatasetStats(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_DatasetStatsRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_DatasetStats>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "GetChatActivity" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A simple RPC.
+    /// > 
+    /// > Counts the notes recently posted with RouteChat in a region, clustered
+    /// > into the map tiles of a zoom level, so clients can render busy
+    /// > locations on a map.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_ChatActivityRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func getChatActivity<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_ChatActivityRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ChatActivity>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.getChatActivity(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_ChatActivityRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_ChatActivity>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "DownloadRegionBundle" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A server-to-client streaming RPC.
+    /// > 
+    /// > Streams a gzip-compressed tar archive of the features in a region, and
+    /// > optionally their vector tiles, for offline use. An interrupted download
+    /// > can be resumed from any offset as long as the bundle hasn't changed.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_BundleRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func downloadRegionBundle<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_BundleRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_BundleChunk>) async throws -> Result
+    ) async throws -> Result where Result: Sendable {
+        try await self.downloadRegionBundle(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_BundleRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_BundleChunk>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "SnapToNearestFeature" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A simple RPC.
+    /// > 
+    /// > Snaps a point to the nearest feature within a distance, so apps can
+    /// > anchor user pins to known places.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_SnapRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func snapToNearestFeature<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_SnapRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_SnapResult>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.snapToNearestFeature(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_SnapRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_SnapResult>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "NearestFeatures" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A simple RPC.
+    /// > 
+    /// > Returns the named features nearest to a point, closest first, with their
+    /// > distances, wherever they are.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_NearestFeaturesRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func nearestFeatures<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_NearestFeaturesRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_NearestFeaturesResponse>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.nearestFeatures(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_NearestFeaturesRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_NearestFeaturesResponse>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ListFeaturesInRadius" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A server-to-client streaming RPC.
+    /// > 
+    /// > Obtains the features within a distance of a point, closest first, with
+    /// > their distances, e.g. to show the features near a user.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_RadiusRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func listFeaturesInRadius<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_RadiusRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_NearestFeature>) async throws -> Result
+    ) async throws -> Result where Result: Sendable {
+        try await self.listFeaturesInRadius(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_RadiusRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_NearestFeature>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ListFeaturesInPolygon" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A server-to-client streaming RPC.
+    /// > 
+    /// > Obtains the Features within the given Polygon, edges included, in the
+    /// > same order as ListFeatures. A polygon whose edges cross is rejected with
+    /// > INVALID_ARGUMENT.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_Polygon` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func listFeaturesInPolygon<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_Polygon>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_Feature>) async throws -> Result
+    ) async throws -> Result where Result: Sendable {
+        try await self.listFeaturesInPolygon(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_Polygon>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_Feature>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "SubmitFeature" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A simple RPC.
+    /// > 
+    /// > Submits a new or changed feature. Submissions from admins are applied
+    /// > right away; others are queued as PENDING until an admin approves or
+    /// > rejects them with the Admin service, and don't appear in queries
+    /// > meanwhile.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_SubmitFeatureRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func submitFeature<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_SubmitFeatureRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeatureSubmission>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.submitFeature(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_SubmitFeatureRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_FeatureSubmission>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "CreateFeature" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A simple RPC.
+    /// > 
+    /// > Adds a feature at a location that has none. Admin only; the change is
+    /// > applied right away, without review.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_CreateFeatureRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func createFeature<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_CreateFeatureRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeatureMutation>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.createFeature(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_CreateFeatureRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_FeatureMutation>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "UpdateFeature" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A simple RPC.
+    /// > 
+    /// > Replaces the feature at a location. Admin only.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_UpdateFeatureRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func updateFeature<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_UpdateFeatureRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeatureMutation>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.updateFeature(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_UpdateFeatureRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_FeatureMutation>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "DeleteFeature" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A simple RPC.
+    /// > 
+    /// > Removes the feature at a location. Admin only.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_DeleteFeatureRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func deleteFeature<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_DeleteFeatureRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeatureMutation>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.deleteFeature(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_DeleteFeatureRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_FeatureMutation>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "GetClientConfig" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A simple RPC.
+    /// > 
+    /// > Returns the settings the server recommends to clients, so they can be
+    /// > tuned centrally without an app release. Clients should fetch it at
+    /// > startup and again after its refresh_interval.
+    ///
+    /// - Parameters:
+    ///   - request: A request containing a single `Routeguide_GetClientConfigRequest` message.
+    ///   - options: Options to apply to this RPC.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func getClientConfig<Result>(
+        request: GRPCCore.ClientRequest<Routeguide_GetClientConfigRequest>,
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ClientConfig>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        try await self.getClientConfig(
+            request: request,
+            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_GetClientConfigRequest>(),
+            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_ClientConfig>(),
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+}
+
+// Helpers providing sugared APIs for 'ClientProtocol' methods.
+@available(macOS 15.0, iOS 18.0, watchOS 11.0, tvOS 18.0, visionOS 2.0, *)
+extension Routeguide_RouteGuide.ClientProtocol {
+    /// Call the "GetFeature" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A simple RPC.
+    /// > 
+    /// > Obtains the feature at a given position.
+    /// > 
+    /// > A feature with an empty name is returned if there's no feature at the given
+    /// > position.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func getFeature<Result>(
+        _ message: Routeguide_Point,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_Feature>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_Point>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.getFeature(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "GetFeatureFast" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A simple RPC with server-side hedging.
+    /// > 
+    /// > Behaves like GetFeature, but the lookup is fanned out to several storage
+    /// > replicas and the first successful response wins. Slow or failing replicas
+    /// > are cancelled once an answer is available.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func getFeatureFast<Result>(
+        _ message: Routeguide_Point,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_Feature>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_Point>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.getFeatureFast(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ListFeatures" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A server-to-client streaming RPC.
+    /// > 
+    /// > Obtains the Features available within the given Rectangle.  Results are
+    /// > streamed rather than returned at once (e.g. in a response message with a
+    /// > repeated field), as the rectangle may cover a large area and contain a
+    /// > huge number of features. A stream sends the features of the dataset
+    /// > version current when it started, whatever changes meanwhile. The
+    /// > rectangle's name_filter narrows them down by name.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func listFeatures<Result>(
+        _ message: Routeguide_Rectangle,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_Feature>) async throws -> Result
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_Rectangle>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.listFeatures(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ListFeaturesPage" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A simple RPC.
+    /// > 
+    /// > Lists the features within a rectangle a page at a time, ordered by
+    /// > location. Page tokens remain valid when the dataset changes between
+    /// > pages: features present throughout are neither skipped nor repeated.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func listFeaturesPage<Result>(
+        _ message: Routeguide_ListFeaturesPageRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeaturePage>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_ListFeaturesPageRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.listFeaturesPage(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "RecordRoute" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A client-to-server streaming RPC.
+    /// > 
+    /// > Accepts a stream of Points on a route being traversed, returning a
+    /// > RouteSummary when traversal is completed.
+    ///
+    /// - Parameters:
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - producer: A closure producing request messages to send to the server. The request
+    ///       stream is closed when the closure returns.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func recordRoute<Result>(
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        requestProducer producer: @Sendable @escaping (GRPCCore.RPCWriter<Routeguide_Point>) async throws -> Void,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_RouteSummary>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.StreamingClientRequest<Routeguide_Point>(
+            metadata: metadata,
+            producer: producer
+        )
+        return try await self.recordRoute(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "RouteChat" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A Bidirectional streaming RPC.
+    /// > 
+    /// > Accepts a stream of RouteNotes sent while a route is being traversed,
+    /// > while receiving other RouteNotes (e.g. from other users).
+    ///
+    /// - Parameters:
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - producer: A closure producing request messages to send to the server. The request
+    ///       stream is closed when the closure returns.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func routeChat<Result>(
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        requestProducer producer: @Sendable @escaping (GRPCCore.RPCWriter<Routeguide_RouteNote>) async throws -> Void,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_RouteNote>) async throws -> Result
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.StreamingClientRequest<Routeguide_RouteNote>(
+            metadata: metadata,
+            producer: producer
+        )
+        return try await self.routeChat(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ListNoteHistory" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A simple RPC.
+    /// > 
+    /// > Returns a page of the notes ever sent at a location, oldest first,
+    /// > including notes that are no longer replayed by RouteChat.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func listNoteHistory<Result>(
+        _ message: Routeguide_NoteHistoryRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_NoteHistoryPage>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_NoteHistoryRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.listNoteHistory(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ComputeIsochrone" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A server-to-client streaming RPC.
+    /// > 
+    /// > Computes the area reachable from a center point within a time budget,
+    /// > travelling straight lines between known features with the given profile.
+    /// > The area is streamed as closed polygon rings, one per time band, from the
+    /// > smallest band to the full duration.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func computeIsochrone<Result>(
+        _ message: Routeguide_IsochroneRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_IsochroneRing>) async throws -> Result
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_IsochroneRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.computeIsochrone(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "CompareRoutes" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A simple RPC.
+    /// > 
+    /// > Compares two routes, each given either as the ID of a route previously
+    /// > recorded with RecordRoute or as an inline list of points.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func compareRoutes<Result>(
+        _ message: Routeguide_CompareRoutesRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_RouteComparison>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_CompareRoutesRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.compareRoutes(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "EstimateTravelTime" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A simple RPC.
+    /// > 
+    /// > Estimates how long it takes to travel between two points with a given
+    /// > profile, based on previously recorded routes passing near both points.
+    /// > Falls back to a straight-line heuristic when there is no history.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func estimateTravelTime<Result>(
+        _ message: Routeguide_TravelTimeRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_TravelTimeEstimate>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_TravelTimeRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.estimateTravelTime(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "WatchFeatures" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A server-to-client streaming RPC.
+    /// > 
+    /// > Streams an event describing the current dataset, followed by an event
+    /// > every time the server swaps in a new dataset (e.g. after a scheduled
+    /// > refresh), so clients know when cached features are stale.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func watchFeatures<Result>(
+        _ message: Routeguide_WatchFeaturesRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_FeatureEvent>) async throws -> Result
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_WatchFeaturesRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.watchFeatures(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "SyncFeatures" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A server-to-client streaming RPC.
+    /// > 
+    /// > Keeps an offline copy of the features in sync: streams a paged snapshot
+    /// > of the dataset, then a delta every time it changes. Every message that
+    /// > leaves the copy consistent carries a resume token, so a reconnecting
+    /// > client only receives what it missed.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func syncFeatures<Result>(
+        _ message: Routeguide_SyncRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_SyncMessage>) async throws -> Result
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_SyncRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.syncFeatures(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
     }
-}
 
-// Helpers providing default arguments to 'ClientProtocol' methods.
-@available(macOS 15.0, iOS 18.0, watchOS 11.0, tvOS 18.0, visionOS 2.0, *)
-extension Routeguide_RouteGuide.ClientProtocol {
-    /// Call the "GetFeature" method.
+    /// Call the "GetDatasetStats" method.
     ///
     /// > Source IDL Documentation:
     /// >
     /// > A simple RPC.
     /// > 
-    /// > Obtains the feature at a given position.
+    /// > Summarizes the dataset being served (size, extent, density, duplicates
+    /// > and how long it took to load) so clients and operators can sanity-check
+    /// > what was loaded.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func getDatasetStats<Result>(
+        _ message: Routeguide_DatasetStatsRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_DatasetStats>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_DatasetStatsRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.getDatasetStats(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "GetChatActivity" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A simple RPC.
     /// > 
-    /// > A feature with an empty name is returned if there's no feature at the given
-    /// > position.
+    /// > Counts the notes recently posted with RouteChat in a region, clustered
+    /// > into the map tiles of a zoom level, so clients can render busy
+    /// > locations on a map.
     ///
     /// - Parameters:
-    ///   - request: A request containing a single `Routeguide_Point` message.
-    ///   - options: Options to apply to this RPC.
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
     ///   - handleResponse: A closure which handles the response, the result of which is
     ///       returned to the caller. Returning from the closure will cancel the RPC if it
     ///       hasn't already finished.
     /// - Returns: The result of `handleResponse`.
-    public func getFeature<Result>(
-        request: GRPCCore.ClientRequest<Routeguide_Point>,
+    public func getChatActivity<Result>(
+        _ message: Routeguide_ChatActivityRequest,
+        metadata: GRPCCore.Metadata = [:],
         options: GRPCCore.CallOptions = .defaults,
-        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_Feature>) async throws -> Result = { response in
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ChatActivity>) async throws -> Result = { response in
             try response.message
         }
     ) async throws -> Result where Result: Sendable {
-        try await self.getFeature(
+        let request = GRPCCore.ClientRequest<Routeguide_ChatActivityRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.getChatActivity(
             request: request,
-            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_Point>(),
-            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_Feature>(),
             options: options,
             onResponse: handleResponse
         )
     }
 
-    /// Call the "ListFeatures" method.
+    /// Call the "DownloadRegionBundle" method.
     ///
     /// > Source IDL Documentation:
     /// >
     /// > A server-to-client streaming RPC.
     /// > 
-    /// > Obtains the Features available within the given Rectangle.  Results are
-    /// > streamed rather than returned at once (e.g. in a response message with a
-    /// > repeated field), as the rectangle may cover a large area and contain a
-    /// > huge number of features.
+    /// > Streams a gzip-compressed tar archive of the features in a region, and
+    /// > optionally their vector tiles, for offline use. An interrupted download
+    /// > can be resumed from any offset as long as the bundle hasn't changed.
     ///
     /// - Parameters:
-    ///   - request: A request containing a single `Routeguide_Rectangle` message.
-    ///   - options: Options to apply to this RPC.
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
     ///   - handleResponse: A closure which handles the response, the result of which is
     ///       returned to the caller. Returning from the closure will cancel the RPC if it
     ///       hasn't already finished.
     /// - Returns: The result of `handleResponse`.
-    public func listFeatures<Result>(
-        request: GRPCCore.ClientRequest<Routeguide_Rectangle>,
+    public func downloadRegionBundle<Result>(
+        _ message: Routeguide_BundleRequest,
+        metadata: GRPCCore.Metadata = [:],
         options: GRPCCore.CallOptions = .defaults,
-        onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_Feature>) async throws -> Result
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_BundleChunk>) async throws -> Result
     ) async throws -> Result where Result: Sendable {
-        try await self.listFeatures(
+        let request = GRPCCore.ClientRequest<Routeguide_BundleRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.downloadRegionBundle(
             request: request,
-            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_Rectangle>(),
-            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_Feature>(),
             options: options,
             onResponse: handleResponse
         )
     }
 
-    /// Call the "RecordRoute" method.
+    /// Call the "SnapToNearestFeature" method.
     ///
     /// > Source IDL Documentation:
     /// >
-    /// > A client-to-server streaming RPC.
+    /// > A simple RPC.
     /// > 
-    /// > Accepts a stream of Points on a route being traversed, returning a
-    /// > RouteSummary when traversal is completed.
+    /// > Snaps a point to the nearest feature within a distance, so apps can
+    /// > anchor user pins to known places.
     ///
     /// - Parameters:
-    ///   - request: A streaming request producing `Routeguide_Point` messages.
-    ///   - options: Options to apply to this RPC.
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
     ///   - handleResponse: A closure which handles the response, the result of which is
     ///       returned to the caller. Returning from the closure will cancel the RPC if it
     ///       hasn't already finished.
     /// - Returns: The result of `handleResponse`.
-    public func recordRoute<Result>(
-        request: GRPCCore.StreamingClientRequest<Routeguide_Point>,
+    public func snapToNearestFeature<Result>(
+        _ message: Routeguide_SnapRequest,
+        metadata: GRPCCore.Metadata = [:],
         options: GRPCCore.CallOptions = .defaults,
-        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_RouteSummary>) async throws -> Result = { response in
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_SnapResult>) async throws -> Result = { response in
             try response.message
         }
     ) async throws -> Result where Result: Sendable {
-        try await self.recordRoute(
+        let request = GRPCCore.ClientRequest<Routeguide_SnapRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.snapToNearestFeature(
             request: request,
-            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_Point>(),
-            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_RouteSummary>(),
             options: options,
             onResponse: handleResponse
         )
     }
 
-    /// Call the "RouteChat" method.
+    /// Call the "NearestFeatures" method.
     ///
     /// > Source IDL Documentation:
     /// >
-    /// > A Bidirectional streaming RPC.
+    /// > A simple RPC.
     /// > 
-    /// > Accepts a stream of RouteNotes sent while a route is being traversed,
-    /// > while receiving other RouteNotes (e.g. from other users).
+    /// > Returns the named features nearest to a point, closest first, with their
+    /// > distances, wherever they are.
     ///
     /// - Parameters:
-    ///   - request: A streaming request producing `Routeguide_RouteNote` messages.
-    ///   - options: Options to apply to this RPC.
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
     ///   - handleResponse: A closure which handles the response, the result of which is
     ///       returned to the caller. Returning from the closure will cancel the RPC if it
     ///       hasn't already finished.
     /// - Returns: The result of `handleResponse`.
-    public func routeChat<Result>(
-        request: GRPCCore.StreamingClientRequest<Routeguide_RouteNote>,
+    public func nearestFeatures<Result>(
+        _ message: Routeguide_NearestFeaturesRequest,
+        metadata: GRPCCore.Metadata = [:],
         options: GRPCCore.CallOptions = .defaults,
-        onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_RouteNote>) async throws -> Result
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_NearestFeaturesResponse>) async throws -> Result = { response in
+            try response.message
+        }
     ) async throws -> Result where Result: Sendable {
-        try await self.routeChat(
+        let request = GRPCCore.ClientRequest<Routeguide_NearestFeaturesRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.nearestFeatures(
             request: request,
-            serializer: GRPCProtobuf.ProtobufSerializer<Routeguide_RouteNote>(),
-            deserializer: GRPCProtobuf.ProtobufDeserializer<Routeguide_RouteNote>(),
             options: options,
             onResponse: handleResponse
         )
     }
-}
 
-// Helpers providing sugared APIs for 'ClientProtocol' methods.
-@available(macOS 15.0, iOS 18.0, watchOS 11.0, tvOS 18.0, visionOS 2.0, *)
-extension Routeguide_RouteGuide.ClientProtocol {
-    /// Call the "GetFeature" method.
+    /// Call the "ListFeaturesInRadius" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A server-to-client streaming RPC.
+    /// > 
+    /// > Obtains the features within a distance of a point, closest first, with
+    /// > their distances, e.g. to show the features near a user.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func listFeaturesInRadius<Result>(
+        _ message: Routeguide_RadiusRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_NearestFeature>) async throws -> Result
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_RadiusRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.listFeaturesInRadius(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "ListFeaturesInPolygon" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A server-to-client streaming RPC.
+    /// > 
+    /// > Obtains the Features within the given Polygon, edges included, in the
+    /// > same order as ListFeatures. A polygon whose edges cross is rejected with
+    /// > INVALID_ARGUMENT.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func listFeaturesInPolygon<Result>(
+        _ message: Routeguide_Polygon,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_Feature>) async throws -> Result
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_Polygon>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.listFeaturesInPolygon(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "SubmitFeature" method.
     ///
     /// > Source IDL Documentation:
     /// >
     /// > A simple RPC.
     /// > 
-    /// > Obtains the feature at a given position.
+    /// > Submits a new or changed feature. Submissions from admins are applied
+    /// > right away; others are queued as PENDING until an admin approves or
+    /// > rejects them with the Admin service, and don't appear in queries
+    /// > meanwhile.
+    ///
+    /// - Parameters:
+    ///   - message: request message to send.
+    ///   - metadata: Additional metadata to send, defaults to empty.
+    ///   - options: Options to apply to this RPC, defaults to `.defaults`.
+    ///   - handleResponse: A closure which handles the response, the result of which is
+    ///       returned to the caller. Returning from the closure will cancel the RPC if it
+    ///       hasn't already finished.
+    /// - Returns: The result of `handleResponse`.
+    public func submitFeature<Result>(
+        _ message: Routeguide_SubmitFeatureRequest,
+        metadata: GRPCCore.Metadata = [:],
+        options: GRPCCore.CallOptions = .defaults,
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeatureSubmission>) async throws -> Result = { response in
+            try response.message
+        }
+    ) async throws -> Result where Result: Sendable {
+        let request = GRPCCore.ClientRequest<Routeguide_SubmitFeatureRequest>(
+            message: message,
+            metadata: metadata
+        )
+        return try await self.submitFeature(
+            request: request,
+            options: options,
+            onResponse: handleResponse
+        )
+    }
+
+    /// Call the "CreateFeature" method.
+    ///
+    /// > Source IDL Documentation:
+    /// >
+    /// > A simple RPC.
     /// > 
-    /// > A feature with an empty name is returned if there's no feature at the given
-    /// > position.
+    /// > Adds a feature at a location that has none. Admin only; the change is
+    /// > applied right away, without review.
     ///
     /// - Parameters:
     ///   - message: request message to send.
@@ -993,35 +5933,32 @@ extension Routeguide_RouteGuide.ClientProtocol {
     ///       returned to the caller. Returning from the closure will cancel the RPC if it
     ///       hasn't already finished.
     /// - Returns: The result of `handleResponse`.
-    public func getFeature<Result>(
-        _ message: Routeguide_Point,
+    public func createFeature<Result>(
+        _ message: Routeguide_CreateFeatureRequest,
         metadata: GRPCCore.Metadata = [:],
         options: GRPCCore.CallOptions = .defaults,
-        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_Feature>) async throws -> Result = { response in
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeatureMutation>) async throws -> Result = { response in
             try response.message
         }
     ) async throws -> Result where Result: Sendable {
-        let request = GRPCCore.ClientRequest<Routeguide_Point>(
+        let request = GRPCCore.ClientRequest<Routeguide_CreateFeatureRequest>(
             message: message,
             metadata: metadata
         )
-        return try await self.getFeature(
+        return try await self.createFeature(
             request: request,
             options: options,
             onResponse: handleResponse
         )
     }
 
-    /// Call the "ListFeatures" method.
+    /// Call the "UpdateFeature" method.
     ///
     /// > Source IDL Documentation:
     /// >
-    /// > A server-to-client streaming RPC.
+    /// > A simple RPC.
     /// > 
-    /// > Obtains the Features available within the given Rectangle.  Results are
-    /// > streamed rather than returned at once (e.g. in a response message with a
-    /// > repeated field), as the rectangle may cover a large area and contain a
-    /// > huge number of features.
+    /// > Replaces the feature at a location. Admin only.
     ///
     /// - Parameters:
     ///   - message: request message to send.
@@ -1031,89 +5968,91 @@ extension Routeguide_RouteGuide.ClientProtocol {
     ///       returned to the caller. Returning from the closure will cancel the RPC if it
     ///       hasn't already finished.
     /// - Returns: The result of `handleResponse`.
-    public func listFeatures<Result>(
-        _ message: Routeguide_Rectangle,
+    public func updateFeature<Result>(
+        _ message: Routeguide_UpdateFeatureRequest,
         metadata: GRPCCore.Metadata = [:],
         options: GRPCCore.CallOptions = .defaults,
-        onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_Feature>) async throws -> Result
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeatureMutation>) async throws -> Result = { response in
+            try response.message
+        }
     ) async throws -> Result where Result: Sendable {
-        let request = GRPCCore.ClientRequest<Routeguide_Rectangle>(
+        let request = GRPCCore.ClientRequest<Routeguide_UpdateFeatureRequest>(
             message: message,
             metadata: metadata
         )
-        return try await self.listFeatures(
+        return try await self.updateFeature(
             request: request,
             options: options,
             onResponse: handleResponse
         )
     }
 
-    /// Call the "RecordRoute" method.
+    /// Call the "DeleteFeature" method.
     ///
     /// > Source IDL Documentation:
     /// >
-    /// > A client-to-server streaming RPC.
+    /// > A simple RPC.
     /// > 
-    /// > Accepts a stream of Points on a route being traversed, returning a
-    /// > RouteSummary when traversal is completed.
+    /// > Removes the feature at a location. Admin only.
     ///
     /// - Parameters:
+    ///   - message: request message to send.
     ///   - metadata: Additional metadata to send, defaults to empty.
     ///   - options: Options to apply to this RPC, defaults to `.defaults`.
-    ///   - producer: A closure producing request messages to send to the server. The request
-    ///       stream is closed when the closure returns.
     ///   - handleResponse: A closure which handles the response, the result of which is
     ///       returned to the caller. Returning from the closure will cancel the RPC if it
     ///       hasn't already finished.
     /// - Returns: The result of `handleResponse`.
-    public func recordRoute<Result>(
+    public func deleteFeature<Result>(
+        _ message: Routeguide_DeleteFeatureRequest,
         metadata: GRPCCore.Metadata = [:],
         options: GRPCCore.CallOptions = .defaults,
-        requestProducer producer: @Sendable @escaping (GRPCCore.RPCWriter<Routeguide_Point>) async throws -> Void,
-        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_RouteSummary>) async throws -> Result = { response in
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_FeatureMutation>) async throws -> Result = { response in
             try response.message
         }
     ) async throws -> Result where Result: Sendable {
-        let request = GRPCCore.StreamingClientRequest<Routeguide_Point>(
-            metadata: metadata,
-            producer: producer
+        let request = GRPCCore.ClientRequest<Routeguide_DeleteFeatureRequest>(
+            message: message,
+            metadata: metadata
         )
-        return try await self.recordRoute(
+        return try await self.deleteFeature(
             request: request,
             options: options,
             onResponse: handleResponse
         )
     }
 
-    /// Call the "RouteChat" method.
+    /// Call the "GetClientConfig" method.
     ///
     /// > Source IDL Documentation:
     /// >
-    /// > A Bidirectional streaming RPC.
+    /// > A simple RPC.
     /// > 
-    /// > Accepts a stream of RouteNotes sent while a route is being traversed,
-    /// > while receiving other RouteNotes (e.g. from other users).
+    /// > Returns the settings the server recommends to clients, so they can be
+    /// > tuned centrally without an app release. Clients should fetch it at
+    /// > startup and again after its refresh_interval.
     ///
     /// - Parameters:
+    ///   - message: request message to send.
     ///   - metadata: Additional metadata to send, defaults to empty.
     ///   - options: Options to apply to this RPC, defaults to `.defaults`.
-    ///   - producer: A closure producing request messages to send to the server. The request
-    ///       stream is closed when the closure returns.
     ///   - handleResponse: A closure which handles the response, the result of which is
     ///       returned to the caller. Returning from the closure will cancel the RPC if it
     ///       hasn't already finished.
     /// - Returns: The result of `handleResponse`.
-    public func routeChat<Result>(
+    public func getClientConfig<Result>(
+        _ message: Routeguide_GetClientConfigRequest,
         metadata: GRPCCore.Metadata = [:],
         options: GRPCCore.CallOptions = .defaults,
-        requestProducer producer: @Sendable @escaping (GRPCCore.RPCWriter<Routeguide_RouteNote>) async throws -> Void,
-        onResponse handleResponse: @Sendable @escaping (GRPCCore.StreamingClientResponse<Routeguide_RouteNote>) async throws -> Result
+        onResponse handleResponse: @Sendable @escaping (GRPCCore.ClientResponse<Routeguide_ClientConfig>) async throws -> Result = { response in
+            try response.message
+        }
     ) async throws -> Result where Result: Sendable {
-        let request = GRPCCore.StreamingClientRequest<Routeguide_RouteNote>(
-            metadata: metadata,
-            producer: producer
+        let request = GRPCCore.ClientRequest<Routeguide_GetClientConfigRequest>(
+            message: message,
+            metadata: metadata
         )
-        return try await self.routeChat(
+        return try await self.getClientConfig(
             request: request,
             options: options,
             onResponse: handleResponse
@@ -22,6 +22,7 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+import Foundation
 import SwiftProtobuf
 
 // If the compiler emits an error on this type, it is because this file
@@ -34,10 +35,155 @@ fileprivate struct _GeneratedWithProtocGenSwiftVersion: SwiftProtobuf.ProtobufAP
   typealias Version = _2
 }
 
+/// The mode of transport a route was traversed with. It is sent as
+/// "travel-profile" metadata on RecordRoute (e.g. "walking").
+public enum Routeguide_TravelProfile: SwiftProtobuf.Enum, Swift.CaseIterable {
+  public typealias RawValue = Int
+  case unspecified // = 0
+  case walking // = 1
+  case cycling // = 2
+  case driving // = 3
+  case UNRECOGNIZED(Int)
+
+  public init() {
+    self = .unspecified
+  }
+
+  public init?(rawValue: Int) {
+    switch rawValue {
+    case 0: self = .unspecified
+    case 1: self = .walking
+    case 2: self = .cycling
+    case 3: self = .driving
+    default: self = .UNRECOGNIZED(rawValue)
+    }
+  }
+
+  public var rawValue: Int {
+    switch self {
+    case .unspecified: return 0
+    case .walking: return 1
+    case .cycling: return 2
+    case .driving: return 3
+    case .UNRECOGNIZED(let i): return i
+    }
+  }
+
+  // The compiler won't synthesize support with the UNRECOGNIZED case.
+  public static let allCases: [Routeguide_TravelProfile] = [
+    .unspecified,
+    .walking,
+    .cycling,
+    .driving,
+  ]
+
+}
+
+/// How feature names are compared by name filters.
+public enum Routeguide_NameCollation: SwiftProtobuf.Enum, Swift.CaseIterable {
+  public typealias RawValue = Int
+
+  /// Names must match exactly, byte for byte.
+  case exact // = 0
+
+  /// Names match regardless of case ("berkshire" matches "Berkshire").
+  case caseInsensitive // = 1
+
+  /// Names match regardless of case, Unicode normalization form and
+  /// diacritics ("cafe" matches "Café", whether é is composed or not).
+  case normalized // = 2
+  case UNRECOGNIZED(Int)
+
+  public init() {
+    self = .exact
+  }
+
+  public init?(rawValue: Int) {
+    switch rawValue {
+    case 0: self = .exact
+    case 1: self = .caseInsensitive
+    case 2: self = .normalized
+    default: self = .UNRECOGNIZED(rawValue)
+    }
+  }
+
+  public var rawValue: Int {
+    switch self {
+    case .exact: return 0
+    case .caseInsensitive: return 1
+    case .normalized: return 2
+    case .UNRECOGNIZED(let i): return i
+    }
+  }
+
+  // The compiler won't synthesize support with the UNRECOGNIZED case.
+  public static let allCases: [Routeguide_NameCollation] = [
+    .exact,
+    .caseInsensitive,
+    .normalized,
+  ]
+
+}
+
+/// The moderation state of a feature submission.
+public enum Routeguide_SubmissionState: SwiftProtobuf.Enum, Swift.CaseIterable {
+  public typealias RawValue = Int
+  case unspecified // = 0
+
+  /// Waiting for an admin to review it.
+  case pending // = 1
+
+  /// Applied to the dataset.
+  case approved // = 2
+
+  /// Turned down by an admin.
+  case rejected // = 3
+  case UNRECOGNIZED(Int)
+
+  public init() {
+    self = .unspecified
+  }
+
+  public init?(rawValue: Int) {
+    switch rawValue {
+    case 0: self = .unspecified
+    case 1: self = .pending
+    case 2: self = .approved
+    case 3: self = .rejected
+    default: self = .UNRECOGNIZED(rawValue)
+    }
+  }
+
+  public var rawValue: Int {
+    switch self {
+    case .unspecified: return 0
+    case .pending: return 1
+    case .approved: return 2
+    case .rejected: return 3
+    case .UNRECOGNIZED(let i): return i
+    }
+  }
+
+  // The compiler won't synthesize support with the UNRECOGNIZED case.
+  public static let allCases: [Routeguide_SubmissionState] = [
+    .unspecified,
+    .pending,
+    .approved,
+    .rejected,
+  ]
+
+}
+
 /// Points are represented as latitude-longitude pairs in the E7 representation
 /// (degrees multiplied by 10**7 and rounded to the nearest integer).
 /// Latitudes should be in the range +/- 90 degrees and longitude should be in
 /// the range +/- 180 degrees (inclusive).
+///
+/// Calls sending coordinate-system metadata other than "e7" use x and y
+/// instead, in that coordinate system: longitude and latitude in degrees for
+/// "degrees", or easting and northing in meters for "web-mercator"
+/// (EPSG:3857). The server reads them from requests and sets them, along with
+/// latitude and longitude, in responses.
 public struct Routeguide_Point: Sendable {
   // SwiftProtobuf.Message conformance is added in an extension below. See the
   // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
@@ -47,13 +193,19 @@ public struct Routeguide_Point: Sendable {
 
   public var longitude: Int32 = 0
 
+  public var x: Double = 0
+
+  public var y: Double = 0
+
   public var unknownFields = SwiftProtobuf.UnknownStorage()
 
   public init() {}
 }
 
 /// A latitude-longitude rectangle, represented as two diagonally opposite
-/// points "lo" and "hi".
+/// points "lo" and "hi", given either way round: the rectangle spans from the
+/// lesser latitude and longitude of the two to the greater, unless
+/// crosses_antimeridian is set.
 public struct Routeguide_Rectangle: Sendable {
   // SwiftProtobuf.Message conformance is added in an extension below. See the
   // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
@@ -79,12 +231,74 @@ public struct Routeguide_Rectangle: Sendable {
   /// Clears the value of `hi`. Subsequent reads from it will return its default value.
   public mutating func clearHi() {self._hi = nil}
 
+  /// Optional: ListFeatures only sends the features whose names match it.
+  /// Other RPCs taking a rectangle ignore it.
+  public var nameFilter: Routeguide_NameFilter {
+    get {return _nameFilter ?? Routeguide_NameFilter()}
+    set {_nameFilter = newValue}
+  }
+  /// Returns true if `nameFilter` has been explicitly set.
+  public var hasNameFilter: Bool {return self._nameFilter != nil}
+  /// Clears the value of `nameFilter`. Subsequent reads from it will return its default value.
+  public mutating func clearNameFilter() {self._nameFilter = nil}
+
+  /// Whether the rectangle spans eastwards from lo's longitude to hi's, so
+  /// that one whose lo longitude is greater than its hi longitude crosses
+  /// the antimeridian instead of spanning the longitudes between them.
+  /// Latitudes may still come either way round.
+  public var crossesAntimeridian: Bool = false
+
   public var unknownFields = SwiftProtobuf.UnknownStorage()
 
   public init() {}
 
   fileprivate var _lo: Routeguide_Point? = nil
   fileprivate var _hi: Routeguide_Point? = nil
+  fileprivate var _nameFilter: Routeguide_NameFilter? = nil
+}
+
+/// A NameFilter selects features by name. Names are matched in the locale
+/// they are sent in, and every condition set must hold.
+public struct Routeguide_NameFilter: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Only features whose name contains this text, if not empty. At most 256
+  /// bytes.
+  public var contains: String = String()
+
+  /// Only features whose name starts with this text, if not empty. At most
+  /// 256 bytes.
+  public var prefix: String = String()
+
+  /// Only named features, skipping the unnamed points.
+  public var namedOnly: Bool = false
+
+  /// How names are compared with contains and prefix.
+  public var collation: Routeguide_NameCollation = .exact
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// A simple polygon in latitude-longitude space: its edges run straight
+/// between consecutive points, and from the last point back to the first, and
+/// never cross or touch.
+public struct Routeguide_Polygon: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The vertices of the polygon, 3 to 1000, in either winding order. A last
+  /// point equal to the first is ignored, so closed rings such as
+  /// IsochroneRing's are accepted.
+  public var points: [Routeguide_Point] = []
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
 }
 
 /// A feature names something at a given point.
@@ -115,6 +329,56 @@ public struct Routeguide_Feature: Sendable {
   fileprivate var _location: Routeguide_Point? = nil
 }
 
+/// A ListFeaturesPageRequest asks for a page of the features in a rectangle.
+public struct Routeguide_ListFeaturesPageRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The rectangle to list features in.
+  public var rectangle: Routeguide_Rectangle {
+    get {return _rectangle ?? Routeguide_Rectangle()}
+    set {_rectangle = newValue}
+  }
+  /// Returns true if `rectangle` has been explicitly set.
+  public var hasRectangle: Bool {return self._rectangle != nil}
+  /// Clears the value of `rectangle`. Subsequent reads from it will return its default value.
+  public mutating func clearRectangle() {self._rectangle = nil}
+
+  /// The maximum number of features to return. Defaults to 100, capped at 1000.
+  public var pageSize: Int32 = 0
+
+  /// The next_page_token of a previous response for the same rectangle, or
+  /// empty for the first page.
+  public var pageToken: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _rectangle: Routeguide_Rectangle? = nil
+}
+
+/// A FeaturePage is a page of features, ordered by latitude, then longitude.
+public struct Routeguide_FeaturePage: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The features in this page.
+  public var features: [Routeguide_Feature] = []
+
+  /// A token for the following page, or empty if this is the last one.
+  public var nextPageToken: String = String()
+
+  /// The version of the dataset the page was read from.
+  public var version: Int64 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
 /// A RouteNote is a message sent while at a given point.
 public struct Routeguide_RouteNote: Sendable {
   // SwiftProtobuf.Message conformance is added in an extension below. See the
@@ -134,11 +398,42 @@ public struct Routeguide_RouteNote: Sendable {
   /// The message to be sent.
   public var message: String = String()
 
+  /// Who sent the note: the sender's authenticated identity, such as the
+  /// common name of its client certificate. Set by the server; empty for
+  /// anonymous senders.
+  public var author: String = String()
+
+  /// Identifies the note. Set by the server.
+  public var id: String = String()
+
+  /// The RouteNote schema version the sender wrote the note with; 0 for
+  /// clients predating versioning, which write version 1. Notes from newer
+  /// versions may carry fields the server doesn't know: it keeps and relays
+  /// them unchanged.
+  public var schemaVersion: UInt32 = 0
+
+  /// Continues a capped replay of the notes at a location. The server sets
+  /// it on the last note it replays when it left later ones out; a client
+  /// sends it back, in a note with the same location and no message, to have
+  /// the next notes replayed. It is also a ListNoteHistory page_token.
+  public var historyToken: String = String()
+
+  /// When the server received the note. Set by the server.
+  public var receivedAt: SwiftProtobuf.Google_Protobuf_Timestamp {
+    get {return _receivedAt ?? SwiftProtobuf.Google_Protobuf_Timestamp()}
+    set {_receivedAt = newValue}
+  }
+  /// Returns true if `receivedAt` has been explicitly set.
+  public var hasReceivedAt: Bool {return self._receivedAt != nil}
+  /// Clears the value of `receivedAt`. Subsequent reads from it will return its default value.
+  public mutating func clearReceivedAt() {self._receivedAt = nil}
+
   public var unknownFields = SwiftProtobuf.UnknownStorage()
 
   public init() {}
 
   fileprivate var _location: Routeguide_Point? = nil
+  fileprivate var _receivedAt: SwiftProtobuf.Google_Protobuf_Timestamp? = nil
 }
 
 /// A RouteSummary is received in response to a RecordRoute rpc.
@@ -163,140 +458,3395 @@ public struct Routeguide_RouteSummary: Sendable {
   /// The duration of the traversal in seconds.
   public var elapsedTime: Int32 = 0
 
+  /// Segments that could not have been traversed physically, in the order
+  /// they were received.
+  public var anomalies: [Routeguide_RouteAnomaly] = []
+
+  /// The travel profile the statistics were computed for.
+  public var profile: Routeguide_TravelProfile = .unspecified
+
+  /// The estimated time to travel the distance with the profile, in seconds.
+  /// Zero when no profile was given.
+  public var estimatedTime: Int32 = 0
+
+  /// The estimated energy spent travelling the distance, in kilocalories.
+  /// Zero for motorised profiles or when no profile was given.
+  public var calories: Int32 = 0
+
+  /// The ID the route was stored under, for use with CompareRoutes.
+  public var routeID: String = String()
+
   public var unknownFields = SwiftProtobuf.UnknownStorage()
 
   public init() {}
 }
 
-// MARK: - Code below here is support for the SwiftProtobuf runtime.
+/// A RouteAnomaly flags a segment between two consecutive points of a recorded
+/// route, such as a teleport or an implausible speed.
+public struct Routeguide_RouteAnomaly: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
 
-fileprivate let _protobuf_package = "routeguide"
+  /// What is wrong with the segment.
+  public var kind: Routeguide_RouteAnomaly.Kind = .unspecified
 
-extension Routeguide_Point: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
-  public static let protoMessageName: String = _protobuf_package + ".Point"
-  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}latitude\0\u{1}longitude\0")
+  /// The 1-based index of the point ending the segment.
+  public var pointIndex: Int32 = 0
 
-  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
-    while let fieldNumber = try decoder.nextFieldNumber() {
-      // The use of inline closures is to circumvent an issue where the compiler
-      // allocates stack space for every case branch when no optimizations are
-      // enabled. https://github.com/apple/swift-protobuf/issues/1034
-      switch fieldNumber {
-      case 1: try { try decoder.decodeSingularInt32Field(value: &self.latitude) }()
-      case 2: try { try decoder.decodeSingularInt32Field(value: &self.longitude) }()
-      default: break
-      }
-    }
+  /// The point starting the segment.
+  public var from: Routeguide_Point {
+    get {return _from ?? Routeguide_Point()}
+    set {_from = newValue}
   }
+  /// Returns true if `from` has been explicitly set.
+  public var hasFrom: Bool {return self._from != nil}
+  /// Clears the value of `from`. Subsequent reads from it will return its default value.
+  public mutating func clearFrom() {self._from = nil}
 
-  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
-    if self.latitude != 0 {
-      try visitor.visitSingularInt32Field(value: self.latitude, fieldNumber: 1)
-    }
-    if self.longitude != 0 {
-      try visitor.visitSingularInt32Field(value: self.longitude, fieldNumber: 2)
-    }
-    try unknownFields.traverse(visitor: &visitor)
+  /// The point ending the segment.
+  public var to: Routeguide_Point {
+    get {return _to ?? Routeguide_Point()}
+    set {_to = newValue}
   }
+  /// Returns true if `to` has been explicitly set.
+  public var hasTo: Bool {return self._to != nil}
+  /// Clears the value of `to`. Subsequent reads from it will return its default value.
+  public mutating func clearTo() {self._to = nil}
 
-  public static func ==(lhs: Routeguide_Point, rhs: Routeguide_Point) -> Bool {
-    if lhs.latitude != rhs.latitude {return false}
-    if lhs.longitude != rhs.longitude {return false}
-    if lhs.unknownFields != rhs.unknownFields {return false}
-    return true
-  }
-}
+  /// The length of the segment in metres.
+  public var distance: Int32 = 0
 
-extension Routeguide_Rectangle: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
-  public static let protoMessageName: String = _protobuf_package + ".Rectangle"
-  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}lo\0\u{1}hi\0")
+  /// The implied speed in km/h, or 0 if it could not be measured.
+  public var speedKmh: Double = 0
 
-  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
-    while let fieldNumber = try decoder.nextFieldNumber() {
-      // The use of inline closures is to circumvent an issue where the compiler
-      // allocates stack space for every case branch when no optimizations are
-      // enabled. https://github.com/apple/swift-protobuf/issues/1034
-      switch fieldNumber {
-      case 1: try { try decoder.decodeSingularMessageField(value: &self._lo) }()
-      case 2: try { try decoder.decodeSingularMessageField(value: &self._hi) }()
-      default: break
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public enum Kind: SwiftProtobuf.Enum, Swift.CaseIterable {
+    public typealias RawValue = Int
+    case unspecified // = 0
+
+    /// The segment is longer than any plausible gap between two fixes.
+    case teleport // = 1
+
+    /// The speed implied by the segment exceeds the configured maximum.
+    case excessiveSpeed // = 2
+    case UNRECOGNIZED(Int)
+
+    public init() {
+      self = .unspecified
+    }
+
+    public init?(rawValue: Int) {
+      switch rawValue {
+      case 0: self = .unspecified
+      case 1: self = .teleport
+      case 2: self = .excessiveSpeed
+      default: self = .UNRECOGNIZED(rawValue)
       }
     }
-  }
 
-  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
-    // The use of inline closures is to circumvent an issue where the compiler
-    // allocates stack space for every if/case branch local when no optimizations
-    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
-    // https://github.com/apple/swift-protobuf/issues/1182
-    try { if let v = self._lo {
-      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
-    } }()
-    try { if let v = self._hi {
-      try visitor.visitSingularMessageField(value: v, fieldNumber: 2)
-    } }()
-    try unknownFields.traverse(visitor: &visitor)
+    public var rawValue: Int {
+      switch self {
+      case .unspecified: return 0
+      case .teleport: return 1
+      case .excessiveSpeed: return 2
+      case .UNRECOGNIZED(let i): return i
+      }
+    }
+
+    // The compiler won't synthesize support with the UNRECOGNIZED case.
+    public static let allCases: [Routeguide_RouteAnomaly.Kind] = [
+      .unspecified,
+      .teleport,
+      .excessiveSpeed,
+    ]
+
   }
 
-  public static func ==(lhs: Routeguide_Rectangle, rhs: Routeguide_Rectangle) -> Bool {
-    if lhs._lo != rhs._lo {return false}
-    if lhs._hi != rhs._hi {return false}
-    if lhs.unknownFields != rhs.unknownFields {return false}
-    return true
+  public init() {}
+
+  fileprivate var _from: Routeguide_Point? = nil
+  fileprivate var _to: Routeguide_Point? = nil
+}
+
+/// An IsochroneRequest asks for the area reachable from a point in a given time.
+public struct Routeguide_IsochroneRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The starting point.
+  public var center: Routeguide_Point {
+    get {return _center ?? Routeguide_Point()}
+    set {_center = newValue}
   }
+  /// Returns true if `center` has been explicitly set.
+  public var hasCenter: Bool {return self._center != nil}
+  /// Clears the value of `center`. Subsequent reads from it will return its default value.
+  public mutating func clearCenter() {self._center = nil}
+
+  /// The time budget in seconds.
+  public var duration: Int32 = 0
+
+  /// The mode of transport. Must be specified.
+  public var profile: Routeguide_TravelProfile = .unspecified
+
+  /// The number of equally spaced time bands to return. Defaults to 1.
+  public var bands: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _center: Routeguide_Point? = nil
 }
 
-extension Routeguide_Feature: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
-  public static let protoMessageName: String = _protobuf_package + ".Feature"
-  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}name\0\u{1}location\0")
+/// An IsochroneRing is a closed polygon ring bounding the area reachable within
+/// a time band. The first and last points are equal.
+public struct Routeguide_IsochroneRing: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
 
-  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
-    while let fieldNumber = try decoder.nextFieldNumber() {
-      // The use of inline closures is to circumvent an issue where the compiler
-      // allocates stack space for every case branch when no optimizations are
-      // enabled. https://github.com/apple/swift-protobuf/issues/1034
-      switch fieldNumber {
-      case 1: try { try decoder.decodeSingularStringField(value: &self.name) }()
-      case 2: try { try decoder.decodeSingularMessageField(value: &self._location) }()
-      default: break
-      }
+  /// The time budget this ring was computed for, in seconds.
+  public var duration: Int32 = 0
+
+  /// The vertices of the ring.
+  public var points: [Routeguide_Point] = []
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// A RouteRef identifies a route to compare.
+public struct Routeguide_RouteRef: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var route: Routeguide_RouteRef.OneOf_Route? = nil
+
+  /// The ID of a route recorded with RecordRoute.
+  public var id: String {
+    get {
+      if case .id(let v)? = route {return v}
+      return String()
     }
+    set {route = .id(newValue)}
   }
 
-  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
-    // The use of inline closures is to circumvent an issue where the compiler
-    // allocates stack space for every if/case branch local when no optimizations
-    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
-    // https://github.com/apple/swift-protobuf/issues/1182
-    if !self.name.isEmpty {
-      try visitor.visitSingularStringField(value: self.name, fieldNumber: 1)
+  /// An ad-hoc route.
+  public var points: Routeguide_RoutePoints {
+    get {
+      if case .points(let v)? = route {return v}
+      return Routeguide_RoutePoints()
     }
-    try { if let v = self._location {
-      try visitor.visitSingularMessageField(value: v, fieldNumber: 2)
-    } }()
-    try unknownFields.traverse(visitor: &visitor)
+    set {route = .points(newValue)}
   }
 
-  public static func ==(lhs: Routeguide_Feature, rhs: Routeguide_Feature) -> Bool {
-    if lhs.name != rhs.name {return false}
-    if lhs._location != rhs._location {return false}
-    if lhs.unknownFields != rhs.unknownFields {return false}
-    return true
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public enum OneOf_Route: Equatable, Sendable {
+    /// The ID of a route recorded with RecordRoute.
+    case id(String)
+    /// An ad-hoc route.
+    case points(Routeguide_RoutePoints)
+
   }
+
+  public init() {}
 }
 
-extension Routeguide_RouteNote: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
-  public static let protoMessageName: String = _protobuf_package + ".RouteNote"
-  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}location\0\u{1}message\0")
+/// RoutePoints is an ordered list of points making up a route.
+public struct Routeguide_RoutePoints: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
 
-  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
-    while let fieldNumber = try decoder.nextFieldNumber() {
-      // The use of inline closures is to circumvent an issue where the compiler
-      // allocates stack space for every case branch when no optimizations are
+  public var points: [Routeguide_Point] = []
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// A CompareRoutesRequest names the two routes to compare.
+public struct Routeguide_CompareRoutesRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The reference route.
+  public var first: Routeguide_RouteRef {
+    get {return _first ?? Routeguide_RouteRef()}
+    set {_first = newValue}
+  }
+  /// Returns true if `first` has been explicitly set.
+  public var hasFirst: Bool {return self._first != nil}
+  /// Clears the value of `first`. Subsequent reads from it will return its default value.
+  public mutating func clearFirst() {self._first = nil}
+
+  /// The route compared against the reference.
+  public var second: Routeguide_RouteRef {
+    get {return _second ?? Routeguide_RouteRef()}
+    set {_second = newValue}
+  }
+  /// Returns true if `second` has been explicitly set.
+  public var hasSecond: Bool {return self._second != nil}
+  /// Clears the value of `second`. Subsequent reads from it will return its default value.
+  public mutating func clearSecond() {self._second = nil}
+
+  /// How far apart, in metres, two routes may be and still count as
+  /// overlapping. Defaults to 50.
+  public var tolerance: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _first: Routeguide_RouteRef? = nil
+  fileprivate var _second: Routeguide_RouteRef? = nil
+}
+
+/// A RouteComparison describes how two routes relate.
+public struct Routeguide_RouteComparison: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The percentage (0-100) of points of both routes lying within the
+  /// tolerance of the other route.
+  public var overlap: Double = 0
+
+  /// Points of the first route after which it leaves the second route.
+  public var divergencePoints: [Routeguide_Point] = []
+
+  /// The length of the second route minus the length of the first, in metres.
+  public var distanceDelta: Int32 = 0
+
+  /// The length of the first route in metres.
+  public var firstDistance: Int32 = 0
+
+  /// The length of the second route in metres.
+  public var secondDistance: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// A TravelTimeRequest asks for the travel time between two points.
+public struct Routeguide_TravelTimeRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The point the trip starts at.
+  public var start: Routeguide_Point {
+    get {return _start ?? Routeguide_Point()}
+    set {_start = newValue}
+  }
+  /// Returns true if `start` has been explicitly set.
+  public var hasStart: Bool {return self._start != nil}
+  /// Clears the value of `start`. Subsequent reads from it will return its default value.
+  public mutating func clearStart() {self._start = nil}
+
+  /// The point the trip ends at.
+  public var end: Routeguide_Point {
+    get {return _end ?? Routeguide_Point()}
+    set {_end = newValue}
+  }
+  /// Returns true if `end` has been explicitly set.
+  public var hasEnd: Bool {return self._end != nil}
+  /// Clears the value of `end`. Subsequent reads from it will return its default value.
+  public mutating func clearEnd() {self._end = nil}
+
+  /// The mode of transport. Must be specified.
+  public var profile: Routeguide_TravelProfile = .unspecified
+
+  /// How close, in metres, a recorded route must pass to the start and end
+  /// points to be used. Defaults to 500.
+  public var searchRadius: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _start: Routeguide_Point? = nil
+  fileprivate var _end: Routeguide_Point? = nil
+}
+
+/// A TravelTimeEstimate is the estimated duration of a trip.
+public struct Routeguide_TravelTimeEstimate: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The estimated duration in seconds.
+  public var duration: Int32 = 0
+
+  /// The straight-line distance between the points in metres.
+  public var distance: Int32 = 0
+
+  /// The number of recorded trips the estimate is based on.
+  public var sampleCount: Int32 = 0
+
+  /// How the estimate was produced.
+  public var source: Routeguide_TravelTimeEstimate.Source = .unspecified
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public enum Source: SwiftProtobuf.Enum, Swift.CaseIterable {
+    public typealias RawValue = Int
+    case unspecified // = 0
+
+    /// Derived from previously recorded routes.
+    case historical // = 1
+
+    /// Derived from the straight-line distance and the profile's speed.
+    case heuristic // = 2
+    case UNRECOGNIZED(Int)
+
+    public init() {
+      self = .unspecified
+    }
+
+    public init?(rawValue: Int) {
+      switch rawValue {
+      case 0: self = .unspecified
+      case 1: self = .historical
+      case 2: self = .heuristic
+      default: self = .UNRECOGNIZED(rawValue)
+      }
+    }
+
+    public var rawValue: Int {
+      switch self {
+      case .unspecified: return 0
+      case .historical: return 1
+      case .heuristic: return 2
+      case .UNRECOGNIZED(let i): return i
+      }
+    }
+
+    // The compiler won't synthesize support with the UNRECOGNIZED case.
+    public static let allCases: [Routeguide_TravelTimeEstimate.Source] = [
+      .unspecified,
+      .historical,
+      .heuristic,
+    ]
+
+  }
+
+  public init() {}
+}
+
+/// A WatchFeaturesRequest subscribes to dataset changes.
+public struct Routeguide_WatchFeaturesRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The resume_token of the last event received by a previous watch. Only
+  /// honoured for clients that negotiated supports-resume.
+  public var resumeToken: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// A FeatureEvent describes a version of the feature dataset.
+public struct Routeguide_FeatureEvent: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Why the event was sent.
+  public var type: Routeguide_FeatureEvent.TypeEnum = .unspecified
+
+  /// The dataset version, increasing by one with every swap.
+  public var version: Int64 = 0
+
+  /// The number of features in the dataset.
+  public var featureCount: Int32 = 0
+
+  /// Where the dataset was loaded from.
+  public var source: String = String()
+
+  /// When the dataset was loaded.
+  public var loadedAt: SwiftProtobuf.Google_Protobuf_Timestamp {
+    get {return _loadedAt ?? SwiftProtobuf.Google_Protobuf_Timestamp()}
+    set {_loadedAt = newValue}
+  }
+  /// Returns true if `loadedAt` has been explicitly set.
+  public var hasLoadedAt: Bool {return self._loadedAt != nil}
+  /// Clears the value of `loadedAt`. Subsequent reads from it will return its default value.
+  public mutating func clearLoadedAt() {self._loadedAt = nil}
+
+  /// Resumes the watch after this event. Only set for clients that
+  /// negotiated supports-resume.
+  public var resumeToken: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public enum TypeEnum: SwiftProtobuf.Enum, Swift.CaseIterable {
+    public typealias RawValue = Int
+    case unspecified // = 0
+
+    /// The dataset in use when the watch started.
+    case datasetCurrent // = 1
+
+    /// A new dataset replaced the previous one.
+    case datasetReplaced // = 2
+
+    /// Nothing changed; sent periodically to clients that negotiated
+    /// supports-heartbeats so they can detect dead connections.
+    case heartbeat // = 3
+    case UNRECOGNIZED(Int)
+
+    public init() {
+      self = .unspecified
+    }
+
+    public init?(rawValue: Int) {
+      switch rawValue {
+      case 0: self = .unspecified
+      case 1: self = .datasetCurrent
+      case 2: self = .datasetReplaced
+      case 3: self = .heartbeat
+      default: self = .UNRECOGNIZED(rawValue)
+      }
+    }
+
+    public var rawValue: Int {
+      switch self {
+      case .unspecified: return 0
+      case .datasetCurrent: return 1
+      case .datasetReplaced: return 2
+      case .heartbeat: return 3
+      case .UNRECOGNIZED(let i): return i
+      }
+    }
+
+    // The compiler won't synthesize support with the UNRECOGNIZED case.
+    public static let allCases: [Routeguide_FeatureEvent.TypeEnum] = [
+      .unspecified,
+      .datasetCurrent,
+      .datasetReplaced,
+      .heartbeat,
+    ]
+
+  }
+
+  public init() {}
+
+  fileprivate var _loadedAt: SwiftProtobuf.Google_Protobuf_Timestamp? = nil
+}
+
+/// A NoteHistoryRequest asks for a page of the notes sent at a location.
+public struct Routeguide_NoteHistoryRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The location to list notes for.
+  public var location: Routeguide_Point {
+    get {return _location ?? Routeguide_Point()}
+    set {_location = newValue}
+  }
+  /// Returns true if `location` has been explicitly set.
+  public var hasLocation: Bool {return self._location != nil}
+  /// Clears the value of `location`. Subsequent reads from it will return its default value.
+  public mutating func clearLocation() {self._location = nil}
+
+  /// The maximum number of notes to return. Defaults to 50, capped at 500.
+  public var pageSize: Int32 = 0
+
+  /// The next_page_token of a previous response, or empty for the first page.
+  public var pageToken: String = String()
+
+  /// Lists the notes as they stood at this time, leaving out those received
+  /// later; unset for the current notes. Notes evicted since are missing
+  /// either way. Pass the same as_of when fetching the following pages.
+  public var asOf: SwiftProtobuf.Google_Protobuf_Timestamp {
+    get {return _asOf ?? SwiftProtobuf.Google_Protobuf_Timestamp()}
+    set {_asOf = newValue}
+  }
+  /// Returns true if `asOf` has been explicitly set.
+  public var hasAsOf: Bool {return self._asOf != nil}
+  /// Clears the value of `asOf`. Subsequent reads from it will return its default value.
+  public mutating func clearAsOf() {self._asOf = nil}
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _location: Routeguide_Point? = nil
+  fileprivate var _asOf: SwiftProtobuf.Google_Protobuf_Timestamp? = nil
+}
+
+/// A NoteHistoryPage is a page of notes, oldest first.
+public struct Routeguide_NoteHistoryPage: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The notes in this page.
+  public var notes: [Routeguide_RouteNote] = []
+
+  /// A token for the following page, or empty if this is the last one.
+  public var nextPageToken: String = String()
+
+  /// The total number of notes currently available at the location, or that
+  /// were as of as_of.
+  public var totalSize: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// A SyncRequest starts or resumes a feature sync.
+public struct Routeguide_SyncRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The resume_token of the last message applied by a previous sync. When
+  /// empty, or too old to resume from, the sync starts with a snapshot.
+  public var resumeToken: String = String()
+
+  /// The maximum number of features per snapshot page. Defaults to 100,
+  /// capped at 1000.
+  public var pageSize: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// A SyncMessage is one step of a feature sync.
+public struct Routeguide_SyncMessage: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var payload: Routeguide_SyncMessage.OneOf_Payload? = nil
+
+  public var snapshot: Routeguide_SnapshotPage {
+    get {
+      if case .snapshot(let v)? = payload {return v}
+      return Routeguide_SnapshotPage()
+    }
+    set {payload = .snapshot(newValue)}
+  }
+
+  public var delta: Routeguide_FeatureDelta {
+    get {
+      if case .delta(let v)? = payload {return v}
+      return Routeguide_FeatureDelta()
+    }
+    set {payload = .delta(newValue)}
+  }
+
+  /// The dataset version the synced copy is at once this message is applied.
+  public var version: Int64 = 0
+
+  /// Resumes the sync after this message. Empty for all but the last page of
+  /// a snapshot, since the copy is incomplete until then.
+  public var resumeToken: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public enum OneOf_Payload: Equatable, Sendable {
+    case snapshot(Routeguide_SnapshotPage)
+    case delta(Routeguide_FeatureDelta)
+
+  }
+
+  public init() {}
+}
+
+/// A SnapshotPage is part of a full copy of the dataset. Features are
+/// identified by their location.
+public struct Routeguide_SnapshotPage: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The features in this page.
+  public var features: [Routeguide_Feature] = []
+
+  /// Whether this is the first page; the client discards its copy before
+  /// applying it.
+  public var first: Bool = false
+
+  /// Whether this is the last page.
+  public var last: Bool = false
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// A FeatureDelta lists the changes between two dataset versions.
+public struct Routeguide_FeatureDelta: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Features that were added or changed, replacing any at the same location.
+  public var upserted: [Routeguide_Feature] = []
+
+  /// Locations whose feature was removed.
+  public var removed: [Routeguide_Point] = []
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// A BundleRequest asks for an offline bundle of a region.
+public struct Routeguide_BundleRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The region to bundle.
+  public var region: Routeguide_Rectangle {
+    get {return _region ?? Routeguide_Rectangle()}
+    set {_region = newValue}
+  }
+  /// Returns true if `region` has been explicitly set.
+  public var hasRegion: Bool {return self._region != nil}
+  /// Clears the value of `region`. Subsequent reads from it will return its default value.
+  public mutating func clearRegion() {self._region = nil}
+
+  /// Whether to include the vector tiles covering the region.
+  public var includeTiles: Bool = false
+
+  /// The deepest zoom level of the included tiles, at most 14.
+  public var maxTileZoom: Int32 = 0
+
+  /// Only report the size of the bundle, without sending it.
+  public var estimateOnly: Bool = false
+
+  /// The byte offset to resume an interrupted download from.
+  public var offset: Int64 = 0
+
+  /// The etag of the bundle being resumed; required with a non-zero offset.
+  public var etag: String = String()
+
+  /// The maximum number of bytes per chunk. Defaults to 64 KiB, capped at 1 MiB.
+  public var chunkSize: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _region: Routeguide_Rectangle? = nil
+}
+
+/// A BundleChunk is a piece of an offline bundle.
+public struct Routeguide_BundleChunk: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The position of data in the bundle.
+  public var offset: Int64 = 0
+
+  /// The bundle bytes from offset on.
+  public var data: Data = Data()
+
+  /// The size of the whole bundle in bytes.
+  public var totalSize: Int64 = 0
+
+  /// Identifies the content of the bundle; it changes with the dataset.
+  public var etag: String = String()
+
+  /// The number of features in the bundle.
+  public var featureCount: Int32 = 0
+
+  /// The number of tiles in the bundle.
+  public var tileCount: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// A ChatActivityRequest asks where notes were recently posted in a region.
+public struct Routeguide_ChatActivityRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The region to count notes in.
+  public var region: Routeguide_Rectangle {
+    get {return _region ?? Routeguide_Rectangle()}
+    set {_region = newValue}
+  }
+  /// Returns true if `region` has been explicitly set.
+  public var hasRegion: Bool {return self._region != nil}
+  /// Clears the value of `region`. Subsequent reads from it will return its default value.
+  public mutating func clearRegion() {self._region = nil}
+
+  /// The zoom level of the Web Mercator tiles notes are clustered into, from
+  /// 0 (one tile for the world) to 22.
+  public var zoom: Int32 = 0
+
+  /// How many of the last minutes to count notes over, up to 60. Defaults to
+  /// 15.
+  public var windowMinutes: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _region: Routeguide_Rectangle? = nil
+}
+
+/// A ChatActivity clusters the notes recently posted in a region by map
+/// tile.
+public struct Routeguide_ChatActivity: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The tiles with notes in the region, busiest first.
+  public var clusters: [Routeguide_ActivityCluster] = []
+
+  /// The notes counted, across all clusters.
+  public var total: Int64 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// An ActivityCluster counts the notes posted in a map tile.
+public struct Routeguide_ActivityCluster: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The tile, in the z/x/y scheme of the requested zoom.
+  public var tileX: Int32 = 0
+
+  public var tileY: Int32 = 0
+
+  /// Where the notes were posted, on average.
+  public var center: Routeguide_Point {
+    get {return _center ?? Routeguide_Point()}
+    set {_center = newValue}
+  }
+  /// Returns true if `center` has been explicitly set.
+  public var hasCenter: Bool {return self._center != nil}
+  /// Clears the value of `center`. Subsequent reads from it will return its default value.
+  public mutating func clearCenter() {self._center = nil}
+
+  /// How many notes were posted.
+  public var count: Int64 = 0
+
+  /// At how many distinct locations.
+  public var locations: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _center: Routeguide_Point? = nil
+}
+
+/// A DatasetStatsRequest asks for statistics about the dataset.
+public struct Routeguide_DatasetStatsRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The geohash length of the density buckets, between 1 and 8. Defaults to 4
+  /// (cells of roughly 39 by 20 km).
+  public var geohashPrecision: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// A GeohashBucket counts the features within a geohash cell.
+public struct Routeguide_GeohashBucket: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The geohash of the cell.
+  public var geohash: String = String()
+
+  /// The number of features in the cell.
+  public var count: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// DatasetStats summarizes a dataset.
+public struct Routeguide_DatasetStats: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The dataset version, increasing by one with every swap.
+  public var version: Int64 = 0
+
+  /// Where the dataset was loaded from.
+  public var source: String = String()
+
+  /// When the dataset was loaded.
+  public var loadedAt: SwiftProtobuf.Google_Protobuf_Timestamp {
+    get {return _loadedAt ?? SwiftProtobuf.Google_Protobuf_Timestamp()}
+    set {_loadedAt = newValue}
+  }
+  /// Returns true if `loadedAt` has been explicitly set.
+  public var hasLoadedAt: Bool {return self._loadedAt != nil}
+  /// Clears the value of `loadedAt`. Subsequent reads from it will return its default value.
+  public mutating func clearLoadedAt() {self._loadedAt = nil}
+
+  /// How long reading, parsing and validating the dataset took, in
+  /// milliseconds.
+  public var loadDurationMs: Int64 = 0
+
+  /// The number of features.
+  public var featureCount: Int32 = 0
+
+  /// The smallest rectangle containing every feature.
+  public var bounds: Routeguide_Rectangle {
+    get {return _bounds ?? Routeguide_Rectangle()}
+    set {_bounds = newValue}
+  }
+  /// Returns true if `bounds` has been explicitly set.
+  public var hasBounds: Bool {return self._bounds != nil}
+  /// Clears the value of `bounds`. Subsequent reads from it will return its default value.
+  public mutating func clearBounds() {self._bounds = nil}
+
+  /// Feature counts per geohash cell, most populated first.
+  public var density: [Routeguide_GeohashBucket] = []
+
+  /// The number of features at the same location as an earlier feature.
+  public var duplicateCount: Int32 = 0
+
+  /// The number of entries of the features file that were skipped because
+  /// they are malformed.
+  public var skippedCount: Int32 = 0
+
+  /// Why entries were skipped, in file order. Only the first 100 are listed.
+  public var loadErrors: [Routeguide_LoadError] = []
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _loadedAt: SwiftProtobuf.Google_Protobuf_Timestamp? = nil
+  fileprivate var _bounds: Routeguide_Rectangle? = nil
+}
+
+/// A LoadError describes an entry of the features file that was skipped.
+public struct Routeguide_LoadError: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The position of the entry in the file, from 0.
+  public var index: Int32 = 0
+
+  /// The entry's name, if it could be read.
+  public var name: String = String()
+
+  /// What is wrong with the entry.
+  public var error: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+public struct Routeguide_SnapRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The point to snap.
+  public var point: Routeguide_Point {
+    get {return _point ?? Routeguide_Point()}
+    set {_point = newValue}
+  }
+  /// Returns true if `point` has been explicitly set.
+  public var hasPoint: Bool {return self._point != nil}
+  /// Clears the value of `point`. Subsequent reads from it will return its default value.
+  public mutating func clearPoint() {self._point = nil}
+
+  /// How far, in meters, the point may be moved. Required; at most 50000.
+  public var maxDistanceMeters: Int32 = 0
+
+  /// Whether only features with a name may be snapped to.
+  public var namedOnly: Bool = false
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _point: Routeguide_Point? = nil
+}
+
+public struct Routeguide_SnapResult: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Whether a feature was found within max_distance_meters.
+  public var snapped: Bool = false
+
+  /// The location of the feature, or the requested point if none was found.
+  public var point: Routeguide_Point {
+    get {return _point ?? Routeguide_Point()}
+    set {_point = newValue}
+  }
+  /// Returns true if `point` has been explicitly set.
+  public var hasPoint: Bool {return self._point != nil}
+  /// Clears the value of `point`. Subsequent reads from it will return its default value.
+  public mutating func clearPoint() {self._point = nil}
+
+  /// The feature snapped to, unset if none was found.
+  public var feature: Routeguide_Feature {
+    get {return _feature ?? Routeguide_Feature()}
+    set {_feature = newValue}
+  }
+  /// Returns true if `feature` has been explicitly set.
+  public var hasFeature: Bool {return self._feature != nil}
+  /// Clears the value of `feature`. Subsequent reads from it will return its default value.
+  public mutating func clearFeature() {self._feature = nil}
+
+  /// How far the point was moved, in meters.
+  public var distanceMeters: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _point: Routeguide_Point? = nil
+  fileprivate var _feature: Routeguide_Feature? = nil
+}
+
+public struct Routeguide_NearestFeaturesRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The point to search around.
+  public var point: Routeguide_Point {
+    get {return _point ?? Routeguide_Point()}
+    set {_point = newValue}
+  }
+  /// Returns true if `point` has been explicitly set.
+  public var hasPoint: Bool {return self._point != nil}
+  /// Clears the value of `point`. Subsequent reads from it will return its default value.
+  public mutating func clearPoint() {self._point = nil}
+
+  /// How many features to return: 10 when unset, at most 100.
+  public var k: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _point: Routeguide_Point? = nil
+}
+
+public struct Routeguide_RadiusRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The center of the circle to list the features of.
+  public var center: Routeguide_Point {
+    get {return _center ?? Routeguide_Point()}
+    set {_center = newValue}
+  }
+  /// Returns true if `center` has been explicitly set.
+  public var hasCenter: Bool {return self._center != nil}
+  /// Clears the value of `center`. Subsequent reads from it will return its default value.
+  public mutating func clearCenter() {self._center = nil}
+
+  /// The radius of the circle, in meters, edge included. Required; at most
+  /// 20015086, half the Earth's circumference.
+  public var radiusMeters: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _center: Routeguide_Point? = nil
+}
+
+public struct Routeguide_NearestFeature: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  public var feature: Routeguide_Feature {
+    get {return _feature ?? Routeguide_Feature()}
+    set {_feature = newValue}
+  }
+  /// Returns true if `feature` has been explicitly set.
+  public var hasFeature: Bool {return self._feature != nil}
+  /// Clears the value of `feature`. Subsequent reads from it will return its default value.
+  public mutating func clearFeature() {self._feature = nil}
+
+  /// The great-circle distance from the requested point, in meters.
+  public var distanceMeters: Int32 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _feature: Routeguide_Feature? = nil
+}
+
+public struct Routeguide_NearestFeaturesResponse: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The nearest named features, closest first; ties are in dataset order.
+  /// Fewer than k when the dataset has fewer named features.
+  public var features: [Routeguide_NearestFeature] = []
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+public struct Routeguide_SubmitFeatureRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The feature to add, or to replace the feature at its location with.
+  public var feature: Routeguide_Feature {
+    get {return _feature ?? Routeguide_Feature()}
+    set {_feature = newValue}
+  }
+  /// Returns true if `feature` has been explicitly set.
+  public var hasFeature: Bool {return self._feature != nil}
+  /// Clears the value of `feature`. Subsequent reads from it will return its default value.
+  public mutating func clearFeature() {self._feature = nil}
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _feature: Routeguide_Feature? = nil
+}
+
+public struct Routeguide_CreateFeatureRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The feature to add. Its location must not have a feature yet.
+  public var feature: Routeguide_Feature {
+    get {return _feature ?? Routeguide_Feature()}
+    set {_feature = newValue}
+  }
+  /// Returns true if `feature` has been explicitly set.
+  public var hasFeature: Bool {return self._feature != nil}
+  /// Clears the value of `feature`. Subsequent reads from it will return its default value.
+  public mutating func clearFeature() {self._feature = nil}
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _feature: Routeguide_Feature? = nil
+}
+
+public struct Routeguide_UpdateFeatureRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The feature replacing the one at its location.
+  public var feature: Routeguide_Feature {
+    get {return _feature ?? Routeguide_Feature()}
+    set {_feature = newValue}
+  }
+  /// Returns true if `feature` has been explicitly set.
+  public var hasFeature: Bool {return self._feature != nil}
+  /// Clears the value of `feature`. Subsequent reads from it will return its default value.
+  public mutating func clearFeature() {self._feature = nil}
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _feature: Routeguide_Feature? = nil
+}
+
+public struct Routeguide_DeleteFeatureRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The location of the feature to remove.
+  public var location: Routeguide_Point {
+    get {return _location ?? Routeguide_Point()}
+    set {_location = newValue}
+  }
+  /// Returns true if `location` has been explicitly set.
+  public var hasLocation: Bool {return self._location != nil}
+  /// Clears the value of `location`. Subsequent reads from it will return its default value.
+  public mutating func clearLocation() {self._location = nil}
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _location: Routeguide_Point? = nil
+}
+
+/// A FeatureMutation is the outcome of a feature mutation RPC.
+public struct Routeguide_FeatureMutation: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The feature created or updated, or the feature deleted.
+  public var feature: Routeguide_Feature {
+    get {return _feature ?? Routeguide_Feature()}
+    set {_feature = newValue}
+  }
+  /// Returns true if `feature` has been explicitly set.
+  public var hasFeature: Bool {return self._feature != nil}
+  /// Clears the value of `feature`. Subsequent reads from it will return its default value.
+  public mutating func clearFeature() {self._feature = nil}
+
+  /// The dataset version the change is part of.
+  public var datasetVersion: Int64 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _feature: Routeguide_Feature? = nil
+}
+
+public struct Routeguide_FeatureSubmission: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// Identifies the submission.
+  public var id: String = String()
+
+  /// The submitted feature.
+  public var feature: Routeguide_Feature {
+    get {return _feature ?? Routeguide_Feature()}
+    set {_feature = newValue}
+  }
+  /// Returns true if `feature` has been explicitly set.
+  public var hasFeature: Bool {return self._feature != nil}
+  /// Clears the value of `feature`. Subsequent reads from it will return its default value.
+  public mutating func clearFeature() {self._feature = nil}
+
+  /// The submission's moderation state.
+  public var state: Routeguide_SubmissionState = .unspecified
+
+  /// Who submitted the feature, "anonymous" for unauthenticated callers.
+  public var submitter: String = String()
+
+  /// When the feature was submitted.
+  public var submittedAt: SwiftProtobuf.Google_Protobuf_Timestamp {
+    get {return _submittedAt ?? SwiftProtobuf.Google_Protobuf_Timestamp()}
+    set {_submittedAt = newValue}
+  }
+  /// Returns true if `submittedAt` has been explicitly set.
+  public var hasSubmittedAt: Bool {return self._submittedAt != nil}
+  /// Clears the value of `submittedAt`. Subsequent reads from it will return its default value.
+  public mutating func clearSubmittedAt() {self._submittedAt = nil}
+
+  /// The admin who approved or rejected the submission.
+  public var reviewer: String = String()
+
+  /// When the submission was approved or rejected.
+  public var reviewedAt: SwiftProtobuf.Google_Protobuf_Timestamp {
+    get {return _reviewedAt ?? SwiftProtobuf.Google_Protobuf_Timestamp()}
+    set {_reviewedAt = newValue}
+  }
+  /// Returns true if `reviewedAt` has been explicitly set.
+  public var hasReviewedAt: Bool {return self._reviewedAt != nil}
+  /// Clears the value of `reviewedAt`. Subsequent reads from it will return its default value.
+  public mutating func clearReviewedAt() {self._reviewedAt = nil}
+
+  /// Why the submission was rejected.
+  public var reason: String = String()
+
+  /// The dataset version the feature was applied in, once approved.
+  public var datasetVersion: Int64 = 0
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _feature: Routeguide_Feature? = nil
+  fileprivate var _submittedAt: SwiftProtobuf.Google_Protobuf_Timestamp? = nil
+  fileprivate var _reviewedAt: SwiftProtobuf.Google_Protobuf_Timestamp? = nil
+}
+
+public struct Routeguide_GetClientConfigRequest: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The client's app version, e.g. "2.3.1", logged by the server.
+  public var clientVersion: String = String()
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+}
+
+/// Settings recommended to clients. Unset fields mean the client should keep
+/// its built-in default.
+public struct Routeguide_ClientConfig: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// How often WatchFeatures sends heartbeats; clients should consider a
+  /// stream dead after missing a few.
+  public var heartbeatInterval: SwiftProtobuf.Google_Protobuf_Duration {
+    get {return _heartbeatInterval ?? SwiftProtobuf.Google_Protobuf_Duration()}
+    set {_heartbeatInterval = newValue}
+  }
+  /// Returns true if `heartbeatInterval` has been explicitly set.
+  public var hasHeartbeatInterval: Bool {return self._heartbeatInterval != nil}
+  /// Clears the value of `heartbeatInterval`. Subsequent reads from it will return its default value.
+  public mutating func clearHeartbeatInterval() {self._heartbeatInterval = nil}
+
+  /// The page size to request from paginated RPCs such as ListFeaturesPage.
+  public var pageSize: Int32 = 0
+
+  /// How to retry failed calls.
+  public var retry: Routeguide_RetryPolicy {
+    get {return _retry ?? Routeguide_RetryPolicy()}
+    set {_retry = newValue}
+  }
+  /// Returns true if `retry` has been explicitly set.
+  public var hasRetry: Bool {return self._retry != nil}
+  /// Clears the value of `retry`. Subsequent reads from it will return its default value.
+  public mutating func clearRetry() {self._retry = nil}
+
+  /// Client features to turn on or off, by name.
+  public var featureFlags: Dictionary<String,Bool> = [:]
+
+  /// How long the client may use this config before fetching it again.
+  public var refreshInterval: SwiftProtobuf.Google_Protobuf_Duration {
+    get {return _refreshInterval ?? SwiftProtobuf.Google_Protobuf_Duration()}
+    set {_refreshInterval = newValue}
+  }
+  /// Returns true if `refreshInterval` has been explicitly set.
+  public var hasRefreshInterval: Bool {return self._refreshInterval != nil}
+  /// Clears the value of `refreshInterval`. Subsequent reads from it will return its default value.
+  public mutating func clearRefreshInterval() {self._refreshInterval = nil}
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _heartbeatInterval: SwiftProtobuf.Google_Protobuf_Duration? = nil
+  fileprivate var _retry: Routeguide_RetryPolicy? = nil
+  fileprivate var _refreshInterval: SwiftProtobuf.Google_Protobuf_Duration? = nil
+}
+
+/// Exponential backoff settings for retrying failed calls.
+public struct Routeguide_RetryPolicy: Sendable {
+  // SwiftProtobuf.Message conformance is added in an extension below. See the
+  // `Message` and `Message+*Additions` files in the SwiftProtobuf library for
+  // methods supported on all messages.
+
+  /// The maximum number of attempts of a call, including the first.
+  public var maxAttempts: Int32 = 0
+
+  /// The delay before the first retry.
+  public var initialBackoff: SwiftProtobuf.Google_Protobuf_Duration {
+    get {return _initialBackoff ?? SwiftProtobuf.Google_Protobuf_Duration()}
+    set {_initialBackoff = newValue}
+  }
+  /// Returns true if `initialBackoff` has been explicitly set.
+  public var hasInitialBackoff: Bool {return self._initialBackoff != nil}
+  /// Clears the value of `initialBackoff`. Subsequent reads from it will return its default value.
+  public mutating func clearInitialBackoff() {self._initialBackoff = nil}
+
+  /// The maximum delay between retries.
+  public var maxBackoff: SwiftProtobuf.Google_Protobuf_Duration {
+    get {return _maxBackoff ?? SwiftProtobuf.Google_Protobuf_Duration()}
+    set {_maxBackoff = newValue}
+  }
+  /// Returns true if `maxBackoff` has been explicitly set.
+  public var hasMaxBackoff: Bool {return self._maxBackoff != nil}
+  /// Clears the value of `maxBackoff`. Subsequent reads from it will return its default value.
+  public mutating func clearMaxBackoff() {self._maxBackoff = nil}
+
+  /// The factor the delay grows by after each retry.
+  public var backoffMultiplier: Double = 0
+
+  /// The status codes worth retrying, e.g. "UNAVAILABLE". Calls failing with
+  /// RESOURCE_EXHAUSTED should wait at least their RetryInfo delay.
+  public var retryableCodes: [String] = []
+
+  public var unknownFields = SwiftProtobuf.UnknownStorage()
+
+  public init() {}
+
+  fileprivate var _initialBackoff: SwiftProtobuf.Google_Protobuf_Duration? = nil
+  fileprivate var _maxBackoff: SwiftProtobuf.Google_Protobuf_Duration? = nil
+}
+
+// MARK: - Code below here is support for the SwiftProtobuf runtime.
+
+fileprivate let _protobuf_package = "routeguide"
+
+extension Routeguide_TravelProfile: SwiftProtobuf._ProtoNameProviding {
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{2}\0TRAVEL_PROFILE_UNSPECIFIED\0\u{1}TRAVEL_PROFILE_WALKING\0\u{1}TRAVEL_PROFILE_CYCLING\0\u{1}TRAVEL_PROFILE_DRIVING\0")
+}
+
+extension Routeguide_NameCollation: SwiftProtobuf._ProtoNameProviding {
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{2}\0NAME_COLLATION_EXACT\0\u{1}NAME_COLLATION_CASE_INSENSITIVE\0\u{1}NAME_COLLATION_NORMALIZED\0")
+}
+
+extension Routeguide_SubmissionState: SwiftProtobuf._ProtoNameProviding {
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{2}\0SUBMISSION_STATE_UNSPECIFIED\0\u{1}SUBMISSION_STATE_PENDING\0\u{1}SUBMISSION_STATE_APPROVED\0\u{1}SUBMISSION_STATE_REJECTED\0")
+}
+
+extension Routeguide_Point: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".Point"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}latitude\0\u{1}longitude\0\u{1}x\0\u{1}y\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt32Field(value: &self.latitude) }()
+      case 2: try { try decoder.decodeSingularInt32Field(value: &self.longitude) }()
+      case 3: try { try decoder.decodeSingularDoubleField(value: &self.x) }()
+      case 4: try { try decoder.decodeSingularDoubleField(value: &self.y) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.latitude != 0 {
+      try visitor.visitSingularInt32Field(value: self.latitude, fieldNumber: 1)
+    }
+    if self.longitude != 0 {
+      try visitor.visitSingularInt32Field(value: self.longitude, fieldNumber: 2)
+    }
+    if self.x.bitPattern != 0 {
+      try visitor.visitSingularDoubleField(value: self.x, fieldNumber: 3)
+    }
+    if self.y.bitPattern != 0 {
+      try visitor.visitSingularDoubleField(value: self.y, fieldNumber: 4)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_Point, rhs: Routeguide_Point) -> Bool {
+    if lhs.latitude != rhs.latitude {return false}
+    if lhs.longitude != rhs.longitude {return false}
+    if lhs.x != rhs.x {return false}
+    if lhs.y != rhs.y {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_Rectangle: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".Rectangle"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}lo\0\u{1}hi\0\u{3}name_filter\0\u{3}crosses_antimeridian\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._lo) }()
+      case 2: try { try decoder.decodeSingularMessageField(value: &self._hi) }()
+      case 3: try { try decoder.decodeSingularMessageField(value: &self._nameFilter) }()
+      case 4: try { try decoder.decodeSingularBoolField(value: &self.crossesAntimeridian) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._lo {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    try { if let v = self._hi {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 2)
+    } }()
+    try { if let v = self._nameFilter {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 3)
+    } }()
+    if self.crossesAntimeridian != false {
+      try visitor.visitSingularBoolField(value: self.crossesAntimeridian, fieldNumber: 4)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_Rectangle, rhs: Routeguide_Rectangle) -> Bool {
+    if lhs._lo != rhs._lo {return false}
+    if lhs._hi != rhs._hi {return false}
+    if lhs._nameFilter != rhs._nameFilter {return false}
+    if lhs.crossesAntimeridian != rhs.crossesAntimeridian {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_NameFilter: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".NameFilter"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}contains\0\u{1}prefix\0\u{3}named_only\0\u{1}collation\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.contains) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.prefix) }()
+      case 3: try { try decoder.decodeSingularBoolField(value: &self.namedOnly) }()
+      case 4: try { try decoder.decodeSingularEnumField(value: &self.collation) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.contains.isEmpty {
+      try visitor.visitSingularStringField(value: self.contains, fieldNumber: 1)
+    }
+    if !self.prefix.isEmpty {
+      try visitor.visitSingularStringField(value: self.prefix, fieldNumber: 2)
+    }
+    if self.namedOnly != false {
+      try visitor.visitSingularBoolField(value: self.namedOnly, fieldNumber: 3)
+    }
+    if self.collation != .exact {
+      try visitor.visitSingularEnumField(value: self.collation, fieldNumber: 4)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_NameFilter, rhs: Routeguide_NameFilter) -> Bool {
+    if lhs.contains != rhs.contains {return false}
+    if lhs.prefix != rhs.prefix {return false}
+    if lhs.namedOnly != rhs.namedOnly {return false}
+    if lhs.collation != rhs.collation {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_Polygon: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".Polygon"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}points\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeRepeatedMessageField(value: &self.points) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.points.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.points, fieldNumber: 1)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_Polygon, rhs: Routeguide_Polygon) -> Bool {
+    if lhs.points != rhs.points {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_Feature: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".Feature"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}name\0\u{1}location\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.name) }()
+      case 2: try { try decoder.decodeSingularMessageField(value: &self._location) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    if !self.name.isEmpty {
+      try visitor.visitSingularStringField(value: self.name, fieldNumber: 1)
+    }
+    try { if let v = self._location {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 2)
+    } }()
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_Feature, rhs: Routeguide_Feature) -> Bool {
+    if lhs.name != rhs.name {return false}
+    if lhs._location != rhs._location {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_ListFeaturesPageRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".ListFeaturesPageRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}rectangle\0\u{3}page_size\0\u{3}page_token\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._rectangle) }()
+      case 2: try { try decoder.decodeSingularInt32Field(value: &self.pageSize) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.pageToken) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._rectangle {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    if self.pageSize != 0 {
+      try visitor.visitSingularInt32Field(value: self.pageSize, fieldNumber: 2)
+    }
+    if !self.pageToken.isEmpty {
+      try visitor.visitSingularStringField(value: self.pageToken, fieldNumber: 3)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_ListFeaturesPageRequest, rhs: Routeguide_ListFeaturesPageRequest) -> Bool {
+    if lhs._rectangle != rhs._rectangle {return false}
+    if lhs.pageSize != rhs.pageSize {return false}
+    if lhs.pageToken != rhs.pageToken {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_FeaturePage: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".FeaturePage"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}features\0\u{3}next_page_token\0\u{1}version\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeRepeatedMessageField(value: &self.features) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.nextPageToken) }()
+      case 3: try { try decoder.decodeSingularInt64Field(value: &self.version) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.features.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.features, fieldNumber: 1)
+    }
+    if !self.nextPageToken.isEmpty {
+      try visitor.visitSingularStringField(value: self.nextPageToken, fieldNumber: 2)
+    }
+    if self.version != 0 {
+      try visitor.visitSingularInt64Field(value: self.version, fieldNumber: 3)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_FeaturePage, rhs: Routeguide_FeaturePage) -> Bool {
+    if lhs.features != rhs.features {return false}
+    if lhs.nextPageToken != rhs.nextPageToken {return false}
+    if lhs.version != rhs.version {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_RouteNote: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".RouteNote"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}location\0\u{1}message\0\u{1}author\0\u{1}id\0\u{3}schema_version\0\u{3}history_token\0\u{3}received_at\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._location) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.message) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.author) }()
+      case 4: try { try decoder.decodeSingularStringField(value: &self.id) }()
+      case 5: try { try decoder.decodeSingularUInt32Field(value: &self.schemaVersion) }()
+      case 6: try { try decoder.decodeSingularStringField(value: &self.historyToken) }()
+      case 7: try { try decoder.decodeSingularMessageField(value: &self._receivedAt) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._location {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    if !self.message.isEmpty {
+      try visitor.visitSingularStringField(value: self.message, fieldNumber: 2)
+    }
+    if !self.author.isEmpty {
+      try visitor.visitSingularStringField(value: self.author, fieldNumber: 3)
+    }
+    if !self.id.isEmpty {
+      try visitor.visitSingularStringField(value: self.id, fieldNumber: 4)
+    }
+    if self.schemaVersion != 0 {
+      try visitor.visitSingularUInt32Field(value: self.schemaVersion, fieldNumber: 5)
+    }
+    if !self.historyToken.isEmpty {
+      try visitor.visitSingularStringField(value: self.historyToken, fieldNumber: 6)
+    }
+    try { if let v = self._receivedAt {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 7)
+    } }()
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_RouteNote, rhs: Routeguide_RouteNote) -> Bool {
+    if lhs._location != rhs._location {return false}
+    if lhs.message != rhs.message {return false}
+    if lhs.author != rhs.author {return false}
+    if lhs.id != rhs.id {return false}
+    if lhs.schemaVersion != rhs.schemaVersion {return false}
+    if lhs.historyToken != rhs.historyToken {return false}
+    if lhs._receivedAt != rhs._receivedAt {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_RouteSummary: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".RouteSummary"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}point_count\0\u{3}feature_count\0\u{1}distance\0\u{3}elapsed_time\0\u{1}anomalies\0\u{1}profile\0\u{3}estimated_time\0\u{1}calories\0\u{3}route_id\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt32Field(value: &self.pointCount) }()
+      case 2: try { try decoder.decodeSingularInt32Field(value: &self.featureCount) }()
+      case 3: try { try decoder.decodeSingularInt32Field(value: &self.distance) }()
+      case 4: try { try decoder.decodeSingularInt32Field(value: &self.elapsedTime) }()
+      case 5: try { try decoder.decodeRepeatedMessageField(value: &self.anomalies) }()
+      case 6: try { try decoder.decodeSingularEnumField(value: &self.profile) }()
+      case 7: try { try decoder.decodeSingularInt32Field(value: &self.estimatedTime) }()
+      case 8: try { try decoder.decodeSingularInt32Field(value: &self.calories) }()
+      case 9: try { try decoder.decodeSingularStringField(value: &self.routeID) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.pointCount != 0 {
+      try visitor.visitSingularInt32Field(value: self.pointCount, fieldNumber: 1)
+    }
+    if self.featureCount != 0 {
+      try visitor.visitSingularInt32Field(value: self.featureCount, fieldNumber: 2)
+    }
+    if self.distance != 0 {
+      try visitor.visitSingularInt32Field(value: self.distance, fieldNumber: 3)
+    }
+    if self.elapsedTime != 0 {
+      try visitor.visitSingularInt32Field(value: self.elapsedTime, fieldNumber: 4)
+    }
+    if !self.anomalies.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.anomalies, fieldNumber: 5)
+    }
+    if self.profile != .unspecified {
+      try visitor.visitSingularEnumField(value: self.profile, fieldNumber: 6)
+    }
+    if self.estimatedTime != 0 {
+      try visitor.visitSingularInt32Field(value: self.estimatedTime, fieldNumber: 7)
+    }
+    if self.calories != 0 {
+      try visitor.visitSingularInt32Field(value: self.calories, fieldNumber: 8)
+    }
+    if !self.routeID.isEmpty {
+      try visitor.visitSingularStringField(value: self.routeID, fieldNumber: 9)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_RouteSummary, rhs: Routeguide_RouteSummary) -> Bool {
+    if lhs.pointCount != rhs.pointCount {return false}
+    if lhs.featureCount != rhs.featureCount {return false}
+    if lhs.distance != rhs.distance {return false}
+    if lhs.elapsedTime != rhs.elapsedTime {return false}
+    if lhs.anomalies != rhs.anomalies {return false}
+    if lhs.profile != rhs.profile {return false}
+    if lhs.estimatedTime != rhs.estimatedTime {return false}
+    if lhs.calories != rhs.calories {return false}
+    if lhs.routeID != rhs.routeID {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_RouteAnomaly: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".RouteAnomaly"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}kind\0\u{3}point_index\0\u{1}from\0\u{1}to\0\u{1}distance\0\u{3}speed_kmh\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularEnumField(value: &self.kind) }()
+      case 2: try { try decoder.decodeSingularInt32Field(value: &self.pointIndex) }()
+      case 3: try { try decoder.decodeSingularMessageField(value: &self._from) }()
+      case 4: try { try decoder.decodeSingularMessageField(value: &self._to) }()
+      case 5: try { try decoder.decodeSingularInt32Field(value: &self.distance) }()
+      case 6: try { try decoder.decodeSingularDoubleField(value: &self.speedKmh) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    if self.kind != .unspecified {
+      try visitor.visitSingularEnumField(value: self.kind, fieldNumber: 1)
+    }
+    if self.pointIndex != 0 {
+      try visitor.visitSingularInt32Field(value: self.pointIndex, fieldNumber: 2)
+    }
+    try { if let v = self._from {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 3)
+    } }()
+    try { if let v = self._to {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 4)
+    } }()
+    if self.distance != 0 {
+      try visitor.visitSingularInt32Field(value: self.distance, fieldNumber: 5)
+    }
+    if self.speedKmh.bitPattern != 0 {
+      try visitor.visitSingularDoubleField(value: self.speedKmh, fieldNumber: 6)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_RouteAnomaly, rhs: Routeguide_RouteAnomaly) -> Bool {
+    if lhs.kind != rhs.kind {return false}
+    if lhs.pointIndex != rhs.pointIndex {return false}
+    if lhs._from != rhs._from {return false}
+    if lhs._to != rhs._to {return false}
+    if lhs.distance != rhs.distance {return false}
+    if lhs.speedKmh != rhs.speedKmh {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_RouteAnomaly.Kind: SwiftProtobuf._ProtoNameProviding {
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{2}\0KIND_UNSPECIFIED\0\u{1}TELEPORT\0\u{1}EXCESSIVE_SPEED\0")
+}
+
+extension Routeguide_IsochroneRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".IsochroneRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}center\0\u{1}duration\0\u{1}profile\0\u{1}bands\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._center) }()
+      case 2: try { try decoder.decodeSingularInt32Field(value: &self.duration) }()
+      case 3: try { try decoder.decodeSingularEnumField(value: &self.profile) }()
+      case 4: try { try decoder.decodeSingularInt32Field(value: &self.bands) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._center {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    if self.duration != 0 {
+      try visitor.visitSingularInt32Field(value: self.duration, fieldNumber: 2)
+    }
+    if self.profile != .unspecified {
+      try visitor.visitSingularEnumField(value: self.profile, fieldNumber: 3)
+    }
+    if self.bands != 0 {
+      try visitor.visitSingularInt32Field(value: self.bands, fieldNumber: 4)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_IsochroneRequest, rhs: Routeguide_IsochroneRequest) -> Bool {
+    if lhs._center != rhs._center {return false}
+    if lhs.duration != rhs.duration {return false}
+    if lhs.profile != rhs.profile {return false}
+    if lhs.bands != rhs.bands {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_IsochroneRing: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".IsochroneRing"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}duration\0\u{1}points\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt32Field(value: &self.duration) }()
+      case 2: try { try decoder.decodeRepeatedMessageField(value: &self.points) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.duration != 0 {
+      try visitor.visitSingularInt32Field(value: self.duration, fieldNumber: 1)
+    }
+    if !self.points.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.points, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_IsochroneRing, rhs: Routeguide_IsochroneRing) -> Bool {
+    if lhs.duration != rhs.duration {return false}
+    if lhs.points != rhs.points {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_RouteRef: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".RouteRef"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}id\0\u{1}points\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try {
+        var v: String?
+        try decoder.decodeSingularStringField(value: &v)
+        if let v = v {
+          if self.route != nil {try decoder.handleConflictingOneOf()}
+          self.route = .id(v)
+        }
+      }()
+      case 2: try {
+        var v: Routeguide_RoutePoints?
+        var hadOneofValue = false
+        if let current = self.route {
+          hadOneofValue = true
+          if case .points(let m) = current {v = m}
+        }
+        try decoder.decodeSingularMessageField(value: &v)
+        if let v = v {
+          if hadOneofValue {try decoder.handleConflictingOneOf()}
+          self.route = .points(v)
+        }
+      }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    switch self.route {
+    case .id?: try {
+      guard case .id(let v)? = self.route else { preconditionFailure() }
+      try visitor.visitSingularStringField(value: v, fieldNumber: 1)
+    }()
+    case .points?: try {
+      guard case .points(let v)? = self.route else { preconditionFailure() }
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 2)
+    }()
+    case nil: break
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_RouteRef, rhs: Routeguide_RouteRef) -> Bool {
+    if lhs.route != rhs.route {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_RoutePoints: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".RoutePoints"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}points\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeRepeatedMessageField(value: &self.points) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.points.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.points, fieldNumber: 1)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_RoutePoints, rhs: Routeguide_RoutePoints) -> Bool {
+    if lhs.points != rhs.points {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_CompareRoutesRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".CompareRoutesRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}first\0\u{1}second\0\u{1}tolerance\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._first) }()
+      case 2: try { try decoder.decodeSingularMessageField(value: &self._second) }()
+      case 3: try { try decoder.decodeSingularInt32Field(value: &self.tolerance) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._first {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    try { if let v = self._second {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 2)
+    } }()
+    if self.tolerance != 0 {
+      try visitor.visitSingularInt32Field(value: self.tolerance, fieldNumber: 3)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_CompareRoutesRequest, rhs: Routeguide_CompareRoutesRequest) -> Bool {
+    if lhs._first != rhs._first {return false}
+    if lhs._second != rhs._second {return false}
+    if lhs.tolerance != rhs.tolerance {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_RouteComparison: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".RouteComparison"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}overlap\0\u{3}divergence_points\0\u{3}distance_delta\0\u{3}first_distance\0\u{3}second_distance\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularDoubleField(value: &self.overlap) }()
+      case 2: try { try decoder.decodeRepeatedMessageField(value: &self.divergencePoints) }()
+      case 3: try { try decoder.decodeSingularInt32Field(value: &self.distanceDelta) }()
+      case 4: try { try decoder.decodeSingularInt32Field(value: &self.firstDistance) }()
+      case 5: try { try decoder.decodeSingularInt32Field(value: &self.secondDistance) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.overlap.bitPattern != 0 {
+      try visitor.visitSingularDoubleField(value: self.overlap, fieldNumber: 1)
+    }
+    if !self.divergencePoints.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.divergencePoints, fieldNumber: 2)
+    }
+    if self.distanceDelta != 0 {
+      try visitor.visitSingularInt32Field(value: self.distanceDelta, fieldNumber: 3)
+    }
+    if self.firstDistance != 0 {
+      try visitor.visitSingularInt32Field(value: self.firstDistance, fieldNumber: 4)
+    }
+    if self.secondDistance != 0 {
+      try visitor.visitSingularInt32Field(value: self.secondDistance, fieldNumber: 5)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_RouteComparison, rhs: Routeguide_RouteComparison) -> Bool {
+    if lhs.overlap != rhs.overlap {return false}
+    if lhs.divergencePoints != rhs.divergencePoints {return false}
+    if lhs.distanceDelta != rhs.distanceDelta {return false}
+    if lhs.firstDistance != rhs.firstDistance {return false}
+    if lhs.secondDistance != rhs.secondDistance {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_TravelTimeRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".TravelTimeRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}start\0\u{1}end\0\u{1}profile\0\u{3}search_radius\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._start) }()
+      case 2: try { try decoder.decodeSingularMessageField(value: &self._end) }()
+      case 3: try { try decoder.decodeSingularEnumField(value: &self.profile) }()
+      case 4: try { try decoder.decodeSingularInt32Field(value: &self.searchRadius) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._start {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    try { if let v = self._end {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 2)
+    } }()
+    if self.profile != .unspecified {
+      try visitor.visitSingularEnumField(value: self.profile, fieldNumber: 3)
+    }
+    if self.searchRadius != 0 {
+      try visitor.visitSingularInt32Field(value: self.searchRadius, fieldNumber: 4)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_TravelTimeRequest, rhs: Routeguide_TravelTimeRequest) -> Bool {
+    if lhs._start != rhs._start {return false}
+    if lhs._end != rhs._end {return false}
+    if lhs.profile != rhs.profile {return false}
+    if lhs.searchRadius != rhs.searchRadius {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_TravelTimeEstimate: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".TravelTimeEstimate"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}duration\0\u{1}distance\0\u{3}sample_count\0\u{1}source\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt32Field(value: &self.duration) }()
+      case 2: try { try decoder.decodeSingularInt32Field(value: &self.distance) }()
+      case 3: try { try decoder.decodeSingularInt32Field(value: &self.sampleCount) }()
+      case 4: try { try decoder.decodeSingularEnumField(value: &self.source) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.duration != 0 {
+      try visitor.visitSingularInt32Field(value: self.duration, fieldNumber: 1)
+    }
+    if self.distance != 0 {
+      try visitor.visitSingularInt32Field(value: self.distance, fieldNumber: 2)
+    }
+    if self.sampleCount != 0 {
+      try visitor.visitSingularInt32Field(value: self.sampleCount, fieldNumber: 3)
+    }
+    if self.source != .unspecified {
+      try visitor.visitSingularEnumField(value: self.source, fieldNumber: 4)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_TravelTimeEstimate, rhs: Routeguide_TravelTimeEstimate) -> Bool {
+    if lhs.duration != rhs.duration {return false}
+    if lhs.distance != rhs.distance {return false}
+    if lhs.sampleCount != rhs.sampleCount {return false}
+    if lhs.source != rhs.source {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_TravelTimeEstimate.Source: SwiftProtobuf._ProtoNameProviding {
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{2}\0SOURCE_UNSPECIFIED\0\u{1}HISTORICAL\0\u{1}HEURISTIC\0")
+}
+
+extension Routeguide_WatchFeaturesRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".WatchFeaturesRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}resume_token\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.resumeToken) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.resumeToken.isEmpty {
+      try visitor.visitSingularStringField(value: self.resumeToken, fieldNumber: 1)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_WatchFeaturesRequest, rhs: Routeguide_WatchFeaturesRequest) -> Bool {
+    if lhs.resumeToken != rhs.resumeToken {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_FeatureEvent: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".FeatureEvent"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}type\0\u{1}version\0\u{3}feature_count\0\u{1}source\0\u{3}loaded_at\0\u{3}resume_token\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularEnumField(value: &self.type) }()
+      case 2: try { try decoder.decodeSingularInt64Field(value: &self.version) }()
+      case 3: try { try decoder.decodeSingularInt32Field(value: &self.featureCount) }()
+      case 4: try { try decoder.decodeSingularStringField(value: &self.source) }()
+      case 5: try { try decoder.decodeSingularMessageField(value: &self._loadedAt) }()
+      case 6: try { try decoder.decodeSingularStringField(value: &self.resumeToken) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    if self.type != .unspecified {
+      try visitor.visitSingularEnumField(value: self.type, fieldNumber: 1)
+    }
+    if self.version != 0 {
+      try visitor.visitSingularInt64Field(value: self.version, fieldNumber: 2)
+    }
+    if self.featureCount != 0 {
+      try visitor.visitSingularInt32Field(value: self.featureCount, fieldNumber: 3)
+    }
+    if !self.source.isEmpty {
+      try visitor.visitSingularStringField(value: self.source, fieldNumber: 4)
+    }
+    try { if let v = self._loadedAt {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 5)
+    } }()
+    if !self.resumeToken.isEmpty {
+      try visitor.visitSingularStringField(value: self.resumeToken, fieldNumber: 6)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_FeatureEvent, rhs: Routeguide_FeatureEvent) -> Bool {
+    if lhs.type != rhs.type {return false}
+    if lhs.version != rhs.version {return false}
+    if lhs.featureCount != rhs.featureCount {return false}
+    if lhs.source != rhs.source {return false}
+    if lhs._loadedAt != rhs._loadedAt {return false}
+    if lhs.resumeToken != rhs.resumeToken {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_FeatureEvent.TypeEnum: SwiftProtobuf._ProtoNameProviding {
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{2}\0TYPE_UNSPECIFIED\0\u{1}DATASET_CURRENT\0\u{1}DATASET_REPLACED\0\u{1}HEARTBEAT\0")
+}
+
+extension Routeguide_NoteHistoryRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".NoteHistoryRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}location\0\u{3}page_size\0\u{3}page_token\0\u{3}as_of\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._location) }()
+      case 2: try { try decoder.decodeSingularInt32Field(value: &self.pageSize) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.pageToken) }()
+      case 4: try { try decoder.decodeSingularMessageField(value: &self._asOf) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._location {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    if self.pageSize != 0 {
+      try visitor.visitSingularInt32Field(value: self.pageSize, fieldNumber: 2)
+    }
+    if !self.pageToken.isEmpty {
+      try visitor.visitSingularStringField(value: self.pageToken, fieldNumber: 3)
+    }
+    try { if let v = self._asOf {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 4)
+    } }()
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_NoteHistoryRequest, rhs: Routeguide_NoteHistoryRequest) -> Bool {
+    if lhs._location != rhs._location {return false}
+    if lhs.pageSize != rhs.pageSize {return false}
+    if lhs.pageToken != rhs.pageToken {return false}
+    if lhs._asOf != rhs._asOf {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_NoteHistoryPage: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".NoteHistoryPage"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}notes\0\u{3}next_page_token\0\u{3}total_size\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeRepeatedMessageField(value: &self.notes) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.nextPageToken) }()
+      case 3: try { try decoder.decodeSingularInt32Field(value: &self.totalSize) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.notes.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.notes, fieldNumber: 1)
+    }
+    if !self.nextPageToken.isEmpty {
+      try visitor.visitSingularStringField(value: self.nextPageToken, fieldNumber: 2)
+    }
+    if self.totalSize != 0 {
+      try visitor.visitSingularInt32Field(value: self.totalSize, fieldNumber: 3)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_NoteHistoryPage, rhs: Routeguide_NoteHistoryPage) -> Bool {
+    if lhs.notes != rhs.notes {return false}
+    if lhs.nextPageToken != rhs.nextPageToken {return false}
+    if lhs.totalSize != rhs.totalSize {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_SyncRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".SyncRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}resume_token\0\u{3}page_size\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.resumeToken) }()
+      case 2: try { try decoder.decodeSingularInt32Field(value: &self.pageSize) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.resumeToken.isEmpty {
+      try visitor.visitSingularStringField(value: self.resumeToken, fieldNumber: 1)
+    }
+    if self.pageSize != 0 {
+      try visitor.visitSingularInt32Field(value: self.pageSize, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_SyncRequest, rhs: Routeguide_SyncRequest) -> Bool {
+    if lhs.resumeToken != rhs.resumeToken {return false}
+    if lhs.pageSize != rhs.pageSize {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_SyncMessage: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".SyncMessage"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}snapshot\0\u{1}delta\0\u{1}version\0\u{3}resume_token\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try {
+        var v: Routeguide_SnapshotPage?
+        var hadOneofValue = false
+        if let current = self.payload {
+          hadOneofValue = true
+          if case .snapshot(let m) = current {v = m}
+        }
+        try decoder.decodeSingularMessageField(value: &v)
+        if let v = v {
+          if hadOneofValue {try decoder.handleConflictingOneOf()}
+          self.payload = .snapshot(v)
+        }
+      }()
+      case 2: try {
+        var v: Routeguide_FeatureDelta?
+        var hadOneofValue = false
+        if let current = self.payload {
+          hadOneofValue = true
+          if case .delta(let m) = current {v = m}
+        }
+        try decoder.decodeSingularMessageField(value: &v)
+        if let v = v {
+          if hadOneofValue {try decoder.handleConflictingOneOf()}
+          self.payload = .delta(v)
+        }
+      }()
+      case 3: try { try decoder.decodeSingularInt64Field(value: &self.version) }()
+      case 4: try { try decoder.decodeSingularStringField(value: &self.resumeToken) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    switch self.payload {
+    case .snapshot?: try {
+      guard case .snapshot(let v)? = self.payload else { preconditionFailure() }
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    }()
+    case .delta?: try {
+      guard case .delta(let v)? = self.payload else { preconditionFailure() }
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 2)
+    }()
+    case nil: break
+    }
+    if self.version != 0 {
+      try visitor.visitSingularInt64Field(value: self.version, fieldNumber: 3)
+    }
+    if !self.resumeToken.isEmpty {
+      try visitor.visitSingularStringField(value: self.resumeToken, fieldNumber: 4)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_SyncMessage, rhs: Routeguide_SyncMessage) -> Bool {
+    if lhs.payload != rhs.payload {return false}
+    if lhs.version != rhs.version {return false}
+    if lhs.resumeToken != rhs.resumeToken {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_SnapshotPage: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".SnapshotPage"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}features\0\u{1}first\0\u{1}last\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeRepeatedMessageField(value: &self.features) }()
+      case 2: try { try decoder.decodeSingularBoolField(value: &self.first) }()
+      case 3: try { try decoder.decodeSingularBoolField(value: &self.last) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.features.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.features, fieldNumber: 1)
+    }
+    if self.first != false {
+      try visitor.visitSingularBoolField(value: self.first, fieldNumber: 2)
+    }
+    if self.last != false {
+      try visitor.visitSingularBoolField(value: self.last, fieldNumber: 3)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_SnapshotPage, rhs: Routeguide_SnapshotPage) -> Bool {
+    if lhs.features != rhs.features {return false}
+    if lhs.first != rhs.first {return false}
+    if lhs.last != rhs.last {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_FeatureDelta: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".FeatureDelta"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}upserted\0\u{1}removed\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeRepeatedMessageField(value: &self.upserted) }()
+      case 2: try { try decoder.decodeRepeatedMessageField(value: &self.removed) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.upserted.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.upserted, fieldNumber: 1)
+    }
+    if !self.removed.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.removed, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_FeatureDelta, rhs: Routeguide_FeatureDelta) -> Bool {
+    if lhs.upserted != rhs.upserted {return false}
+    if lhs.removed != rhs.removed {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_BundleRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".BundleRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}region\0\u{3}include_tiles\0\u{3}max_tile_zoom\0\u{3}estimate_only\0\u{1}offset\0\u{1}etag\0\u{3}chunk_size\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._region) }()
+      case 2: try { try decoder.decodeSingularBoolField(value: &self.includeTiles) }()
+      case 3: try { try decoder.decodeSingularInt32Field(value: &self.maxTileZoom) }()
+      case 4: try { try decoder.decodeSingularBoolField(value: &self.estimateOnly) }()
+      case 5: try { try decoder.decodeSingularInt64Field(value: &self.offset) }()
+      case 6: try { try decoder.decodeSingularStringField(value: &self.etag) }()
+      case 7: try { try decoder.decodeSingularInt32Field(value: &self.chunkSize) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._region {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    if self.includeTiles != false {
+      try visitor.visitSingularBoolField(value: self.includeTiles, fieldNumber: 2)
+    }
+    if self.maxTileZoom != 0 {
+      try visitor.visitSingularInt32Field(value: self.maxTileZoom, fieldNumber: 3)
+    }
+    if self.estimateOnly != false {
+      try visitor.visitSingularBoolField(value: self.estimateOnly, fieldNumber: 4)
+    }
+    if self.offset != 0 {
+      try visitor.visitSingularInt64Field(value: self.offset, fieldNumber: 5)
+    }
+    if !self.etag.isEmpty {
+      try visitor.visitSingularStringField(value: self.etag, fieldNumber: 6)
+    }
+    if self.chunkSize != 0 {
+      try visitor.visitSingularInt32Field(value: self.chunkSize, fieldNumber: 7)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_BundleRequest, rhs: Routeguide_BundleRequest) -> Bool {
+    if lhs._region != rhs._region {return false}
+    if lhs.includeTiles != rhs.includeTiles {return false}
+    if lhs.maxTileZoom != rhs.maxTileZoom {return false}
+    if lhs.estimateOnly != rhs.estimateOnly {return false}
+    if lhs.offset != rhs.offset {return false}
+    if lhs.etag != rhs.etag {return false}
+    if lhs.chunkSize != rhs.chunkSize {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_BundleChunk: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".BundleChunk"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}offset\0\u{1}data\0\u{3}total_size\0\u{1}etag\0\u{3}feature_count\0\u{3}tile_count\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt64Field(value: &self.offset) }()
+      case 2: try { try decoder.decodeSingularBytesField(value: &self.data) }()
+      case 3: try { try decoder.decodeSingularInt64Field(value: &self.totalSize) }()
+      case 4: try { try decoder.decodeSingularStringField(value: &self.etag) }()
+      case 5: try { try decoder.decodeSingularInt32Field(value: &self.featureCount) }()
+      case 6: try { try decoder.decodeSingularInt32Field(value: &self.tileCount) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.offset != 0 {
+      try visitor.visitSingularInt64Field(value: self.offset, fieldNumber: 1)
+    }
+    if !self.data.isEmpty {
+      try visitor.visitSingularBytesField(value: self.data, fieldNumber: 2)
+    }
+    if self.totalSize != 0 {
+      try visitor.visitSingularInt64Field(value: self.totalSize, fieldNumber: 3)
+    }
+    if !self.etag.isEmpty {
+      try visitor.visitSingularStringField(value: self.etag, fieldNumber: 4)
+    }
+    if self.featureCount != 0 {
+      try visitor.visitSingularInt32Field(value: self.featureCount, fieldNumber: 5)
+    }
+    if self.tileCount != 0 {
+      try visitor.visitSingularInt32Field(value: self.tileCount, fieldNumber: 6)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_BundleChunk, rhs: Routeguide_BundleChunk) -> Bool {
+    if lhs.offset != rhs.offset {return false}
+    if lhs.data != rhs.data {return false}
+    if lhs.totalSize != rhs.totalSize {return false}
+    if lhs.etag != rhs.etag {return false}
+    if lhs.featureCount != rhs.featureCount {return false}
+    if lhs.tileCount != rhs.tileCount {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_ChatActivityRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".ChatActivityRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}region\0\u{1}zoom\0\u{3}window_minutes\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._region) }()
+      case 2: try { try decoder.decodeSingularInt32Field(value: &self.zoom) }()
+      case 3: try { try decoder.decodeSingularInt32Field(value: &self.windowMinutes) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._region {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    if self.zoom != 0 {
+      try visitor.visitSingularInt32Field(value: self.zoom, fieldNumber: 2)
+    }
+    if self.windowMinutes != 0 {
+      try visitor.visitSingularInt32Field(value: self.windowMinutes, fieldNumber: 3)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_ChatActivityRequest, rhs: Routeguide_ChatActivityRequest) -> Bool {
+    if lhs._region != rhs._region {return false}
+    if lhs.zoom != rhs.zoom {return false}
+    if lhs.windowMinutes != rhs.windowMinutes {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_ChatActivity: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".ChatActivity"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}clusters\0\u{1}total\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeRepeatedMessageField(value: &self.clusters) }()
+      case 2: try { try decoder.decodeSingularInt64Field(value: &self.total) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.clusters.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.clusters, fieldNumber: 1)
+    }
+    if self.total != 0 {
+      try visitor.visitSingularInt64Field(value: self.total, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_ChatActivity, rhs: Routeguide_ChatActivity) -> Bool {
+    if lhs.clusters != rhs.clusters {return false}
+    if lhs.total != rhs.total {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_ActivityCluster: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".ActivityCluster"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}tile_x\0\u{3}tile_y\0\u{1}center\0\u{1}count\0\u{1}locations\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt32Field(value: &self.tileX) }()
+      case 2: try { try decoder.decodeSingularInt32Field(value: &self.tileY) }()
+      case 3: try { try decoder.decodeSingularMessageField(value: &self._center) }()
+      case 4: try { try decoder.decodeSingularInt64Field(value: &self.count) }()
+      case 5: try { try decoder.decodeSingularInt32Field(value: &self.locations) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    if self.tileX != 0 {
+      try visitor.visitSingularInt32Field(value: self.tileX, fieldNumber: 1)
+    }
+    if self.tileY != 0 {
+      try visitor.visitSingularInt32Field(value: self.tileY, fieldNumber: 2)
+    }
+    try { if let v = self._center {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 3)
+    } }()
+    if self.count != 0 {
+      try visitor.visitSingularInt64Field(value: self.count, fieldNumber: 4)
+    }
+    if self.locations != 0 {
+      try visitor.visitSingularInt32Field(value: self.locations, fieldNumber: 5)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_ActivityCluster, rhs: Routeguide_ActivityCluster) -> Bool {
+    if lhs.tileX != rhs.tileX {return false}
+    if lhs.tileY != rhs.tileY {return false}
+    if lhs._center != rhs._center {return false}
+    if lhs.count != rhs.count {return false}
+    if lhs.locations != rhs.locations {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_DatasetStatsRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".DatasetStatsRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}geohash_precision\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt32Field(value: &self.geohashPrecision) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.geohashPrecision != 0 {
+      try visitor.visitSingularInt32Field(value: self.geohashPrecision, fieldNumber: 1)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_DatasetStatsRequest, rhs: Routeguide_DatasetStatsRequest) -> Bool {
+    if lhs.geohashPrecision != rhs.geohashPrecision {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_GeohashBucket: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GeohashBucket"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}geohash\0\u{1}count\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.geohash) }()
+      case 2: try { try decoder.decodeSingularInt32Field(value: &self.count) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.geohash.isEmpty {
+      try visitor.visitSingularStringField(value: self.geohash, fieldNumber: 1)
+    }
+    if self.count != 0 {
+      try visitor.visitSingularInt32Field(value: self.count, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_GeohashBucket, rhs: Routeguide_GeohashBucket) -> Bool {
+    if lhs.geohash != rhs.geohash {return false}
+    if lhs.count != rhs.count {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_DatasetStats: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".DatasetStats"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}version\0\u{1}source\0\u{3}loaded_at\0\u{3}load_duration_ms\0\u{3}feature_count\0\u{1}bounds\0\u{1}density\0\u{3}duplicate_count\0\u{3}skipped_count\0\u{3}load_errors\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt64Field(value: &self.version) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.source) }()
+      case 3: try { try decoder.decodeSingularMessageField(value: &self._loadedAt) }()
+      case 4: try { try decoder.decodeSingularInt64Field(value: &self.loadDurationMs) }()
+      case 5: try { try decoder.decodeSingularInt32Field(value: &self.featureCount) }()
+      case 6: try { try decoder.decodeSingularMessageField(value: &self._bounds) }()
+      case 7: try { try decoder.decodeRepeatedMessageField(value: &self.density) }()
+      case 8: try { try decoder.decodeSingularInt32Field(value: &self.duplicateCount) }()
+      case 9: try { try decoder.decodeSingularInt32Field(value: &self.skippedCount) }()
+      case 10: try { try decoder.decodeRepeatedMessageField(value: &self.loadErrors) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    if self.version != 0 {
+      try visitor.visitSingularInt64Field(value: self.version, fieldNumber: 1)
+    }
+    if !self.source.isEmpty {
+      try visitor.visitSingularStringField(value: self.source, fieldNumber: 2)
+    }
+    try { if let v = self._loadedAt {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 3)
+    } }()
+    if self.loadDurationMs != 0 {
+      try visitor.visitSingularInt64Field(value: self.loadDurationMs, fieldNumber: 4)
+    }
+    if self.featureCount != 0 {
+      try visitor.visitSingularInt32Field(value: self.featureCount, fieldNumber: 5)
+    }
+    try { if let v = self._bounds {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 6)
+    } }()
+    if !self.density.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.density, fieldNumber: 7)
+    }
+    if self.duplicateCount != 0 {
+      try visitor.visitSingularInt32Field(value: self.duplicateCount, fieldNumber: 8)
+    }
+    if self.skippedCount != 0 {
+      try visitor.visitSingularInt32Field(value: self.skippedCount, fieldNumber: 9)
+    }
+    if !self.loadErrors.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.loadErrors, fieldNumber: 10)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_DatasetStats, rhs: Routeguide_DatasetStats) -> Bool {
+    if lhs.version != rhs.version {return false}
+    if lhs.source != rhs.source {return false}
+    if lhs._loadedAt != rhs._loadedAt {return false}
+    if lhs.loadDurationMs != rhs.loadDurationMs {return false}
+    if lhs.featureCount != rhs.featureCount {return false}
+    if lhs._bounds != rhs._bounds {return false}
+    if lhs.density != rhs.density {return false}
+    if lhs.duplicateCount != rhs.duplicateCount {return false}
+    if lhs.skippedCount != rhs.skippedCount {return false}
+    if lhs.loadErrors != rhs.loadErrors {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_LoadError: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".LoadError"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}index\0\u{1}name\0\u{1}error\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt32Field(value: &self.index) }()
+      case 2: try { try decoder.decodeSingularStringField(value: &self.name) }()
+      case 3: try { try decoder.decodeSingularStringField(value: &self.error) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if self.index != 0 {
+      try visitor.visitSingularInt32Field(value: self.index, fieldNumber: 1)
+    }
+    if !self.name.isEmpty {
+      try visitor.visitSingularStringField(value: self.name, fieldNumber: 2)
+    }
+    if !self.error.isEmpty {
+      try visitor.visitSingularStringField(value: self.error, fieldNumber: 3)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_LoadError, rhs: Routeguide_LoadError) -> Bool {
+    if lhs.index != rhs.index {return false}
+    if lhs.name != rhs.name {return false}
+    if lhs.error != rhs.error {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_SnapRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".SnapRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}point\0\u{3}max_distance_meters\0\u{3}named_only\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._point) }()
+      case 2: try { try decoder.decodeSingularInt32Field(value: &self.maxDistanceMeters) }()
+      case 3: try { try decoder.decodeSingularBoolField(value: &self.namedOnly) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._point {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    if self.maxDistanceMeters != 0 {
+      try visitor.visitSingularInt32Field(value: self.maxDistanceMeters, fieldNumber: 2)
+    }
+    if self.namedOnly != false {
+      try visitor.visitSingularBoolField(value: self.namedOnly, fieldNumber: 3)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_SnapRequest, rhs: Routeguide_SnapRequest) -> Bool {
+    if lhs._point != rhs._point {return false}
+    if lhs.maxDistanceMeters != rhs.maxDistanceMeters {return false}
+    if lhs.namedOnly != rhs.namedOnly {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_SnapResult: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".SnapResult"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}snapped\0\u{1}point\0\u{1}feature\0\u{3}distance_meters\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularBoolField(value: &self.snapped) }()
+      case 2: try { try decoder.decodeSingularMessageField(value: &self._point) }()
+      case 3: try { try decoder.decodeSingularMessageField(value: &self._feature) }()
+      case 4: try { try decoder.decodeSingularInt32Field(value: &self.distanceMeters) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    if self.snapped != false {
+      try visitor.visitSingularBoolField(value: self.snapped, fieldNumber: 1)
+    }
+    try { if let v = self._point {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 2)
+    } }()
+    try { if let v = self._feature {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 3)
+    } }()
+    if self.distanceMeters != 0 {
+      try visitor.visitSingularInt32Field(value: self.distanceMeters, fieldNumber: 4)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_SnapResult, rhs: Routeguide_SnapResult) -> Bool {
+    if lhs.snapped != rhs.snapped {return false}
+    if lhs._point != rhs._point {return false}
+    if lhs._feature != rhs._feature {return false}
+    if lhs.distanceMeters != rhs.distanceMeters {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_NearestFeaturesRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".NearestFeaturesRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}point\0\u{1}k\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._point) }()
+      case 2: try { try decoder.decodeSingularInt32Field(value: &self.k) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._point {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    if self.k != 0 {
+      try visitor.visitSingularInt32Field(value: self.k, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_NearestFeaturesRequest, rhs: Routeguide_NearestFeaturesRequest) -> Bool {
+    if lhs._point != rhs._point {return false}
+    if lhs.k != rhs.k {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_RadiusRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".RadiusRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}center\0\u{3}radius_meters\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._center) }()
+      case 2: try { try decoder.decodeSingularInt32Field(value: &self.radiusMeters) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._center {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    if self.radiusMeters != 0 {
+      try visitor.visitSingularInt32Field(value: self.radiusMeters, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_RadiusRequest, rhs: Routeguide_RadiusRequest) -> Bool {
+    if lhs._center != rhs._center {return false}
+    if lhs.radiusMeters != rhs.radiusMeters {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_NearestFeature: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".NearestFeature"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}feature\0\u{3}distance_meters\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._feature) }()
+      case 2: try { try decoder.decodeSingularInt32Field(value: &self.distanceMeters) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._feature {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    if self.distanceMeters != 0 {
+      try visitor.visitSingularInt32Field(value: self.distanceMeters, fieldNumber: 2)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_NearestFeature, rhs: Routeguide_NearestFeature) -> Bool {
+    if lhs._feature != rhs._feature {return false}
+    if lhs.distanceMeters != rhs.distanceMeters {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_NearestFeaturesResponse: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".NearestFeaturesResponse"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}features\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeRepeatedMessageField(value: &self.features) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.features.isEmpty {
+      try visitor.visitRepeatedMessageField(value: self.features, fieldNumber: 1)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_NearestFeaturesResponse, rhs: Routeguide_NearestFeaturesResponse) -> Bool {
+    if lhs.features != rhs.features {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_SubmitFeatureRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".SubmitFeatureRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}feature\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._feature) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._feature {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_SubmitFeatureRequest, rhs: Routeguide_SubmitFeatureRequest) -> Bool {
+    if lhs._feature != rhs._feature {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_CreateFeatureRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".CreateFeatureRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}feature\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._feature) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._feature {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_CreateFeatureRequest, rhs: Routeguide_CreateFeatureRequest) -> Bool {
+    if lhs._feature != rhs._feature {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_UpdateFeatureRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".UpdateFeatureRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}feature\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._feature) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._feature {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_UpdateFeatureRequest, rhs: Routeguide_UpdateFeatureRequest) -> Bool {
+    if lhs._feature != rhs._feature {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_DeleteFeatureRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".DeleteFeatureRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}location\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
       // enabled. https://github.com/apple/swift-protobuf/issues/1034
       switch fieldNumber {
       case 1: try { try decoder.decodeSingularMessageField(value: &self._location) }()
-      case 2: try { try decoder.decodeSingularStringField(value: &self.message) }()
       default: break
       }
     }
@@ -310,23 +3860,19 @@ extension Routeguide_RouteNote: SwiftProtobuf.Message, SwiftProtobuf._MessageImp
     try { if let v = self._location {
       try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
     } }()
-    if !self.message.isEmpty {
-      try visitor.visitSingularStringField(value: self.message, fieldNumber: 2)
-    }
     try unknownFields.traverse(visitor: &visitor)
   }
 
-  public static func ==(lhs: Routeguide_RouteNote, rhs: Routeguide_RouteNote) -> Bool {
+  public static func ==(lhs: Routeguide_DeleteFeatureRequest, rhs: Routeguide_DeleteFeatureRequest) -> Bool {
     if lhs._location != rhs._location {return false}
-    if lhs.message != rhs.message {return false}
     if lhs.unknownFields != rhs.unknownFields {return false}
     return true
   }
 }
 
-extension Routeguide_RouteSummary: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
-  public static let protoMessageName: String = _protobuf_package + ".RouteSummary"
-  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}point_count\0\u{3}feature_count\0\u{1}distance\0\u{3}elapsed_time\0")
+extension Routeguide_FeatureMutation: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".FeatureMutation"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}feature\0\u{3}dataset_version\0")
 
   public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
     while let fieldNumber = try decoder.nextFieldNumber() {
@@ -334,36 +3880,242 @@ extension Routeguide_RouteSummary: SwiftProtobuf.Message, SwiftProtobuf._Message
       // allocates stack space for every case branch when no optimizations are
       // enabled. https://github.com/apple/swift-protobuf/issues/1034
       switch fieldNumber {
-      case 1: try { try decoder.decodeSingularInt32Field(value: &self.pointCount) }()
-      case 2: try { try decoder.decodeSingularInt32Field(value: &self.featureCount) }()
-      case 3: try { try decoder.decodeSingularInt32Field(value: &self.distance) }()
-      case 4: try { try decoder.decodeSingularInt32Field(value: &self.elapsedTime) }()
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._feature) }()
+      case 2: try { try decoder.decodeSingularInt64Field(value: &self.datasetVersion) }()
       default: break
       }
     }
   }
 
   public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
-    if self.pointCount != 0 {
-      try visitor.visitSingularInt32Field(value: self.pointCount, fieldNumber: 1)
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._feature {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    if self.datasetVersion != 0 {
+      try visitor.visitSingularInt64Field(value: self.datasetVersion, fieldNumber: 2)
     }
-    if self.featureCount != 0 {
-      try visitor.visitSingularInt32Field(value: self.featureCount, fieldNumber: 2)
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_FeatureMutation, rhs: Routeguide_FeatureMutation) -> Bool {
+    if lhs._feature != rhs._feature {return false}
+    if lhs.datasetVersion != rhs.datasetVersion {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_FeatureSubmission: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".FeatureSubmission"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{1}id\0\u{1}feature\0\u{1}state\0\u{1}submitter\0\u{3}submitted_at\0\u{1}reviewer\0\u{3}reviewed_at\0\u{1}reason\0\u{3}dataset_version\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.id) }()
+      case 2: try { try decoder.decodeSingularMessageField(value: &self._feature) }()
+      case 3: try { try decoder.decodeSingularEnumField(value: &self.state) }()
+      case 4: try { try decoder.decodeSingularStringField(value: &self.submitter) }()
+      case 5: try { try decoder.decodeSingularMessageField(value: &self._submittedAt) }()
+      case 6: try { try decoder.decodeSingularStringField(value: &self.reviewer) }()
+      case 7: try { try decoder.decodeSingularMessageField(value: &self._reviewedAt) }()
+      case 8: try { try decoder.decodeSingularStringField(value: &self.reason) }()
+      case 9: try { try decoder.decodeSingularInt64Field(value: &self.datasetVersion) }()
+      default: break
+      }
     }
-    if self.distance != 0 {
-      try visitor.visitSingularInt32Field(value: self.distance, fieldNumber: 3)
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    if !self.id.isEmpty {
+      try visitor.visitSingularStringField(value: self.id, fieldNumber: 1)
     }
-    if self.elapsedTime != 0 {
-      try visitor.visitSingularInt32Field(value: self.elapsedTime, fieldNumber: 4)
+    try { if let v = self._feature {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 2)
+    } }()
+    if self.state != .unspecified {
+      try visitor.visitSingularEnumField(value: self.state, fieldNumber: 3)
+    }
+    if !self.submitter.isEmpty {
+      try visitor.visitSingularStringField(value: self.submitter, fieldNumber: 4)
+    }
+    try { if let v = self._submittedAt {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 5)
+    } }()
+    if !self.reviewer.isEmpty {
+      try visitor.visitSingularStringField(value: self.reviewer, fieldNumber: 6)
+    }
+    try { if let v = self._reviewedAt {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 7)
+    } }()
+    if !self.reason.isEmpty {
+      try visitor.visitSingularStringField(value: self.reason, fieldNumber: 8)
+    }
+    if self.datasetVersion != 0 {
+      try visitor.visitSingularInt64Field(value: self.datasetVersion, fieldNumber: 9)
     }
     try unknownFields.traverse(visitor: &visitor)
   }
 
-  public static func ==(lhs: Routeguide_RouteSummary, rhs: Routeguide_RouteSummary) -> Bool {
-    if lhs.pointCount != rhs.pointCount {return false}
-    if lhs.featureCount != rhs.featureCount {return false}
-    if lhs.distance != rhs.distance {return false}
-    if lhs.elapsedTime != rhs.elapsedTime {return false}
+  public static func ==(lhs: Routeguide_FeatureSubmission, rhs: Routeguide_FeatureSubmission) -> Bool {
+    if lhs.id != rhs.id {return false}
+    if lhs._feature != rhs._feature {return false}
+    if lhs.state != rhs.state {return false}
+    if lhs.submitter != rhs.submitter {return false}
+    if lhs._submittedAt != rhs._submittedAt {return false}
+    if lhs.reviewer != rhs.reviewer {return false}
+    if lhs._reviewedAt != rhs._reviewedAt {return false}
+    if lhs.reason != rhs.reason {return false}
+    if lhs.datasetVersion != rhs.datasetVersion {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_GetClientConfigRequest: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".GetClientConfigRequest"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}client_version\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularStringField(value: &self.clientVersion) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    if !self.clientVersion.isEmpty {
+      try visitor.visitSingularStringField(value: self.clientVersion, fieldNumber: 1)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_GetClientConfigRequest, rhs: Routeguide_GetClientConfigRequest) -> Bool {
+    if lhs.clientVersion != rhs.clientVersion {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_ClientConfig: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".ClientConfig"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}heartbeat_interval\0\u{3}page_size\0\u{1}retry\0\u{3}feature_flags\0\u{3}refresh_interval\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularMessageField(value: &self._heartbeatInterval) }()
+      case 2: try { try decoder.decodeSingularInt32Field(value: &self.pageSize) }()
+      case 3: try { try decoder.decodeSingularMessageField(value: &self._retry) }()
+      case 4: try { try decoder.decodeMapField(fieldType: SwiftProtobuf._ProtobufMap<SwiftProtobuf.ProtobufString,SwiftProtobuf.ProtobufBool>.self, value: &self.featureFlags) }()
+      case 5: try { try decoder.decodeSingularMessageField(value: &self._refreshInterval) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    try { if let v = self._heartbeatInterval {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 1)
+    } }()
+    if self.pageSize != 0 {
+      try visitor.visitSingularInt32Field(value: self.pageSize, fieldNumber: 2)
+    }
+    try { if let v = self._retry {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 3)
+    } }()
+    if !self.featureFlags.isEmpty {
+      try visitor.visitMapField(fieldType: SwiftProtobuf._ProtobufMap<SwiftProtobuf.ProtobufString,SwiftProtobuf.ProtobufBool>.self, value: self.featureFlags, fieldNumber: 4)
+    }
+    try { if let v = self._refreshInterval {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 5)
+    } }()
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_ClientConfig, rhs: Routeguide_ClientConfig) -> Bool {
+    if lhs._heartbeatInterval != rhs._heartbeatInterval {return false}
+    if lhs.pageSize != rhs.pageSize {return false}
+    if lhs._retry != rhs._retry {return false}
+    if lhs.featureFlags != rhs.featureFlags {return false}
+    if lhs._refreshInterval != rhs._refreshInterval {return false}
+    if lhs.unknownFields != rhs.unknownFields {return false}
+    return true
+  }
+}
+
+extension Routeguide_RetryPolicy: SwiftProtobuf.Message, SwiftProtobuf._MessageImplementationBase, SwiftProtobuf._ProtoNameProviding {
+  public static let protoMessageName: String = _protobuf_package + ".RetryPolicy"
+  public static let _protobuf_nameMap = SwiftProtobuf._NameMap(bytecode: "\0\u{3}max_attempts\0\u{3}initial_backoff\0\u{3}max_backoff\0\u{3}backoff_multiplier\0\u{3}retryable_codes\0")
+
+  public mutating func decodeMessage<D: SwiftProtobuf.Decoder>(decoder: inout D) throws {
+    while let fieldNumber = try decoder.nextFieldNumber() {
+      // The use of inline closures is to circumvent an issue where the compiler
+      // allocates stack space for every case branch when no optimizations are
+      // enabled. https://github.com/apple/swift-protobuf/issues/1034
+      switch fieldNumber {
+      case 1: try { try decoder.decodeSingularInt32Field(value: &self.maxAttempts) }()
+      case 2: try { try decoder.decodeSingularMessageField(value: &self._initialBackoff) }()
+      case 3: try { try decoder.decodeSingularMessageField(value: &self._maxBackoff) }()
+      case 4: try { try decoder.decodeSingularDoubleField(value: &self.backoffMultiplier) }()
+      case 5: try { try decoder.decodeRepeatedStringField(value: &self.retryableCodes) }()
+      default: break
+      }
+    }
+  }
+
+  public func traverse<V: SwiftProtobuf.Visitor>(visitor: inout V) throws {
+    // The use of inline closures is to circumvent an issue where the compiler
+    // allocates stack space for every if/case branch local when no optimizations
+    // are enabled. https://github.com/apple/swift-protobuf/issues/1034 and
+    // https://github.com/apple/swift-protobuf/issues/1182
+    if self.maxAttempts != 0 {
+      try visitor.visitSingularInt32Field(value: self.maxAttempts, fieldNumber: 1)
+    }
+    try { if let v = self._initialBackoff {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 2)
+    } }()
+    try { if let v = self._maxBackoff {
+      try visitor.visitSingularMessageField(value: v, fieldNumber: 3)
+    } }()
+    if self.backoffMultiplier.bitPattern != 0 {
+      try visitor.visitSingularDoubleField(value: self.backoffMultiplier, fieldNumber: 4)
+    }
+    if !self.retryableCodes.isEmpty {
+      try visitor.visitRepeatedStringField(value: self.retryableCodes, fieldNumber: 5)
+    }
+    try unknownFields.traverse(visitor: &visitor)
+  }
+
+  public static func ==(lhs: Routeguide_RetryPolicy, rhs: Routeguide_RetryPolicy) -> Bool {
+    if lhs.maxAttempts != rhs.maxAttempts {return false}
+    if lhs._initialBackoff != rhs._initialBackoff {return false}
+    if lhs._maxBackoff != rhs._maxBackoff {return false}
+    if lhs.backoffMultiplier != rhs.backoffMultiplier {return false}
+    if lhs.retryableCodes != rhs.retryableCodes {return false}
     if lhs.unknownFields != rhs.unknownFields {return false}
     return true
   }
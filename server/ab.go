@@ -0,0 +1,94 @@
+package main
+
+import (
+	"context"
+	"hash/fnv"
+	"log/slog"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// datasetKey is the metadata key clients use to pick the dataset serving a
+// request ("stable" or "candidate"); the server echoes the choice in a header
+const datasetKey = "dataset"
+
+const (
+	stableDataset    = "stable"
+	candidateDataset = "candidate"
+)
+
+// candidateVersion is the dataset version of the candidate. Stable versions
+// count up from 1, so responses and page cursors can't mix the two up.
+const candidateVersion = -1
+
+// abSplit serves a candidate dataset next to the current one, to a share of
+// the callers, so a new dataset can be rolled out gradually
+type abSplit struct {
+	candidate atomic.Pointer[dataset] // nil when no A/B test is running
+	percent   float64                 // share of callers sent to the candidate when unpinned
+}
+
+// loadCandidate loads the candidate dataset and sends percent% of the
+// callers that don't pick a dataset to it
+func (s *routeGuideServer) loadCandidate(filePath string, percent float64) error {
+	d, err := loadDatasetFile(filePath, s.strictLoad)
+	if err != nil {
+		return err
+	}
+
+	d.version = candidateVersion
+	s.ab.percent = percent
+	s.ab.candidate.Store(d)
+	slog.Info("Candidate dataset loaded", "count", len(d.features), "file", filePath, "percent", percent)
+	return nil
+}
+
+// datasetFor returns the dataset serving a request: the one pinned by the
+// caller's dataset metadata, otherwise the candidate for the configured share
+// of callers and the current dataset for the rest. Callers are assigned by
+// principal, or by address when anonymous, so each keeps seeing the same
+// dataset.
+func (s *routeGuideServer) datasetFor(ctx context.Context) (d *dataset, err error) {
+	if pin, ok := ctx.Value(datasetPinKey{}).(*datasetPin); ok {
+		defer func() { pin.d = d }()
+	}
+
+	candidate := s.ab.candidate.Load()
+
+	name := stableDataset
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md.Get(datasetKey)) > 0 {
+		switch name = md.Get(datasetKey)[0]; name {
+		case stableDataset:
+		case candidateDataset:
+			if candidate == nil {
+				return nil, status.Error(codes.FailedPrecondition, "no candidate dataset is loaded")
+			}
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "unknown %s %q: expected %q or %q", datasetKey, name, stableDataset, candidateDataset)
+		}
+	} else if candidate != nil && abBucket(accessClient(ctx)) < s.ab.percent {
+		name = candidateDataset
+	}
+
+	if candidate != nil {
+		// Only worth telling the client when there is a choice
+		grpc.SetHeader(ctx, metadata.Pairs(datasetKey, name))
+	}
+
+	if name == candidateDataset {
+		return candidate, nil
+	}
+	return s.current(), nil
+}
+
+// abBucket places a caller in [0, 100), in steps of 0.01, always at the same
+// place
+func abBucket(caller string) float64 {
+	h := fnv.New64a()
+	h.Write([]byte(caller))
+	return float64(h.Sum64()%10000) / 100
+}
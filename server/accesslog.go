@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// accessRecord is a line of the access log, one per call
+type accessRecord struct {
+	Time       time.Time `json:"time"`
+	Method     string    `json:"method"`
+	Code       string    `json:"code"`
+	DurationMS float64   `json:"duration_ms"`
+	Client     string    `json:"client"`            // principal, or anonymous@host
+	Feature    string    `json:"feature,omitempty"` // name of the feature returned, if any
+	RequestID  string    `json:"request_id,omitempty"`
+}
+
+// accessLog appends a JSON line per call to a file, for the report command
+type accessLog struct {
+	mu   sync.Mutex
+	file *os.File
+	enc  *json.Encoder
+}
+
+// openAccessLog opens the access log at path, appending to it if it exists
+func openAccessLog(path string) (*accessLog, error) {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &accessLog{file: file, enc: json.NewEncoder(file)}, nil
+}
+
+// record writes the line of a finished call
+func (a *accessLog) record(ctx context.Context, fullMethod string, start time.Time, resp any, err error) {
+	rec := accessRecord{
+		Time:       start.UTC(),
+		Method:     fullMethod,
+		Code:       status.Code(err).String(),
+		DurationMS: float64(time.Since(start).Microseconds()) / 1000,
+		Client:     accessClient(ctx),
+		RequestID:  requestID(ctx),
+	}
+	if feature, ok := resp.(*pb.Feature); ok && err == nil {
+		rec.Feature = feature.Name
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if err := a.enc.Encode(rec); err != nil {
+		loggerFrom(ctx).Error("Failed to write access log", "error", err)
+	}
+}
+
+// accessClient identifies the caller of a call: its principal, or its host
+// for anonymous callers
+func accessClient(ctx context.Context) string {
+	if p := principalFromContext(ctx); p != nil {
+		return p.Name
+	}
+	if p, ok := peer.FromContext(ctx); ok {
+		return "anonymous@" + peerHost(p)
+	}
+	return "anonymous"
+}
+
+// close closes the file once the server stopped
+func (a *accessLog) close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.file.Close()
+}
+
+// unaryInterceptor logs unary calls. It runs after authentication, so calls
+// rejected for their credentials aren't logged.
+func (a *accessLog) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	a.record(ctx, info.FullMethod, start, resp, err)
+	return resp, err
+}
+
+// streamInterceptor logs streams once they end
+func (a *accessLog) streamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	a.record(ss.Context(), info.FullMethod, start, nil, err)
+	return err
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// activityBucket is the resolution of the chat activity window
+	activityBucket = time.Minute
+	// maxActivityWindow is how far back chat activity is kept, in buckets
+	maxActivityWindow = 60
+	// defaultActivityWindow is the window of a request that doesn't set one
+	defaultActivityWindow = 15
+)
+
+// chatActivity counts the notes posted at each location over a sliding
+// window of the last maxActivityWindow minutes, one bucket per minute
+type chatActivity struct {
+	mu      sync.Mutex
+	buckets map[int64]map[string]*locationActivity // by minute since the epoch, then location key
+}
+
+// locationActivity is the number of notes posted at a location in a bucket
+type locationActivity struct {
+	location *pb.Point
+	count    int64
+}
+
+// newChatActivity creates an empty activity window
+func newChatActivity() *chatActivity {
+	return &chatActivity{buckets: make(map[int64]map[string]*locationActivity)}
+}
+
+// record counts a note posted at location at time now, dropping the buckets
+// that slid out of the window
+func (a *chatActivity) record(location *pb.Point, now time.Time) {
+	minute := now.Unix() / int64(activityBucket/time.Second)
+	key := geo.Key(location)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	bucket := a.buckets[minute]
+	if bucket == nil {
+		bucket = make(map[string]*locationActivity)
+		a.buckets[minute] = bucket
+		for m := range a.buckets {
+			if m <= minute-maxActivityWindow {
+				delete(a.buckets, m)
+			}
+		}
+	}
+	if counted := bucket[key]; counted != nil {
+		counted.count++
+	} else {
+		bucket[key] = &locationActivity{location: location, count: 1}
+	}
+}
+
+// since returns the notes posted per location in the last window minutes,
+// the current one included
+func (a *chatActivity) since(window int, now time.Time) map[string]*locationActivity {
+	minute := now.Unix() / int64(activityBucket/time.Second)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	totals := make(map[string]*locationActivity)
+	for m, bucket := range a.buckets {
+		if m <= minute-int64(window) || m > minute {
+			continue
+		}
+		for key, counted := range bucket {
+			if total := totals[key]; total != nil {
+				total.count += counted.count
+			} else {
+				totals[key] = &locationActivity{location: counted.location, count: counted.count}
+			}
+		}
+	}
+	return totals
+}
+
+// GetChatActivity counts recent notes in a region per map tile (unary RPC)
+func (s *routeGuideServer) GetChatActivity(ctx context.Context, req *pb.ChatActivityRequest) (*pb.ChatActivity, error) {
+	loggerFrom(ctx).Info("GetChatActivity called", "zoom", req.Zoom, "window_minutes", req.WindowMinutes)
+
+	if err := geo.ValidateRectangle(req.Region); err != nil {
+		return nil, reasonError(codes.InvalidArgument, "INVALID_RECTANGLE", map[string]string{"detail": err.Error()})
+	}
+	if req.Zoom < 0 || req.Zoom > maxTileZoom {
+		return nil, status.Errorf(codes.InvalidArgument, "zoom must be between 0 and %d", maxTileZoom)
+	}
+	window := int(req.WindowMinutes)
+	if window == 0 {
+		window = defaultActivityWindow
+	}
+	if window < 1 || window > maxActivityWindow {
+		return nil, status.Errorf(codes.InvalidArgument, "window_minutes must be between 1 and %d", maxActivityWindow)
+	}
+
+	return clusterActivity(s.activity.since(window, time.Now()), geo.Canonical(req.Region), int(req.Zoom)), nil
+}
+
+// clusterActivity sums the activity within bounds per tile at zoom
+func clusterActivity(activity map[string]*locationActivity, bounds geo.Bounds, zoom int) *pb.ChatActivity {
+	type tile struct{ x, y int32 }
+	type cluster struct {
+		count     int64
+		locations int32
+		lat, lon  float64 // count-weighted sums, in degrees
+	}
+
+	n := float64(int(1) << zoom)
+	clusters := make(map[tile]*cluster)
+	for _, counted := range activity {
+		if !bounds.Contains(counted.location) {
+			continue
+		}
+		lat, lon := geo.Degrees(counted.location.Latitude), geo.Degrees(counted.location.Longitude)
+		x, y := mercatorTile(lat, lon, n)
+		// The east edge and the south pole belong to the last tile
+		t := tile{int32(min(math.Floor(x), n-1)), int32(min(math.Floor(y), n-1))}
+		c := clusters[t]
+		if c == nil {
+			c = &cluster{}
+			clusters[t] = c
+		}
+		c.count += counted.count
+		c.locations++
+		c.lat += lat * float64(counted.count)
+		c.lon += lon * float64(counted.count)
+	}
+
+	resp := &pb.ChatActivity{}
+	for t, c := range clusters {
+		resp.Total += c.count
+		resp.Clusters = append(resp.Clusters, &pb.ActivityCluster{
+			TileX:     t.x,
+			TileY:     t.y,
+			Center:    &pb.Point{Latitude: geo.E7(c.lat / float64(c.count)), Longitude: geo.E7(c.lon / float64(c.count))},
+			Count:     c.count,
+			Locations: c.locations,
+		})
+	}
+	slices.SortFunc(resp.Clusters, func(a, b *pb.ActivityCluster) int {
+		return cmp.Or(
+			cmp.Compare(b.Count, a.Count),
+			cmp.Compare(a.TileY, b.TileY),
+			cmp.Compare(a.TileX, b.TileX),
+		)
+	})
+	return resp
+}
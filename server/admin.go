@@ -0,0 +1,65 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"runtime"
+	"runtime/pprof"
+	"strings"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// adminServer implements the Admin service
+type adminServer struct {
+	pb.UnimplementedAdminServer
+	server *routeGuideServer
+}
+
+// newAdminServer creates a new Admin server operating on s
+func newAdminServer(s *routeGuideServer) *adminServer {
+	return &adminServer{server: s}
+}
+
+// DebugDump returns a goroutine dump and optionally a heap profile (unary RPC)
+func (a *adminServer) DebugDump(ctx context.Context, req *pb.DebugDumpRequest) (*pb.DebugDumpResponse, error) {
+	loggerFrom(ctx).Info("DebugDump called", "include_heap", req.IncludeHeap)
+
+	var goroutines bytes.Buffer
+	if err := pprof.Lookup("goroutine").WriteTo(&goroutines, 2); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to dump goroutines: %v", err)
+	}
+
+	resp := &pb.DebugDumpResponse{Goroutines: goroutines.Bytes()}
+
+	if req.IncludeHeap {
+		// Run a collection first so the profile reflects live objects
+		runtime.GC()
+
+		var heap bytes.Buffer
+		if err := pprof.WriteHeapProfile(&heap); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to write heap profile: %v", err)
+		}
+		resp.HeapProfile = heap.Bytes()
+	}
+
+	return resp, nil
+}
+
+// Admin authentication
+
+// adminRouteGuideMethods are the RouteGuide methods only admins may call:
+// the ones changing features without review
+var adminRouteGuideMethods = map[string]bool{
+	pb.RouteGuide_CreateFeature_FullMethodName: true,
+	pb.RouteGuide_UpdateFeature_FullMethodName: true,
+	pb.RouteGuide_DeleteFeature_FullMethodName: true,
+}
+
+// isAdminMethod reports whether a full method name needs the admin role: it
+// belongs to the Admin service or is one of adminRouteGuideMethods
+func isAdminMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/"+pb.Admin_ServiceDesc.ServiceName+"/") || adminRouteGuideMethods[fullMethod]
+}
@@ -0,0 +1,67 @@
+//go:build !minimal && !no_http
+
+package main
+
+import (
+	"context"
+	"expvar"
+	"flag"
+	"fmt"
+	"log"
+	"log/slog"
+	"net/http"
+)
+
+var (
+	adminHTTP   = flag.Int("admin-http-port", 0, "Port for the admin HTTP server serving vector tiles and metrics (disabled when 0)")
+	metricsPort = flag.Int("metrics-port", 0, "Port for the HTTP server exposing Prometheus metrics on /metrics (disabled when 0; also served on --admin-http-port)")
+)
+
+func init() {
+	registerExtension(extension{name: "HTTP ports", start: startHTTPPorts})
+}
+
+// startHTTPPorts starts the admin HTTP server and the Prometheus metrics
+// server on the ports set by their flags
+func startHTTPPorts(h *extensionHost) error {
+	if *adminHTTP != 0 {
+		serveHTTP(h, "Admin HTTP", newAdminHTTPServer(*adminHTTP, h.server))
+	}
+	if *metricsPort != 0 {
+		serveHTTP(h, "Metrics HTTP", newMetricsHTTPServer(*metricsPort))
+	}
+	return nil
+}
+
+// serveHTTP runs an HTTP server until the gRPC server has stopped
+func serveHTTP(h *extensionHost, name string, server *http.Server) {
+	go func() {
+		slog.Info("HTTP server listening", "server", name, "address", server.Addr)
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("%s server failed: %v", name, err)
+		}
+	}()
+	h.stops = append(h.stops, func() { server.Shutdown(context.Background()) })
+}
+
+// newAdminHTTPServer creates the HTTP server for operator endpoints that are
+// easier to consume over plain HTTP than gRPC
+func newAdminHTTPServer(port int, s *routeGuideServer) *http.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /tiles/{z}/{x}/{y}", s.handleTile)
+	mux.Handle("GET /debug/vars", expvar.Handler())
+	mux.Handle("GET /metrics", metricsHandler())
+	mux.HandleFunc("GET /flags", s.flags.handleFlags)
+
+	return &http.Server{
+		Addr:    fmt.Sprintf(":%d", port),
+		Handler: mux,
+	}
+}
+
+// newMetricsHTTPServer creates the HTTP server exposing /metrics on its own port
+func newMetricsHTTPServer(port int) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle("GET /metrics", metricsHandler())
+	return &http.Server{Addr: fmt.Sprintf(":%d", port), Handler: mux}
+}
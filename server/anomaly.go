@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/grpc/metadata"
+)
+
+// routeValidationKey is the metadata key selecting how RecordRoute treats
+// anomalies: "strict" rejects the route, anything else only reports them.
+const routeValidationKey = "route-validation"
+
+// anomalyLimits are the thresholds beyond which a route segment is flagged
+type anomalyLimits struct {
+	maxSpeedKmh      float64       // fastest plausible speed
+	maxSegmentMeters int32         // longest plausible gap between two consecutive points
+	minInterval      time.Duration // shortest interval over which speed is measured
+}
+
+// check returns the anomaly for the segment from -> to, or nil if it is plausible.
+// Speed is derived from the time between receiving the two points, so segments
+// received less than minInterval apart (e.g. a client uploading a recorded
+// track in one burst) are only checked for teleports.
+func (l anomalyLimits) check(index int32, from, to *pb.Point, distance int32, elapsed time.Duration) *pb.RouteAnomaly {
+	anomaly := &pb.RouteAnomaly{
+		PointIndex: index,
+		From:       from,
+		To:         to,
+		Distance:   distance,
+	}
+
+	if elapsed >= l.minInterval && elapsed > 0 {
+		anomaly.SpeedKmh = float64(distance) / 1000 / elapsed.Hours()
+	}
+
+	switch {
+	case l.maxSegmentMeters > 0 && distance > l.maxSegmentMeters:
+		anomaly.Kind = pb.RouteAnomaly_TELEPORT
+	case l.maxSpeedKmh > 0 && anomaly.SpeedKmh > l.maxSpeedKmh:
+		anomaly.Kind = pb.RouteAnomaly_EXCESSIVE_SPEED
+	default:
+		return nil
+	}
+
+	return anomaly
+}
+
+// strictRouteValidation reports whether the caller asked for routes with anomalies to be rejected
+func strictRouteValidation(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	values := md.Get(routeValidationKey)
+	return len(values) > 0 && strings.EqualFold(values[0], "strict")
+}
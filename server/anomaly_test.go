@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAnomalyLimitsCheck(t *testing.T) {
+	limits := anomalyLimits{maxSpeedKmh: 300, maxSegmentMeters: 100000, minInterval: time.Second}
+	walking := travelProfiles[pb.TravelProfile_TRAVEL_PROFILE_WALKING].limits
+	walking.minInterval = time.Second
+
+	tests := []struct {
+		name     string
+		limits   anomalyLimits
+		distance int32
+		elapsed  time.Duration
+		want     pb.RouteAnomaly_Kind // UNSPECIFIED for none
+		speed    float64
+	}{
+		{"plausible", limits, 1000, time.Minute, pb.RouteAnomaly_KIND_UNSPECIFIED, 0},
+		{"too fast", limits, 10000, time.Minute, pb.RouteAnomaly_EXCESSIVE_SPEED, 600},
+		{"teleport", limits, 200000, time.Hour, pb.RouteAnomaly_TELEPORT, 200},
+		{"teleport in a burst", limits, 200000, time.Millisecond, pb.RouteAnomaly_TELEPORT, 0},
+		{"fast in a burst", limits, 10000, time.Millisecond, pb.RouteAnomaly_KIND_UNSPECIFIED, 0},
+		{"no limits", anomalyLimits{}, 200000, time.Second, pb.RouteAnomaly_KIND_UNSPECIFIED, 0},
+		{"cycling pace on foot", walking, 1000, 2 * time.Minute, pb.RouteAnomaly_EXCESSIVE_SPEED, 30},
+		{"long gap on foot", walking, 6000, time.Hour, pb.RouteAnomaly_TELEPORT, 6},
+	}
+	from, to := &pb.Point{Latitude: 1}, &pb.Point{Latitude: 2}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			anomaly := tt.limits.check(5, from, to, tt.distance, tt.elapsed)
+			if tt.want == pb.RouteAnomaly_KIND_UNSPECIFIED {
+				if anomaly != nil {
+					t.Errorf("check() = %v, want no anomaly", anomaly)
+				}
+				return
+			}
+			if anomaly == nil || anomaly.Kind != tt.want {
+				t.Fatalf("check() = %v, want %v", anomaly, tt.want)
+			}
+			if anomaly.PointIndex != 5 || anomaly.Distance != tt.distance || anomaly.SpeedKmh != tt.speed {
+				t.Errorf("check() = %v, want point 5, %d meters at %.0f km/h", anomaly, tt.distance, tt.speed)
+			}
+		})
+	}
+}
+
+func TestRecordRouteStrictValidation(t *testing.T) {
+	s, err := newServer("features.json", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.routes = newRouteStore(100)
+	s.anomalyLimits = anomalyLimits{maxSpeedKmh: 300, maxSegmentMeters: 100000, minInterval: time.Second}
+	start := &pb.Point{Latitude: 400000000, Longitude: -740000000}
+	near := &pb.Point{Latitude: 400100000, Longitude: -740000000}   // about 1 km north
+	nearby := &pb.Point{Latitude: 401000000, Longitude: -740000000} // about 11 km north
+	far := &pb.Point{Latitude: 420000000, Longitude: -740000000}    // about 220 km north
+
+	// Points sent in one burst are only checked for teleports
+	tests := []struct {
+		name      string
+		md        metadata.MD
+		points    []*pb.Point
+		code      codes.Code
+		anomalies int
+	}{
+		{"plausible route", nil, []*pb.Point{start, near, start}, codes.OK, 0},
+		{"teleport reported", nil, []*pb.Point{start, far, start}, codes.OK, 2},
+		{"plausible route, strict", metadata.Pairs(routeValidationKey, "strict"), []*pb.Point{start, near}, codes.OK, 0},
+		{"teleport rejected", metadata.Pairs(routeValidationKey, "strict"), []*pb.Point{start, far}, codes.InvalidArgument, 0},
+		{"strict is case insensitive", metadata.Pairs(routeValidationKey, "STRICT"), []*pb.Point{start, far}, codes.InvalidArgument, 0},
+		{"other modes only report", metadata.Pairs(routeValidationKey, "lenient"), []*pb.Point{start, far}, codes.OK, 1},
+		{"teleport on foot", metadata.Pairs(travelProfileKey, "walking"), []*pb.Point{start, nearby}, codes.OK, 1},
+		{"teleport on foot rejected", metadata.Pairs(travelProfileKey, "walking", routeValidationKey, "strict"), []*pb.Point{start, nearby}, codes.InvalidArgument, 0},
+		{"same gap driving", metadata.Pairs(travelProfileKey, "driving", routeValidationKey, "strict"), []*pb.Point{start, nearby}, codes.OK, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tt.md)
+			summary, err := recordRoute(s, ctx, tt.points...)
+			if status.Code(err) != tt.code {
+				t.Fatalf("RecordRoute() = %v, want %v", err, tt.code)
+			}
+			if err != nil {
+				return
+			}
+			if len(summary.Anomalies) != tt.anomalies {
+				t.Fatalf("RecordRoute() reported anomalies %v, want %d", summary.Anomalies, tt.anomalies)
+			}
+			for _, anomaly := range summary.Anomalies {
+				if anomaly.Kind != pb.RouteAnomaly_TELEPORT {
+					t.Errorf("anomaly %v, want a teleport", anomaly)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,443 @@
+package main
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// adminRole is the role required to call the Admin service
+const adminRole = "admin"
+
+// apiKeyHeader is the metadata key API keys are sent in
+const apiKeyHeader = "x-api-key"
+
+// Principal is an authenticated caller
+type Principal struct {
+	Name    string
+	Roles   []string
+	TokenID string // the "jti" claim of a JWT, if any
+}
+
+// HasRole reports whether the principal has a role. A nil principal, an
+// anonymous caller, has none.
+func (p *Principal) HasRole(role string) bool {
+	return p != nil && slices.Contains(p.Roles, role)
+}
+
+// AuthProvider validates the credentials a caller sent in its metadata.
+// Providers return errNoCredentials when the metadata holds no credentials
+// they understand, so the next provider can try; any other error rejects the
+// call and should be a gRPC status.
+type AuthProvider interface {
+	ValidateCredentials(ctx context.Context, md metadata.MD) (*Principal, error)
+}
+
+// errNoCredentials means a provider found no credentials it handles
+var errNoCredentials = errors.New("no credentials")
+
+// principalKey is the context key of the authenticated Principal
+type principalKey struct{}
+
+// principalFromContext returns the caller authenticated by the auth
+// interceptors, or nil for anonymous callers
+func principalFromContext(ctx context.Context) *Principal {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	return p
+}
+
+// bearerToken returns the token of an "authorization: Bearer" header
+func bearerToken(md metadata.MD) (string, bool) {
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return "", false
+	}
+	return strings.CutPrefix(values[0], "Bearer ")
+}
+
+// staticTokenProvider accepts a single shared bearer token
+type staticTokenProvider struct {
+	token     string
+	principal *Principal
+}
+
+// newStaticTokenProvider authenticates callers presenting token as principal
+func newStaticTokenProvider(token string, principal *Principal) *staticTokenProvider {
+	return &staticTokenProvider{token: token, principal: principal}
+}
+
+func (p *staticTokenProvider) ValidateCredentials(ctx context.Context, md metadata.MD) (*Principal, error) {
+	got, ok := bearerToken(md)
+	if !ok {
+		return nil, errNoCredentials
+	}
+	if subtle.ConstantTimeCompare([]byte(got), []byte(p.token)) == 1 {
+		return p.principal, nil
+	}
+	if strings.Count(got, ".") == 2 {
+		// Other tokens shaped like JWTs are left to the JWT provider
+		return nil, errNoCredentials
+	}
+	return nil, reasonError(codes.Unauthenticated, "INVALID_TOKEN", nil)
+}
+
+// jwtProvider accepts JWT bearer tokens signed with an HMAC secret (HS256)
+// or an RSA key (RS256). The principal is the token's subject and its roles
+// come from the "roles" claim. Tokens must expire.
+type jwtProvider struct {
+	secret   []byte         // verifies HS256 tokens, nil to reject them
+	rsaKey   *rsa.PublicKey // verifies RS256 tokens, nil to reject them
+	issuer   string         // required "iss" claim, if not empty
+	audience string         // required "aud" claim, if not empty
+	now      func() time.Time
+}
+
+// newJWTProvider verifies JWTs signed with secret or with the private key of
+// rsaKey; either may be nil
+func newJWTProvider(secret []byte, rsaKey *rsa.PublicKey, issuer, audience string) *jwtProvider {
+	return &jwtProvider{secret: secret, rsaKey: rsaKey, issuer: issuer, audience: audience, now: time.Now}
+}
+
+// loadRSAPublicKey reads an RSA public key from a PEM file holding a PKIX
+// ("PUBLIC KEY") or PKCS #1 ("RSA PUBLIC KEY") key, or a certificate
+func loadRSAPublicKey(path string) (*rsa.PublicKey, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("%s holds no PEM data", path)
+	}
+	var key any
+	switch block.Type {
+	case "PUBLIC KEY":
+		key, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		key, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	case "CERTIFICATE":
+		var cert *x509.Certificate
+		if cert, err = x509.ParseCertificate(block.Bytes); err == nil {
+			key = cert.PublicKey
+		}
+	default:
+		return nil, fmt.Errorf("%s holds a %s, not a public key or certificate", path, block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%s doesn't hold an RSA key", path)
+	}
+	return rsaKey, nil
+}
+
+// jwtClaims are the JWT claims the provider understands
+type jwtClaims struct {
+	Subject   string           `json:"sub"`
+	Issuer    string           `json:"iss"`
+	Audience  jwtAudienceClaim `json:"aud"`
+	ExpiresAt int64            `json:"exp"`
+	NotBefore int64            `json:"nbf"`
+	ID        string           `json:"jti"`
+	Roles     []string         `json:"roles"`
+}
+
+func (p *jwtProvider) ValidateCredentials(ctx context.Context, md metadata.MD) (*Principal, error) {
+	token, ok := bearerToken(md)
+	if !ok || strings.Count(token, ".") != 2 {
+		return nil, errNoCredentials
+	}
+
+	claims, err := p.verify(token)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "invalid JWT: %v", err)
+	}
+	return &Principal{Name: claims.Subject, Roles: claims.Roles, TokenID: claims.ID}, nil
+}
+
+// jwtAudienceClaim is the "aud" claim, which is a single string or an
+// array of them
+type jwtAudienceClaim []string
+
+func (a *jwtAudienceClaim) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = jwtAudienceClaim{single}
+		return nil
+	}
+	return json.Unmarshal(data, (*[]string)(a))
+}
+
+// verify checks a JWT's signature and validity period and returns its claims
+func (p *jwtProvider) verify(token string) (*jwtClaims, error) {
+	parts := strings.Split(token, ".")
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeJWTPart(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("malformed header: %v", err)
+	}
+
+	signed := []byte(parts[0] + "." + parts[1])
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, errors.New("bad signature")
+	}
+	// The algorithm must match the key configured for it, so an RS256
+	// public key can't be passed off as an HS256 secret
+	switch {
+	case header.Alg == "HS256" && p.secret != nil:
+		mac := hmac.New(sha256.New, p.secret)
+		mac.Write(signed)
+		if !hmac.Equal(signature, mac.Sum(nil)) {
+			return nil, errors.New("bad signature")
+		}
+	case header.Alg == "RS256" && p.rsaKey != nil:
+		digest := sha256.Sum256(signed)
+		if rsa.VerifyPKCS1v15(p.rsaKey, crypto.SHA256, digest[:], signature) != nil {
+			return nil, errors.New("bad signature")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported algorithm %q", header.Alg)
+	}
+
+	var claims jwtClaims
+	if err := decodeJWTPart(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("malformed claims: %v", err)
+	}
+	now := p.now().Unix()
+	if claims.ExpiresAt == 0 {
+		return nil, errors.New("missing expiry")
+	}
+	if now >= claims.ExpiresAt {
+		return nil, errors.New("token expired")
+	}
+	if claims.NotBefore != 0 && now < claims.NotBefore {
+		return nil, errors.New("token not valid yet")
+	}
+	if p.issuer != "" && claims.Issuer != p.issuer {
+		return nil, fmt.Errorf("unexpected issuer %q", claims.Issuer)
+	}
+	if p.audience != "" && !slices.Contains(claims.Audience, p.audience) {
+		return nil, fmt.Errorf("token not meant for audience %q", p.audience)
+	}
+	if claims.Subject == "" {
+		return nil, errors.New("missing subject")
+	}
+	return &claims, nil
+}
+
+// decodeJWTPart decodes a base64url JSON segment of a JWT
+func decodeJWTPart(part string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// apiKeyProvider accepts API keys sent in x-api-key metadata. Keys are
+// stored as SHA-256 hashes so they can't be recovered from memory dumps.
+type apiKeyProvider struct {
+	keys map[[32]byte]*Principal
+}
+
+// apiKeyEntry is an entry of the API keys file
+type apiKeyEntry struct {
+	Key   string   `json:"key"`
+	Name  string   `json:"name"`
+	Roles []string `json:"roles"`
+}
+
+// loadAPIKeyProvider reads a JSON array of {"key", "name", "roles"} entries
+func loadAPIKeyProvider(filePath string) (*apiKeyProvider, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	var entries []apiKeyEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+
+	p := &apiKeyProvider{keys: make(map[[32]byte]*Principal, len(entries))}
+	for i, entry := range entries {
+		if entry.Key == "" || entry.Name == "" {
+			return nil, fmt.Errorf("API key %d: key and name are required", i)
+		}
+		p.keys[sha256.Sum256([]byte(entry.Key))] = &Principal{Name: entry.Name, Roles: entry.Roles}
+	}
+	return p, nil
+}
+
+func (p *apiKeyProvider) ValidateCredentials(ctx context.Context, md metadata.MD) (*Principal, error) {
+	values := md.Get(apiKeyHeader)
+	if len(values) == 0 {
+		return nil, errNoCredentials
+	}
+	principal, ok := p.keys[sha256.Sum256([]byte(values[0]))]
+	if !ok {
+		return nil, reasonError(codes.Unauthenticated, "INVALID_API_KEY", nil)
+	}
+	return principal, nil
+}
+
+// clientCertProvider authenticates callers by the client certificate they
+// presented in an mTLS handshake. The principal is the certificate's common
+// name and its roles are its organizational units.
+type clientCertProvider struct{}
+
+func (clientCertProvider) ValidateCredentials(ctx context.Context, md metadata.MD) (*Principal, error) {
+	cert := clientCertificate(ctx)
+	if cert == nil {
+		return nil, errNoCredentials
+	}
+	if cert.Subject.CommonName == "" {
+		return nil, status.Error(codes.PermissionDenied, "client certificate has no common name")
+	}
+	return &Principal{Name: cert.Subject.CommonName, Roles: cert.Subject.OrganizationalUnit}, nil
+}
+
+// clientCertificate returns the verified certificate the caller presented in
+// an mTLS handshake, or nil
+func clientCertificate(ctx context.Context) *x509.Certificate {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return nil
+	}
+	return info.State.VerifiedChains[0][0]
+}
+
+// authenticate asks each provider in turn to validate the caller's
+// credentials. Callers without credentials are anonymous (nil principal).
+func authenticate(ctx context.Context, providers []AuthProvider) (*Principal, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, provider := range providers {
+		principal, err := provider.ValidateCredentials(ctx, md)
+		if errors.Is(err, errNoCredentials) {
+			continue
+		}
+		return principal, err
+	}
+
+	if _, ok := bearerToken(md); ok || len(md.Get(apiKeyHeader)) > 0 {
+		return nil, status.Error(codes.Unauthenticated, "unsupported credentials")
+	}
+	return nil, nil
+}
+
+// authPolicy decides which methods anonymous callers may use
+type authPolicy struct {
+	required bool            // reject anonymous callers on methods not exempt
+	exempt   map[string]bool // full names of the methods open to anyone
+}
+
+// parseAuthExemptions parses a comma-separated list of RouteGuide methods
+// anonymous callers may use when authentication is required, e.g.
+// "GetFeature,ListFeatures"
+func parseAuthExemptions(list string) (map[string]bool, error) {
+	exempt := make(map[string]bool)
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		fullMethod, ok := lookupMethod(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown RouteGuide method %q", name)
+		}
+		if isAdminMethod(fullMethod) {
+			return nil, fmt.Errorf("%s is an admin method, which always requires credentials", name)
+		}
+		exempt[fullMethod] = true
+	}
+	return exempt, nil
+}
+
+// allowsAnonymous reports whether anonymous callers may use a method. Health
+// checks, so load balancers keep working, and server reflection are always
+// open.
+func (p authPolicy) allowsAnonymous(fullMethod string) bool {
+	if !p.required || p.exempt[fullMethod] {
+		return true
+	}
+	return strings.HasPrefix(fullMethod, "/grpc.health.v1.") || strings.HasPrefix(fullMethod, "/grpc.reflection.")
+}
+
+// authorize authenticates a call and checks that the caller may make it
+// with credentials that aren't revoked
+func authorize(ctx context.Context, providers []AuthProvider, revoked *revocationList, fullMethod string, policy authPolicy) (*Principal, error) {
+	principal, err := authenticate(ctx, providers)
+	if err != nil {
+		return nil, err
+	}
+	if err := revoked.check(ctx, principal); err != nil {
+		return nil, err
+	}
+	if principal == nil && !policy.allowsAnonymous(fullMethod) {
+		return nil, reasonError(codes.Unauthenticated, "MISSING_CREDENTIALS", nil)
+	}
+	if isAdminMethod(fullMethod) && !principal.HasRole(adminRole) {
+		if principal == nil {
+			return nil, reasonError(codes.Unauthenticated, "MISSING_CREDENTIALS", nil)
+		}
+		return nil, reasonError(codes.PermissionDenied, "NOT_ADMIN", map[string]string{"principal": principal.Name})
+	}
+	return principal, nil
+}
+
+// authUnaryInterceptor authenticates unary calls and restricts the Admin
+// service to principals with the admin role
+func authUnaryInterceptor(providers []AuthProvider, revoked *revocationList, policy authPolicy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		principal, err := authorize(ctx, providers, revoked, info.FullMethod, policy)
+		if err != nil {
+			loggerFrom(ctx).Warn("Rejected call", "error", err)
+			return nil, err
+		}
+		return handler(context.WithValue(ctx, principalKey{}, principal), req)
+	}
+}
+
+// authStreamInterceptor authenticates streams and restricts the Admin
+// service to principals with the admin role
+func authStreamInterceptor(providers []AuthProvider, revoked *revocationList, policy authPolicy) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		principal, err := authorize(ss.Context(), providers, revoked, info.FullMethod, policy)
+		if err != nil {
+			loggerFrom(ss.Context()).Warn("Rejected stream", "error", err)
+			return err
+		}
+		return handler(srv, &contextStream{
+			ServerStream: ss,
+			ctx:          context.WithValue(ss.Context(), principalKey{}, principal),
+		})
+	}
+}
@@ -0,0 +1,258 @@
+package main
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// signJWT returns a JWT of claims signed with alg: HS256 with secret, or
+// RS256 with key
+func signJWT(t *testing.T, alg string, secret []byte, key *rsa.PrivateKey, claims map[string]any) string {
+	t.Helper()
+	header, _ := json.Marshal(map[string]string{"alg": alg, "typ": "JWT"})
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		t.Fatal(err)
+	}
+	signed := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	var signature []byte
+	switch alg {
+	case "HS256":
+		mac := hmac.New(sha256.New, secret)
+		mac.Write([]byte(signed))
+		signature = mac.Sum(nil)
+	case "RS256":
+		digest := sha256.Sum256([]byte(signed))
+		if signature, err = rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return signed + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+// bearer returns a context with an incoming bearer token
+func bearer(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+func TestJWTVerification(t *testing.T) {
+	secret := []byte("s3cret")
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Unix(1700000000, 0)
+	provider := newJWTProvider(secret, &key.PublicKey, "issuer", "routeguide")
+	provider.now = func() time.Time { return now }
+
+	valid := func() map[string]any {
+		return map[string]any{"sub": "alice", "iss": "issuer", "aud": "routeguide", "exp": now.Add(time.Hour).Unix(), "roles": []string{"admin"}}
+	}
+	with := func(key string, value any) map[string]any {
+		claims := valid()
+		if value == nil {
+			delete(claims, key)
+		} else {
+			claims[key] = value
+		}
+		return claims
+	}
+
+	tests := []struct {
+		name  string
+		token string
+		ok    bool
+	}{
+		{"HS256", signJWT(t, "HS256", secret, nil, valid()), true},
+		{"RS256", signJWT(t, "RS256", nil, key, valid()), true},
+		{"HS256 with another secret", signJWT(t, "HS256", []byte("other"), nil, valid()), false},
+		{"RS256 with another key", signJWT(t, "RS256", nil, otherKey, valid()), false},
+		{"unsupported algorithm", signJWT(t, "none", nil, nil, valid()), false},
+		{"expired", signJWT(t, "HS256", secret, nil, with("exp", now.Unix())), false},
+		{"no expiry", signJWT(t, "HS256", secret, nil, with("exp", nil)), false},
+		{"not valid yet", signJWT(t, "HS256", secret, nil, with("nbf", now.Add(time.Minute).Unix())), false},
+		{"another issuer", signJWT(t, "HS256", secret, nil, with("iss", "other")), false},
+		{"audience in a list", signJWT(t, "HS256", secret, nil, with("aud", []string{"other", "routeguide"})), true},
+		{"another audience", signJWT(t, "HS256", secret, nil, with("aud", "other")), false},
+		{"no audience", signJWT(t, "HS256", secret, nil, with("aud", nil)), false},
+		{"no subject", signJWT(t, "HS256", secret, nil, with("sub", nil)), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := authenticate(bearer(tt.token), []AuthProvider{provider})
+			if !tt.ok {
+				if status.Code(err) != codes.Unauthenticated {
+					t.Errorf("authenticate() = %v, %v, want UNAUTHENTICATED", principal, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("authenticate() failed: %v", err)
+			}
+			if principal.Name != "alice" || !principal.HasRole(adminRole) {
+				t.Errorf("authenticate() = %+v, want alice with the admin role", principal)
+			}
+		})
+	}
+}
+
+func TestInvalidCredentials(t *testing.T) {
+	static := newStaticTokenProvider("a.b.c", &Principal{Name: "admin", Roles: []string{adminRole}})
+	keys := &apiKeyProvider{keys: map[[32]byte]*Principal{sha256.Sum256([]byte("key")): {Name: "ci"}}}
+	providers := []AuthProvider{static, keys}
+
+	// A static token may have two dots, like a JWT
+	if principal, err := authenticate(bearer("a.b.c"), providers); err != nil || principal.Name != "admin" {
+		t.Errorf("authenticate() with the static token = %v, %v, want admin", principal, err)
+	}
+	for name, ctx := range map[string]context.Context{
+		"bearer token": bearer("wrong"),
+		"JWT":          bearer("x.y.z"),
+		"API key":      metadata.NewIncomingContext(context.Background(), metadata.Pairs(apiKeyHeader, "wrong")),
+	} {
+		if _, err := authenticate(ctx, providers); status.Code(err) != codes.Unauthenticated {
+			t.Errorf("authenticate() with a wrong %s = %v, want UNAUTHENTICATED", name, err)
+		}
+	}
+}
+
+func TestRevokedCredentials(t *testing.T) {
+	secret := []byte("s3cret")
+	provider := newJWTProvider(secret, nil, "", "")
+	token := func(sub, jti string) string {
+		return signJWT(t, "HS256", secret, nil, map[string]any{"sub": sub, "jti": jti, "exp": time.Now().Add(time.Hour).Unix()})
+	}
+	revokedToken := token("carol", "")
+
+	path := filepath.Join(t.TempDir(), "revoked.txt")
+	entries := "# revoked\njti:stolen\nprincipal:bob\n" + credentialHash(revokedToken) + "\n"
+	if err := os.WriteFile(path, []byte(entries), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	revoked, err := newRevocationList(path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		revoked bool
+	}{
+		{"valid", token("alice", "fine"), false},
+		{"revoked jti", token("alice", "stolen"), true},
+		{"revoked principal", token("bob", "fine"), true},
+		{"revoked token hash", revokedToken, true},
+	}
+	const method = "/routeguide.RouteGuide/GetFeature"
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := authorize(bearer(tt.token), []AuthProvider{provider}, revoked, method, authPolicy{})
+			if !tt.revoked {
+				if err != nil {
+					t.Errorf("authorize() failed: %v", err)
+				}
+				return
+			}
+			if status.Code(err) != codes.Unauthenticated {
+				t.Errorf("authorize() = %v, want UNAUTHENTICATED", err)
+			}
+		})
+	}
+}
+
+func TestJWTAlgorithmMustMatchKey(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})
+	claims := map[string]any{"sub": "alice", "exp": time.Now().Add(time.Hour).Unix()}
+
+	tests := []struct {
+		name     string
+		provider *jwtProvider
+		token    string
+	}{
+		// The classic confusion attack: the RSA public key used as an HMAC secret
+		{"HS256 signed with the RSA public key", newJWTProvider(nil, &key.PublicKey, "", ""), signJWT(t, "HS256", publicPEM, nil, claims)},
+		{"HS256 without a secret", newJWTProvider(nil, &key.PublicKey, "", ""), signJWT(t, "HS256", nil, nil, claims)},
+		{"RS256 without an RSA key", newJWTProvider([]byte("s3cret"), nil, "", ""), signJWT(t, "RS256", nil, key, claims)},
+		{"alg none", newJWTProvider([]byte("s3cret"), &key.PublicKey, "", ""), signJWT(t, "none", nil, nil, claims)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if principal, err := authenticate(bearer(tt.token), []AuthProvider{tt.provider}); status.Code(err) != codes.Unauthenticated {
+				t.Errorf("authenticate() = %v, %v, want UNAUTHENTICATED", principal, err)
+			}
+		})
+	}
+}
+
+func TestAdminMethodGating(t *testing.T) {
+	providers := []AuthProvider{
+		newStaticTokenProvider("admin-token", &Principal{Name: "root", Roles: []string{adminRole}}),
+		&apiKeyProvider{keys: map[[32]byte]*Principal{sha256.Sum256([]byte("user-key")): {Name: "alice"}}},
+	}
+	anonymous := context.Background()
+	user := metadata.NewIncomingContext(context.Background(), metadata.Pairs(apiKeyHeader, "user-key"))
+	const (
+		adminMethod  = "/routeguide.Admin/ListPendingFeatures"
+		createMethod = "/routeguide.RouteGuide/CreateFeature"
+		readMethod   = "/routeguide.RouteGuide/GetFeature"
+	)
+
+	tests := []struct {
+		name   string
+		ctx    context.Context
+		method string
+		policy authPolicy
+		want   codes.Code
+	}{
+		{"admin calls the Admin service", bearer("admin-token"), adminMethod, authPolicy{}, codes.OK},
+		{"admin creates a feature", bearer("admin-token"), createMethod, authPolicy{}, codes.OK},
+		{"user calls the Admin service", user, adminMethod, authPolicy{}, codes.PermissionDenied},
+		{"user creates a feature", user, createMethod, authPolicy{}, codes.PermissionDenied},
+		{"user reads a feature", user, readMethod, authPolicy{}, codes.OK},
+		{"anonymous calls the Admin service", anonymous, adminMethod, authPolicy{}, codes.Unauthenticated},
+		{"anonymous reads a feature", anonymous, readMethod, authPolicy{}, codes.OK},
+		{"anonymous reads with credentials required", anonymous, readMethod, authPolicy{required: true}, codes.Unauthenticated},
+		{"anonymous reads an exempt method", anonymous, readMethod, authPolicy{required: true, exempt: map[string]bool{readMethod: true}}, codes.OK},
+		{"anonymous checks health", anonymous, "/grpc.health.v1.Health/Check", authPolicy{required: true}, codes.OK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := authorize(tt.ctx, providers, nil, tt.method, tt.policy); status.Code(err) != tt.want {
+				t.Errorf("authorize() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	if _, err := parseAuthExemptions("GetFeature,DeleteFeature"); err == nil {
+		t.Error("parseAuthExemptions() exempted an admin method")
+	}
+}
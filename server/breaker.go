@@ -0,0 +1,175 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// breakerMetrics publishes the state of every circuit breaker on /debug/vars
+var breakerMetrics = expvar.NewMap("breakers")
+
+// breakerState is the state of a circuit breaker
+type breakerState int
+
+const (
+	// breakerClosed lets calls through, counting consecutive failures
+	breakerClosed breakerState = iota
+	// breakerOpen fails calls immediately until the cooldown has passed
+	breakerOpen
+	// breakerHalfOpen lets a single probe call through to decide whether to close
+	breakerHalfOpen
+)
+
+func (st breakerState) String() string {
+	switch st {
+	case breakerClosed:
+		return "closed"
+	case breakerOpen:
+		return "open"
+	default:
+		return "half-open"
+	}
+}
+
+// errBreakerOpen is returned by circuitBreaker.allow while calls are rejected
+var errBreakerOpen = errors.New("circuit breaker open")
+
+// circuitBreaker stops calling an integration that keeps failing, so callers
+// fail fast instead of piling up on it. After threshold consecutive failures
+// it opens for cooldown, then lets a single probe through: the breaker closes
+// if the probe succeeds and reopens otherwise.
+type circuitBreaker struct {
+	name      string
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	state    breakerState
+	failures int       // consecutive failures while closed
+	openedAt time.Time // when the breaker last opened
+	probing  bool      // a half-open probe is in flight
+	opened   int64     // times the breaker opened
+}
+
+// breakerConfig holds the settings shared by the server's circuit breakers
+type breakerConfig struct {
+	threshold int           // consecutive failures that open a breaker, 0 to disable
+	cooldown  time.Duration // how long a breaker stays open before probing
+}
+
+// new creates a circuit breaker for the named integration
+func (c breakerConfig) new(name string) *circuitBreaker {
+	return newCircuitBreaker(name, c.threshold, c.cooldown)
+}
+
+// newCircuitBreaker creates a closed breaker and publishes its state. A
+// threshold of 0 disables it.
+func newCircuitBreaker(name string, threshold int, cooldown time.Duration) *circuitBreaker {
+	b := &circuitBreaker{name: name, threshold: threshold, cooldown: cooldown}
+	breakerMetrics.Set(name, expvar.Func(b.metrics))
+	return b
+}
+
+// allow reports whether a call may proceed, returning errBreakerOpen if not.
+// Every allowed call must be followed by done.
+func (b *circuitBreaker) allow() error {
+	if b.threshold <= 0 {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == breakerOpen && time.Since(b.openedAt) >= b.cooldown {
+		b.state = breakerHalfOpen
+		slog.Info("Circuit breaker half-open, probing", "breaker", b.name)
+	}
+	switch {
+	case b.state == breakerOpen, b.state == breakerHalfOpen && b.probing:
+		return errBreakerOpen
+	case b.state == breakerHalfOpen:
+		b.probing = true
+	}
+	return nil
+}
+
+// done records the outcome of an allowed call. Cancelled calls say nothing
+// about the integration's health and are ignored.
+func (b *circuitBreaker) done(err error) {
+	if b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	wasProbe := b.probing && b.state == breakerHalfOpen
+	if wasProbe {
+		b.probing = false
+	}
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+
+	switch {
+	case err == nil:
+		if b.state != breakerClosed {
+			slog.Info("Circuit breaker closed", "breaker", b.name)
+		}
+		b.state = breakerClosed
+		b.failures = 0
+	case wasProbe:
+		b.trip()
+	case b.state == breakerClosed:
+		b.failures++
+		if b.failures >= b.threshold {
+			b.trip()
+		}
+	}
+}
+
+// trip opens the breaker. b.mu must be held.
+func (b *circuitBreaker) trip() {
+	b.state = breakerOpen
+	b.openedAt = time.Now()
+	b.failures = 0
+	b.opened++
+	slog.Warn("Circuit breaker open", "breaker", b.name, "cooldown", b.cooldown)
+}
+
+// unavailable builds the UNAVAILABLE status returned while the breaker is
+// open, carrying an ErrorInfo naming the integration and a RetryInfo with
+// the remaining cooldown
+func (b *circuitBreaker) unavailable() error {
+	b.mu.Lock()
+	state := b.state
+	retry := b.cooldown - time.Since(b.openedAt)
+	b.mu.Unlock()
+	if retry < 0 {
+		retry = 0
+	}
+
+	st := reasonStatus(codes.Unavailable, "CIRCUIT_OPEN", map[string]string{"integration": b.name, "state": state.String()})
+	detailed, err := st.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(retry)})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// metrics reports the breaker's state, as an expvar.Func
+func (b *circuitBreaker) metrics() any {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return map[string]any{
+		"state":                b.state.String(),
+		"consecutive_failures": b.failures,
+		"opened_total":         b.opened,
+	}
+}
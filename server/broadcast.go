@@ -0,0 +1,79 @@
+package main
+
+import (
+	"sync"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+)
+
+// chatListenerBuffer is how many broadcast notes a RouteChat stream may fall
+// behind on before further ones are dropped for it
+const chatListenerBuffer = 64
+
+// chatListener is a RouteChat stream receiving the notes other streams post
+// at the locations it posted at
+type chatListener struct {
+	notes     chan *pb.RouteNote
+	locations map[string]bool // guarded by the broadcaster's mu
+}
+
+// chatBroadcaster pushes new route notes to the other RouteChat streams at
+// the same location, when the broadcast_chat feature flag is on
+type chatBroadcaster struct {
+	mu        sync.Mutex
+	listeners map[string]map[*chatListener]struct{} // by location key
+}
+
+// newChatBroadcaster creates a broadcaster without listeners
+func newChatBroadcaster() *chatBroadcaster {
+	return &chatBroadcaster{listeners: make(map[string]map[*chatListener]struct{})}
+}
+
+// newChatListener creates the listener of a stream, which must leave the
+// broadcaster when it ends
+func newChatListener() *chatListener {
+	return &chatListener{notes: make(chan *pb.RouteNote, chatListenerBuffer), locations: make(map[string]bool)}
+}
+
+// join subscribes a stream to the notes posted at a location
+func (b *chatBroadcaster) join(l *chatListener, key string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if l.locations[key] {
+		return
+	}
+	l.locations[key] = true
+	if b.listeners[key] == nil {
+		b.listeners[key] = make(map[*chatListener]struct{})
+	}
+	b.listeners[key][l] = struct{}{}
+}
+
+// leave unsubscribes a stream from every location
+func (b *chatBroadcaster) leave(l *chatListener) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for key := range l.locations {
+		delete(b.listeners[key], l)
+		if len(b.listeners[key]) == 0 {
+			delete(b.listeners, key)
+		}
+	}
+}
+
+// publish sends a note posted at a location to the streams listening there,
+// except its sender, without blocking: streams too far behind miss it, and
+// get it with the location's replay on their next post
+func (b *chatBroadcaster) publish(from *chatListener, key string, note *pb.RouteNote) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for l := range b.listeners[key] {
+		if l == from {
+			continue
+		}
+		select {
+		case l.notes <- note:
+		default:
+		}
+	}
+}
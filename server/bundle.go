@@ -0,0 +1,219 @@
+package main
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxBundleTileZoom is the deepest zoom level a bundle may include
+	maxBundleTileZoom = 14
+	// maxBundleTiles caps the number of tiles in a bundle
+	maxBundleTiles = 4096
+	// defaultBundleChunkSize is used when a bundle request has no chunk size
+	defaultBundleChunkSize = 64 << 10
+	// maxBundleChunkSize caps the chunk size of a bundle request
+	maxBundleChunkSize = 1 << 20
+)
+
+// regionBundle is an encoded offline bundle
+type regionBundle struct {
+	data         []byte
+	etag         string
+	featureCount int
+	tileCount    int
+}
+
+// DownloadRegionBundle streams an offline bundle of a region (server streaming RPC)
+func (s *routeGuideServer) DownloadRegionBundle(req *pb.BundleRequest, stream pb.RouteGuide_DownloadRegionBundleServer) error {
+	logger := loggerFrom(stream.Context())
+	logger.Info("DownloadRegionBundle called",
+		"include_tiles", req.IncludeTiles, "max_tile_zoom", req.MaxTileZoom, "offset", req.Offset, "estimate_only", req.EstimateOnly)
+
+	if err := geo.ValidateRectangle(req.Region); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid region: %v", err)
+	}
+	if req.IncludeTiles && (req.MaxTileZoom < 0 || req.MaxTileZoom > maxBundleTileZoom) {
+		return status.Errorf(codes.InvalidArgument, "max_tile_zoom must be between 0 and %d", maxBundleTileZoom)
+	}
+	if req.Offset < 0 || (req.Offset > 0 && req.Etag == "") {
+		return status.Error(codes.InvalidArgument, "resuming requires a non-negative offset and the bundle's etag")
+	}
+
+	chunkSize := int64(req.ChunkSize)
+	switch {
+	case chunkSize <= 0:
+		chunkSize = defaultBundleChunkSize
+	case chunkSize > maxBundleChunkSize:
+		chunkSize = maxBundleChunkSize
+	}
+
+	d, err := s.datasetFor(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	maxZoom := -1
+	if req.IncludeTiles {
+		maxZoom = int(req.MaxTileZoom)
+	}
+	bundle, err := s.buildBundle(stream.Context(), d, req.Region, maxZoom)
+	if err != nil {
+		return err
+	}
+
+	total := int64(len(bundle.data))
+	if req.Etag != "" && req.Etag != bundle.etag {
+		return status.Error(codes.FailedPrecondition, "the bundle changed since the download started; restart it from offset 0")
+	}
+	if req.Offset > total {
+		return status.Errorf(codes.OutOfRange, "offset %d is past the end of the %d byte bundle", req.Offset, total)
+	}
+
+	chunk := func(offset int64, data []byte) *pb.BundleChunk {
+		return &pb.BundleChunk{
+			Offset:       offset,
+			Data:         data,
+			TotalSize:    total,
+			Etag:         bundle.etag,
+			FeatureCount: int32(bundle.featureCount),
+			TileCount:    int32(bundle.tileCount),
+		}
+	}
+
+	if req.EstimateOnly {
+		return stream.Send(chunk(0, nil))
+	}
+
+	for offset := req.Offset; offset < total; offset += chunkSize {
+		end := offset + chunkSize
+		if end > total {
+			end = total
+		}
+		if err := stream.Send(chunk(offset, bundle.data[offset:end])); err != nil {
+			return err
+		}
+	}
+
+	logger.Info("DownloadRegionBundle completed",
+		"sent_bytes", total-req.Offset, "total_bytes", total, "features", bundle.featureCount, "tiles", bundle.tileCount)
+	return nil
+}
+
+// buildBundle encodes the features in region, and their tiles up to maxZoom
+// (none if negative), as a gzip-compressed tar archive. The archive only
+// depends on its content, so rebuilding it for a resumed download yields the
+// same bytes.
+func (s *routeGuideServer) buildBundle(ctx context.Context, d *dataset, region *pb.Rectangle, maxZoom int) (*regionBundle, error) {
+	var keys []tileKey
+	for z := 0; z <= maxZoom; z++ {
+		x0, y0, x1, y1 := regionTiles(region, z)
+		if len(keys)+(x1-x0+1)*(y1-y0+1) > maxBundleTiles {
+			return nil, status.Errorf(codes.InvalidArgument, "the region needs more than %d tiles; lower max_tile_zoom", maxBundleTiles)
+		}
+		for x := x0; x <= x1; x++ {
+			for y := y0; y <= y1; y++ {
+				keys = append(keys, tileKey{z: z, x: x % (1 << z), y: y, version: d.version})
+			}
+		}
+	}
+
+	// Features keep their localized names and validity windows so the client
+	// can apply them offline
+	store := s.featuresOf(d)
+	features, err := store.FeaturesIn(ctx, region)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]featureJSON, len(features))
+	for i, feature := range features {
+		records[i] = featureJSON{
+			Location: feature.Location,
+			Name:     feature.Name,
+			Names:    feature.names,
+			Windows:  feature.windows,
+		}
+	}
+	featuresData, err := json.Marshal(records)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "encoding features: %v", err)
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	modTime := d.loadedAt.Truncate(time.Second)
+
+	add := func(name string, data []byte) error {
+		hdr := &tar.Header{Name: name, Mode: 0o644, Size: int64(len(data)), ModTime: modTime, Format: tar.FormatPAX}
+		if err := tw.WriteHeader(hdr); err != nil {
+			return err
+		}
+		_, err := tw.Write(data)
+		return err
+	}
+
+	if err := add("features.json", featuresData); err != nil {
+		return nil, status.Errorf(codes.Internal, "writing bundle: %v", err)
+	}
+	at := time.Now()
+	for _, key := range keys {
+		entry, _, err := s.tile(ctx, store, key, at)
+		if err != nil {
+			return nil, err
+		}
+		if err := add(fmt.Sprintf("tiles/%d/%d/%d.mvt", key.z, key.x, key.y), entry.data); err != nil {
+			return nil, status.Errorf(codes.Internal, "writing bundle: %v", err)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return nil, status.Errorf(codes.Internal, "writing bundle: %v", err)
+	}
+	if err := gz.Close(); err != nil {
+		return nil, status.Errorf(codes.Internal, "writing bundle: %v", err)
+	}
+
+	sum := sha256.Sum256(buf.Bytes())
+	return &regionBundle{
+		data:         buf.Bytes(),
+		etag:         hex.EncodeToString(sum[:16]),
+		featureCount: len(features),
+		tileCount:    len(keys),
+	}, nil
+}
+
+// regionTiles returns the range of tiles covering a region at zoom z. For a
+// region crossing the antimeridian x1 runs past the last column; take x
+// modulo 2^z.
+func regionTiles(region *pb.Rectangle, z int) (x0, y0, x1, y1 int) {
+	n := 1 << z
+	tileOf := func(lat, lon int32) (int, int) {
+		fx, fy := mercatorTile(geo.Degrees(lat), geo.Degrees(lon), float64(n))
+		x := int(math.Min(math.Floor(fx), float64(n-1)))
+		y := int(math.Min(math.Floor(fy), float64(n-1)))
+		return x, y
+	}
+
+	// Tile rows grow southwards, so the northern edge has the smallest y
+	bounds := geo.Canonical(region)
+	x0, y0 = tileOf(bounds.North, bounds.West)
+	x1, y1 = tileOf(bounds.South, bounds.East)
+	if bounds.Wraps() {
+		x1 = min(x1+n, x0+n-1)
+	}
+	return x0, y0, x1, y1
+}
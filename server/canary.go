@@ -0,0 +1,234 @@
+package main
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// canaryMetrics counts canary matches and divergences per method on /debug/vars
+var canaryMetrics = expvar.NewMap("canary")
+
+// canaryHandler is an alternative implementation of a RouteGuide method. It
+// runs against the dataset the primary handler used and returns every message
+// the method would send.
+type canaryHandler func(ctx context.Context, d *dataset, req any) ([]proto.Message, error)
+
+// datasetPinKey is the context key of the datasetPin of a shadowed call
+type datasetPinKey struct{}
+
+// datasetPin records the dataset a primary handler picked so its canary can
+// reuse it, whatever the A/B split decided
+type datasetPin struct {
+	d *dataset
+}
+
+// canaryHandlers returns the alternative implementations that can be
+// shadowed, keyed by method name. Each one is the straightforward full scan
+// the indexed query path replaced, so shadowing them verifies the index.
+func (s *routeGuideServer) canaryHandlers() map[string]canaryHandler {
+	return map[string]canaryHandler{
+		"GetFeature":   s.scanGetFeature,
+		"ListFeatures": s.scanListFeatures,
+	}
+}
+
+// scanGetFeature is GetFeature without the spatial index
+func (s *routeGuideServer) scanGetFeature(ctx context.Context, d *dataset, req any) ([]proto.Message, error) {
+	point := req.(*pb.Point)
+	at, err := queryTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, feature := range d.features {
+		if feature.Location.Latitude == point.Latitude &&
+			feature.Location.Longitude == point.Longitude &&
+			feature.activeAt(at) {
+			return []proto.Message{feature.localized(s.requestLocales(ctx))}, nil
+		}
+	}
+	return []proto.Message{&pb.Feature{Location: point}}, nil
+}
+
+// scanListFeatures is ListFeatures without the spatial index
+func (s *routeGuideServer) scanListFeatures(ctx context.Context, d *dataset, req any) ([]proto.Message, error) {
+	rect := req.(*pb.Rectangle)
+	filter, err := newNameFilter(rect.NameFilter)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid name_filter: %v", err)
+	}
+	at, err := queryTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	order, err := requestOrder(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bounds := geo.Canonical(rect)
+	var matches []*featureRecord
+	for _, feature := range d.features {
+		if bounds.Contains(feature.Location) && feature.activeAt(at) {
+			matches = append(matches, feature)
+		}
+	}
+	if order == orderByPopularity {
+		s.popularity.sortByPopularity(matches)
+	}
+
+	locales := s.requestLocales(ctx)
+	var sent []proto.Message
+	for _, feature := range matches {
+		if localized := feature.localized(locales); filter.matches(localized.Name) {
+			sent = append(sent, localized)
+		}
+	}
+	return sent, nil
+}
+
+// canary shadows selected methods with their canary handlers
+type canary struct {
+	handlers      map[string]canaryHandler // keyed by full method name
+	samplePercent float64                  // share of calls that are shadowed
+}
+
+// newCanary shadows the named RouteGuide methods (comma-separated)
+func (s *routeGuideServer) newCanary(methods string, samplePercent float64) (*canary, error) {
+	available := s.canaryHandlers()
+	c := &canary{
+		handlers:      make(map[string]canaryHandler),
+		samplePercent: samplePercent,
+	}
+	for _, name := range strings.Split(methods, ",") {
+		name = strings.TrimSpace(name)
+		handler, ok := available[name]
+		if !ok {
+			return nil, fmt.Errorf("no canary implementation for method %q", name)
+		}
+		c.handlers["/"+pb.RouteGuide_ServiceDesc.ServiceName+"/"+name] = handler
+	}
+	return c, nil
+}
+
+// shadowed returns the canary handler to run for a call, or nil
+func (c *canary) shadowed(fullMethod string) canaryHandler {
+	handler := c.handlers[fullMethod]
+	if handler == nil || rand.Float64()*100 >= c.samplePercent {
+		return nil
+	}
+	return handler
+}
+
+// compare runs the canary in the background and logs whether it agrees with
+// what the primary handler sent. The primary's response is never affected.
+func (c *canary) compare(ctx context.Context, fullMethod string, handler canaryHandler, pin *datasetPin, req any, primary []proto.Message, primaryErr error) {
+	if pin.d == nil || req == nil {
+		// The primary failed before picking a dataset; there is nothing to compare
+		return
+	}
+
+	// The call is over by the time the canary runs, so detach it from cancellation
+	ctx = context.WithoutCancel(ctx)
+	go func() {
+		shadow, shadowErr := handler(ctx, pin.d, req)
+		if diff := diffResults(primary, primaryErr, shadow, shadowErr); diff != "" {
+			canaryMetrics.Add(fullMethod+"_divergences", 1)
+			loggerFrom(ctx).Warn("Canary divergence", "diff", diff)
+			return
+		}
+		canaryMetrics.Add(fullMethod+"_matches", 1)
+	}()
+}
+
+// diffResults describes the first difference between two results, or returns "" if they match
+func diffResults(primary []proto.Message, primaryErr error, shadow []proto.Message, shadowErr error) string {
+	if status.Code(primaryErr) != status.Code(shadowErr) {
+		return fmt.Sprintf("primary returned %v, canary returned %v", status.Code(primaryErr), status.Code(shadowErr))
+	}
+	if primaryErr != nil {
+		return ""
+	}
+	if len(primary) != len(shadow) {
+		return fmt.Sprintf("primary sent %d messages, canary %d", len(primary), len(shadow))
+	}
+	for i := range primary {
+		if !proto.Equal(primary[i], shadow[i]) {
+			return fmt.Sprintf("message %d differs: primary {%v}, canary {%v}", i, primary[i], shadow[i])
+		}
+	}
+	return ""
+}
+
+// unaryInterceptor shadows unary calls
+func (c *canary) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	shadow := c.shadowed(info.FullMethod)
+	if shadow == nil {
+		return handler(ctx, req)
+	}
+
+	pin := &datasetPin{}
+	resp, err := handler(context.WithValue(ctx, datasetPinKey{}, pin), req)
+
+	var sent []proto.Message
+	if msg, ok := resp.(proto.Message); ok && err == nil {
+		sent = append(sent, msg)
+	}
+	c.compare(ctx, info.FullMethod, shadow, pin, req, sent, err)
+	return resp, err
+}
+
+// streamInterceptor shadows server-streaming calls
+func (c *canary) streamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	shadow := c.shadowed(info.FullMethod)
+	if shadow == nil {
+		return handler(srv, ss)
+	}
+
+	pin := &datasetPin{}
+	recorder := &recordingStream{
+		ServerStream: ss,
+		ctx:          context.WithValue(ss.Context(), datasetPinKey{}, pin),
+	}
+	err := handler(srv, recorder)
+	c.compare(ss.Context(), info.FullMethod, shadow, pin, recorder.req, recorder.sent, err)
+	return err
+}
+
+// recordingStream remembers the request and the messages sent on a stream
+type recordingStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	req  any
+	sent []proto.Message
+}
+
+func (r *recordingStream) Context() context.Context {
+	return r.ctx
+}
+
+func (r *recordingStream) RecvMsg(m any) error {
+	err := r.ServerStream.RecvMsg(m)
+	if err == nil && r.req == nil {
+		r.req = m
+	}
+	return err
+}
+
+func (r *recordingStream) SendMsg(m any) error {
+	if msg, ok := m.(proto.Message); ok {
+		r.sent = append(r.sent, msg)
+	}
+	return r.ServerStream.SendMsg(m)
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"slices"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/metadata"
+)
+
+// clientFeaturesKey is the metadata key clients list their capabilities in;
+// the server echoes the negotiated subset back in a header of the same name
+const clientFeaturesKey = "client-features"
+
+const (
+	// capResume: the client resumes WatchFeatures with resume tokens
+	capResume = "supports-resume"
+	// capCompression: responses may be gzip-compressed
+	capCompression = "supports-compression"
+	// capHeartbeats: the client expects WatchFeatures heartbeats
+	capHeartbeats = "supports-heartbeats"
+)
+
+// serverCapabilities lists the capabilities this server can negotiate
+var serverCapabilities = []string{capResume, capCompression, capHeartbeats}
+
+// capabilitySet is the set of capabilities negotiated for a call
+type capabilitySet map[string]bool
+
+// capabilitiesKey is the context key of a call's capabilitySet
+type capabilitiesKey struct{}
+
+// clientCapabilities returns the capabilities negotiated for a call
+func clientCapabilities(ctx context.Context) capabilitySet {
+	caps, _ := ctx.Value(capabilitiesKey{}).(capabilitySet)
+	return caps
+}
+
+// negotiate intersects the capabilities a client sent with the server's.
+// Compression is only offered if the method may compress its responses.
+func negotiate(ctx context.Context, compressible bool) capabilitySet {
+	caps := make(capabilitySet)
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, value := range md.Get(clientFeaturesKey) {
+		for _, name := range strings.Split(value, ",") {
+			name = strings.ToLower(strings.TrimSpace(name))
+			if slices.Contains(serverCapabilities, name) {
+				caps[name] = true
+			}
+		}
+	}
+
+	// Compression also needs the client to accept gzip
+	if caps[capCompression] && !compressible {
+		delete(caps, capCompression)
+	}
+	if caps[capCompression] {
+		supported, _ := grpc.ClientSupportedCompressors(ctx)
+		if !slices.Contains(supported, gzip.Name) {
+			delete(caps, capCompression)
+		}
+	}
+	return caps
+}
+
+// header lists the negotiated capabilities, in a stable order, as metadata
+func (caps capabilitySet) header() metadata.MD {
+	var names []string
+	for _, name := range serverCapabilities {
+		if caps[name] {
+			names = append(names, name)
+		}
+	}
+	return metadata.Pairs(clientFeaturesKey, strings.Join(names, ","))
+}
+
+// apply echoes the negotiated capabilities and enables compression if agreed
+func (caps capabilitySet) apply(ctx context.Context) {
+	if len(caps) == 0 {
+		return
+	}
+	grpc.SetHeader(ctx, caps.header())
+	if caps[capCompression] {
+		if err := grpc.SetSendCompressor(ctx, gzip.Name); err != nil {
+			loggerFrom(ctx).Warn("Failed to enable response compression", "error", err)
+		}
+	}
+}
+
+// capabilitiesUnaryInterceptor negotiates capabilities for unary calls,
+// compressing the responses of the methods compression allows
+func capabilitiesUnaryInterceptor(compression *compressionPolicy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		caps := negotiate(ctx, compression.allows(info.FullMethod))
+		caps.apply(ctx)
+		return handler(context.WithValue(ctx, capabilitiesKey{}, caps), req)
+	}
+}
+
+// capabilitiesStreamInterceptor negotiates capabilities for streams,
+// compressing the responses of the methods compression allows
+func capabilitiesStreamInterceptor(compression *compressionPolicy) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		caps := negotiate(ss.Context(), compression.allows(info.FullMethod))
+		caps.apply(ss.Context())
+		return handler(srv, &contextStream{
+			ServerStream: ss,
+			ctx:          context.WithValue(ss.Context(), capabilitiesKey{}, caps),
+		})
+	}
+}
+
+// contextStream is a server stream with a context derived by an interceptor
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (c *contextStream) Context() context.Context {
+	return c.ctx
+}
+
+// encodeResumeToken turns the last dataset version a watcher saw into an opaque token
+func encodeResumeToken(version int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte("v" + strconv.FormatInt(version, 10)))
+}
+
+// decodeResumeToken is the inverse of encodeResumeToken
+func decodeResumeToken(token string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+	digits, ok := strings.CutPrefix(string(raw), "v")
+	if !ok {
+		return 0, strconv.ErrSyntax
+	}
+	return strconv.ParseInt(digits, 10, 64)
+}
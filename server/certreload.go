@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// certReloader serves the certificate of a cert/key file pair to TLS
+// handshakes, along with the CAs trusted to issue client certificates, if
+// any, reloading them when the files change so certificates can be rotated
+// and new client CAs trusted without a restart. Established connections
+// keep the certificates they were set up with.
+type certReloader struct {
+	certFile, keyFile string
+	caFile            string // empty unless client certificates are required
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	modTime   time.Time // latest modification time of the files when last loaded
+}
+
+// newCertReloader loads the certificate in certFile and keyFile, and the
+// client CAs in caFile unless it is empty
+func newCertReloader(certFile, keyFile, caFile string) (*certReloader, error) {
+	r := &certReloader{certFile: certFile, keyFile: keyFile, caFile: caFile}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// reload loads the certificate and client CAs from the files, keeping the
+// current ones if any file can't be loaded
+func (r *certReloader) reload() error {
+	modTime, err := r.filesModTime()
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return err
+	}
+	var clientCAs *x509.CertPool
+	if r.caFile != "" {
+		if clientCAs, err = loadCertPool(r.caFile); err != nil {
+			return err
+		}
+	}
+
+	r.mu.Lock()
+	r.cert = &cert
+	r.clientCAs = clientCAs
+	r.modTime = modTime
+	r.mu.Unlock()
+	return nil
+}
+
+// loadCertPool reads the PEM certificates in a file
+func loadCertPool(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificates in %s", path)
+	}
+	return pool, nil
+}
+
+// filesModTime returns the latest modification time of the files
+func (r *certReloader) filesModTime() (time.Time, error) {
+	var latest time.Time
+	for _, path := range []string{r.certFile, r.keyFile, r.caFile} {
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+// getCertificate is the tls.Config.GetCertificate callback
+func (r *certReloader) getCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+// configForClient is the tls.Config.GetConfigForClient callback used when
+// client certificates are required, verifying them against the client CAs
+// currently trusted
+func (r *certReloader) configForClient(*tls.ClientHelloInfo) (*tls.Config, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return &tls.Config{
+		GetCertificate: r.getCertificate,
+		ClientCAs:      r.clientCAs,
+		ClientAuth:     tls.RequireAndVerifyClientCert,
+	}, nil
+}
+
+// watch reloads the certificate and client CAs whenever the files' modification time
+// changes, checking every interval, and on SIGHUP, until ctx is cancelled. A
+// failed reload, e.g. of a half-written pair, keeps the current certificate
+// and is retried at the next check.
+func (r *certReloader) watch(ctx context.Context, interval time.Duration) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	var tick <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-tick:
+			modTime, err := r.filesModTime()
+			r.mu.RLock()
+			changed := err == nil && !modTime.Equal(r.modTime)
+			r.mu.RUnlock()
+			if !changed {
+				continue
+			}
+		case <-hup:
+			slog.Info("Received SIGHUP, reloading TLS certificates")
+		case <-ctx.Done():
+			return
+		}
+
+		if err := r.reload(); err != nil {
+			slog.Error("Failed to reload TLS certificates, keeping the current ones", "error", err)
+			continue
+		}
+		if r.caFile != "" {
+			slog.Info("Reloaded TLS certificate and client CAs", "cert", r.certFile, "client_ca", r.caFile)
+		} else {
+			slog.Info("Reloaded TLS certificate", "cert", r.certFile)
+		}
+	}
+}
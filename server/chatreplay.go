@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// chatReplay paces the notes RouteChat replays to a stream, so that a
+// location with thousands of notes doesn't flood a new participant
+type chatReplay struct {
+	max   int           // notes replayed at once, 0 for unlimited; the rest wait for a history_token
+	batch int           // notes sent between pauses, 0 for no pauses
+	pause time.Duration // wait between batches
+}
+
+// replay sends the notes stored at a location from position from on, in
+// batches, up to r.max of them. When it leaves notes out, the last note sent
+// carries a history_token continuing from the first one left out.
+func (r chatReplay) replay(ctx context.Context, notes []*pb.RouteNote, from int, send func(*pb.RouteNote) error) (int, error) {
+	more := r.max > 0 && len(notes) > r.max
+	if more {
+		notes = notes[:r.max]
+	}
+	for i, note := range notes {
+		if i > 0 && r.batch > 0 && r.pause > 0 && i%r.batch == 0 {
+			select {
+			case <-time.After(r.pause):
+			case <-ctx.Done():
+				return i, status.FromContextError(ctx.Err()).Err()
+			}
+		}
+		if more && i == len(notes)-1 {
+			// Stored notes are shared with other streams
+			note = proto.Clone(note).(*pb.RouteNote)
+			note.HistoryToken = encodePageToken(from + len(notes))
+		}
+		if err := send(note); err != nil {
+			return i, err
+		}
+	}
+	return len(notes), nil
+}
+
+// loadMoreNotes continues a capped replay from the history_token of a note
+// received on a RouteChat stream
+func (s *routeGuideServer) loadMoreNotes(ctx context.Context, key, token string, send func(*pb.RouteNote) error) (int, error) {
+	offset, err := decodePageToken(token)
+	if err != nil {
+		return 0, status.Error(codes.InvalidArgument, "invalid history token")
+	}
+	notes, next, err := s.notes.Since(ctx, key, offset)
+	if err != nil {
+		return 0, notesError(err)
+	}
+	return s.chatReplay.replay(ctx, notes, next-len(notes), send)
+}
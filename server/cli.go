@@ -0,0 +1,184 @@
+package main
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// runCommand runs a command-line subcommand against a running server instead
+// of starting one
+func runCommand(args []string) error {
+	switch args[0] {
+	case "replay-route":
+		return runReplayRoute(args[1:])
+	case "mint-jwt":
+		return runMintJWT(args[1:])
+	case "report":
+		return runReport(args[1:])
+	default:
+		return fmt.Errorf("unknown command (available: replay-route, mint-jwt, report)")
+	}
+}
+
+// runReplayRoute replays a route file through a server's Admin ReplayRoute
+// RPC and prints the summary as JSON
+func runReplayRoute(args []string) error {
+	fs := flag.NewFlagSet("replay-route", flag.ExitOnError)
+	addr := fs.String("addr", clientAddress(), "Address of the server, host:port or unix:///path/to/socket")
+	token := fs.String("token", *adminToken, "Bearer token (static admin token or JWT) to authenticate with")
+	apiKey := fs.String("api-key", "", "API key to authenticate with")
+	tlsCA := fs.String("tls-ca", "", "PEM CA certificate verifying the server's TLS certificate (plaintext when empty)")
+	clientCert := fs.String("tls-client-cert", "", "PEM client certificate to present to servers requiring mutual TLS")
+	clientKey := fs.String("tls-client-key", "", "PEM private key of -tls-client-cert")
+	profile := fs.String("profile", "", "Travel profile of the route (walking, cycling or driving)")
+	strict := fs.Bool("strict", false, "Reject the route if it has anomalies")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: server [flags] replay-route [options] FILE.gpx|FILE.json")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	data, err := os.ReadFile(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	req := &pb.ReplayRouteRequest{Data: data, Strict: *strict}
+	switch {
+	case strings.HasSuffix(strings.ToLower(fs.Arg(0)), ".gpx"):
+		req.Format = pb.RouteFileFormat_ROUTE_FILE_FORMAT_GPX
+	case strings.HasSuffix(strings.ToLower(fs.Arg(0)), ".json"):
+		req.Format = pb.RouteFileFormat_ROUTE_FILE_FORMAT_JSON
+	}
+	if *profile != "" {
+		v, ok := pb.TravelProfile_value["TRAVEL_PROFILE_"+strings.ToUpper(*profile)]
+		if !ok {
+			return fmt.Errorf("unknown travel profile %q", *profile)
+		}
+		req.Profile = pb.TravelProfile(v)
+	}
+
+	creds := insecure.NewCredentials()
+	if *tlsCA != "" {
+		pool := x509.NewCertPool()
+		pem, err := os.ReadFile(*tlsCA)
+		if err != nil {
+			return err
+		}
+		if !pool.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no certificates in %s", *tlsCA)
+		}
+		config := &tls.Config{RootCAs: pool}
+		if *clientCert != "" {
+			cert, err := tls.LoadX509KeyPair(*clientCert, *clientKey)
+			if err != nil {
+				return err
+			}
+			config.Certificates = []tls.Certificate{cert}
+		}
+		creds = credentials.NewTLS(config)
+	}
+	conn, err := grpc.NewClient(*addr, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if *token != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+*token)
+	}
+	if *apiKey != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, apiKeyHeader, *apiKey)
+	}
+
+	summary, err := pb.NewAdminClient(conn).ReplayRoute(ctx, req)
+	if err != nil {
+		return err
+	}
+	out, err := protojson.MarshalOptions{Multiline: true}.Marshal(summary)
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+// runMintJWT prints an HS256 JWT the server accepts, for trying out
+// authenticated calls from clients
+func runMintJWT(args []string) error {
+	fs := flag.NewFlagSet("mint-jwt", flag.ExitOnError)
+	secret := fs.String("secret", *jwtSecret, "HMAC secret to sign the token with, as given to --jwt-secret")
+	subject := fs.String("sub", "", "Subject of the token, the caller's principal name")
+	roles := fs.String("roles", "", "Comma-separated roles of the caller, e.g. admin")
+	issuer := fs.String("issuer", *jwtIssuer, "Issuer of the token (none when empty)")
+	audience := fs.String("audience", *jwtAudience, "Audience of the token (none when empty)")
+	ttl := fs.Duration("ttl", time.Hour, "How long the token is valid")
+	id := fs.String("jti", randomIDs{}.NewID(), "ID of the token, revoked with a jti: entry (none when empty)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: server [flags] mint-jwt -sub NAME [options]")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if *subject == "" || fs.NArg() != 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	if *secret == "" {
+		return fmt.Errorf("-secret is required")
+	}
+
+	claims := map[string]any{"sub": *subject, "exp": time.Now().Add(*ttl).Unix()}
+	if *issuer != "" {
+		claims["iss"] = *issuer
+	}
+	if *audience != "" {
+		claims["aud"] = *audience
+	}
+	if *id != "" {
+		claims["jti"] = *id
+	}
+	if *roles != "" {
+		claims["roles"] = strings.Split(*roles, ",")
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return err
+	}
+	signed := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`)) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, []byte(*secret))
+	mac.Write([]byte(signed))
+	fmt.Println(signed + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil)))
+	return nil
+}
+
+// clientAddress returns the address of a server started with the same
+// --listen or --port flags
+func clientAddress() string {
+	addr := listenAddress()
+	if strings.HasPrefix(addr, ":") {
+		addr = "localhost" + addr
+	}
+	return addr
+}
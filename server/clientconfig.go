@@ -0,0 +1,116 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// defaultClientConfigRefresh is how long clients may use their config before
+// fetching it again, unless the config file says otherwise
+const defaultClientConfigRefresh = 15 * time.Minute
+
+// clientConfigSource serves the config recommended to clients: defaults
+// derived from the server's own settings, overridden by an optional JSON
+// file. The file is read again when it changes, so clients can be retuned
+// without restarting the server.
+type clientConfigSource struct {
+	defaults *pb.ClientConfig
+	path     string // empty for defaults only
+
+	mu      sync.Mutex
+	modTime time.Time
+	config  *pb.ClientConfig // defaults merged with the file's settings
+}
+
+// newClientConfigSource creates a source overriding defaults with the
+// settings in the file at path, failing if it can't be loaded
+func newClientConfigSource(defaults *pb.ClientConfig, path string) (*clientConfigSource, error) {
+	c := &clientConfigSource{defaults: defaults, path: path, config: defaults}
+	if path == "" {
+		return c, nil
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.load(info.ModTime()); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// defaultClientConfig returns the settings recommended when the config file
+// doesn't say otherwise
+func (s *routeGuideServer) defaultClientConfig() *pb.ClientConfig {
+	return &pb.ClientConfig{
+		HeartbeatInterval: durationpb.New(s.heartbeatInterval),
+		PageSize:          defaultFeaturePageSize,
+		Retry: &pb.RetryPolicy{
+			MaxAttempts:       4,
+			InitialBackoff:    durationpb.New(s.streamLimits.retryDelay),
+			MaxBackoff:        durationpb.New(30 * time.Second),
+			BackoffMultiplier: 2,
+			RetryableCodes:    []string{"UNAVAILABLE", "RESOURCE_EXHAUSTED"},
+		},
+		RefreshInterval: durationpb.New(defaultClientConfigRefresh),
+	}
+}
+
+// load reads the config file, written with ClientConfig's JSON field names,
+// e.g. {"pageSize": 50, "featureFlags": {"offlineMaps": true}}. c.mu must be
+// held, or c not yet shared.
+func (c *clientConfigSource) load(modTime time.Time) error {
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return err
+	}
+	overrides := &pb.ClientConfig{}
+	if err := protojson.Unmarshal(data, overrides); err != nil {
+		return fmt.Errorf("invalid client config %s: %v", c.path, err)
+	}
+	config := proto.Clone(c.defaults).(*pb.ClientConfig)
+	if overrides.Retry != nil && len(overrides.Retry.RetryableCodes) > 0 {
+		// Replace the default codes rather than adding to them
+		config.Retry.RetryableCodes = nil
+	}
+	proto.Merge(config, overrides)
+	c.config = config
+	c.modTime = modTime
+	return nil
+}
+
+// current returns the config, reloading the file first if it changed. A file
+// that no longer loads is logged and the last good config kept.
+func (c *clientConfigSource) current() *pb.ClientConfig {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.path != "" {
+		info, err := os.Stat(c.path)
+		switch {
+		case err != nil:
+			slog.Error("Keeping the current client config", "error", err)
+		case !info.ModTime().Equal(c.modTime):
+			if err := c.load(info.ModTime()); err != nil {
+				slog.Error("Keeping the current client config", "error", err)
+			} else {
+				slog.Info("Reloaded client config", "file", c.path)
+			}
+		}
+	}
+	return c.config
+}
+
+// GetClientConfig returns the settings recommended to clients (unary RPC)
+func (s *routeGuideServer) GetClientConfig(ctx context.Context, req *pb.GetClientConfigRequest) (*pb.ClientConfig, error) {
+	loggerFrom(ctx).Info("GetClientConfig called", "client_version", req.ClientVersion)
+	return s.clientConfig.current(), nil
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// collationKey returns the form of name that is compared under a collation:
+// names match under c when their keys are equal
+func collationKey(name string, c pb.NameCollation) string {
+	switch c {
+	case pb.NameCollation_NAME_COLLATION_CASE_INSENSITIVE:
+		return cases.Fold().String(name)
+	case pb.NameCollation_NAME_COLLATION_NORMALIZED:
+		// Decompose so diacritics become separate marks, drop the marks, then
+		// fold case and compatibility characters (such as ligatures)
+		t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFKC)
+		stripped, _, err := transform.String(t, name)
+		if err != nil {
+			stripped = name
+		}
+		return cases.Fold().String(stripped)
+	default:
+		return name
+	}
+}
+
+// nameMatcher matches feature names against a pattern under a collation
+type nameMatcher struct {
+	pattern   string // collation key of the pattern
+	collation pb.NameCollation
+}
+
+// newNameMatcher creates a matcher for pattern under collation c
+func newNameMatcher(pattern string, c pb.NameCollation) *nameMatcher {
+	return &nameMatcher{pattern: collationKey(pattern, c), collation: c}
+}
+
+// contains reports whether name contains the pattern
+func (m *nameMatcher) contains(name string) bool {
+	return strings.Contains(collationKey(name, m.collation), m.pattern)
+}
+
+// hasPrefix reports whether name starts with the pattern
+func (m *nameMatcher) hasPrefix(name string) bool {
+	return strings.HasPrefix(collationKey(name, m.collation), m.pattern)
+}
+
+// maxNameFilterLength caps the texts of a name filter, in bytes
+const maxNameFilterLength = 256
+
+// nameFilter selects features by name, as a NameFilter asks
+type nameFilter struct {
+	namedOnly bool
+	contains  *nameMatcher // nil to accept any name
+	prefix    *nameMatcher // likewise
+}
+
+// newNameFilter checks a NameFilter, returning nil for a missing one
+func newNameFilter(f *pb.NameFilter) (*nameFilter, error) {
+	if f == nil {
+		return nil, nil
+	}
+	if _, ok := pb.NameCollation_name[int32(f.Collation)]; !ok {
+		return nil, fmt.Errorf("unknown collation %d", f.Collation)
+	}
+	if len(f.Contains) > maxNameFilterLength || len(f.Prefix) > maxNameFilterLength {
+		return nil, fmt.Errorf("contains and prefix are at most %d bytes", maxNameFilterLength)
+	}
+	filter := &nameFilter{namedOnly: f.NamedOnly}
+	if f.Contains != "" {
+		filter.contains = newNameMatcher(f.Contains, f.Collation)
+	}
+	if f.Prefix != "" {
+		filter.prefix = newNameMatcher(f.Prefix, f.Collation)
+	}
+	return filter, nil
+}
+
+// matches reports whether a feature named name passes the filter. A nil
+// filter passes every feature.
+func (f *nameFilter) matches(name string) bool {
+	switch {
+	case f == nil:
+		return true
+	case f.namedOnly && name == "":
+		return false
+	case f.contains != nil && !f.contains.contains(name):
+		return false
+	default:
+		return f.prefix == nil || f.prefix.hasPrefix(name)
+	}
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"math"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultCompareTolerance is used when a CompareRoutes request has no tolerance
+const defaultCompareTolerance = 50
+
+// CompareRoutes compares two routes (unary RPC)
+func (s *routeGuideServer) CompareRoutes(ctx context.Context, req *pb.CompareRoutesRequest) (*pb.RouteComparison, error) {
+	logger := loggerFrom(ctx)
+	logger.Info("CompareRoutes called")
+
+	first, err := s.resolveRoute(req.First, "first")
+	if err != nil {
+		return nil, err
+	}
+	second, err := s.resolveRoute(req.Second, "second")
+	if err != nil {
+		return nil, err
+	}
+
+	tolerance := float64(req.Tolerance)
+	if tolerance <= 0 {
+		tolerance = defaultCompareTolerance
+	}
+
+	firstMatched := matchRoute(first, second, tolerance)
+	secondMatched := matchRoute(second, first, tolerance)
+
+	matched := 0
+	for _, m := range append(firstMatched, secondMatched...) {
+		if m {
+			matched++
+		}
+	}
+
+	// A divergence is where the first route stops following the second
+	var divergences []*pb.Point
+	for i := 1; i < len(first); i++ {
+		if firstMatched[i-1] && !firstMatched[i] {
+			divergences = append(divergences, first[i-1])
+		}
+	}
+
+	firstDistance, secondDistance := routeDistance(first), routeDistance(second)
+	comparison := &pb.RouteComparison{
+		Overlap:          100 * float64(matched) / float64(len(first)+len(second)),
+		DivergencePoints: divergences,
+		DistanceDelta:    secondDistance - firstDistance,
+		FirstDistance:    firstDistance,
+		SecondDistance:   secondDistance,
+	}
+
+	logger.Info("CompareRoutes completed",
+		"overlap_percent", comparison.Overlap, "divergences", len(divergences), "distance_delta_meters", comparison.DistanceDelta)
+	return comparison, nil
+}
+
+// resolveRoute returns the points of a route reference
+func (s *routeGuideServer) resolveRoute(ref *pb.RouteRef, which string) ([]*pb.Point, error) {
+	var points []*pb.Point
+	switch r := ref.GetRoute().(type) {
+	case *pb.RouteRef_Id:
+		route := s.routes.get(r.Id)
+		if route == nil {
+			return nil, status.Errorf(codes.NotFound, "%s route %q not found", which, r.Id)
+		}
+		points = route.points
+	case *pb.RouteRef_Points:
+		points = r.Points.GetPoints()
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "%s route is required", which)
+	}
+
+	if len(points) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s route has no points", which)
+	}
+	return points, nil
+}
+
+// matchRoute reports, for every point of route, whether it lies within
+// tolerance metres of the polyline formed by other
+func matchRoute(route, other []*pb.Point, tolerance float64) []bool {
+	matched := make([]bool, len(route))
+	for i, p := range route {
+		matched[i] = distanceToPolyline(p, other) <= tolerance
+	}
+	return matched
+}
+
+// distanceToPolyline returns the distance in metres from p to the closest point of line
+func distanceToPolyline(p *pb.Point, line []*pb.Point) float64 {
+	if len(line) == 1 {
+		return float64(geo.Distance(p, line[0]))
+	}
+
+	closest := math.Inf(1)
+	for i := 1; i < len(line); i++ {
+		// Work in a plane centred on p so p is the origin
+		ax, ay := toLocal(p, line[i-1])
+		bx, by := toLocal(p, line[i])
+		dx, dy := bx-ax, by-ay
+
+		t := 0.0
+		if l2 := dx*dx + dy*dy; l2 > 0 {
+			t = math.Max(0, math.Min(1, -(ax*dx+ay*dy)/l2))
+		}
+		closest = math.Min(closest, math.Hypot(ax+t*dx, ay+t*dy))
+	}
+	return closest
+}
+
+// routeDistance returns the length of a route in metres
+func routeDistance(points []*pb.Point) int32 {
+	var distance int32
+	for i := 1; i < len(points); i++ {
+		distance += geo.Distance(points[i-1], points[i])
+	}
+	return distance
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"strings"
+	"sync"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/grpc/stats"
+)
+
+// compressionMetrics publishes per-method compression ratios on /debug/vars
+var compressionMetrics = expvar.NewMap("compression")
+
+// compressionPolicy decides which methods compress their responses for
+// clients that negotiated supports-compression. Compression saves mobile
+// bandwidth on bulky responses but costs CPU on chatty streams.
+type compressionPolicy struct {
+	all     bool
+	methods map[string]bool // full method names
+}
+
+// parseCompressionPolicy parses "*" or a comma-separated list of RouteGuide
+// method names. An empty list disables compression.
+func parseCompressionPolicy(list string) (*compressionPolicy, error) {
+	p := &compressionPolicy{methods: make(map[string]bool)}
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		switch {
+		case name == "":
+		case name == "*":
+			p.all = true
+		case !isRouteGuideMethod(name):
+			return nil, fmt.Errorf("unknown RouteGuide method %q", name)
+		default:
+			p.methods["/"+pb.RouteGuide_ServiceDesc.ServiceName+"/"+name] = true
+		}
+	}
+	return p, nil
+}
+
+// isRouteGuideMethod reports whether name is a RouteGuide method
+func isRouteGuideMethod(name string) bool {
+	for _, m := range pb.RouteGuide_ServiceDesc.Methods {
+		if m.MethodName == name {
+			return true
+		}
+	}
+	for _, s := range pb.RouteGuide_ServiceDesc.Streams {
+		if s.StreamName == name {
+			return true
+		}
+	}
+	return false
+}
+
+// allows reports whether responses of a method may be compressed
+func (p *compressionPolicy) allows(fullMethod string) bool {
+	return p.all || p.methods[fullMethod]
+}
+
+// compressionRatio accumulates the payload sizes of a method's compressed responses
+type compressionRatio struct {
+	mu                       sync.Mutex
+	uncompressed, compressed int64
+}
+
+// String reports the ratio of compressed to uncompressed bytes, as expvar.Var
+func (r *compressionRatio) String() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.uncompressed == 0 {
+		return "0"
+	}
+	return fmt.Sprintf("%.4f", float64(r.compressed)/float64(r.uncompressed))
+}
+
+// compressionStats is a stats.Handler recording how well each method's
+// responses compress
+type compressionStats struct{}
+
+// compressionTag follows an RPC through the stats handler
+type compressionTag struct {
+	method     string
+	compressed bool // set once the response headers announce a compressor
+}
+
+// compressionTagKey is the context key of an RPC's compressionTag
+type compressionTagKey struct{}
+
+func (compressionStats) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
+	return context.WithValue(ctx, compressionTagKey{}, &compressionTag{method: info.FullMethodName})
+}
+
+func (compressionStats) HandleRPC(ctx context.Context, s stats.RPCStats) {
+	tag, ok := ctx.Value(compressionTagKey{}).(*compressionTag)
+	if !ok {
+		return
+	}
+
+	switch s := s.(type) {
+	case *stats.OutHeader:
+		tag.compressed = s.Compression != "" && s.Compression != "identity"
+	case *stats.OutPayload:
+		if !tag.compressed {
+			return
+		}
+		compressionMetrics.Add(tag.method+"_bytes", int64(s.Length))
+		compressionMetrics.Add(tag.method+"_compressed_bytes", int64(s.CompressedLength))
+
+		ratio, _ := compressionMetrics.Get(tag.method + "_ratio").(*compressionRatio)
+		if ratio == nil {
+			ratio = &compressionRatio{}
+			compressionMetrics.Set(tag.method+"_ratio", ratio)
+		}
+		ratio.mu.Lock()
+		ratio.uncompressed += int64(s.Length)
+		ratio.compressed += int64(s.CompressedLength)
+		ratio.mu.Unlock()
+	}
+}
+
+func (compressionStats) TagConn(ctx context.Context, _ *stats.ConnTagInfo) context.Context {
+	return ctx
+}
+
+func (compressionStats) HandleConn(context.Context, stats.ConnStats) {}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// loadConfigFile sets the flags not given on the command line from a YAML
+// file. Keys are flag names, and may be grouped into sections joined to their
+// keys with "-", so that
+//
+//	log:
+//	  level: debug
+//
+// sets --log-level. Flags taking comma-separated lists accept sequences, and
+// those taking Method=value lists accept mappings.
+func loadConfigFile(fs *flag.FlagSet, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	if len(doc.Content) == 0 {
+		return nil // empty file
+	}
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return errors.New("the file must hold a mapping of settings")
+	}
+
+	onCommandLine := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) { onCommandLine[f.Name] = true })
+	return applyConfig(fs, root, "", onCommandLine)
+}
+
+// applyConfig sets the flags named in a mapping of settings, whose keys are
+// prefixed with the section it belongs to, if any
+func applyConfig(fs *flag.FlagSet, node *yaml.Node, section string, onCommandLine map[string]bool) error {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		name := key.Value
+		if section != "" {
+			name = section + "-" + name
+		}
+		if value.Kind == yaml.MappingNode && (fs.Lookup(name) == nil || isConfigSection(fs, name, value)) {
+			if err := applyConfig(fs, value, name, onCommandLine); err != nil {
+				return err
+			}
+			continue
+		}
+
+		switch {
+		case fs.Lookup(name) == nil:
+			return fmt.Errorf("line %d: unknown setting %q", key.Line, name)
+		case name == "config":
+			return fmt.Errorf("line %d: config files can't include other config files", key.Line)
+		case onCommandLine[name]:
+			continue
+		}
+		text, err := configValue(value)
+		if err != nil {
+			return fmt.Errorf("line %d: %s: %v", value.Line, name, err)
+		}
+		if err := fs.Set(name, text); err != nil {
+			return fmt.Errorf("line %d: invalid %s: %v", value.Line, name, err)
+		}
+	}
+	return nil
+}
+
+// isConfigSection reports whether a mapping under a flag's name is a section,
+// because one of its keys completes another flag's name, rather than a
+// Method=value list
+func isConfigSection(fs *flag.FlagSet, section string, node *yaml.Node) bool {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if fs.Lookup(section+"-"+node.Content[i].Value) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// configValue returns the flag value of a setting: scalars as they are,
+// sequences as comma-separated lists and mappings as comma-separated
+// key=value lists
+func configValue(node *yaml.Node) (string, error) {
+	switch node.Kind {
+	case yaml.AliasNode:
+		return configValue(node.Alias)
+	case yaml.ScalarNode:
+		if node.Tag == "!!null" {
+			return "", nil
+		}
+		return node.Value, nil
+	case yaml.SequenceNode:
+		items := make([]string, 0, len(node.Content))
+		for _, item := range node.Content {
+			if item.Kind != yaml.ScalarNode {
+				return "", errors.New("list items must be plain values")
+			}
+			items = append(items, item.Value)
+		}
+		return strings.Join(items, ","), nil
+	case yaml.MappingNode:
+		entries := make([]string, 0, len(node.Content)/2)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i+1].Kind != yaml.ScalarNode {
+				return "", errors.New("mapping values must be plain values")
+			}
+			entries = append(entries, node.Content[i].Value+"="+node.Content[i+1].Value)
+		}
+		return strings.Join(entries, ","), nil
+	}
+	return "", errors.New("unsupported value")
+}
@@ -0,0 +1,276 @@
+package main
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/stats"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// connTracker follows the open client connections and the streams open on
+// them, for the Admin ListConnections and TerminateStream RPCs. As a
+// stats.Handler it sees connections open and close and messages go by; as a
+// stream interceptor it sees who opened each stream.
+type connTracker struct {
+	mu      sync.Mutex
+	nextID  uint64
+	conns   map[uint64]*trackedConn
+	streams map[uint64]*trackedStream // by stream ID
+}
+
+// trackedConn is an open client connection
+type trackedConn struct {
+	id          uint64
+	peer        string
+	connectedAt time.Time
+	calls       atomic.Int64
+	streams     map[uint64]*trackedStream // guarded by the tracker's mu
+}
+
+// rpcMessages counts the messages of a call
+type rpcMessages struct {
+	received atomic.Int64
+	sent     atomic.Int64
+}
+
+// trackedStream is an open stream
+type trackedStream struct {
+	id        uint64
+	method    string
+	principal string
+	requestID string
+	startedAt time.Time
+	messages  *rpcMessages
+	terminate context.CancelCauseFunc // cancels the stream with its status
+}
+
+// Context keys of a connection's trackedConn and a call's rpcMessages
+type (
+	trackedConnKey struct{}
+	rpcMessagesKey struct{}
+)
+
+// newConnTracker creates a tracker without connections
+func newConnTracker() *connTracker {
+	return &connTracker{
+		conns:   make(map[uint64]*trackedConn),
+		streams: make(map[uint64]*trackedStream),
+	}
+}
+
+func (t *connTracker) TagConn(ctx context.Context, info *stats.ConnTagInfo) context.Context {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.nextID++
+	c := &trackedConn{
+		id:          t.nextID,
+		peer:        info.RemoteAddr.String(),
+		connectedAt: time.Now(),
+		streams:     make(map[uint64]*trackedStream),
+	}
+	t.conns[c.id] = c
+	return context.WithValue(ctx, trackedConnKey{}, c)
+}
+
+func (t *connTracker) HandleConn(ctx context.Context, s stats.ConnStats) {
+	c, ok := ctx.Value(trackedConnKey{}).(*trackedConn)
+	if _, end := s.(*stats.ConnEnd); ok && end {
+		t.mu.Lock()
+		delete(t.conns, c.id)
+		t.mu.Unlock()
+	}
+}
+
+func (t *connTracker) TagRPC(ctx context.Context, _ *stats.RPCTagInfo) context.Context {
+	return context.WithValue(ctx, rpcMessagesKey{}, &rpcMessages{})
+}
+
+func (t *connTracker) HandleRPC(ctx context.Context, s stats.RPCStats) {
+	switch s.(type) {
+	case *stats.Begin:
+		if c, ok := ctx.Value(trackedConnKey{}).(*trackedConn); ok {
+			c.calls.Add(1)
+		}
+	case *stats.InPayload:
+		if m, ok := ctx.Value(rpcMessagesKey{}).(*rpcMessages); ok {
+			m.received.Add(1)
+		}
+	case *stats.OutPayload:
+		if m, ok := ctx.Value(rpcMessagesKey{}).(*rpcMessages); ok {
+			m.sent.Add(1)
+		}
+	}
+}
+
+// streamInterceptor tracks streams while they are open and lets TerminateStream
+// end them. It must run after authentication, to know who opened them.
+func (t *connTracker) streamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	c, ok := ss.Context().Value(trackedConnKey{}).(*trackedConn)
+	messages, _ := ss.Context().Value(rpcMessagesKey{}).(*rpcMessages)
+	if !ok || messages == nil {
+		return handler(srv, ss)
+	}
+
+	ctx, cancel := context.WithCancelCause(ss.Context())
+	defer cancel(nil)
+	s := &trackedStream{
+		id:        streamID(ctx),
+		method:    info.FullMethod,
+		requestID: requestID(ctx),
+		startedAt: time.Now(),
+		messages:  messages,
+		terminate: cancel,
+	}
+	if p := principalFromContext(ctx); p != nil {
+		s.principal = p.Name
+	}
+	if s.id == 0 {
+		s.id = streamIDs.Add(1)
+	}
+
+	t.mu.Lock()
+	t.streams[s.id] = s
+	c.streams[s.id] = s
+	t.mu.Unlock()
+	defer func() {
+		t.mu.Lock()
+		delete(t.streams, s.id)
+		delete(c.streams, s.id)
+		t.mu.Unlock()
+	}()
+
+	err := handler(srv, &terminableStream{contextStream{ServerStream: ss, ctx: ctx}})
+	if terminated := terminationError(ctx); terminated != nil {
+		// Handlers end streams quietly when their context is done
+		return terminated
+	}
+	return err
+}
+
+// list describes the open connections and their streams, oldest first
+func (t *connTracker) list(now time.Time) []*pb.Connection {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	conns := make([]*pb.Connection, 0, len(t.conns))
+	for _, id := range slices.Sorted(maps.Keys(t.conns)) {
+		c := t.conns[id]
+		conn := &pb.Connection{
+			Id:          c.id,
+			Peer:        c.peer,
+			ConnectedAt: timestamppb.New(c.connectedAt),
+			Age:         durationpb.New(now.Sub(c.connectedAt)),
+			Calls:       c.calls.Load(),
+		}
+		for _, streamID := range slices.Sorted(maps.Keys(c.streams)) {
+			conn.Streams = append(conn.Streams, c.streams[streamID].info(now))
+		}
+		conns = append(conns, conn)
+	}
+	return conns
+}
+
+// terminate ends an open stream with ABORTED, returning its description, or
+// nil if no open stream has the ID
+func (t *connTracker) terminate(id uint64, reason string) *pb.StreamInfo {
+	t.mu.Lock()
+	s := t.streams[id]
+	t.mu.Unlock()
+	if s == nil {
+		return nil
+	}
+	message := "stream terminated by an administrator"
+	if reason != "" {
+		message += ": " + reason
+	}
+	s.terminate(status.Error(codes.Aborted, message))
+	return s.info(time.Now())
+}
+
+// info describes the stream
+func (s *trackedStream) info(now time.Time) *pb.StreamInfo {
+	return &pb.StreamInfo{
+		Id:               s.id,
+		Method:           s.method,
+		Principal:        s.principal,
+		RequestId:        s.requestID,
+		StartedAt:        timestamppb.New(s.startedAt),
+		Age:              durationpb.New(now.Sub(s.startedAt)),
+		MessagesReceived: s.messages.received.Load(),
+		MessagesSent:     s.messages.sent.Load(),
+	}
+}
+
+// terminationError returns the status a terminated stream ends with, or nil
+// if ctx, the stream's context, wasn't canceled by TerminateStream
+func terminationError(ctx context.Context) error {
+	if ctx.Err() == nil {
+		return nil
+	}
+	cause := context.Cause(ctx)
+	if _, ok := status.FromError(cause); !ok {
+		return nil
+	}
+	return cause
+}
+
+// terminableStream is a stream whose sends and receives fail once it is
+// terminated, including receives already waiting for a message
+type terminableStream struct {
+	contextStream
+}
+
+func (s *terminableStream) SendMsg(m any) error {
+	if err := terminationError(s.ctx); err != nil {
+		return err
+	}
+	return s.ServerStream.SendMsg(m)
+}
+
+func (s *terminableStream) RecvMsg(m any) error {
+	if err := terminationError(s.ctx); err != nil {
+		return err
+	}
+	// Receive in the background so termination can interrupt the wait. An
+	// abandoned receive returns once the handler has returned, which ends
+	// the stream.
+	done := make(chan error, 1)
+	go func() { done <- s.ServerStream.RecvMsg(m) }()
+	select {
+	case err := <-done:
+		return err
+	case <-s.ctx.Done():
+		if err := terminationError(s.ctx); err != nil {
+			return err
+		}
+		return status.FromContextError(s.ctx.Err()).Err()
+	}
+}
+
+// ListConnections lists the open connections and their streams (unary RPC)
+func (a *adminServer) ListConnections(ctx context.Context, req *pb.ListConnectionsRequest) (*pb.ListConnectionsResponse, error) {
+	return &pb.ListConnectionsResponse{Connections: a.server.connections.list(time.Now())}, nil
+}
+
+// TerminateStream ends an open stream with ABORTED (unary RPC)
+func (a *adminServer) TerminateStream(ctx context.Context, req *pb.TerminateStreamRequest) (*pb.StreamInfo, error) {
+	stream := a.server.connections.terminate(req.StreamId, req.Reason)
+	if stream == nil {
+		return nil, status.Errorf(codes.NotFound, "no open stream has ID %d", req.StreamId)
+	}
+	by := "an anonymous caller"
+	if p := principalFromContext(ctx); p != nil {
+		by = p.Name
+	}
+	loggerFrom(ctx).Info("Stream terminated", "terminated_stream_id", stream.Id, "terminated_method", stream.Method, "by", by, "reason", req.Reason)
+	return stream, nil
+}
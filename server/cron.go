@@ -0,0 +1,175 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// schedule computes when a recurring job runs next
+type schedule interface {
+	next(after time.Time) time.Time
+}
+
+// everySchedule runs at a fixed interval
+type everySchedule struct {
+	interval time.Duration
+}
+
+func (e everySchedule) next(after time.Time) time.Time {
+	return after.Add(e.interval)
+}
+
+// cronSchedule is a standard five-field cron expression
+// (minute hour day-of-month month day-of-week), evaluated in local time
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64 // bit sets of allowed values
+	domAny, dowAny                bool   // whether the day fields were "*"
+}
+
+// parseSchedule parses "@every <duration>", one of the @hourly/@daily/@weekly
+// shorthands, or a five-field cron expression such as "*/15 * * * *"
+func parseSchedule(spec string) (schedule, error) {
+	spec = strings.TrimSpace(spec)
+
+	if d, ok := strings.CutPrefix(spec, "@every "); ok {
+		interval, err := time.ParseDuration(strings.TrimSpace(d))
+		if err != nil {
+			return nil, fmt.Errorf("invalid schedule %q: %v", spec, err)
+		}
+		if interval <= 0 {
+			return nil, fmt.Errorf("invalid schedule %q: interval must be positive", spec)
+		}
+		return everySchedule{interval: interval}, nil
+	}
+
+	switch spec {
+	case "@hourly":
+		spec = "0 * * * *"
+	case "@daily", "@midnight":
+		spec = "0 0 * * *"
+	case "@weekly":
+		spec = "0 0 * * 0"
+	}
+
+	fields := strings.Fields(spec)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("invalid schedule %q: expected 5 fields, got %d", spec, len(fields))
+	}
+
+	var c cronSchedule
+	var err error
+	parsers := []struct {
+		field    string
+		min, max int
+		dst      *uint64
+	}{
+		{fields[0], 0, 59, &c.minute},
+		{fields[1], 0, 23, &c.hour},
+		{fields[2], 1, 31, &c.dom},
+		{fields[3], 1, 12, &c.month},
+		{fields[4], 0, 7, &c.dow},
+	}
+	for _, p := range parsers {
+		if *p.dst, err = parseCronField(p.field, p.min, p.max); err != nil {
+			return nil, fmt.Errorf("invalid schedule %q: %v", spec, err)
+		}
+	}
+
+	// Sunday may be written as 0 or 7
+	if c.dow&(1<<7) != 0 {
+		c.dow |= 1
+	}
+	c.domAny = fields[2] == "*"
+	c.dowAny = fields[4] == "*"
+
+	return c, nil
+}
+
+// parseCronField parses a comma-separated list of values, ranges (a-b) and
+// steps (*/n, a-b/n) into a bit set
+func parseCronField(field string, min, max int) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		rng, stepStr, hasStep := strings.Cut(part, "/")
+		step := 1
+		if hasStep {
+			var err error
+			if step, err = strconv.Atoi(stepStr); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step %q", stepStr)
+			}
+		}
+
+		lo, hi := min, max
+		if rng != "*" {
+			loStr, hiStr, isRange := strings.Cut(rng, "-")
+			var err error
+			if lo, err = strconv.Atoi(loStr); err != nil {
+				return 0, fmt.Errorf("invalid value %q", loStr)
+			}
+			hi = lo
+			if isRange {
+				if hi, err = strconv.Atoi(hiStr); err != nil {
+					return 0, fmt.Errorf("invalid value %q", hiStr)
+				}
+			} else if hasStep {
+				hi = max
+			}
+		}
+		if lo < min || hi > max || lo > hi {
+			return 0, fmt.Errorf("value %q out of range %d-%d", part, min, max)
+		}
+
+		for v := lo; v <= hi; v += step {
+			bits |= 1 << v
+		}
+	}
+	return bits, nil
+}
+
+// next returns the first minute strictly after after matching the expression.
+// As in standard cron, when both day fields are restricted a day matches if
+// either of them does.
+func (c cronSchedule) next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+
+	// Every schedule matches at least once within five years (Feb 29 included)
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if !c.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, t.Location())
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, t.Location())
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+// dayMatches applies cron's day-of-month / day-of-week rules
+func (c cronSchedule) dayMatches(t time.Time) bool {
+	domOK := c.dom&(1<<uint(t.Day())) != 0
+	dowOK := c.dow&(1<<uint(t.Weekday())) != 0
+	switch {
+	case c.domAny && c.dowAny:
+		return true
+	case c.domAny:
+		return dowOK
+	case c.dowAny:
+		return domOK
+	default:
+		return domOK || dowOK
+	}
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// coordinateSystemKey is the metadata key selecting the coordinate system of
+// a call's points
+const coordinateSystemKey = "coordinate-system"
+
+// coordinateSystem converts between E7 points and the x and y a call uses
+type coordinateSystem struct {
+	toXY   func(point *pb.Point) (x, y float64)
+	fromXY func(x, y float64) (*pb.Point, error)
+}
+
+// coordinateSystems holds the coordinate systems calls may ask for besides
+// "e7", the default, which needs no conversion
+var coordinateSystems = map[string]*coordinateSystem{
+	"degrees": {
+		toXY: func(point *pb.Point) (float64, float64) {
+			return geo.Degrees(point.Longitude), geo.Degrees(point.Latitude)
+		},
+		fromXY: geo.FromDegrees,
+	},
+	"web-mercator": {toXY: geo.WebMercator, fromXY: geo.FromWebMercator},
+}
+
+// requestCoordinateSystem returns the coordinate system requested in the call
+// metadata, or nil for E7
+func requestCoordinateSystem(ctx context.Context) (*coordinateSystem, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(coordinateSystemKey)
+	if len(values) == 0 {
+		return nil, nil
+	}
+	name := strings.ToLower(strings.TrimSpace(values[0]))
+	if name == "e7" {
+		return nil, nil
+	}
+	if cs, ok := coordinateSystems[name]; ok {
+		return cs, nil
+	}
+	return nil, status.Errorf(codes.InvalidArgument, "unknown %s %q: expected e7, degrees or web-mercator", coordinateSystemKey, values[0])
+}
+
+// fromCall converts the points of a request to E7 in place, clearing their x
+// and y so the handlers only ever see E7
+func (cs *coordinateSystem) fromCall(m any) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return nil
+	}
+	return walkPoints(msg.ProtoReflect(), func(point *pb.Point) error {
+		converted, err := cs.fromXY(point.X, point.Y)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid point: %v", err)
+		}
+		point.Latitude, point.Longitude = converted.Latitude, converted.Longitude
+		point.X, point.Y = 0, 0
+		return nil
+	})
+}
+
+// forCall returns a copy of a response with x and y set on its points.
+// Responses often share messages with the dataset, so the original is never
+// modified.
+func (cs *coordinateSystem) forCall(m any) any {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return m
+	}
+	msg = proto.Clone(msg)
+	walkPoints(msg.ProtoReflect(), func(point *pb.Point) error {
+		point.X, point.Y = cs.toXY(point)
+		return nil
+	})
+	return msg
+}
+
+// walkPoints calls f on every point in m, stopping at the first error
+func walkPoints(m protoreflect.Message, f func(point *pb.Point) error) error {
+	if point, ok := m.Interface().(*pb.Point); ok {
+		return f(point)
+	}
+	var err error
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.Message() == nil:
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					err = walkPoints(mv.Message(), f)
+					return err == nil
+				})
+			}
+		case fd.IsList():
+			list := v.List()
+			for i := 0; i < list.Len() && err == nil; i++ {
+				err = walkPoints(list.Get(i).Message(), f)
+			}
+		default:
+			err = walkPoints(v.Message(), f)
+		}
+		return err == nil
+	})
+	return err
+}
+
+// crsUnaryInterceptor converts the points of unary calls that ask for
+// another coordinate system
+func crsUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	cs, err := requestCoordinateSystem(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if cs == nil {
+		return handler(ctx, req)
+	}
+	if err := cs.fromCall(req); err != nil {
+		return nil, err
+	}
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+	return cs.forCall(resp), nil
+}
+
+// crsStreamInterceptor converts the points of every message of streams that
+// ask for another coordinate system
+func crsStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	cs, err := requestCoordinateSystem(ss.Context())
+	if err != nil {
+		return err
+	}
+	if cs == nil {
+		return handler(srv, ss)
+	}
+	return handler(srv, &crsStream{ServerStream: ss, cs: cs})
+}
+
+// crsStream converts the points of the messages received and sent on the
+// stream it wraps
+type crsStream struct {
+	grpc.ServerStream
+	cs *coordinateSystem
+}
+
+func (c *crsStream) RecvMsg(m any) error {
+	if err := c.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return c.cs.fromCall(m)
+}
+
+func (c *crsStream) SendMsg(m any) error {
+	return c.ServerStream.SendMsg(c.cs.forCall(m))
+}
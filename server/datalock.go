@@ -0,0 +1,61 @@
+//go:build unix
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// errDataLocked reports that another server holds the lock of a data file
+var errDataLocked = errors.New("locked by another server")
+
+// dataLock is an advisory lock on a data file, held through a .lock file
+// beside it. The kernel releases it when the process exits, however it
+// exits, so a crashed server never leaves a stale lock behind.
+type dataLock struct {
+	file *os.File
+}
+
+// lockDataFile takes the lock of the data file at path without waiting,
+// failing with errDataLocked if another server holds it
+func lockDataFile(path string) (*dataLock, error) {
+	file, err := os.OpenFile(path+".lock", os.O_RDWR|os.O_CREATE, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	if err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		defer file.Close()
+		if errors.Is(err, syscall.EWOULDBLOCK) {
+			return nil, fmt.Errorf("%s is %w%s", path, errDataLocked, lockHolder(file))
+		}
+		return nil, fmt.Errorf("locking %s: %v", path, err)
+	}
+
+	// Record who holds it, for the error of the next server to try
+	if err := file.Truncate(0); err == nil {
+		file.WriteAt([]byte(strconv.Itoa(os.Getpid())+"\n"), 0)
+	}
+	return &dataLock{file: file}, nil
+}
+
+// lockHolder describes the process recorded in a lock file, if any
+func lockHolder(file *os.File) string {
+	data := make([]byte, 32)
+	n, _ := file.ReadAt(data, 0)
+	if pid := strings.TrimSpace(string(data[:n])); pid != "" {
+		return " (pid " + pid + ")"
+	}
+	return ""
+}
+
+// unlock releases the lock. The .lock file is left in place: removing it
+// would let a server waiting on the old file and one creating a new file
+// both hold a lock.
+func (l *dataLock) unlock() error {
+	return l.file.Close()
+}
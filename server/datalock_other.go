@@ -0,0 +1,25 @@
+//go:build !unix
+
+package main
+
+import (
+	"errors"
+	"log/slog"
+)
+
+// errDataLocked reports that another server holds the lock of a data file
+var errDataLocked = errors.New("locked by another server")
+
+// dataLock is a no-op where advisory file locks aren't available
+type dataLock struct{}
+
+// lockDataFile can't detect other servers on this platform
+func lockDataFile(path string) (*dataLock, error) {
+	slog.Warn("Not locking the data file: file locks are only supported on Unix", "file", path)
+	return &dataLock{}, nil
+}
+
+// unlock does nothing
+func (l *dataLock) unlock() error {
+	return nil
+}
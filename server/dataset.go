@@ -0,0 +1,143 @@
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync/atomic"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+)
+
+// dataset is an immutable snapshot of the loaded features. Handlers grab the
+// current snapshot once per call, so a concurrent swap never mixes versions
+// within a single RPC.
+type dataset struct {
+	features     []*featureRecord
+	version      int64           // increases by one with every swap
+	source       string          // file path or URL the features came from
+	loadedAt     time.Time       // when the snapshot was parsed
+	loadDuration time.Duration   // time spent reading, parsing and validating it
+	checksum     [32]byte        // SHA-256 of the raw features JSON, used to skip no-op refreshes
+	skipped      int             // malformed entries left out of features
+	loadErrors   []*pb.LoadError // why entries were skipped, up to maxLoadErrors
+
+	index atomic.Pointer[featureIndex] // spatial index, nil until built
+}
+
+// maxLoadErrors caps the skipped entries a dataset describes
+const maxLoadErrors = 100
+
+// parseDataset decodes and validates a features JSON document. Malformed
+// entries are skipped and reported in the dataset's loadErrors, unless strict
+// is set, in which case they fail the load.
+func parseDataset(data []byte, source string, strict bool) (*dataset, error) {
+	start := time.Now()
+	var entries []json.RawMessage
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+
+	d := &dataset{source: source, checksum: sha256.Sum256(data)}
+	for i, entry := range entries {
+		feature := &featureRecord{}
+		err := json.Unmarshal(entry, feature)
+		if err == nil {
+			err = validateFeature(feature)
+		}
+		if err == nil {
+			d.features = append(d.features, feature)
+			continue
+		}
+
+		if strict {
+			return nil, fmt.Errorf("feature %d: %v", i, err)
+		}
+		d.skipped++
+		if len(d.loadErrors) < maxLoadErrors {
+			loadErr := &pb.LoadError{Index: int32(i), Error: err.Error()}
+			if feature.Feature != nil {
+				loadErr.Name = feature.Name
+			}
+			d.loadErrors = append(d.loadErrors, loadErr)
+		}
+	}
+	if len(d.features) == 0 && d.skipped > 0 {
+		// An empty array is a valid (if dull) dataset, one where every
+		// entry is malformed is almost certainly the wrong file
+		return nil, fmt.Errorf("all %d features are malformed, the first because %s", len(entries), d.loadErrors[0].Error)
+	}
+	if d.skipped > 0 {
+		slog.Warn("Skipped malformed features", "skipped", d.skipped, "source", source,
+			"first_index", d.loadErrors[0].Index, "first_error", d.loadErrors[0].Error)
+	}
+
+	d.loadedAt = time.Now()
+	d.loadDuration = time.Since(start)
+	return d, nil
+}
+
+// validateFeature checks that a feature has a plausible location
+func validateFeature(feature *featureRecord) error {
+	return geo.ValidatePoint(feature.Location)
+}
+
+// loadDatasetFile reads and parses a features JSON file
+func loadDatasetFile(filePath string, strict bool) (*dataset, error) {
+	start := time.Now()
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+	readTime := time.Since(start)
+
+	d, err := parseDataset(data, filePath, strict)
+	if err != nil {
+		return nil, err
+	}
+	d.loadDuration += readTime
+	return d, nil
+}
+
+// current returns the dataset in use
+func (s *routeGuideServer) current() *dataset {
+	return s.data.Load()
+}
+
+// changeHook is told about every dataset swap, with the dataset swapped in
+// and what changed
+type changeHook func(next *dataset, delta *featureDelta)
+
+// swapDataset atomically replaces the dataset in use and notifies watchers.
+// It reports false, leaving the dataset untouched, if the new one has the
+// same content as the current one.
+func (s *routeGuideServer) swapDataset(next *dataset) bool {
+	s.swapMu.Lock()
+	defer s.swapMu.Unlock()
+
+	prev := s.data.Load()
+	if prev != nil {
+		if prev.checksum == next.checksum {
+			return false
+		}
+		next.version = prev.version + 1
+	} else {
+		next.version = 1
+	}
+	s.data.Store(next)
+	s.previous.Store(prev)
+
+	slog.Info("Dataset loaded", "dataset_version", next.version, "count", len(next.features), "source", next.source)
+	if prev != nil {
+		delta := s.recordDelta(prev, next)
+		if s.onChange != nil {
+			s.onChange(next, delta)
+		}
+		s.watchers.publish(datasetEvent(next, true))
+	}
+	return true
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+)
+
+func TestEmptyDatasetLoads(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "features.json")
+	if err := os.WriteFile(path, []byte("[]"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	s, err := newServer(path, true)
+	if err != nil {
+		t.Fatalf("newServer() with an empty features file failed: %v", err)
+	}
+	s.current().buildIndex(nil)
+	world := &pb.Rectangle{
+		Lo: &pb.Point{Latitude: -900000000, Longitude: -1800000000},
+		Hi: &pb.Point{Latitude: 900000000, Longitude: 1800000000},
+	}
+	if sent := listFeatures(t, s, world, nil); len(sent) != 0 {
+		t.Errorf("ListFeatures() over an empty dataset sent %v", sent)
+	}
+	if _, err := s.GetFeature(t.Context(), &pb.Point{Latitude: 1, Longitude: 1}); err != nil {
+		t.Errorf("GetFeature() over an empty dataset failed: %v", err)
+	}
+}
+
+func TestAllMalformedDatasetRejected(t *testing.T) {
+	if _, err := parseDataset([]byte(`[{"name": "nowhere", "location": {"latitude": 1000000000}}]`), "test", false); err == nil {
+		t.Error("parseDataset() with only malformed features succeeded")
+	}
+}
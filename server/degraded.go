@@ -0,0 +1,168 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"maps"
+	"runtime/debug"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// degradation records why and since when a subsystem was turned off
+type degradation struct {
+	reason string
+	since  time.Time
+}
+
+// subsystemMaxFailures is how many times in a row a subsystem may fail to
+// reach its backend, e.g. the OTLP collector, before it is turned off
+const subsystemMaxFailures = 10
+
+// subsystemGuard keeps the optional subsystems (metrics, tracing and
+// webhooks) from taking request handling down with them. Their hooks run
+// through run: the first panic in one, or subsystemMaxFailures failures in
+// a row reported with fail, turns its subsystem into a no-op until the
+// server restarts, logs an error and reports the subsystem NOT_SERVING on
+// its health service.
+type subsystemGuard struct {
+	mu       sync.Mutex // serializes enabling and degrading subsystems
+	health   *health.Server
+	enabled  []string
+	failures map[string]int // failures in a row, by subsystem
+	degraded atomic.Pointer[map[string]degradation]
+}
+
+// subsystems guards the optional subsystems of the server
+var subsystems = &subsystemGuard{}
+
+// subsystemHealthService is the health service reporting whether an
+// optional subsystem works, e.g. routeguide.RouteGuide/tracing
+func subsystemHealthService(name string) string {
+	return pb.RouteGuide_ServiceDesc.ServiceName + "/" + name
+}
+
+// enable records that a subsystem is on, reporting it on its health service
+func (g *subsystemGuard) enable(name string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.enabled = append(g.enabled, name)
+	g.reportHealth(name)
+}
+
+// active reports whether a subsystem hasn't been degraded
+func (g *subsystemGuard) active(name string) bool {
+	degraded := g.degraded.Load()
+	if degraded == nil {
+		return true
+	}
+	_, ok := (*degraded)[name]
+	return !ok
+}
+
+// run calls f, a hook of a subsystem, unless the subsystem is degraded. A
+// panic in f degrades the subsystem instead of propagating. It reports
+// whether f completed.
+func (g *subsystemGuard) run(name string, f func()) (ok bool) {
+	if !g.active(name) {
+		return false
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			g.degrade(name, fmt.Sprint("panic: ", r), string(debug.Stack()))
+			ok = false
+		}
+	}()
+	f()
+	return true
+}
+
+// goRun runs f, a background loop of a subsystem, in a goroutine through run
+func (g *subsystemGuard) goRun(name string, f func()) {
+	go g.run(name, f)
+}
+
+// fail counts a failure of a subsystem to reach its backend, degrading the
+// subsystem once it failed subsystemMaxFailures times in a row. It reports
+// whether the subsystem is still active.
+func (g *subsystemGuard) fail(name string, err error) bool {
+	g.mu.Lock()
+	if g.failures == nil {
+		g.failures = make(map[string]int)
+	}
+	g.failures[name]++
+	failures := g.failures[name]
+	g.mu.Unlock()
+
+	if failures >= subsystemMaxFailures {
+		g.degrade(name, fmt.Sprintf("failed %d times in a row, last: %v", failures, err), "")
+	}
+	return g.active(name)
+}
+
+// succeed resets the failures counted by fail
+func (g *subsystemGuard) succeed(name string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.failures, name)
+}
+
+// degrade turns a subsystem off for good
+func (g *subsystemGuard) degrade(name, reason, stack string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if !g.active(name) {
+		return
+	}
+	degraded := make(map[string]degradation)
+	if previous := g.degraded.Load(); previous != nil {
+		maps.Copy(degraded, *previous)
+	}
+	degraded[name] = degradation{reason: reason, since: time.Now()}
+	g.degraded.Store(&degraded)
+	slog.Error("Subsystem failed, turning it off until restart", "subsystem", name, "reason", reason, "stack", stack)
+	g.reportHealth(name)
+}
+
+// attachHealth reports the subsystems on a health server from now on
+func (g *subsystemGuard) attachHealth(h *health.Server) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.health = h
+	for _, name := range g.enabled {
+		g.reportHealth(name)
+	}
+}
+
+// reportHealth publishes whether a subsystem works. g.mu must be held.
+func (g *subsystemGuard) reportHealth(name string) {
+	if g.health == nil {
+		return
+	}
+	servingStatus := healthpb.HealthCheckResponse_SERVING
+	if !g.active(name) {
+		servingStatus = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	g.health.SetServingStatus(subsystemHealthService(name), servingStatus)
+}
+
+// snapshot describes the degraded subsystems, by name
+func (g *subsystemGuard) snapshot() []*pb.DegradedSubsystem {
+	degraded := g.degraded.Load()
+	if degraded == nil {
+		return nil
+	}
+	var subsystems []*pb.DegradedSubsystem
+	for _, name := range slices.Sorted(maps.Keys(*degraded)) {
+		d := (*degraded)[name]
+		subsystems = append(subsystems, &pb.DegradedSubsystem{Name: name, Reason: d.reason, Since: timestamppb.New(d.since)})
+	}
+	return subsystems
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"context"
+	"log"
+	"log/slog"
+	"time"
+)
+
+const (
+	// dependencyMaxBackoff caps the delay between checks of a dependency
+	// that isn't ready
+	dependencyMaxBackoff = 30 * time.Second
+	// dependencyCheckTimeout bounds a single check
+	dependencyCheckTimeout = 5 * time.Second
+)
+
+// dependency is an external service the server needs, such as a database.
+// The server reports NOT_SERVING until every dependency is ready.
+type dependency struct {
+	name  string
+	check func(ctx context.Context) error // nil once the dependency responds
+}
+
+// dependencyWarmupTask waits for a dependency as part of the startup
+// warm-up, checking it until it responds with an exponential backoff
+// between attempts. Unless timeout is zero, the server exits if the
+// dependency isn't ready within timeout.
+func dependencyWarmupTask(ctx context.Context, dep dependency, timeout time.Duration) *warmupTask {
+	return &warmupTask{
+		name:  "dependency_" + dep.name,
+		total: 1,
+		run: func(progress func(int)) {
+			start := time.Now()
+			for attempt := 1; ; attempt++ {
+				checkCtx, cancel := context.WithTimeout(ctx, dependencyCheckTimeout)
+				err := dep.check(checkCtx)
+				cancel()
+				if err == nil {
+					slog.Info("Dependency is ready", "dependency", dep.name)
+					progress(1)
+					return
+				}
+				if ctx.Err() != nil {
+					return
+				}
+				if timeout > 0 && time.Since(start) >= timeout {
+					log.Fatalf("Dependency %s isn't ready after %s: %v", dep.name, timeout.Round(time.Second), err)
+				}
+
+				backoff := dependencyMaxBackoff
+				if shift := attempt - 1; shift < 16 && time.Second<<shift < backoff {
+					backoff = time.Second << shift
+				}
+				slog.Warn("Dependency isn't ready, retrying", "dependency", dep.name, "attempt", attempt, "backoff", backoff, "error", err)
+				select {
+				case <-time.After(backoff):
+				case <-ctx.Done():
+					return
+				}
+			}
+		},
+	}
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"context"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DiffDatasets compares the served dataset with the one it replaced (unary RPC)
+func (a *adminServer) DiffDatasets(ctx context.Context, req *pb.DiffDatasetsRequest) (*pb.DatasetDiff, error) {
+	loggerFrom(ctx).Info("DiffDatasets called")
+
+	s := a.server
+	// Read under swapMu so the two datasets are consecutive versions
+	s.swapMu.Lock()
+	prev, next := s.previous.Load(), s.current()
+	s.swapMu.Unlock()
+	if prev == nil {
+		return nil, status.Error(codes.FailedPrecondition, "the dataset has not been replaced since the server started")
+	}
+	return datasetDiff(prev, next), nil
+}
+
+// datasetDiff describes the changes that turn prev into next, telling added
+// features apart from changed ones
+func datasetDiff(prev, next *dataset) *pb.DatasetDiff {
+	delta := diffDatasets(prev, next)
+	before := make(map[string]*featureRecord, len(prev.features))
+	for _, feature := range prev.features {
+		before[geo.Key(feature.Location)] = feature
+	}
+
+	diff := &pb.DatasetDiff{FromVersion: prev.version, ToVersion: next.version}
+	for _, feature := range delta.upserted {
+		if old, ok := before[geo.Key(feature.Location)]; ok {
+			diff.Changed = append(diff.Changed, &pb.FeatureChange{Before: old.Feature, After: feature.Feature})
+		} else {
+			diff.Added = append(diff.Added, feature.Feature)
+		}
+	}
+	for _, point := range delta.removed {
+		diff.Removed = append(diff.Removed, before[geo.Key(point)].Feature)
+	}
+	return diff
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain is the ErrorInfo domain of the server's errors
+const errorDomain = "routeguide"
+
+// errorCatalog holds the message of every ErrorInfo reason by locale.
+// Reasons are stable: clients match on them, so they are never renamed.
+// Messages refer to the ErrorInfo metadata as {key}; the English one is the
+// status message.
+var errorCatalog = map[string]map[string]string{
+	"CIRCUIT_OPEN": {
+		"en": "{integration} is unavailable (circuit breaker {state})",
+		"es": "{integration} no está disponible (disyuntor {state})",
+		"fr": "{integration} est indisponible (disjoncteur {state})",
+		"de": "{integration} ist nicht verfügbar (Schutzschalter {state})",
+	},
+	"MISSING_CREDENTIALS": {
+		"en": "missing credentials",
+		"es": "faltan las credenciales",
+		"fr": "identifiants manquants",
+		"de": "Anmeldedaten fehlen",
+	},
+	"INVALID_TOKEN": {
+		"en": "invalid token",
+		"es": "token no válido",
+		"fr": "jeton invalide",
+		"de": "ungültiges Token",
+	},
+	"INVALID_API_KEY": {
+		"en": "invalid API key",
+		"es": "clave de API no válida",
+		"fr": "clé d'API invalide",
+		"de": "ungültiger API-Schlüssel",
+	},
+	"CREDENTIALS_REVOKED": {
+		"en": "credentials revoked",
+		"es": "credenciales revocadas",
+		"fr": "identifiants révoqués",
+		"de": "Anmeldedaten widerrufen",
+	},
+	"NOT_ADMIN": {
+		"en": "{principal} is not an admin",
+		"es": "{principal} no es administrador",
+		"fr": "{principal} n'est pas administrateur",
+		"de": "{principal} ist kein Administrator",
+	},
+	"INVALID_RECTANGLE": {
+		"en": "invalid rectangle: {detail}",
+		"es": "rectángulo no válido: {detail}",
+		"fr": "rectangle invalide : {detail}",
+		"de": "ungültiges Rechteck: {detail}",
+	},
+	"INVALID_PAGE_TOKEN": {
+		"en": "invalid page token",
+		"es": "token de página no válido",
+		"fr": "jeton de page invalide",
+		"de": "ungültiges Seitentoken",
+	},
+	"INVALID_RESUME_TOKEN": {
+		"en": "invalid resume token",
+		"es": "token de reanudación no válido",
+		"fr": "jeton de reprise invalide",
+		"de": "ungültiges Fortsetzungstoken",
+	},
+	"LOCATION_REQUIRED": {
+		"en": "location is required",
+		"es": "la ubicación es obligatoria",
+		"fr": "l'emplacement est obligatoire",
+		"de": "ein Ort ist erforderlich",
+	},
+	"DATASET_READ_ONLY": {
+		"en": "the dataset is read-only since {since}: {reason}",
+		"es": "el conjunto de datos es de solo lectura desde {since}: {reason}",
+		"fr": "le jeu de données est en lecture seule depuis {since} : {reason}",
+		"de": "der Datensatz ist seit {since} schreibgeschützt: {reason}",
+	},
+	"NOTES_READ_ONLY": {
+		"en": "route notes are read-only: {reason}",
+		"es": "las notas de ruta son de solo lectura: {reason}",
+		"fr": "les notes de route sont en lecture seule : {reason}",
+		"de": "Routennotizen sind schreibgeschützt: {reason}",
+	},
+	"METHOD_TIMEOUT": {
+		"en": "{method} exceeded its maximum duration of {timeout}",
+		"es": "{method} superó su duración máxima de {timeout}",
+		"fr": "{method} a dépassé sa durée maximale de {timeout}",
+		"de": "{method} hat die maximale Dauer von {timeout} überschritten",
+	},
+	"INTERNAL_ERROR": {
+		"en": "internal server error (request ID {request_id})",
+		"es": "error interno del servidor (ID de solicitud {request_id})",
+		"fr": "erreur interne du serveur (ID de requête {request_id})",
+		"de": "interner Serverfehler (Anfrage-ID {request_id})",
+	},
+}
+
+// catalogMessage returns the message of reason in locale with the metadata
+// filled in, or "" if the catalog has none
+func catalogMessage(reason, locale string, metadata map[string]string) string {
+	message := errorCatalog[reason][locale]
+	for key, value := range metadata {
+		message = strings.ReplaceAll(message, "{"+key+"}", value)
+	}
+	return message
+}
+
+// reasonStatus builds a status with the English message of a catalog reason
+// and an ErrorInfo carrying the reason and its metadata. Callers may add
+// further details.
+func reasonStatus(code codes.Code, reason string, metadata map[string]string) *status.Status {
+	st := status.New(code, catalogMessage(reason, "en", metadata))
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{Reason: reason, Domain: errorDomain, Metadata: metadata})
+	if err != nil {
+		return st
+	}
+	return detailed
+}
+
+// reasonError is reasonStatus as an error
+func reasonError(code codes.Code, reason string, metadata map[string]string) error {
+	return reasonStatus(code, reason, metadata).Err()
+}
+
+// localizeError adds a LocalizedMessage in the caller's preferred locale to
+// an error carrying a catalog ErrorInfo. Other errors are returned as is.
+func (s *routeGuideServer) localizeError(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	var info *errdetails.ErrorInfo
+	for _, detail := range st.Details() {
+		switch detail := detail.(type) {
+		case *errdetails.ErrorInfo:
+			if detail.Domain == errorDomain {
+				info = detail
+			}
+		case *errdetails.LocalizedMessage:
+			return err
+		}
+	}
+	if info == nil {
+		return err
+	}
+	for _, locale := range s.requestLocales(ctx) {
+		message := catalogMessage(info.Reason, locale, info.Metadata)
+		if message == "" {
+			continue
+		}
+		localized, detailErr := st.WithDetails(&errdetails.LocalizedMessage{Locale: locale, Message: message})
+		if detailErr != nil {
+			return err
+		}
+		return localized.Err()
+	}
+	return err
+}
+
+// localizeUnaryInterceptor localizes the errors of unary calls
+func (s *routeGuideServer) localizeUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	return resp, s.localizeError(ctx, err)
+}
+
+// localizeStreamInterceptor localizes the errors ending streams
+func (s *routeGuideServer) localizeStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return s.localizeError(ss.Context(), handler(srv, ss))
+}
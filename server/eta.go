@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// defaultSearchRadius is used when a TravelTimeRequest has no search radius
+const defaultSearchRadius = 500
+
+// EstimateTravelTime estimates the travel time between two points (unary RPC)
+func (s *routeGuideServer) EstimateTravelTime(ctx context.Context, req *pb.TravelTimeRequest) (*pb.TravelTimeEstimate, error) {
+	logger := loggerFrom(ctx)
+	logger.Info("EstimateTravelTime called", "profile", req.Profile)
+
+	if req.Start == nil || req.End == nil {
+		return nil, status.Error(codes.InvalidArgument, "start and end are required")
+	}
+	profile, ok := travelProfiles[req.Profile]
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "a travel profile is required")
+	}
+	radius := req.SearchRadius
+	if radius <= 0 {
+		radius = defaultSearchRadius
+	}
+
+	estimate := &pb.TravelTimeEstimate{Distance: geo.Distance(req.Start, req.End)}
+
+	samples := s.historicalTrips(req.Start, req.End, req.Profile, radius)
+	if len(samples) > 0 {
+		// The median is robust to the odd stop or detour
+		slices.Sort(samples)
+		estimate.Duration = int32(samples[len(samples)/2].Seconds())
+		estimate.SampleCount = int32(len(samples))
+		estimate.Source = pb.TravelTimeEstimate_HISTORICAL
+	} else {
+		km := float64(estimate.Distance) / 1000 * detourFactor
+		estimate.Duration = int32(km / profile.speedKmh * 3600)
+		estimate.Source = pb.TravelTimeEstimate_HEURISTIC
+	}
+
+	logger.Info("EstimateTravelTime completed",
+		"duration_seconds", estimate.Duration, "source", estimate.Source, "samples", estimate.SampleCount)
+	return estimate, nil
+}
+
+// historicalTrips returns the durations of recorded trips, made with the given
+// profile, that pass within radius metres of start and later of end. Trips
+// whose points arrived in a burst carry no timing information and are skipped.
+func (s *routeGuideServer) historicalTrips(start, end *pb.Point, profile pb.TravelProfile, radius int32) []time.Duration {
+	minDuration := s.anomalyLimits.minInterval
+	if minDuration <= 0 {
+		minDuration = time.Second
+	}
+
+	var samples []time.Duration
+	for _, route := range s.routes.list() {
+		if route.summary.Profile != profile {
+			continue
+		}
+
+		// Use the last departure near start before the first arrival near end
+		departure := -1
+		for i, p := range route.points {
+			if geo.Distance(p, start) <= radius {
+				departure = i
+				continue
+			}
+			if departure >= 0 && geo.Distance(p, end) <= radius {
+				if d := route.times[i].Sub(route.times[departure]); d >= minDuration {
+					samples = append(samples, d)
+				}
+				break
+			}
+		}
+	}
+	return samples
+}
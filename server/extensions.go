@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/stats"
+)
+
+// extension is an optional subsystem of the server: tracing export, webhook
+// delivery, remote dataset refresh, the Redis, PostGIS and SQLite backends
+// and the HTTP ports. Each registers itself from an init function in files behind its build tag, along with its
+// flags, so that building with -tags minimal (or its own no_* tag) leaves it
+// out of the binary entirely.
+type extension struct {
+	name  string
+	start func(h *extensionHost) error // wires it in if its flags turn it on
+}
+
+// extensions are the extensions built into the binary, in registration order
+var extensions []extension
+
+// registerExtension adds an extension to the binary. It must be called from
+// an init function.
+func registerExtension(e extension) {
+	extensions = append(extensions, e)
+}
+
+// extensionHost is what extensions plug into while the server starts
+type extensionHost struct {
+	server   *routeGuideServer
+	ctx      context.Context // canceled when the server shuts down
+	breakers breakerConfig   // circuit breaker settings for outgoing calls
+
+	unary  []grpc.UnaryServerInterceptor  // run right after request IDs are assigned
+	stream []grpc.StreamServerInterceptor // likewise, for streams
+	stops  []func()                       // run once the gRPC server has stopped
+
+	statsHandlers []stats.Handler // see every call, before any interceptor
+
+	dependencies []dependency // must be ready before the server reports serving
+
+	revoked revocationSet // shared set of revoked credentials, or nil
+}
+
+// startExtensions starts the extensions built into the binary
+func startExtensions(h *extensionHost) error {
+	for _, e := range extensions {
+		if err := e.start(h); err != nil {
+			return fmt.Errorf("%s: %v", e.name, err)
+		}
+	}
+	return nil
+}
+
+// stop runs the extensions' shutdown hooks, in the order they were added
+func (h *extensionHost) stop() {
+	for _, stop := range h.stops {
+		stop()
+	}
+}
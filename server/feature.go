@@ -0,0 +1,108 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+)
+
+// featureRecord is a feature as stored in the dataset. It embeds the wire
+// Feature and carries server-side attributes that are never sent as-is.
+type featureRecord struct {
+	*pb.Feature
+
+	// names maps a lowercased language tag (e.g. "es", "pt-br") to the
+	// feature name in that locale. Feature.Name holds the default-locale name.
+	names map[string]string
+
+	// windows limits when the feature exists (seasonal features, temporary
+	// closures). A feature without windows is always valid.
+	windows []timeWindow
+}
+
+// timeWindow is a half-open validity interval [From, Until). A zero bound is unbounded.
+type timeWindow struct {
+	From  time.Time `json:"from"`
+	Until time.Time `json:"until"`
+}
+
+// contains reports whether t falls inside the window
+func (w timeWindow) contains(t time.Time) bool {
+	return (w.From.IsZero() || !t.Before(w.From)) &&
+		(w.Until.IsZero() || t.Before(w.Until))
+}
+
+// featureJSON is the on-disk representation of a feature in the features file
+type featureJSON struct {
+	Location *pb.Point         `json:"location"`
+	Name     string            `json:"name"`
+	Names    map[string]string `json:"names,omitempty"`
+	Windows  []timeWindow      `json:"windows,omitempty"`
+}
+
+// UnmarshalJSON decodes a feature from the features file
+func (f *featureRecord) UnmarshalJSON(data []byte) error {
+	var raw featureJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	f.Feature = &pb.Feature{Location: raw.Location, Name: raw.Name}
+	f.names = nil
+	if len(raw.Names) > 0 {
+		f.names = make(map[string]string, len(raw.Names))
+		for tag, name := range raw.Names {
+			f.names[normalizeLocale(tag)] = name
+		}
+	}
+
+	for _, w := range raw.Windows {
+		if !w.From.IsZero() && !w.Until.IsZero() && !w.From.Before(w.Until) {
+			return fmt.Errorf("feature %q: window from %s is not before until %s",
+				raw.Name, w.From.Format(time.RFC3339), w.Until.Format(time.RFC3339))
+		}
+	}
+	f.windows = raw.Windows
+
+	return nil
+}
+
+// activeAt reports whether the feature is valid at time t
+func (f *featureRecord) activeAt(t time.Time) bool {
+	if len(f.windows) == 0 {
+		return true
+	}
+	for _, w := range f.windows {
+		if w.contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// nextChange returns the first window bound after time t, when the feature
+// becomes valid or stops being valid, or the zero time if there is none
+func (f *featureRecord) nextChange(t time.Time) time.Time {
+	var next time.Time
+	for _, w := range f.windows {
+		for _, bound := range []time.Time{w.From, w.Until} {
+			if bound.After(t) && (next.IsZero() || bound.Before(next)) {
+				next = bound
+			}
+		}
+	}
+	return next
+}
+
+// localized returns the feature with its name in the first of the given
+// locales it has a translation for, or the default name otherwise
+func (f *featureRecord) localized(locales []string) *pb.Feature {
+	for _, locale := range locales {
+		if name, ok := f.names[locale]; ok {
+			return &pb.Feature{Name: name, Location: f.Location}
+		}
+	}
+	return f.Feature
+}
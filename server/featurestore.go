@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+)
+
+// FeatureStore finds the features the RouteGuide RPCs serve: every lookup
+// by location or area goes through it. The dataset store serves the
+// features file the server loaded; the PostGIS and SQLite stores run
+// spatial queries against a database.
+type FeatureStore interface {
+	// FeatureAt returns the feature at point valid at time at, or nil
+	FeatureAt(ctx context.Context, point *pb.Point, at time.Time) (*featureRecord, error)
+	// FeaturesIn returns the features within rect, edges included, whatever
+	// their validity windows. The slice may be shared; callers must not
+	// modify it.
+	FeaturesIn(ctx context.Context, rect *pb.Rectangle) ([]*featureRecord, error)
+}
+
+// FeatureWriter is implemented by feature stores that keep the features the
+// server adds, such as approved submissions, so they survive restarts
+type FeatureWriter interface {
+	// PutFeature stores feature, replacing any feature at its location
+	PutFeature(ctx context.Context, feature *pb.Feature) error
+	// DeleteFeature removes the feature at location, if there is one
+	DeleteFeature(ctx context.Context, location *pb.Point) error
+}
+
+// datasetFeatureStore serves the dataset a call picks: the stable one or
+// the A/B candidate, unless it is pinned to a dataset
+type datasetFeatureStore struct {
+	server *routeGuideServer
+	pinned *dataset // the dataset served whatever the call, or nil
+}
+
+// dataset returns the dataset serving a call
+func (ds datasetFeatureStore) dataset(ctx context.Context) (*dataset, error) {
+	if ds.pinned != nil {
+		return ds.pinned, nil
+	}
+	return ds.server.datasetFor(ctx)
+}
+
+func (ds datasetFeatureStore) FeatureAt(ctx context.Context, point *pb.Point, at time.Time) (*featureRecord, error) {
+	d, err := ds.dataset(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return d.findFeature(point, at), nil
+}
+
+func (ds datasetFeatureStore) FeaturesIn(ctx context.Context, rect *pb.Rectangle) ([]*featureRecord, error) {
+	d, err := ds.dataset(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// A single location is answered from the hash index, without filling
+	// the cache with points, unless it is on the antimeridian and so under
+	// two keys
+	if b := geo.Canonical(rect); b.IsPoint() && len(b.Split()) == 1 {
+		return d.atPoint(rect.Lo), nil
+	}
+	return ds.server.rects.inRect(d, rect), nil
+}
+
+// featuresFor returns the feature store a call reads throughout: with the
+// dataset store, the dataset the call picks, pinned so that a reload midway
+// doesn't change the features it sees; otherwise the feature store
+func (s *routeGuideServer) featuresFor(ctx context.Context) (FeatureStore, error) {
+	if _, ok := s.featureStore.(datasetFeatureStore); !ok {
+		return s.featureStore, nil
+	}
+	d, err := s.datasetFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.featuresOf(d), nil
+}
+
+// featuresOf returns the feature store of a call that started on dataset d:
+// with the dataset store, d; otherwise the feature store
+func (s *routeGuideServer) featuresOf(d *dataset) FeatureStore {
+	if _, ok := s.featureStore.(datasetFeatureStore); ok {
+		return datasetFeatureStore{server: s, pinned: d}
+	}
+	return s.featureStore
+}
+
+// worldRectangle covers every valid location
+var worldRectangle = &pb.Rectangle{
+	Lo: &pb.Point{Latitude: -geo.MaxLatitude, Longitude: -geo.MaxLongitude},
+	Hi: &pb.Point{Latitude: geo.MaxLatitude, Longitude: geo.MaxLongitude},
+}
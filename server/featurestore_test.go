@@ -0,0 +1,108 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+)
+
+// stubFeatureStore serves a fixed list of features, standing in for a
+// database such as PostGIS
+type stubFeatureStore struct {
+	features []*featureRecord
+	queries  int
+}
+
+func (st *stubFeatureStore) FeatureAt(ctx context.Context, point *pb.Point, at time.Time) (*featureRecord, error) {
+	st.queries++
+	for _, feature := range st.features {
+		if geo.Key(feature.Location) == geo.Key(point) && feature.activeAt(at) {
+			return feature, nil
+		}
+	}
+	return nil, nil
+}
+
+func (st *stubFeatureStore) FeaturesIn(ctx context.Context, rect *pb.Rectangle) ([]*featureRecord, error) {
+	st.queries++
+	var found []*featureRecord
+	for _, feature := range st.features {
+		if geo.Canonical(rect).Contains(feature.Location) {
+			found = append(found, feature)
+		}
+	}
+	return found, nil
+}
+
+func TestFeatureReadsGoThroughStore(t *testing.T) {
+	s, err := newServer("features.json", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// None of these are in features.json
+	store := &stubFeatureStore{}
+	for i := range 3 {
+		store.features = append(store.features, &featureRecord{Feature: &pb.Feature{
+			Name:     fmt.Sprintf("Stub %d", i),
+			Location: &pb.Point{Latitude: 10000000 + int32(i)*1000, Longitude: 20000000},
+		}})
+	}
+	s.featureStore = store
+	s.popularity = newPopularityTracker(time.Hour)
+	ctx := context.Background()
+	origin := &pb.Point{Latitude: 10000000, Longitude: 20000000}
+
+	tests := []struct {
+		rpc  string
+		call func() (string, error)
+		want string
+	}{
+		{"GetFeature", func() (string, error) {
+			f, err := s.GetFeature(ctx, origin)
+			return f.GetName(), err
+		}, "Stub 0"},
+		{"NearestFeatures", func() (string, error) {
+			resp, err := s.NearestFeatures(ctx, &pb.NearestFeaturesRequest{Point: origin, K: 2})
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("%d %s", len(resp.Features), resp.Features[1].Feature.Name), nil
+		}, "2 Stub 1"},
+		{"SnapToNearestFeature", func() (string, error) {
+			resp, err := s.SnapToNearestFeature(ctx, &pb.SnapRequest{Point: &pb.Point{Latitude: 10001900, Longitude: 20000000}, MaxDistanceMeters: 1000})
+			return resp.GetFeature().GetName(), err
+		}, "Stub 2"},
+		{"ListFeaturesPage", func() (string, error) {
+			rect := &pb.Rectangle{Lo: origin, Hi: &pb.Point{Latitude: 11000000, Longitude: 21000000}}
+			first, err := s.ListFeaturesPage(ctx, &pb.ListFeaturesPageRequest{Rectangle: rect, PageSize: 2})
+			if err != nil {
+				return "", err
+			}
+			second, err := s.ListFeaturesPage(ctx, &pb.ListFeaturesPageRequest{Rectangle: rect, PageSize: 2, PageToken: first.NextPageToken})
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("%d %s %v", len(first.Features), second.Features[0].Name, second.NextPageToken == ""), nil
+		}, "2 Stub 2 true"},
+		{"GetDatasetStats", func() (string, error) {
+			stats, err := s.GetDatasetStats(ctx, &pb.DatasetStatsRequest{})
+			return fmt.Sprint(stats.GetFeatureCount()), err
+		}, "3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.rpc, func(t *testing.T) {
+			before := store.queries
+			got, err := tt.call()
+			if err != nil {
+				t.Fatalf("%s failed: %v", tt.rpc, err)
+			}
+			if got != tt.want || store.queries == before {
+				t.Errorf("%s = %q after %d store queries, want %q from the store", tt.rpc, got, store.queries-before, tt.want)
+			}
+		})
+	}
+}
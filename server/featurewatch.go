@@ -0,0 +1,192 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/fsnotify/fsnotify"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// featureFileWatcher reloads the features file when it changes, so data
+// changes don't need a restart. The new dataset is swapped in atomically;
+// calls already running, such as ListFeatures streams, finish with the
+// dataset they started with.
+//
+// It watches the file's directory with fsnotify rather than the file
+// itself, so that editors and deploy tools replacing the file by renaming
+// another one over it keep being followed. Where notifications aren't
+// available it falls back to polling the file's modification time and size.
+type featureFileWatcher struct {
+	server   *routeGuideServer
+	path     string        // absolute path of the features file
+	interval time.Duration // between polls, and between retries of held back changes
+	modTime  time.Time     // of the file when last read, for polling
+	size     int64
+}
+
+// featureWatchSettle is how long the watcher waits after the last event on
+// the file before reloading it, so a file written in several steps is read
+// once it is complete
+const featureWatchSettle = 100 * time.Millisecond
+
+// newFeatureFileWatcher creates a watcher of the features file the server
+// loaded at startup, polling every interval if it has to
+func newFeatureFileWatcher(s *routeGuideServer, interval time.Duration) (*featureFileWatcher, error) {
+	path, err := filepath.Abs(s.features)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	return &featureFileWatcher{server: s, path: path, interval: interval, modTime: info.ModTime(), size: info.Size()}, nil
+}
+
+// watch follows the file until ctx is done, with file system notifications
+// if possible and by polling otherwise
+func (w *featureFileWatcher) watch(ctx context.Context) {
+	notify, err := fsnotify.NewWatcher()
+	if err == nil {
+		if err = notify.Add(filepath.Dir(w.path)); err != nil {
+			notify.Close()
+		}
+	}
+	if err != nil {
+		slog.Warn("File system notifications unavailable, polling the features file", "interval", w.interval, "error", err)
+		w.poll(ctx)
+		return
+	}
+	defer notify.Close()
+
+	// Changes are reloaded once events on the file settle, and retried
+	// every interval while the dataset is read-only
+	settle := time.NewTimer(0)
+	<-settle.C
+	pending := false
+	for {
+		select {
+		case event, ok := <-notify.Events:
+			if !ok {
+				return
+			}
+			if filepath.Clean(event.Name) != w.path || !event.Has(fsnotify.Write|fsnotify.Create|fsnotify.Rename) {
+				continue
+			}
+			settle.Reset(featureWatchSettle)
+		case err, ok := <-notify.Errors:
+			if !ok {
+				return
+			}
+			// Events may have been dropped, so look at the file again
+			slog.Warn("Watching the features file", "error", err)
+			settle.Reset(featureWatchSettle)
+		case <-settle.C:
+			pending = !w.changed()
+			if pending {
+				settle.Reset(w.interval)
+			}
+		case <-ctx.Done():
+			settle.Stop()
+			return
+		}
+	}
+}
+
+// poll checks the file every interval until ctx is done
+func (w *featureFileWatcher) poll(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			w.check()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// check reloads the file if its modification time or size changed. A file
+// that doesn't load, e.g. one still being written, is logged and the
+// current dataset kept until the file changes again. Changes wait while the
+// dataset is read-only.
+func (w *featureFileWatcher) check() {
+	info, err := os.Stat(w.path)
+	if err != nil {
+		slog.Error("Keeping the current features", "error", err)
+		return
+	}
+	if info.ModTime().Equal(w.modTime) && info.Size() == w.size {
+		return
+	}
+	if w.changed() {
+		w.modTime, w.size = info.ModTime(), info.Size()
+	}
+}
+
+// changed handles a change of the file, reloading it unless the dataset is
+// read-only. It reports false if the change was held back and should be
+// handled again later.
+func (w *featureFileWatcher) changed() bool {
+	if w.server.checkWritable() != nil {
+		return false
+	}
+	if err := w.reload(); err != nil {
+		slog.Error("Keeping the current features, the features file failed to load", "file", w.path, "error", err)
+	}
+	return true
+}
+
+// reload loads the file and swaps it in if its content changed
+func (w *featureFileWatcher) reload() error {
+	_, _, err := w.server.reloadFeatures()
+	return err
+}
+
+// reloadFeatures loads the features file again and swaps it in if its
+// content changed, returning the dataset being served and whether it changed
+func (s *routeGuideServer) reloadFeatures() (*dataset, bool, error) {
+	next, err := loadDatasetFile(s.features, s.strictLoad)
+	if err != nil {
+		return nil, false, err
+	}
+	next.buildIndex(nil)
+	if !s.swapDataset(next) {
+		slog.Info("Features unchanged", "file", s.features)
+		return s.current(), false, nil
+	}
+	return next, true, nil
+}
+
+// ReloadFeatures loads the features file again and swaps it in if it
+// changed (unary RPC)
+func (a *adminServer) ReloadFeatures(ctx context.Context, req *pb.ReloadFeaturesRequest) (*pb.ReloadFeaturesResponse, error) {
+	if err := a.server.checkWritable(); err != nil {
+		return nil, err
+	}
+	d, changed, err := a.server.reloadFeatures()
+	var pathErr *fs.PathError
+	switch {
+	case errors.As(err, &pathErr):
+		return nil, status.Errorf(codes.FailedPrecondition, "can't read the features file: %v", err)
+	case err != nil:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid features file %s: %v", a.server.features, err)
+	}
+	loggerFrom(ctx).Info("ReloadFeatures called", "dataset_version", d.version, "changed", changed)
+	return &pb.ReloadFeaturesResponse{
+		Changed:        changed,
+		DatasetVersion: d.version,
+		FeatureCount:   int32(len(d.features)),
+		SkippedCount:   int32(d.skipped),
+		LoadErrors:     d.loadErrors,
+	}, nil
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// featuresJSON is a one-feature dataset named name
+func featuresJSON(name string) []byte {
+	return []byte(`[{"location": {"latitude": 1, "longitude": 1}, "name": "` + name + `"}]`)
+}
+
+// waitForFeature waits until the server serves a single feature named name
+func waitForFeature(t *testing.T, s *routeGuideServer, name string) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if d := s.current(); len(d.features) == 1 && d.features[0].Name == name {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("the features file with %q wasn't reloaded", name)
+}
+
+func TestFeatureFileWatcher(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "features.json")
+	if err := os.WriteFile(path, featuresJSON("aaaa"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	s, err := newServer(path, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	watcher, err := newFeatureFileWatcher(s, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go watcher.watch(ctx)
+	// Let the watcher start watching the directory
+	time.Sleep(100 * time.Millisecond)
+
+	// An edit keeping the size and modification time, which polling misses
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, featuresJSON("bbbb"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, info.ModTime(), info.ModTime()); err != nil {
+		t.Fatal(err)
+	}
+	waitForFeature(t, s, "bbbb")
+
+	// Editors saving by renaming a new file over the old one
+	tmp := filepath.Join(dir, "features.json.tmp")
+	if err := os.WriteFile(tmp, featuresJSON("cccc"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		t.Fatal(err)
+	}
+	waitForFeature(t, s, "cccc")
+
+	// A second rename, now that the file watched at first is gone
+	if err := os.WriteFile(tmp, featuresJSON("dddd"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		t.Fatal(err)
+	}
+	waitForFeature(t, s, "dddd")
+}
@@ -0,0 +1,197 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"log/slog"
+	"maps"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// flagBroadcastChat makes RouteChat push new notes live to the other
+	// streams that posted at the same location
+	flagBroadcastChat = "broadcast_chat"
+
+	// flagEnvPrefix prefixes the environment variables setting flags, e.g.
+	// FEATURE_BROADCAST_CHAT=true
+	flagEnvPrefix = "FEATURE_"
+	// flagsPollInterval is how often the flags file is checked for changes
+	flagsPollInterval = 5 * time.Second
+)
+
+// knownFlags describes the experimental behaviors feature flags gate. All
+// are off unless turned on.
+var knownFlags = map[string]string{
+	flagBroadcastChat: "RouteChat pushes new notes live to the other streams that posted at the same location",
+}
+
+// featureFlags holds the state of the known flags. Environment variables set
+// their starting state; an optional JSON file of {"name": bool} overrides them
+// and is read again when it changes, so flags can be flipped without a
+// restart.
+type featureFlags struct {
+	path string          // empty when flags only come from the environment
+	env  map[string]bool // states set by environment variables
+
+	mu      sync.Mutex // serializes reloads
+	modTime time.Time
+	values  atomic.Pointer[map[string]bool]
+}
+
+// newFeatureFlags reads the flags from the environment and the file at path,
+// if any, failing if either is invalid
+func newFeatureFlags(path string) (*featureFlags, error) {
+	f := &featureFlags{path: path, env: make(map[string]bool)}
+	for name := range knownFlags {
+		variable := flagEnvPrefix + strings.ToUpper(name)
+		value, ok := os.LookupEnv(variable)
+		if !ok {
+			continue
+		}
+		enabled, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s=%q is not a boolean", variable, value)
+		}
+		f.env[name] = enabled
+	}
+
+	var fromFile map[string]bool
+	if path != "" {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if fromFile, err = readFlagsFile(path); err != nil {
+			return nil, err
+		}
+		f.modTime = info.ModTime()
+	}
+	f.set(fromFile)
+	return f, nil
+}
+
+// readFlagsFile reads a JSON object of flag states, ignoring unknown flags
+func readFlagsFile(path string) (map[string]bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var values map[string]bool
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("invalid flags file %s: %v", path, err)
+	}
+	for name := range values {
+		if _, ok := knownFlags[name]; !ok {
+			slog.Warn("Ignoring unknown feature flag", "flag", name, "file", path)
+			delete(values, name)
+		}
+	}
+	return values, nil
+}
+
+// set computes the flag states from their defaults, the environment and the
+// file's values, and logs the flags that changed
+func (f *featureFlags) set(fromFile map[string]bool) {
+	values := make(map[string]bool, len(knownFlags))
+	for name := range knownFlags {
+		values[name] = false
+	}
+	maps.Copy(values, f.env)
+	maps.Copy(values, fromFile)
+
+	previous := f.values.Swap(&values)
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		if previous == nil || (*previous)[name] != values[name] {
+			slog.Info("Feature flag changed", "flag", name, "value", onOff(values[name]))
+		}
+	}
+}
+
+// onOff describes a flag state
+func onOff(enabled bool) string {
+	if enabled {
+		return "on"
+	}
+	return "off"
+}
+
+// enabled reports whether a flag is on
+func (f *featureFlags) enabled(name string) bool {
+	return (*f.values.Load())[name]
+}
+
+// snapshot returns the state of every flag
+func (f *featureFlags) snapshot() map[string]bool {
+	return maps.Clone(*f.values.Load())
+}
+
+// reload reads the flags file again if it changed. A file that no longer
+// loads is logged and the current states kept.
+func (f *featureFlags) reload() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	info, err := os.Stat(f.path)
+	if err != nil {
+		slog.Error("Keeping the current feature flags", "error", err)
+		return
+	}
+	if info.ModTime().Equal(f.modTime) {
+		return
+	}
+	values, err := readFlagsFile(f.path)
+	if err != nil {
+		slog.Error("Keeping the current feature flags", "error", err)
+		return
+	}
+	f.modTime = info.ModTime()
+	f.set(values)
+}
+
+// watch reloads the flags file whenever it changes, until ctx is done
+func (f *featureFlags) watch(ctx context.Context) {
+	if f.path == "" {
+		return
+	}
+	ticker := time.NewTicker(flagsPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			f.reload()
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// publish exposes the flag states under feature_flags on /debug/vars
+func (f *featureFlags) publish() {
+	expvar.Publish("feature_flags", expvar.Func(func() any { return f.snapshot() }))
+}
+
+// handleFlags lists the feature flags with their state and description on
+// the admin HTTP port
+func (f *featureFlags) handleFlags(w http.ResponseWriter, r *http.Request) {
+	type flagState struct {
+		Name        string `json:"name"`
+		Enabled     bool   `json:"enabled"`
+		Description string `json:"description"`
+	}
+	values := f.snapshot()
+	states := make([]flagState, 0, len(values))
+	for _, name := range slices.Sorted(maps.Keys(values)) {
+		states = append(states, flagState{Name: name, Enabled: values[name], Description: knownFlags[name]})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(states)
+}
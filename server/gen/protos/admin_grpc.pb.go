@@ -0,0 +1,886 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: admin.proto
+
+package protos
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Admin_DebugDump_FullMethodName           = "/routeguide.Admin/DebugDump"
+	Admin_GetServerInfo_FullMethodName       = "/routeguide.Admin/GetServerInfo"
+	Admin_SetReadOnly_FullMethodName         = "/routeguide.Admin/SetReadOnly"
+	Admin_TailLogs_FullMethodName            = "/routeguide.Admin/TailLogs"
+	Admin_ReplayRoute_FullMethodName         = "/routeguide.Admin/ReplayRoute"
+	Admin_ListPendingFeatures_FullMethodName = "/routeguide.Admin/ListPendingFeatures"
+	Admin_ApproveFeature_FullMethodName      = "/routeguide.Admin/ApproveFeature"
+	Admin_RejectFeature_FullMethodName       = "/routeguide.Admin/RejectFeature"
+	Admin_DiffDatasets_FullMethodName        = "/routeguide.Admin/DiffDatasets"
+	Admin_ListConnections_FullMethodName     = "/routeguide.Admin/ListConnections"
+	Admin_TerminateStream_FullMethodName     = "/routeguide.Admin/TerminateStream"
+	Admin_ReloadFeatures_FullMethodName      = "/routeguide.Admin/ReloadFeatures"
+	Admin_DumpRouteNotes_FullMethodName      = "/routeguide.Admin/DumpRouteNotes"
+	Admin_ClearRouteNotes_FullMethodName     = "/routeguide.Admin/ClearRouteNotes"
+	Admin_CompactRouteNotes_FullMethodName   = "/routeguide.Admin/CompactRouteNotes"
+	Admin_RebuildIndexes_FullMethodName      = "/routeguide.Admin/RebuildIndexes"
+	Admin_ListJobs_FullMethodName            = "/routeguide.Admin/ListJobs"
+	Admin_RunJob_FullMethodName              = "/routeguide.Admin/RunJob"
+)
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Operator-only interface exported by the server.
+//
+// Every method requires a caller with the admin role, such as one sending
+// the admin token as "authorization: Bearer <token>" metadata.
+type AdminClient interface {
+	// Returns a dump of all goroutine stacks and, optionally, a heap profile.
+	//
+	// Intended for diagnosing stuck streams in deployments without shell
+	// access.
+	DebugDump(ctx context.Context, in *DebugDumpRequest, opts ...grpc.CallOption) (*DebugDumpResponse, error)
+	// Returns the server's state.
+	GetServerInfo(ctx context.Context, in *ServerInfoRequest, opts ...grpc.CallOption) (*ServerInfo, error)
+	// Puts the dataset in or out of read-only mode. While read-only, dataset
+	// changes (refreshes and feature edits) are rejected with
+	// FAILED_PRECONDITION, and the "routeguide.RouteGuide/writes" health
+	// service reports NOT_SERVING. Queries are unaffected.
+	SetReadOnly(ctx context.Context, in *SetReadOnlyRequest, opts ...grpc.CallOption) (*ServerInfo, error)
+	// Streams the server's log entries as they are written, optionally
+	// preceded by the most recent ones. Intended for watching server-side
+	// events live while debugging a client.
+	TailLogs(ctx context.Context, in *TailLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LogEntry], error)
+	// Replays a stored route file through the RecordRoute logic and returns
+	// its summary, for computing statistics from historical data without a
+	// live stream. The route is stored like a recorded one, so it can be used
+	// with CompareRoutes and EstimateTravelTime.
+	ReplayRoute(ctx context.Context, in *ReplayRouteRequest, opts ...grpc.CallOption) (*RouteSummary, error)
+	// Lists the feature submissions waiting for review, oldest first.
+	ListPendingFeatures(ctx context.Context, in *ListPendingFeaturesRequest, opts ...grpc.CallOption) (*ListPendingFeaturesResponse, error)
+	// Approves a pending feature submission, applying it to the dataset.
+	// Fails with NOT_FOUND if no pending submission has the ID, e.g. because it
+	// was already reviewed, and with FAILED_PRECONDITION while the dataset is
+	// read-only.
+	ApproveFeature(ctx context.Context, in *ReviewFeatureRequest, opts ...grpc.CallOption) (*FeatureSubmission, error)
+	// Rejects a pending feature submission. Fails with NOT_FOUND if no pending
+	// submission has the ID.
+	RejectFeature(ctx context.Context, in *ReviewFeatureRequest, opts ...grpc.CallOption) (*FeatureSubmission, error)
+	// Compares the dataset being served with the one it replaced, to check
+	// that a reload did what was expected. Features are matched by location.
+	// Fails with FAILED_PRECONDITION if the dataset was never replaced.
+	DiffDatasets(ctx context.Context, in *DiffDatasetsRequest, opts ...grpc.CallOption) (*DatasetDiff, error)
+	// Lists the open client connections and the streams open on them, with
+	// their callers and message counts, to find misbehaving clients.
+	ListConnections(ctx context.Context, in *ListConnectionsRequest, opts ...grpc.CallOption) (*ListConnectionsResponse, error)
+	// Ends an open stream, which fails with ABORTED on the client, e.g. one a
+	// misbehaving client keeps open. Fails with NOT_FOUND if no open stream
+	// has the ID.
+	TerminateStream(ctx context.Context, in *TerminateStreamRequest, opts ...grpc.CallOption) (*StreamInfo, error)
+	// Reads the features file again (--features) and swaps it in if it
+	// changed, reporting what was loaded. Malformed features are skipped and
+	// listed, or fail the reload with INVALID_ARGUMENT under --strict-load.
+	// Fails with FAILED_PRECONDITION while the dataset is read-only.
+	ReloadFeatures(ctx context.Context, in *ReloadFeaturesRequest, opts ...grpc.CallOption) (*ReloadFeaturesResponse, error)
+	// Streams every stored route note, live and archived, grouped by location
+	// and oldest first within a location.
+	DumpRouteNotes(ctx context.Context, in *DumpRouteNotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RouteNote], error)
+	// Deletes the route notes stored at a location, or everywhere, resetting
+	// chat state without a restart. Streams already open keep running.
+	ClearRouteNotes(ctx context.Context, in *ClearRouteNotesRequest, opts ...grpc.CallOption) (*ClearRouteNotesResponse, error)
+	// Deletes the notes evicted from the notes database since it was last
+	// compacted and copies it to a file without the pages they freed, like the
+	// compaction job. Fails with FAILED_PRECONDITION without --notes-db or
+	// while the notes are read-only.
+	CompactRouteNotes(ctx context.Context, in *CompactRouteNotesRequest, opts ...grpc.CallOption) (*CompactRouteNotesResponse, error)
+	// Rebuilds the spatial and hash indexes of the served datasets, then
+	// empties the tile and ListFeatures caches. The new indexes are built aside
+	// and swapped in, so queries keep running on the old ones meanwhile. Meant
+	// for after large bulk imports.
+	RebuildIndexes(ctx context.Context, in *RebuildIndexesRequest, opts ...grpc.CallOption) (*RebuildIndexesResponse, error)
+	// Lists the background jobs, scheduled or not, with their last run.
+	ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error)
+	// Runs a background job now, whether or not it is scheduled, and returns
+	// its status once it finished. Fails with NOT_FOUND for an unknown job and
+	// ABORTED while the job is already running.
+	RunJob(ctx context.Context, in *RunJobRequest, opts ...grpc.CallOption) (*JobStatus, error)
+}
+
+type adminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) DebugDump(ctx context.Context, in *DebugDumpRequest, opts ...grpc.CallOption) (*DebugDumpResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DebugDumpResponse)
+	err := c.cc.Invoke(ctx, Admin_DebugDump_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetServerInfo(ctx context.Context, in *ServerInfoRequest, opts ...grpc.CallOption) (*ServerInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServerInfo)
+	err := c.cc.Invoke(ctx, Admin_GetServerInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SetReadOnly(ctx context.Context, in *SetReadOnlyRequest, opts ...grpc.CallOption) (*ServerInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServerInfo)
+	err := c.cc.Invoke(ctx, Admin_SetReadOnly_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) TailLogs(ctx context.Context, in *TailLogsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[LogEntry], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Admin_ServiceDesc.Streams[0], Admin_TailLogs_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[TailLogsRequest, LogEntry]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Admin_TailLogsClient = grpc.ServerStreamingClient[LogEntry]
+
+func (c *adminClient) ReplayRoute(ctx context.Context, in *ReplayRouteRequest, opts ...grpc.CallOption) (*RouteSummary, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RouteSummary)
+	err := c.cc.Invoke(ctx, Admin_ReplayRoute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListPendingFeatures(ctx context.Context, in *ListPendingFeaturesRequest, opts ...grpc.CallOption) (*ListPendingFeaturesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingFeaturesResponse)
+	err := c.cc.Invoke(ctx, Admin_ListPendingFeatures_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ApproveFeature(ctx context.Context, in *ReviewFeatureRequest, opts ...grpc.CallOption) (*FeatureSubmission, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeatureSubmission)
+	err := c.cc.Invoke(ctx, Admin_ApproveFeature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) RejectFeature(ctx context.Context, in *ReviewFeatureRequest, opts ...grpc.CallOption) (*FeatureSubmission, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeatureSubmission)
+	err := c.cc.Invoke(ctx, Admin_RejectFeature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DiffDatasets(ctx context.Context, in *DiffDatasetsRequest, opts ...grpc.CallOption) (*DatasetDiff, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DatasetDiff)
+	err := c.cc.Invoke(ctx, Admin_DiffDatasets_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListConnections(ctx context.Context, in *ListConnectionsRequest, opts ...grpc.CallOption) (*ListConnectionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListConnectionsResponse)
+	err := c.cc.Invoke(ctx, Admin_ListConnections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) TerminateStream(ctx context.Context, in *TerminateStreamRequest, opts ...grpc.CallOption) (*StreamInfo, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StreamInfo)
+	err := c.cc.Invoke(ctx, Admin_TerminateStream_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ReloadFeatures(ctx context.Context, in *ReloadFeaturesRequest, opts ...grpc.CallOption) (*ReloadFeaturesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReloadFeaturesResponse)
+	err := c.cc.Invoke(ctx, Admin_ReloadFeatures_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) DumpRouteNotes(ctx context.Context, in *DumpRouteNotesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[RouteNote], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Admin_ServiceDesc.Streams[1], Admin_DumpRouteNotes_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[DumpRouteNotesRequest, RouteNote]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Admin_DumpRouteNotesClient = grpc.ServerStreamingClient[RouteNote]
+
+func (c *adminClient) ClearRouteNotes(ctx context.Context, in *ClearRouteNotesRequest, opts ...grpc.CallOption) (*ClearRouteNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClearRouteNotesResponse)
+	err := c.cc.Invoke(ctx, Admin_ClearRouteNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) CompactRouteNotes(ctx context.Context, in *CompactRouteNotesRequest, opts ...grpc.CallOption) (*CompactRouteNotesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompactRouteNotesResponse)
+	err := c.cc.Invoke(ctx, Admin_CompactRouteNotes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) RebuildIndexes(ctx context.Context, in *RebuildIndexesRequest, opts ...grpc.CallOption) (*RebuildIndexesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RebuildIndexesResponse)
+	err := c.cc.Invoke(ctx, Admin_RebuildIndexes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListJobs(ctx context.Context, in *ListJobsRequest, opts ...grpc.CallOption) (*ListJobsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListJobsResponse)
+	err := c.cc.Invoke(ctx, Admin_ListJobs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) RunJob(ctx context.Context, in *RunJobRequest, opts ...grpc.CallOption) (*JobStatus, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JobStatus)
+	err := c.cc.Invoke(ctx, Admin_RunJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+// All implementations must embed UnimplementedAdminServer
+// for forward compatibility.
+//
+// Operator-only interface exported by the server.
+//
+// Every method requires a caller with the admin role, such as one sending
+// the admin token as "authorization: Bearer <token>" metadata.
+type AdminServer interface {
+	// Returns a dump of all goroutine stacks and, optionally, a heap profile.
+	//
+	// Intended for diagnosing stuck streams in deployments without shell
+	// access.
+	DebugDump(context.Context, *DebugDumpRequest) (*DebugDumpResponse, error)
+	// Returns the server's state.
+	GetServerInfo(context.Context, *ServerInfoRequest) (*ServerInfo, error)
+	// Puts the dataset in or out of read-only mode. While read-only, dataset
+	// changes (refreshes and feature edits) are rejected with
+	// FAILED_PRECONDITION, and the "routeguide.RouteGuide/writes" health
+	// service reports NOT_SERVING. Queries are unaffected.
+	SetReadOnly(context.Context, *SetReadOnlyRequest) (*ServerInfo, error)
+	// Streams the server's log entries as they are written, optionally
+	// preceded by the most recent ones. Intended for watching server-side
+	// events live while debugging a client.
+	TailLogs(*TailLogsRequest, grpc.ServerStreamingServer[LogEntry]) error
+	// Replays a stored route file through the RecordRoute logic and returns
+	// its summary, for computing statistics from historical data without a
+	// live stream. The route is stored like a recorded one, so it can be used
+	// with CompareRoutes and EstimateTravelTime.
+	ReplayRoute(context.Context, *ReplayRouteRequest) (*RouteSummary, error)
+	// Lists the feature submissions waiting for review, oldest first.
+	ListPendingFeatures(context.Context, *ListPendingFeaturesRequest) (*ListPendingFeaturesResponse, error)
+	// Approves a pending feature submission, applying it to the dataset.
+	// Fails with NOT_FOUND if no pending submission has the ID, e.g. because it
+	// was already reviewed, and with FAILED_PRECONDITION while the dataset is
+	// read-only.
+	ApproveFeature(context.Context, *ReviewFeatureRequest) (*FeatureSubmission, error)
+	// Rejects a pending feature submission. Fails with NOT_FOUND if no pending
+	// submission has the ID.
+	RejectFeature(context.Context, *ReviewFeatureRequest) (*FeatureSubmission, error)
+	// Compares the dataset being served with the one it replaced, to check
+	// that a reload did what was expected. Features are matched by location.
+	// Fails with FAILED_PRECONDITION if the dataset was never replaced.
+	DiffDatasets(context.Context, *DiffDatasetsRequest) (*DatasetDiff, error)
+	// Lists the open client connections and the streams open on them, with
+	// their callers and message counts, to find misbehaving clients.
+	ListConnections(context.Context, *ListConnectionsRequest) (*ListConnectionsResponse, error)
+	// Ends an open stream, which fails with ABORTED on the client, e.g. one a
+	// misbehaving client keeps open. Fails with NOT_FOUND if no open stream
+	// has the ID.
+	TerminateStream(context.Context, *TerminateStreamRequest) (*StreamInfo, error)
+	// Reads the features file again (--features) and swaps it in if it
+	// changed, reporting what was loaded. Malformed features are skipped and
+	// listed, or fail the reload with INVALID_ARGUMENT under --strict-load.
+	// Fails with FAILED_PRECONDITION while the dataset is read-only.
+	ReloadFeatures(context.Context, *ReloadFeaturesRequest) (*ReloadFeaturesResponse, error)
+	// Streams every stored route note, live and archived, grouped by location
+	// and oldest first within a location.
+	DumpRouteNotes(*DumpRouteNotesRequest, grpc.ServerStreamingServer[RouteNote]) error
+	// Deletes the route notes stored at a location, or everywhere, resetting
+	// chat state without a restart. Streams already open keep running.
+	ClearRouteNotes(context.Context, *ClearRouteNotesRequest) (*ClearRouteNotesResponse, error)
+	// Deletes the notes evicted from the notes database since it was last
+	// compacted and copies it to a file without the pages they freed, like the
+	// compaction job. Fails with FAILED_PRECONDITION without --notes-db or
+	// while the notes are read-only.
+	CompactRouteNotes(context.Context, *CompactRouteNotesRequest) (*CompactRouteNotesResponse, error)
+	// Rebuilds the spatial and hash indexes of the served datasets, then
+	// empties the tile and ListFeatures caches. The new indexes are built aside
+	// and swapped in, so queries keep running on the old ones meanwhile. Meant
+	// for after large bulk imports.
+	RebuildIndexes(context.Context, *RebuildIndexesRequest) (*RebuildIndexesResponse, error)
+	// Lists the background jobs, scheduled or not, with their last run.
+	ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error)
+	// Runs a background job now, whether or not it is scheduled, and returns
+	// its status once it finished. Fails with NOT_FOUND for an unknown job and
+	// ABORTED while the job is already running.
+	RunJob(context.Context, *RunJobRequest) (*JobStatus, error)
+	mustEmbedUnimplementedAdminServer()
+}
+
+// UnimplementedAdminServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServer struct{}
+
+func (UnimplementedAdminServer) DebugDump(context.Context, *DebugDumpRequest) (*DebugDumpResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DebugDump not implemented")
+}
+func (UnimplementedAdminServer) GetServerInfo(context.Context, *ServerInfoRequest) (*ServerInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServerInfo not implemented")
+}
+func (UnimplementedAdminServer) SetReadOnly(context.Context, *SetReadOnlyRequest) (*ServerInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReadOnly not implemented")
+}
+func (UnimplementedAdminServer) TailLogs(*TailLogsRequest, grpc.ServerStreamingServer[LogEntry]) error {
+	return status.Errorf(codes.Unimplemented, "method TailLogs not implemented")
+}
+func (UnimplementedAdminServer) ReplayRoute(context.Context, *ReplayRouteRequest) (*RouteSummary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayRoute not implemented")
+}
+func (UnimplementedAdminServer) ListPendingFeatures(context.Context, *ListPendingFeaturesRequest) (*ListPendingFeaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingFeatures not implemented")
+}
+func (UnimplementedAdminServer) ApproveFeature(context.Context, *ReviewFeatureRequest) (*FeatureSubmission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveFeature not implemented")
+}
+func (UnimplementedAdminServer) RejectFeature(context.Context, *ReviewFeatureRequest) (*FeatureSubmission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectFeature not implemented")
+}
+func (UnimplementedAdminServer) DiffDatasets(context.Context, *DiffDatasetsRequest) (*DatasetDiff, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffDatasets not implemented")
+}
+func (UnimplementedAdminServer) ListConnections(context.Context, *ListConnectionsRequest) (*ListConnectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConnections not implemented")
+}
+func (UnimplementedAdminServer) TerminateStream(context.Context, *TerminateStreamRequest) (*StreamInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TerminateStream not implemented")
+}
+func (UnimplementedAdminServer) ReloadFeatures(context.Context, *ReloadFeaturesRequest) (*ReloadFeaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReloadFeatures not implemented")
+}
+func (UnimplementedAdminServer) DumpRouteNotes(*DumpRouteNotesRequest, grpc.ServerStreamingServer[RouteNote]) error {
+	return status.Errorf(codes.Unimplemented, "method DumpRouteNotes not implemented")
+}
+func (UnimplementedAdminServer) ClearRouteNotes(context.Context, *ClearRouteNotesRequest) (*ClearRouteNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearRouteNotes not implemented")
+}
+func (UnimplementedAdminServer) CompactRouteNotes(context.Context, *CompactRouteNotesRequest) (*CompactRouteNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompactRouteNotes not implemented")
+}
+func (UnimplementedAdminServer) RebuildIndexes(context.Context, *RebuildIndexesRequest) (*RebuildIndexesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildIndexes not implemented")
+}
+func (UnimplementedAdminServer) ListJobs(context.Context, *ListJobsRequest) (*ListJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
+func (UnimplementedAdminServer) RunJob(context.Context, *RunJobRequest) (*JobStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunJob not implemented")
+}
+func (UnimplementedAdminServer) mustEmbedUnimplementedAdminServer() {}
+func (UnimplementedAdminServer) testEmbeddedByValue()               {}
+
+// UnsafeAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServer will
+// result in compilation errors.
+type UnsafeAdminServer interface {
+	mustEmbedUnimplementedAdminServer()
+}
+
+func RegisterAdminServer(s grpc.ServiceRegistrar, srv AdminServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Admin_ServiceDesc, srv)
+}
+
+func _Admin_DebugDump_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DebugDumpRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DebugDump(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_DebugDump_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DebugDump(ctx, req.(*DebugDumpRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetServerInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServerInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetServerInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_GetServerInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetServerInfo(ctx, req.(*ServerInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SetReadOnly_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReadOnlyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SetReadOnly(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_SetReadOnly_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SetReadOnly(ctx, req.(*SetReadOnlyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_TailLogs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TailLogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServer).TailLogs(m, &grpc.GenericServerStream[TailLogsRequest, LogEntry]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Admin_TailLogsServer = grpc.ServerStreamingServer[LogEntry]
+
+func _Admin_ReplayRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ReplayRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ReplayRoute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ReplayRoute(ctx, req.(*ReplayRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListPendingFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingFeaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListPendingFeatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ListPendingFeatures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListPendingFeatures(ctx, req.(*ListPendingFeaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ApproveFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewFeatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ApproveFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ApproveFeature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ApproveFeature(ctx, req.(*ReviewFeatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_RejectFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewFeatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).RejectFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_RejectFeature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).RejectFeature(ctx, req.(*ReviewFeatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DiffDatasets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffDatasetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).DiffDatasets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_DiffDatasets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).DiffDatasets(ctx, req.(*DiffDatasetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListConnections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConnectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListConnections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ListConnections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListConnections(ctx, req.(*ListConnectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_TerminateStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TerminateStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).TerminateStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_TerminateStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).TerminateStream(ctx, req.(*TerminateStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ReloadFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReloadFeaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ReloadFeatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ReloadFeatures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ReloadFeatures(ctx, req.(*ReloadFeaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_DumpRouteNotes_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DumpRouteNotesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServer).DumpRouteNotes(m, &grpc.GenericServerStream[DumpRouteNotesRequest, RouteNote]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Admin_DumpRouteNotesServer = grpc.ServerStreamingServer[RouteNote]
+
+func _Admin_ClearRouteNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearRouteNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ClearRouteNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ClearRouteNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ClearRouteNotes(ctx, req.(*ClearRouteNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_CompactRouteNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompactRouteNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).CompactRouteNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_CompactRouteNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).CompactRouteNotes(ctx, req.(*CompactRouteNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_RebuildIndexes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildIndexesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).RebuildIndexes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_RebuildIndexes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).RebuildIndexes(ctx, req.(*RebuildIndexesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ListJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListJobs(ctx, req.(*ListJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_RunJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).RunJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_RunJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).RunJob(ctx, req.(*RunJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Admin_ServiceDesc is the grpc.ServiceDesc for Admin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Admin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "routeguide.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DebugDump",
+			Handler:    _Admin_DebugDump_Handler,
+		},
+		{
+			MethodName: "GetServerInfo",
+			Handler:    _Admin_GetServerInfo_Handler,
+		},
+		{
+			MethodName: "SetReadOnly",
+			Handler:    _Admin_SetReadOnly_Handler,
+		},
+		{
+			MethodName: "ReplayRoute",
+			Handler:    _Admin_ReplayRoute_Handler,
+		},
+		{
+			MethodName: "ListPendingFeatures",
+			Handler:    _Admin_ListPendingFeatures_Handler,
+		},
+		{
+			MethodName: "ApproveFeature",
+			Handler:    _Admin_ApproveFeature_Handler,
+		},
+		{
+			MethodName: "RejectFeature",
+			Handler:    _Admin_RejectFeature_Handler,
+		},
+		{
+			MethodName: "DiffDatasets",
+			Handler:    _Admin_DiffDatasets_Handler,
+		},
+		{
+			MethodName: "ListConnections",
+			Handler:    _Admin_ListConnections_Handler,
+		},
+		{
+			MethodName: "TerminateStream",
+			Handler:    _Admin_TerminateStream_Handler,
+		},
+		{
+			MethodName: "ReloadFeatures",
+			Handler:    _Admin_ReloadFeatures_Handler,
+		},
+		{
+			MethodName: "ClearRouteNotes",
+			Handler:    _Admin_ClearRouteNotes_Handler,
+		},
+		{
+			MethodName: "CompactRouteNotes",
+			Handler:    _Admin_CompactRouteNotes_Handler,
+		},
+		{
+			MethodName: "RebuildIndexes",
+			Handler:    _Admin_RebuildIndexes_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _Admin_ListJobs_Handler,
+		},
+		{
+			MethodName: "RunJob",
+			Handler:    _Admin_RunJob_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "TailLogs",
+			Handler:       _Admin_TailLogs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DumpRouteNotes",
+			Handler:       _Admin_DumpRouteNotes_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "admin.proto",
+}
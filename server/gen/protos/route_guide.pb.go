@@ -9,6 +9,8 @@ package protos
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -21,33 +23,3381 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// The mode of transport a route was traversed with. It is sent as
+// "travel-profile" metadata on RecordRoute (e.g. "walking").
+type TravelProfile int32
+
+const (
+	TravelProfile_TRAVEL_PROFILE_UNSPECIFIED TravelProfile = 0
+	TravelProfile_TRAVEL_PROFILE_WALKING     TravelProfile = 1
+	TravelProfile_TRAVEL_PROFILE_CYCLING     TravelProfile = 2
+	TravelProfile_TRAVEL_PROFILE_DRIVING     TravelProfile = 3
+)
+
+// Enum value maps for TravelProfile.
+var (
+	TravelProfile_name = map[int32]string{
+		0: "TRAVEL_PROFILE_UNSPECIFIED",
+		1: "TRAVEL_PROFILE_WALKING",
+		2: "TRAVEL_PROFILE_CYCLING",
+		3: "TRAVEL_PROFILE_DRIVING",
+	}
+	TravelProfile_value = map[string]int32{
+		"TRAVEL_PROFILE_UNSPECIFIED": 0,
+		"TRAVEL_PROFILE_WALKING":     1,
+		"TRAVEL_PROFILE_CYCLING":     2,
+		"TRAVEL_PROFILE_DRIVING":     3,
+	}
+)
+
+func (x TravelProfile) Enum() *TravelProfile {
+	p := new(TravelProfile)
+	*p = x
+	return p
+}
+
+func (x TravelProfile) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TravelProfile) Descriptor() protoreflect.EnumDescriptor {
+	return file_route_guide_proto_enumTypes[0].Descriptor()
+}
+
+func (TravelProfile) Type() protoreflect.EnumType {
+	return &file_route_guide_proto_enumTypes[0]
+}
+
+func (x TravelProfile) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TravelProfile.Descriptor instead.
+func (TravelProfile) EnumDescriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{0}
+}
+
+// How feature names are compared by name filters.
+type NameCollation int32
+
+const (
+	// Names must match exactly, byte for byte.
+	NameCollation_NAME_COLLATION_EXACT NameCollation = 0
+	// Names match regardless of case ("berkshire" matches "Berkshire").
+	NameCollation_NAME_COLLATION_CASE_INSENSITIVE NameCollation = 1
+	// Names match regardless of case, Unicode normalization form and
+	// diacritics ("cafe" matches "Café", whether é is composed or not).
+	NameCollation_NAME_COLLATION_NORMALIZED NameCollation = 2
+)
+
+// Enum value maps for NameCollation.
+var (
+	NameCollation_name = map[int32]string{
+		0: "NAME_COLLATION_EXACT",
+		1: "NAME_COLLATION_CASE_INSENSITIVE",
+		2: "NAME_COLLATION_NORMALIZED",
+	}
+	NameCollation_value = map[string]int32{
+		"NAME_COLLATION_EXACT":            0,
+		"NAME_COLLATION_CASE_INSENSITIVE": 1,
+		"NAME_COLLATION_NORMALIZED":       2,
+	}
+)
+
+func (x NameCollation) Enum() *NameCollation {
+	p := new(NameCollation)
+	*p = x
+	return p
+}
+
+func (x NameCollation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NameCollation) Descriptor() protoreflect.EnumDescriptor {
+	return file_route_guide_proto_enumTypes[1].Descriptor()
+}
+
+func (NameCollation) Type() protoreflect.EnumType {
+	return &file_route_guide_proto_enumTypes[1]
+}
+
+func (x NameCollation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NameCollation.Descriptor instead.
+func (NameCollation) EnumDescriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{1}
+}
+
+// The moderation state of a feature submission.
+type SubmissionState int32
+
+const (
+	SubmissionState_SUBMISSION_STATE_UNSPECIFIED SubmissionState = 0
+	// Waiting for an admin to review it.
+	SubmissionState_SUBMISSION_STATE_PENDING SubmissionState = 1
+	// Applied to the dataset.
+	SubmissionState_SUBMISSION_STATE_APPROVED SubmissionState = 2
+	// Turned down by an admin.
+	SubmissionState_SUBMISSION_STATE_REJECTED SubmissionState = 3
+)
+
+// Enum value maps for SubmissionState.
+var (
+	SubmissionState_name = map[int32]string{
+		0: "SUBMISSION_STATE_UNSPECIFIED",
+		1: "SUBMISSION_STATE_PENDING",
+		2: "SUBMISSION_STATE_APPROVED",
+		3: "SUBMISSION_STATE_REJECTED",
+	}
+	SubmissionState_value = map[string]int32{
+		"SUBMISSION_STATE_UNSPECIFIED": 0,
+		"SUBMISSION_STATE_PENDING":     1,
+		"SUBMISSION_STATE_APPROVED":    2,
+		"SUBMISSION_STATE_REJECTED":    3,
+	}
+)
+
+func (x SubmissionState) Enum() *SubmissionState {
+	p := new(SubmissionState)
+	*p = x
+	return p
+}
+
+func (x SubmissionState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SubmissionState) Descriptor() protoreflect.EnumDescriptor {
+	return file_route_guide_proto_enumTypes[2].Descriptor()
+}
+
+func (SubmissionState) Type() protoreflect.EnumType {
+	return &file_route_guide_proto_enumTypes[2]
+}
+
+func (x SubmissionState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SubmissionState.Descriptor instead.
+func (SubmissionState) EnumDescriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{2}
+}
+
+type RouteAnomaly_Kind int32
+
+const (
+	RouteAnomaly_KIND_UNSPECIFIED RouteAnomaly_Kind = 0
+	// The segment is longer than any plausible gap between two fixes.
+	RouteAnomaly_TELEPORT RouteAnomaly_Kind = 1
+	// The speed implied by the segment exceeds the configured maximum.
+	RouteAnomaly_EXCESSIVE_SPEED RouteAnomaly_Kind = 2
+)
+
+// Enum value maps for RouteAnomaly_Kind.
+var (
+	RouteAnomaly_Kind_name = map[int32]string{
+		0: "KIND_UNSPECIFIED",
+		1: "TELEPORT",
+		2: "EXCESSIVE_SPEED",
+	}
+	RouteAnomaly_Kind_value = map[string]int32{
+		"KIND_UNSPECIFIED": 0,
+		"TELEPORT":         1,
+		"EXCESSIVE_SPEED":  2,
+	}
+)
+
+func (x RouteAnomaly_Kind) Enum() *RouteAnomaly_Kind {
+	p := new(RouteAnomaly_Kind)
+	*p = x
+	return p
+}
+
+func (x RouteAnomaly_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RouteAnomaly_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_route_guide_proto_enumTypes[3].Descriptor()
+}
+
+func (RouteAnomaly_Kind) Type() protoreflect.EnumType {
+	return &file_route_guide_proto_enumTypes[3]
+}
+
+func (x RouteAnomaly_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RouteAnomaly_Kind.Descriptor instead.
+func (RouteAnomaly_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{9, 0}
+}
+
+type TravelTimeEstimate_Source int32
+
+const (
+	TravelTimeEstimate_SOURCE_UNSPECIFIED TravelTimeEstimate_Source = 0
+	// Derived from previously recorded routes.
+	TravelTimeEstimate_HISTORICAL TravelTimeEstimate_Source = 1
+	// Derived from the straight-line distance and the profile's speed.
+	TravelTimeEstimate_HEURISTIC TravelTimeEstimate_Source = 2
+)
+
+// Enum value maps for TravelTimeEstimate_Source.
+var (
+	TravelTimeEstimate_Source_name = map[int32]string{
+		0: "SOURCE_UNSPECIFIED",
+		1: "HISTORICAL",
+		2: "HEURISTIC",
+	}
+	TravelTimeEstimate_Source_value = map[string]int32{
+		"SOURCE_UNSPECIFIED": 0,
+		"HISTORICAL":         1,
+		"HEURISTIC":          2,
+	}
+)
+
+func (x TravelTimeEstimate_Source) Enum() *TravelTimeEstimate_Source {
+	p := new(TravelTimeEstimate_Source)
+	*p = x
+	return p
+}
+
+func (x TravelTimeEstimate_Source) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TravelTimeEstimate_Source) Descriptor() protoreflect.EnumDescriptor {
+	return file_route_guide_proto_enumTypes[4].Descriptor()
+}
+
+func (TravelTimeEstimate_Source) Type() protoreflect.EnumType {
+	return &file_route_guide_proto_enumTypes[4]
+}
+
+func (x TravelTimeEstimate_Source) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TravelTimeEstimate_Source.Descriptor instead.
+func (TravelTimeEstimate_Source) EnumDescriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{17, 0}
+}
+
+type FeatureEvent_Type int32
+
+const (
+	FeatureEvent_TYPE_UNSPECIFIED FeatureEvent_Type = 0
+	// The dataset in use when the watch started.
+	FeatureEvent_DATASET_CURRENT FeatureEvent_Type = 1
+	// A new dataset replaced the previous one.
+	FeatureEvent_DATASET_REPLACED FeatureEvent_Type = 2
+	// Nothing changed; sent periodically to clients that negotiated
+	// supports-heartbeats so they can detect dead connections.
+	FeatureEvent_HEARTBEAT FeatureEvent_Type = 3
+)
+
+// Enum value maps for FeatureEvent_Type.
+var (
+	FeatureEvent_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "DATASET_CURRENT",
+		2: "DATASET_REPLACED",
+		3: "HEARTBEAT",
+	}
+	FeatureEvent_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"DATASET_CURRENT":  1,
+		"DATASET_REPLACED": 2,
+		"HEARTBEAT":        3,
+	}
+)
+
+func (x FeatureEvent_Type) Enum() *FeatureEvent_Type {
+	p := new(FeatureEvent_Type)
+	*p = x
+	return p
+}
+
+func (x FeatureEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeatureEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_route_guide_proto_enumTypes[5].Descriptor()
+}
+
+func (FeatureEvent_Type) Type() protoreflect.EnumType {
+	return &file_route_guide_proto_enumTypes[5]
+}
+
+func (x FeatureEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeatureEvent_Type.Descriptor instead.
+func (FeatureEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{19, 0}
+}
+
 // Points are represented as latitude-longitude pairs in the E7 representation
 // (degrees multiplied by 10**7 and rounded to the nearest integer).
 // Latitudes should be in the range +/- 90 degrees and longitude should be in
 // the range +/- 180 degrees (inclusive).
+//
+// Calls sending coordinate-system metadata other than "e7" use x and y
+// instead, in that coordinate system: longitude and latitude in degrees for
+// "degrees", or easting and northing in meters for "web-mercator"
+// (EPSG:3857). The server reads them from requests and sets them, along with
+// latitude and longitude, in responses.
 type Point struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Latitude      int32                  `protobuf:"varint,1,opt,name=latitude" json:"latitude,omitempty"`
 	Longitude     int32                  `protobuf:"varint,2,opt,name=longitude" json:"longitude,omitempty"`
+	X             float64                `protobuf:"fixed64,3,opt,name=x" json:"x,omitempty"`
+	Y             float64                `protobuf:"fixed64,4,opt,name=y" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Point) Reset() {
+	*x = Point{}
+	mi := &file_route_guide_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Point) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Point) ProtoMessage() {}
+
+func (x *Point) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Point.ProtoReflect.Descriptor instead.
+func (*Point) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Point) GetLatitude() int32 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Point) GetLongitude() int32 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *Point) GetX() float64 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *Point) GetY() float64 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+// A latitude-longitude rectangle, represented as two diagonally opposite
+// points "lo" and "hi", given either way round: the rectangle spans from the
+// lesser latitude and longitude of the two to the greater, unless
+// crosses_antimeridian is set.
+type Rectangle struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One corner of the rectangle.
+	Lo *Point `protobuf:"bytes,1,opt,name=lo" json:"lo,omitempty"`
+	// The other corner of the rectangle.
+	Hi *Point `protobuf:"bytes,2,opt,name=hi" json:"hi,omitempty"`
+	// Optional: ListFeatures only sends the features whose names match it.
+	// Other RPCs taking a rectangle ignore it.
+	NameFilter *NameFilter `protobuf:"bytes,3,opt,name=name_filter,json=nameFilter" json:"name_filter,omitempty"`
+	// Whether the rectangle spans eastwards from lo's longitude to hi's, so
+	// that one whose lo longitude is greater than its hi longitude crosses
+	// the antimeridian instead of spanning the longitudes between them.
+	// Latitudes may still come either way round.
+	CrossesAntimeridian bool `protobuf:"varint,4,opt,name=crosses_antimeridian,json=crossesAntimeridian" json:"crosses_antimeridian,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Rectangle) Reset() {
+	*x = Rectangle{}
+	mi := &file_route_guide_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Rectangle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rectangle) ProtoMessage() {}
+
+func (x *Rectangle) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rectangle.ProtoReflect.Descriptor instead.
+func (*Rectangle) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Rectangle) GetLo() *Point {
+	if x != nil {
+		return x.Lo
+	}
+	return nil
+}
+
+func (x *Rectangle) GetHi() *Point {
+	if x != nil {
+		return x.Hi
+	}
+	return nil
+}
+
+func (x *Rectangle) GetNameFilter() *NameFilter {
+	if x != nil {
+		return x.NameFilter
+	}
+	return nil
+}
+
+func (x *Rectangle) GetCrossesAntimeridian() bool {
+	if x != nil {
+		return x.CrossesAntimeridian
+	}
+	return false
+}
+
+// A NameFilter selects features by name. Names are matched in the locale
+// they are sent in, and every condition set must hold.
+type NameFilter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Only features whose name contains this text, if not empty. At most 256
+	// bytes.
+	Contains string `protobuf:"bytes,1,opt,name=contains" json:"contains,omitempty"`
+	// Only features whose name starts with this text, if not empty. At most
+	// 256 bytes.
+	Prefix string `protobuf:"bytes,2,opt,name=prefix" json:"prefix,omitempty"`
+	// Only named features, skipping the unnamed points.
+	NamedOnly bool `protobuf:"varint,3,opt,name=named_only,json=namedOnly" json:"named_only,omitempty"`
+	// How names are compared with contains and prefix.
+	Collation     NameCollation `protobuf:"varint,4,opt,name=collation,enum=routeguide.NameCollation" json:"collation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NameFilter) Reset() {
+	*x = NameFilter{}
+	mi := &file_route_guide_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NameFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NameFilter) ProtoMessage() {}
+
+func (x *NameFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NameFilter.ProtoReflect.Descriptor instead.
+func (*NameFilter) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *NameFilter) GetContains() string {
+	if x != nil {
+		return x.Contains
+	}
+	return ""
+}
+
+func (x *NameFilter) GetPrefix() string {
+	if x != nil {
+		return x.Prefix
+	}
+	return ""
+}
+
+func (x *NameFilter) GetNamedOnly() bool {
+	if x != nil {
+		return x.NamedOnly
+	}
+	return false
+}
+
+func (x *NameFilter) GetCollation() NameCollation {
+	if x != nil {
+		return x.Collation
+	}
+	return NameCollation_NAME_COLLATION_EXACT
+}
+
+// A simple polygon in latitude-longitude space: its edges run straight
+// between consecutive points, and from the last point back to the first, and
+// never cross or touch.
+type Polygon struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The vertices of the polygon, 3 to 1000, in either winding order. A last
+	// point equal to the first is ignored, so closed rings such as
+	// IsochroneRing's are accepted.
+	Points        []*Point `protobuf:"bytes,1,rep,name=points" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Polygon) Reset() {
+	*x = Polygon{}
+	mi := &file_route_guide_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Polygon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Polygon) ProtoMessage() {}
+
+func (x *Polygon) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Polygon.ProtoReflect.Descriptor instead.
+func (*Polygon) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Polygon) GetPoints() []*Point {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// A feature names something at a given point.
+//
+// If a feature could not be named, the name is empty.
+type Feature struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the feature.
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// The point where the feature is detected.
+	Location      *Point `protobuf:"bytes,2,opt,name=location" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Feature) Reset() {
+	*x = Feature{}
+	mi := &file_route_guide_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Feature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Feature) ProtoMessage() {}
+
+func (x *Feature) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Feature.ProtoReflect.Descriptor instead.
+func (*Feature) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Feature) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Feature) GetLocation() *Point {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+// A ListFeaturesPageRequest asks for a page of the features in a rectangle.
+type ListFeaturesPageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The rectangle to list features in.
+	Rectangle *Rectangle `protobuf:"bytes,1,opt,name=rectangle" json:"rectangle,omitempty"`
+	// The maximum number of features to return. Defaults to 100, capped at 1000.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
+	// The next_page_token of a previous response for the same rectangle, or
+	// empty for the first page.
+	PageToken     string `protobuf:"bytes,3,opt,name=page_token,json=pageToken" json:"page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFeaturesPageRequest) Reset() {
+	*x = ListFeaturesPageRequest{}
+	mi := &file_route_guide_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFeaturesPageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFeaturesPageRequest) ProtoMessage() {}
+
+func (x *ListFeaturesPageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFeaturesPageRequest.ProtoReflect.Descriptor instead.
+func (*ListFeaturesPageRequest) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListFeaturesPageRequest) GetRectangle() *Rectangle {
+	if x != nil {
+		return x.Rectangle
+	}
+	return nil
+}
+
+func (x *ListFeaturesPageRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFeaturesPageRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// A FeaturePage is a page of features, ordered by latitude, then longitude.
+type FeaturePage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The features in this page.
+	Features []*Feature `protobuf:"bytes,1,rep,name=features" json:"features,omitempty"`
+	// A token for the following page, or empty if this is the last one.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken" json:"next_page_token,omitempty"`
+	// The version of the dataset the page was read from.
+	Version       int64 `protobuf:"varint,3,opt,name=version" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeaturePage) Reset() {
+	*x = FeaturePage{}
+	mi := &file_route_guide_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeaturePage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeaturePage) ProtoMessage() {}
+
+func (x *FeaturePage) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeaturePage.ProtoReflect.Descriptor instead.
+func (*FeaturePage) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *FeaturePage) GetFeatures() []*Feature {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *FeaturePage) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *FeaturePage) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// A RouteNote is a message sent while at a given point.
+type RouteNote struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The location from which the message is sent.
+	Location *Point `protobuf:"bytes,1,opt,name=location" json:"location,omitempty"`
+	// The message to be sent.
+	Message string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+	// Who sent the note: the sender's authenticated identity, such as the
+	// common name of its client certificate. Set by the server; empty for
+	// anonymous senders.
+	Author string `protobuf:"bytes,3,opt,name=author" json:"author,omitempty"`
+	// Identifies the note. Set by the server.
+	Id string `protobuf:"bytes,4,opt,name=id" json:"id,omitempty"`
+	// The RouteNote schema version the sender wrote the note with; 0 for
+	// clients predating versioning, which write version 1. Notes from newer
+	// versions may carry fields the server doesn't know: it keeps and relays
+	// them unchanged.
+	SchemaVersion uint32 `protobuf:"varint,5,opt,name=schema_version,json=schemaVersion" json:"schema_version,omitempty"`
+	// Continues a capped replay of the notes at a location. The server sets
+	// it on the last note it replays when it left later ones out; a client
+	// sends it back, in a note with the same location and no message, to have
+	// the next notes replayed. It is also a ListNoteHistory page_token.
+	HistoryToken string `protobuf:"bytes,6,opt,name=history_token,json=historyToken" json:"history_token,omitempty"`
+	// When the server received the note. Set by the server.
+	ReceivedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=received_at,json=receivedAt" json:"received_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteNote) Reset() {
+	*x = RouteNote{}
+	mi := &file_route_guide_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteNote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteNote) ProtoMessage() {}
+
+func (x *RouteNote) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteNote.ProtoReflect.Descriptor instead.
+func (*RouteNote) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RouteNote) GetLocation() *Point {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *RouteNote) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RouteNote) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *RouteNote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RouteNote) GetSchemaVersion() uint32 {
+	if x != nil {
+		return x.SchemaVersion
+	}
+	return 0
+}
+
+func (x *RouteNote) GetHistoryToken() string {
+	if x != nil {
+		return x.HistoryToken
+	}
+	return ""
+}
+
+func (x *RouteNote) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+// A RouteSummary is received in response to a RecordRoute rpc.
+//
+// It contains the number of individual points received, the number of
+// detected features, and the total distance covered as the cumulative sum of
+// the distance between each point.
+type RouteSummary struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of points received.
+	PointCount int32 `protobuf:"varint,1,opt,name=point_count,json=pointCount" json:"point_count,omitempty"`
+	// The number of known features passed while traversing the route.
+	FeatureCount int32 `protobuf:"varint,2,opt,name=feature_count,json=featureCount" json:"feature_count,omitempty"`
+	// The distance covered in metres.
+	Distance int32 `protobuf:"varint,3,opt,name=distance" json:"distance,omitempty"`
+	// The duration of the traversal in seconds.
+	ElapsedTime int32 `protobuf:"varint,4,opt,name=elapsed_time,json=elapsedTime" json:"elapsed_time,omitempty"`
+	// Segments that could not have been traversed physically, in the order
+	// they were received.
+	Anomalies []*RouteAnomaly `protobuf:"bytes,5,rep,name=anomalies" json:"anomalies,omitempty"`
+	// The travel profile the statistics were computed for.
+	Profile TravelProfile `protobuf:"varint,6,opt,name=profile,enum=routeguide.TravelProfile" json:"profile,omitempty"`
+	// The estimated time to travel the distance with the profile, in seconds.
+	// Zero when no profile was given.
+	EstimatedTime int32 `protobuf:"varint,7,opt,name=estimated_time,json=estimatedTime" json:"estimated_time,omitempty"`
+	// The estimated energy spent travelling the distance, in kilocalories.
+	// Zero for motorised profiles or when no profile was given.
+	Calories int32 `protobuf:"varint,8,opt,name=calories" json:"calories,omitempty"`
+	// The ID the route was stored under, for use with CompareRoutes.
+	RouteId       string `protobuf:"bytes,9,opt,name=route_id,json=routeId" json:"route_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteSummary) Reset() {
+	*x = RouteSummary{}
+	mi := &file_route_guide_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteSummary) ProtoMessage() {}
+
+func (x *RouteSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteSummary.ProtoReflect.Descriptor instead.
+func (*RouteSummary) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RouteSummary) GetPointCount() int32 {
+	if x != nil {
+		return x.PointCount
+	}
+	return 0
+}
+
+func (x *RouteSummary) GetFeatureCount() int32 {
+	if x != nil {
+		return x.FeatureCount
+	}
+	return 0
+}
+
+func (x *RouteSummary) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *RouteSummary) GetElapsedTime() int32 {
+	if x != nil {
+		return x.ElapsedTime
+	}
+	return 0
+}
+
+func (x *RouteSummary) GetAnomalies() []*RouteAnomaly {
+	if x != nil {
+		return x.Anomalies
+	}
+	return nil
+}
+
+func (x *RouteSummary) GetProfile() TravelProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return TravelProfile_TRAVEL_PROFILE_UNSPECIFIED
+}
+
+func (x *RouteSummary) GetEstimatedTime() int32 {
+	if x != nil {
+		return x.EstimatedTime
+	}
+	return 0
+}
+
+func (x *RouteSummary) GetCalories() int32 {
+	if x != nil {
+		return x.Calories
+	}
+	return 0
+}
+
+func (x *RouteSummary) GetRouteId() string {
+	if x != nil {
+		return x.RouteId
+	}
+	return ""
+}
+
+// A RouteAnomaly flags a segment between two consecutive points of a recorded
+// route, such as a teleport or an implausible speed.
+type RouteAnomaly struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// What is wrong with the segment.
+	Kind RouteAnomaly_Kind `protobuf:"varint,1,opt,name=kind,enum=routeguide.RouteAnomaly_Kind" json:"kind,omitempty"`
+	// The 1-based index of the point ending the segment.
+	PointIndex int32 `protobuf:"varint,2,opt,name=point_index,json=pointIndex" json:"point_index,omitempty"`
+	// The point starting the segment.
+	From *Point `protobuf:"bytes,3,opt,name=from" json:"from,omitempty"`
+	// The point ending the segment.
+	To *Point `protobuf:"bytes,4,opt,name=to" json:"to,omitempty"`
+	// The length of the segment in metres.
+	Distance int32 `protobuf:"varint,5,opt,name=distance" json:"distance,omitempty"`
+	// The implied speed in km/h, or 0 if it could not be measured.
+	SpeedKmh      float64 `protobuf:"fixed64,6,opt,name=speed_kmh,json=speedKmh" json:"speed_kmh,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteAnomaly) Reset() {
+	*x = RouteAnomaly{}
+	mi := &file_route_guide_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteAnomaly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteAnomaly) ProtoMessage() {}
+
+func (x *RouteAnomaly) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteAnomaly.ProtoReflect.Descriptor instead.
+func (*RouteAnomaly) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RouteAnomaly) GetKind() RouteAnomaly_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return RouteAnomaly_KIND_UNSPECIFIED
+}
+
+func (x *RouteAnomaly) GetPointIndex() int32 {
+	if x != nil {
+		return x.PointIndex
+	}
+	return 0
+}
+
+func (x *RouteAnomaly) GetFrom() *Point {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *RouteAnomaly) GetTo() *Point {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *RouteAnomaly) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *RouteAnomaly) GetSpeedKmh() float64 {
+	if x != nil {
+		return x.SpeedKmh
+	}
+	return 0
+}
+
+// An IsochroneRequest asks for the area reachable from a point in a given time.
+type IsochroneRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The starting point.
+	Center *Point `protobuf:"bytes,1,opt,name=center" json:"center,omitempty"`
+	// The time budget in seconds.
+	Duration int32 `protobuf:"varint,2,opt,name=duration" json:"duration,omitempty"`
+	// The mode of transport. Must be specified.
+	Profile TravelProfile `protobuf:"varint,3,opt,name=profile,enum=routeguide.TravelProfile" json:"profile,omitempty"`
+	// The number of equally spaced time bands to return. Defaults to 1.
+	Bands         int32 `protobuf:"varint,4,opt,name=bands" json:"bands,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsochroneRequest) Reset() {
+	*x = IsochroneRequest{}
+	mi := &file_route_guide_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsochroneRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsochroneRequest) ProtoMessage() {}
+
+func (x *IsochroneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsochroneRequest.ProtoReflect.Descriptor instead.
+func (*IsochroneRequest) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *IsochroneRequest) GetCenter() *Point {
+	if x != nil {
+		return x.Center
+	}
+	return nil
+}
+
+func (x *IsochroneRequest) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *IsochroneRequest) GetProfile() TravelProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return TravelProfile_TRAVEL_PROFILE_UNSPECIFIED
+}
+
+func (x *IsochroneRequest) GetBands() int32 {
+	if x != nil {
+		return x.Bands
+	}
+	return 0
+}
+
+// An IsochroneRing is a closed polygon ring bounding the area reachable within
+// a time band. The first and last points are equal.
+type IsochroneRing struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The time budget this ring was computed for, in seconds.
+	Duration int32 `protobuf:"varint,1,opt,name=duration" json:"duration,omitempty"`
+	// The vertices of the ring.
+	Points        []*Point `protobuf:"bytes,2,rep,name=points" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IsochroneRing) Reset() {
+	*x = IsochroneRing{}
+	mi := &file_route_guide_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IsochroneRing) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IsochroneRing) ProtoMessage() {}
+
+func (x *IsochroneRing) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IsochroneRing.ProtoReflect.Descriptor instead.
+func (*IsochroneRing) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *IsochroneRing) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *IsochroneRing) GetPoints() []*Point {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// A RouteRef identifies a route to compare.
+type RouteRef struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Route:
+	//
+	//	*RouteRef_Id
+	//	*RouteRef_Points
+	Route         isRouteRef_Route `protobuf_oneof:"route"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteRef) Reset() {
+	*x = RouteRef{}
+	mi := &file_route_guide_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteRef) ProtoMessage() {}
+
+func (x *RouteRef) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteRef.ProtoReflect.Descriptor instead.
+func (*RouteRef) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RouteRef) GetRoute() isRouteRef_Route {
+	if x != nil {
+		return x.Route
+	}
+	return nil
+}
+
+func (x *RouteRef) GetId() string {
+	if x != nil {
+		if x, ok := x.Route.(*RouteRef_Id); ok {
+			return x.Id
+		}
+	}
+	return ""
+}
+
+func (x *RouteRef) GetPoints() *RoutePoints {
+	if x != nil {
+		if x, ok := x.Route.(*RouteRef_Points); ok {
+			return x.Points
+		}
+	}
+	return nil
+}
+
+type isRouteRef_Route interface {
+	isRouteRef_Route()
+}
+
+type RouteRef_Id struct {
+	// The ID of a route recorded with RecordRoute.
+	Id string `protobuf:"bytes,1,opt,name=id,oneof"`
+}
+
+type RouteRef_Points struct {
+	// An ad-hoc route.
+	Points *RoutePoints `protobuf:"bytes,2,opt,name=points,oneof"`
+}
+
+func (*RouteRef_Id) isRouteRef_Route() {}
+
+func (*RouteRef_Points) isRouteRef_Route() {}
+
+// RoutePoints is an ordered list of points making up a route.
+type RoutePoints struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Points        []*Point               `protobuf:"bytes,1,rep,name=points" json:"points,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoutePoints) Reset() {
+	*x = RoutePoints{}
+	mi := &file_route_guide_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoutePoints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoutePoints) ProtoMessage() {}
+
+func (x *RoutePoints) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoutePoints.ProtoReflect.Descriptor instead.
+func (*RoutePoints) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RoutePoints) GetPoints() []*Point {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// A CompareRoutesRequest names the two routes to compare.
+type CompareRoutesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The reference route.
+	First *RouteRef `protobuf:"bytes,1,opt,name=first" json:"first,omitempty"`
+	// The route compared against the reference.
+	Second *RouteRef `protobuf:"bytes,2,opt,name=second" json:"second,omitempty"`
+	// How far apart, in metres, two routes may be and still count as
+	// overlapping. Defaults to 50.
+	Tolerance     int32 `protobuf:"varint,3,opt,name=tolerance" json:"tolerance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompareRoutesRequest) Reset() {
+	*x = CompareRoutesRequest{}
+	mi := &file_route_guide_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompareRoutesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareRoutesRequest) ProtoMessage() {}
+
+func (x *CompareRoutesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareRoutesRequest.ProtoReflect.Descriptor instead.
+func (*CompareRoutesRequest) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CompareRoutesRequest) GetFirst() *RouteRef {
+	if x != nil {
+		return x.First
+	}
+	return nil
+}
+
+func (x *CompareRoutesRequest) GetSecond() *RouteRef {
+	if x != nil {
+		return x.Second
+	}
+	return nil
+}
+
+func (x *CompareRoutesRequest) GetTolerance() int32 {
+	if x != nil {
+		return x.Tolerance
+	}
+	return 0
+}
+
+// A RouteComparison describes how two routes relate.
+type RouteComparison struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The percentage (0-100) of points of both routes lying within the
+	// tolerance of the other route.
+	Overlap float64 `protobuf:"fixed64,1,opt,name=overlap" json:"overlap,omitempty"`
+	// Points of the first route after which it leaves the second route.
+	DivergencePoints []*Point `protobuf:"bytes,2,rep,name=divergence_points,json=divergencePoints" json:"divergence_points,omitempty"`
+	// The length of the second route minus the length of the first, in metres.
+	DistanceDelta int32 `protobuf:"varint,3,opt,name=distance_delta,json=distanceDelta" json:"distance_delta,omitempty"`
+	// The length of the first route in metres.
+	FirstDistance int32 `protobuf:"varint,4,opt,name=first_distance,json=firstDistance" json:"first_distance,omitempty"`
+	// The length of the second route in metres.
+	SecondDistance int32 `protobuf:"varint,5,opt,name=second_distance,json=secondDistance" json:"second_distance,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RouteComparison) Reset() {
+	*x = RouteComparison{}
+	mi := &file_route_guide_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteComparison) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteComparison) ProtoMessage() {}
+
+func (x *RouteComparison) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteComparison.ProtoReflect.Descriptor instead.
+func (*RouteComparison) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RouteComparison) GetOverlap() float64 {
+	if x != nil {
+		return x.Overlap
+	}
+	return 0
+}
+
+func (x *RouteComparison) GetDivergencePoints() []*Point {
+	if x != nil {
+		return x.DivergencePoints
+	}
+	return nil
+}
+
+func (x *RouteComparison) GetDistanceDelta() int32 {
+	if x != nil {
+		return x.DistanceDelta
+	}
+	return 0
+}
+
+func (x *RouteComparison) GetFirstDistance() int32 {
+	if x != nil {
+		return x.FirstDistance
+	}
+	return 0
+}
+
+func (x *RouteComparison) GetSecondDistance() int32 {
+	if x != nil {
+		return x.SecondDistance
+	}
+	return 0
+}
+
+// A TravelTimeRequest asks for the travel time between two points.
+type TravelTimeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The point the trip starts at.
+	Start *Point `protobuf:"bytes,1,opt,name=start" json:"start,omitempty"`
+	// The point the trip ends at.
+	End *Point `protobuf:"bytes,2,opt,name=end" json:"end,omitempty"`
+	// The mode of transport. Must be specified.
+	Profile TravelProfile `protobuf:"varint,3,opt,name=profile,enum=routeguide.TravelProfile" json:"profile,omitempty"`
+	// How close, in metres, a recorded route must pass to the start and end
+	// points to be used. Defaults to 500.
+	SearchRadius  int32 `protobuf:"varint,4,opt,name=search_radius,json=searchRadius" json:"search_radius,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TravelTimeRequest) Reset() {
+	*x = TravelTimeRequest{}
+	mi := &file_route_guide_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TravelTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TravelTimeRequest) ProtoMessage() {}
+
+func (x *TravelTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TravelTimeRequest.ProtoReflect.Descriptor instead.
+func (*TravelTimeRequest) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *TravelTimeRequest) GetStart() *Point {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *TravelTimeRequest) GetEnd() *Point {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *TravelTimeRequest) GetProfile() TravelProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return TravelProfile_TRAVEL_PROFILE_UNSPECIFIED
+}
+
+func (x *TravelTimeRequest) GetSearchRadius() int32 {
+	if x != nil {
+		return x.SearchRadius
+	}
+	return 0
+}
+
+// A TravelTimeEstimate is the estimated duration of a trip.
+type TravelTimeEstimate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The estimated duration in seconds.
+	Duration int32 `protobuf:"varint,1,opt,name=duration" json:"duration,omitempty"`
+	// The straight-line distance between the points in metres.
+	Distance int32 `protobuf:"varint,2,opt,name=distance" json:"distance,omitempty"`
+	// The number of recorded trips the estimate is based on.
+	SampleCount int32 `protobuf:"varint,3,opt,name=sample_count,json=sampleCount" json:"sample_count,omitempty"`
+	// How the estimate was produced.
+	Source        TravelTimeEstimate_Source `protobuf:"varint,4,opt,name=source,enum=routeguide.TravelTimeEstimate_Source" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TravelTimeEstimate) Reset() {
+	*x = TravelTimeEstimate{}
+	mi := &file_route_guide_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TravelTimeEstimate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TravelTimeEstimate) ProtoMessage() {}
+
+func (x *TravelTimeEstimate) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TravelTimeEstimate.ProtoReflect.Descriptor instead.
+func (*TravelTimeEstimate) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TravelTimeEstimate) GetDuration() int32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *TravelTimeEstimate) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *TravelTimeEstimate) GetSampleCount() int32 {
+	if x != nil {
+		return x.SampleCount
+	}
+	return 0
+}
+
+func (x *TravelTimeEstimate) GetSource() TravelTimeEstimate_Source {
+	if x != nil {
+		return x.Source
+	}
+	return TravelTimeEstimate_SOURCE_UNSPECIFIED
+}
+
+// A WatchFeaturesRequest subscribes to dataset changes.
+type WatchFeaturesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resume_token of the last event received by a previous watch. Only
+	// honoured for clients that negotiated supports-resume.
+	ResumeToken   string `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchFeaturesRequest) Reset() {
+	*x = WatchFeaturesRequest{}
+	mi := &file_route_guide_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchFeaturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchFeaturesRequest) ProtoMessage() {}
+
+func (x *WatchFeaturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchFeaturesRequest.ProtoReflect.Descriptor instead.
+func (*WatchFeaturesRequest) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WatchFeaturesRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// A FeatureEvent describes a version of the feature dataset.
+type FeatureEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Why the event was sent.
+	Type FeatureEvent_Type `protobuf:"varint,1,opt,name=type,enum=routeguide.FeatureEvent_Type" json:"type,omitempty"`
+	// The dataset version, increasing by one with every swap.
+	Version int64 `protobuf:"varint,2,opt,name=version" json:"version,omitempty"`
+	// The number of features in the dataset.
+	FeatureCount int32 `protobuf:"varint,3,opt,name=feature_count,json=featureCount" json:"feature_count,omitempty"`
+	// Where the dataset was loaded from.
+	Source string `protobuf:"bytes,4,opt,name=source" json:"source,omitempty"`
+	// When the dataset was loaded.
+	LoadedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=loaded_at,json=loadedAt" json:"loaded_at,omitempty"`
+	// Resumes the watch after this event. Only set for clients that
+	// negotiated supports-resume.
+	ResumeToken   string `protobuf:"bytes,6,opt,name=resume_token,json=resumeToken" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeatureEvent) Reset() {
+	*x = FeatureEvent{}
+	mi := &file_route_guide_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeatureEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeatureEvent) ProtoMessage() {}
+
+func (x *FeatureEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeatureEvent.ProtoReflect.Descriptor instead.
+func (*FeatureEvent) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FeatureEvent) GetType() FeatureEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return FeatureEvent_TYPE_UNSPECIFIED
+}
+
+func (x *FeatureEvent) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *FeatureEvent) GetFeatureCount() int32 {
+	if x != nil {
+		return x.FeatureCount
+	}
+	return 0
+}
+
+func (x *FeatureEvent) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *FeatureEvent) GetLoadedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LoadedAt
+	}
+	return nil
+}
+
+func (x *FeatureEvent) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+// A NoteHistoryRequest asks for a page of the notes sent at a location.
+type NoteHistoryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The location to list notes for.
+	Location *Point `protobuf:"bytes,1,opt,name=location" json:"location,omitempty"`
+	// The maximum number of notes to return. Defaults to 50, capped at 500.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
+	// The next_page_token of a previous response, or empty for the first page.
+	PageToken string `protobuf:"bytes,3,opt,name=page_token,json=pageToken" json:"page_token,omitempty"`
+	// Lists the notes as they stood at this time, leaving out those received
+	// later; unset for the current notes. Notes evicted since are missing
+	// either way. Pass the same as_of when fetching the following pages.
+	AsOf          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=as_of,json=asOf" json:"as_of,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteHistoryRequest) Reset() {
+	*x = NoteHistoryRequest{}
+	mi := &file_route_guide_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteHistoryRequest) ProtoMessage() {}
+
+func (x *NoteHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteHistoryRequest.ProtoReflect.Descriptor instead.
+func (*NoteHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *NoteHistoryRequest) GetLocation() *Point {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *NoteHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *NoteHistoryRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *NoteHistoryRequest) GetAsOf() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AsOf
+	}
+	return nil
+}
+
+// A NoteHistoryPage is a page of notes, oldest first.
+type NoteHistoryPage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The notes in this page.
+	Notes []*RouteNote `protobuf:"bytes,1,rep,name=notes" json:"notes,omitempty"`
+	// A token for the following page, or empty if this is the last one.
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken" json:"next_page_token,omitempty"`
+	// The total number of notes currently available at the location, or that
+	// were as of as_of.
+	TotalSize     int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize" json:"total_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NoteHistoryPage) Reset() {
+	*x = NoteHistoryPage{}
+	mi := &file_route_guide_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NoteHistoryPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NoteHistoryPage) ProtoMessage() {}
+
+func (x *NoteHistoryPage) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NoteHistoryPage.ProtoReflect.Descriptor instead.
+func (*NoteHistoryPage) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *NoteHistoryPage) GetNotes() []*RouteNote {
+	if x != nil {
+		return x.Notes
+	}
+	return nil
+}
+
+func (x *NoteHistoryPage) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *NoteHistoryPage) GetTotalSize() int32 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+// A SyncRequest starts or resumes a feature sync.
+type SyncRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The resume_token of the last message applied by a previous sync. When
+	// empty, or too old to resume from, the sync starts with a snapshot.
+	ResumeToken string `protobuf:"bytes,1,opt,name=resume_token,json=resumeToken" json:"resume_token,omitempty"`
+	// The maximum number of features per snapshot page. Defaults to 100,
+	// capped at 1000.
+	PageSize      int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncRequest) Reset() {
+	*x = SyncRequest{}
+	mi := &file_route_guide_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncRequest) ProtoMessage() {}
+
+func (x *SyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncRequest.ProtoReflect.Descriptor instead.
+func (*SyncRequest) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SyncRequest) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+func (x *SyncRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// A SyncMessage is one step of a feature sync.
+type SyncMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Payload:
+	//
+	//	*SyncMessage_Snapshot
+	//	*SyncMessage_Delta
+	Payload isSyncMessage_Payload `protobuf_oneof:"payload"`
+	// The dataset version the synced copy is at once this message is applied.
+	Version int64 `protobuf:"varint,3,opt,name=version" json:"version,omitempty"`
+	// Resumes the sync after this message. Empty for all but the last page of
+	// a snapshot, since the copy is incomplete until then.
+	ResumeToken   string `protobuf:"bytes,4,opt,name=resume_token,json=resumeToken" json:"resume_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncMessage) Reset() {
+	*x = SyncMessage{}
+	mi := &file_route_guide_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncMessage) ProtoMessage() {}
+
+func (x *SyncMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncMessage.ProtoReflect.Descriptor instead.
+func (*SyncMessage) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SyncMessage) GetPayload() isSyncMessage_Payload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *SyncMessage) GetSnapshot() *SnapshotPage {
+	if x != nil {
+		if x, ok := x.Payload.(*SyncMessage_Snapshot); ok {
+			return x.Snapshot
+		}
+	}
+	return nil
+}
+
+func (x *SyncMessage) GetDelta() *FeatureDelta {
+	if x != nil {
+		if x, ok := x.Payload.(*SyncMessage_Delta); ok {
+			return x.Delta
+		}
+	}
+	return nil
+}
+
+func (x *SyncMessage) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *SyncMessage) GetResumeToken() string {
+	if x != nil {
+		return x.ResumeToken
+	}
+	return ""
+}
+
+type isSyncMessage_Payload interface {
+	isSyncMessage_Payload()
+}
+
+type SyncMessage_Snapshot struct {
+	Snapshot *SnapshotPage `protobuf:"bytes,1,opt,name=snapshot,oneof"`
+}
+
+type SyncMessage_Delta struct {
+	Delta *FeatureDelta `protobuf:"bytes,2,opt,name=delta,oneof"`
+}
+
+func (*SyncMessage_Snapshot) isSyncMessage_Payload() {}
+
+func (*SyncMessage_Delta) isSyncMessage_Payload() {}
+
+// A SnapshotPage is part of a full copy of the dataset. Features are
+// identified by their location.
+type SnapshotPage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The features in this page.
+	Features []*Feature `protobuf:"bytes,1,rep,name=features" json:"features,omitempty"`
+	// Whether this is the first page; the client discards its copy before
+	// applying it.
+	First bool `protobuf:"varint,2,opt,name=first" json:"first,omitempty"`
+	// Whether this is the last page.
+	Last          bool `protobuf:"varint,3,opt,name=last" json:"last,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapshotPage) Reset() {
+	*x = SnapshotPage{}
+	mi := &file_route_guide_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapshotPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotPage) ProtoMessage() {}
+
+func (x *SnapshotPage) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotPage.ProtoReflect.Descriptor instead.
+func (*SnapshotPage) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SnapshotPage) GetFeatures() []*Feature {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *SnapshotPage) GetFirst() bool {
+	if x != nil {
+		return x.First
+	}
+	return false
+}
+
+func (x *SnapshotPage) GetLast() bool {
+	if x != nil {
+		return x.Last
+	}
+	return false
+}
+
+// A FeatureDelta lists the changes between two dataset versions.
+type FeatureDelta struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Features that were added or changed, replacing any at the same location.
+	Upserted []*Feature `protobuf:"bytes,1,rep,name=upserted" json:"upserted,omitempty"`
+	// Locations whose feature was removed.
+	Removed       []*Point `protobuf:"bytes,2,rep,name=removed" json:"removed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeatureDelta) Reset() {
+	*x = FeatureDelta{}
+	mi := &file_route_guide_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeatureDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeatureDelta) ProtoMessage() {}
+
+func (x *FeatureDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeatureDelta.ProtoReflect.Descriptor instead.
+func (*FeatureDelta) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FeatureDelta) GetUpserted() []*Feature {
+	if x != nil {
+		return x.Upserted
+	}
+	return nil
+}
+
+func (x *FeatureDelta) GetRemoved() []*Point {
+	if x != nil {
+		return x.Removed
+	}
+	return nil
+}
+
+// A BundleRequest asks for an offline bundle of a region.
+type BundleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The region to bundle.
+	Region *Rectangle `protobuf:"bytes,1,opt,name=region" json:"region,omitempty"`
+	// Whether to include the vector tiles covering the region.
+	IncludeTiles bool `protobuf:"varint,2,opt,name=include_tiles,json=includeTiles" json:"include_tiles,omitempty"`
+	// The deepest zoom level of the included tiles, at most 14.
+	MaxTileZoom int32 `protobuf:"varint,3,opt,name=max_tile_zoom,json=maxTileZoom" json:"max_tile_zoom,omitempty"`
+	// Only report the size of the bundle, without sending it.
+	EstimateOnly bool `protobuf:"varint,4,opt,name=estimate_only,json=estimateOnly" json:"estimate_only,omitempty"`
+	// The byte offset to resume an interrupted download from.
+	Offset int64 `protobuf:"varint,5,opt,name=offset" json:"offset,omitempty"`
+	// The etag of the bundle being resumed; required with a non-zero offset.
+	Etag string `protobuf:"bytes,6,opt,name=etag" json:"etag,omitempty"`
+	// The maximum number of bytes per chunk. Defaults to 64 KiB, capped at 1 MiB.
+	ChunkSize     int32 `protobuf:"varint,7,opt,name=chunk_size,json=chunkSize" json:"chunk_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BundleRequest) Reset() {
+	*x = BundleRequest{}
+	mi := &file_route_guide_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleRequest) ProtoMessage() {}
+
+func (x *BundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleRequest.ProtoReflect.Descriptor instead.
+func (*BundleRequest) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *BundleRequest) GetRegion() *Rectangle {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+func (x *BundleRequest) GetIncludeTiles() bool {
+	if x != nil {
+		return x.IncludeTiles
+	}
+	return false
+}
+
+func (x *BundleRequest) GetMaxTileZoom() int32 {
+	if x != nil {
+		return x.MaxTileZoom
+	}
+	return 0
+}
+
+func (x *BundleRequest) GetEstimateOnly() bool {
+	if x != nil {
+		return x.EstimateOnly
+	}
+	return false
+}
+
+func (x *BundleRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *BundleRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *BundleRequest) GetChunkSize() int32 {
+	if x != nil {
+		return x.ChunkSize
+	}
+	return 0
+}
+
+// A BundleChunk is a piece of an offline bundle.
+type BundleChunk struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The position of data in the bundle.
+	Offset int64 `protobuf:"varint,1,opt,name=offset" json:"offset,omitempty"`
+	// The bundle bytes from offset on.
+	Data []byte `protobuf:"bytes,2,opt,name=data" json:"data,omitempty"`
+	// The size of the whole bundle in bytes.
+	TotalSize int64 `protobuf:"varint,3,opt,name=total_size,json=totalSize" json:"total_size,omitempty"`
+	// Identifies the content of the bundle; it changes with the dataset.
+	Etag string `protobuf:"bytes,4,opt,name=etag" json:"etag,omitempty"`
+	// The number of features in the bundle.
+	FeatureCount int32 `protobuf:"varint,5,opt,name=feature_count,json=featureCount" json:"feature_count,omitempty"`
+	// The number of tiles in the bundle.
+	TileCount     int32 `protobuf:"varint,6,opt,name=tile_count,json=tileCount" json:"tile_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BundleChunk) Reset() {
+	*x = BundleChunk{}
+	mi := &file_route_guide_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BundleChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleChunk) ProtoMessage() {}
+
+func (x *BundleChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleChunk.ProtoReflect.Descriptor instead.
+func (*BundleChunk) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *BundleChunk) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *BundleChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *BundleChunk) GetTotalSize() int64 {
+	if x != nil {
+		return x.TotalSize
+	}
+	return 0
+}
+
+func (x *BundleChunk) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *BundleChunk) GetFeatureCount() int32 {
+	if x != nil {
+		return x.FeatureCount
+	}
+	return 0
+}
+
+func (x *BundleChunk) GetTileCount() int32 {
+	if x != nil {
+		return x.TileCount
+	}
+	return 0
+}
+
+// A ChatActivityRequest asks where notes were recently posted in a region.
+type ChatActivityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The region to count notes in.
+	Region *Rectangle `protobuf:"bytes,1,opt,name=region" json:"region,omitempty"`
+	// The zoom level of the Web Mercator tiles notes are clustered into, from
+	// 0 (one tile for the world) to 22.
+	Zoom int32 `protobuf:"varint,2,opt,name=zoom" json:"zoom,omitempty"`
+	// How many of the last minutes to count notes over, up to 60. Defaults to
+	// 15.
+	WindowMinutes int32 `protobuf:"varint,3,opt,name=window_minutes,json=windowMinutes" json:"window_minutes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatActivityRequest) Reset() {
+	*x = ChatActivityRequest{}
+	mi := &file_route_guide_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatActivityRequest) ProtoMessage() {}
+
+func (x *ChatActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatActivityRequest.ProtoReflect.Descriptor instead.
+func (*ChatActivityRequest) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ChatActivityRequest) GetRegion() *Rectangle {
+	if x != nil {
+		return x.Region
+	}
+	return nil
+}
+
+func (x *ChatActivityRequest) GetZoom() int32 {
+	if x != nil {
+		return x.Zoom
+	}
+	return 0
+}
+
+func (x *ChatActivityRequest) GetWindowMinutes() int32 {
+	if x != nil {
+		return x.WindowMinutes
+	}
+	return 0
+}
+
+// A ChatActivity clusters the notes recently posted in a region by map
+// tile.
+type ChatActivity struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The tiles with notes in the region, busiest first.
+	Clusters []*ActivityCluster `protobuf:"bytes,1,rep,name=clusters" json:"clusters,omitempty"`
+	// The notes counted, across all clusters.
+	Total         int64 `protobuf:"varint,2,opt,name=total" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChatActivity) Reset() {
+	*x = ChatActivity{}
+	mi := &file_route_guide_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChatActivity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChatActivity) ProtoMessage() {}
+
+func (x *ChatActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChatActivity.ProtoReflect.Descriptor instead.
+func (*ChatActivity) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ChatActivity) GetClusters() []*ActivityCluster {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+func (x *ChatActivity) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// An ActivityCluster counts the notes posted in a map tile.
+type ActivityCluster struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The tile, in the z/x/y scheme of the requested zoom.
+	TileX int32 `protobuf:"varint,1,opt,name=tile_x,json=tileX" json:"tile_x,omitempty"`
+	TileY int32 `protobuf:"varint,2,opt,name=tile_y,json=tileY" json:"tile_y,omitempty"`
+	// Where the notes were posted, on average.
+	Center *Point `protobuf:"bytes,3,opt,name=center" json:"center,omitempty"`
+	// How many notes were posted.
+	Count int64 `protobuf:"varint,4,opt,name=count" json:"count,omitempty"`
+	// At how many distinct locations.
+	Locations     int32 `protobuf:"varint,5,opt,name=locations" json:"locations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityCluster) Reset() {
+	*x = ActivityCluster{}
+	mi := &file_route_guide_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityCluster) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityCluster) ProtoMessage() {}
+
+func (x *ActivityCluster) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityCluster.ProtoReflect.Descriptor instead.
+func (*ActivityCluster) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ActivityCluster) GetTileX() int32 {
+	if x != nil {
+		return x.TileX
+	}
+	return 0
+}
+
+func (x *ActivityCluster) GetTileY() int32 {
+	if x != nil {
+		return x.TileY
+	}
+	return 0
+}
+
+func (x *ActivityCluster) GetCenter() *Point {
+	if x != nil {
+		return x.Center
+	}
+	return nil
+}
+
+func (x *ActivityCluster) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ActivityCluster) GetLocations() int32 {
+	if x != nil {
+		return x.Locations
+	}
+	return 0
+}
+
+// A DatasetStatsRequest asks for statistics about the dataset.
+type DatasetStatsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The geohash length of the density buckets, between 1 and 8. Defaults to 4
+	// (cells of roughly 39 by 20 km).
+	GeohashPrecision int32 `protobuf:"varint,1,opt,name=geohash_precision,json=geohashPrecision" json:"geohash_precision,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DatasetStatsRequest) Reset() {
+	*x = DatasetStatsRequest{}
+	mi := &file_route_guide_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatasetStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatasetStatsRequest) ProtoMessage() {}
+
+func (x *DatasetStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatasetStatsRequest.ProtoReflect.Descriptor instead.
+func (*DatasetStatsRequest) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DatasetStatsRequest) GetGeohashPrecision() int32 {
+	if x != nil {
+		return x.GeohashPrecision
+	}
+	return 0
+}
+
+// A GeohashBucket counts the features within a geohash cell.
+type GeohashBucket struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The geohash of the cell.
+	Geohash string `protobuf:"bytes,1,opt,name=geohash" json:"geohash,omitempty"`
+	// The number of features in the cell.
+	Count         int32 `protobuf:"varint,2,opt,name=count" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GeohashBucket) Reset() {
+	*x = GeohashBucket{}
+	mi := &file_route_guide_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeohashBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeohashBucket) ProtoMessage() {}
+
+func (x *GeohashBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeohashBucket.ProtoReflect.Descriptor instead.
+func (*GeohashBucket) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GeohashBucket) GetGeohash() string {
+	if x != nil {
+		return x.Geohash
+	}
+	return ""
+}
+
+func (x *GeohashBucket) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// DatasetStats summarizes a dataset.
+type DatasetStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The dataset version, increasing by one with every swap.
+	Version int64 `protobuf:"varint,1,opt,name=version" json:"version,omitempty"`
+	// Where the dataset was loaded from.
+	Source string `protobuf:"bytes,2,opt,name=source" json:"source,omitempty"`
+	// When the dataset was loaded.
+	LoadedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=loaded_at,json=loadedAt" json:"loaded_at,omitempty"`
+	// How long reading, parsing and validating the dataset took, in
+	// milliseconds.
+	LoadDurationMs int64 `protobuf:"varint,4,opt,name=load_duration_ms,json=loadDurationMs" json:"load_duration_ms,omitempty"`
+	// The number of features.
+	FeatureCount int32 `protobuf:"varint,5,opt,name=feature_count,json=featureCount" json:"feature_count,omitempty"`
+	// The smallest rectangle containing every feature.
+	Bounds *Rectangle `protobuf:"bytes,6,opt,name=bounds" json:"bounds,omitempty"`
+	// Feature counts per geohash cell, most populated first.
+	Density []*GeohashBucket `protobuf:"bytes,7,rep,name=density" json:"density,omitempty"`
+	// The number of features at the same location as an earlier feature.
+	DuplicateCount int32 `protobuf:"varint,8,opt,name=duplicate_count,json=duplicateCount" json:"duplicate_count,omitempty"`
+	// The number of entries of the features file that were skipped because
+	// they are malformed.
+	SkippedCount int32 `protobuf:"varint,9,opt,name=skipped_count,json=skippedCount" json:"skipped_count,omitempty"`
+	// Why entries were skipped, in file order. Only the first 100 are listed.
+	LoadErrors    []*LoadError `protobuf:"bytes,10,rep,name=load_errors,json=loadErrors" json:"load_errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DatasetStats) Reset() {
+	*x = DatasetStats{}
+	mi := &file_route_guide_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DatasetStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DatasetStats) ProtoMessage() {}
+
+func (x *DatasetStats) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DatasetStats.ProtoReflect.Descriptor instead.
+func (*DatasetStats) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *DatasetStats) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *DatasetStats) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *DatasetStats) GetLoadedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LoadedAt
+	}
+	return nil
+}
+
+func (x *DatasetStats) GetLoadDurationMs() int64 {
+	if x != nil {
+		return x.LoadDurationMs
+	}
+	return 0
+}
+
+func (x *DatasetStats) GetFeatureCount() int32 {
+	if x != nil {
+		return x.FeatureCount
+	}
+	return 0
+}
+
+func (x *DatasetStats) GetBounds() *Rectangle {
+	if x != nil {
+		return x.Bounds
+	}
+	return nil
+}
+
+func (x *DatasetStats) GetDensity() []*GeohashBucket {
+	if x != nil {
+		return x.Density
+	}
+	return nil
+}
+
+func (x *DatasetStats) GetDuplicateCount() int32 {
+	if x != nil {
+		return x.DuplicateCount
+	}
+	return 0
+}
+
+func (x *DatasetStats) GetSkippedCount() int32 {
+	if x != nil {
+		return x.SkippedCount
+	}
+	return 0
+}
+
+func (x *DatasetStats) GetLoadErrors() []*LoadError {
+	if x != nil {
+		return x.LoadErrors
+	}
+	return nil
+}
+
+// A LoadError describes an entry of the features file that was skipped.
+type LoadError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The position of the entry in the file, from 0.
+	Index int32 `protobuf:"varint,1,opt,name=index" json:"index,omitempty"`
+	// The entry's name, if it could be read.
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name,omitempty"`
+	// What is wrong with the entry.
+	Error         string `protobuf:"bytes,3,opt,name=error" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoadError) Reset() {
+	*x = LoadError{}
+	mi := &file_route_guide_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoadError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadError) ProtoMessage() {}
+
+func (x *LoadError) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadError.ProtoReflect.Descriptor instead.
+func (*LoadError) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *LoadError) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *LoadError) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LoadError) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type SnapRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The point to snap.
+	Point *Point `protobuf:"bytes,1,opt,name=point" json:"point,omitempty"`
+	// How far, in meters, the point may be moved. Required; at most 50000.
+	MaxDistanceMeters int32 `protobuf:"varint,2,opt,name=max_distance_meters,json=maxDistanceMeters" json:"max_distance_meters,omitempty"`
+	// Whether only features with a name may be snapped to.
+	NamedOnly     bool `protobuf:"varint,3,opt,name=named_only,json=namedOnly" json:"named_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SnapRequest) Reset() {
+	*x = SnapRequest{}
+	mi := &file_route_guide_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapRequest) ProtoMessage() {}
+
+func (x *SnapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapRequest.ProtoReflect.Descriptor instead.
+func (*SnapRequest) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SnapRequest) GetPoint() *Point {
+	if x != nil {
+		return x.Point
+	}
+	return nil
+}
+
+func (x *SnapRequest) GetMaxDistanceMeters() int32 {
+	if x != nil {
+		return x.MaxDistanceMeters
+	}
+	return 0
+}
+
+func (x *SnapRequest) GetNamedOnly() bool {
+	if x != nil {
+		return x.NamedOnly
+	}
+	return false
+}
+
+type SnapResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether a feature was found within max_distance_meters.
+	Snapped bool `protobuf:"varint,1,opt,name=snapped" json:"snapped,omitempty"`
+	// The location of the feature, or the requested point if none was found.
+	Point *Point `protobuf:"bytes,2,opt,name=point" json:"point,omitempty"`
+	// The feature snapped to, unset if none was found.
+	Feature *Feature `protobuf:"bytes,3,opt,name=feature" json:"feature,omitempty"`
+	// How far the point was moved, in meters.
+	DistanceMeters int32 `protobuf:"varint,4,opt,name=distance_meters,json=distanceMeters" json:"distance_meters,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SnapResult) Reset() {
+	*x = SnapResult{}
+	mi := &file_route_guide_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SnapResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapResult) ProtoMessage() {}
+
+func (x *SnapResult) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapResult.ProtoReflect.Descriptor instead.
+func (*SnapResult) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SnapResult) GetSnapped() bool {
+	if x != nil {
+		return x.Snapped
+	}
+	return false
+}
+
+func (x *SnapResult) GetPoint() *Point {
+	if x != nil {
+		return x.Point
+	}
+	return nil
+}
+
+func (x *SnapResult) GetFeature() *Feature {
+	if x != nil {
+		return x.Feature
+	}
+	return nil
+}
+
+func (x *SnapResult) GetDistanceMeters() int32 {
+	if x != nil {
+		return x.DistanceMeters
+	}
+	return 0
+}
+
+type NearestFeaturesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The point to search around.
+	Point *Point `protobuf:"bytes,1,opt,name=point" json:"point,omitempty"`
+	// How many features to return: 10 when unset, at most 100.
+	K             int32 `protobuf:"varint,2,opt,name=k" json:"k,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NearestFeaturesRequest) Reset() {
+	*x = NearestFeaturesRequest{}
+	mi := &file_route_guide_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearestFeaturesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearestFeaturesRequest) ProtoMessage() {}
+
+func (x *NearestFeaturesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearestFeaturesRequest.ProtoReflect.Descriptor instead.
+func (*NearestFeaturesRequest) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *NearestFeaturesRequest) GetPoint() *Point {
+	if x != nil {
+		return x.Point
+	}
+	return nil
+}
+
+func (x *NearestFeaturesRequest) GetK() int32 {
+	if x != nil {
+		return x.K
+	}
+	return 0
+}
+
+type RadiusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The center of the circle to list the features of.
+	Center *Point `protobuf:"bytes,1,opt,name=center" json:"center,omitempty"`
+	// The radius of the circle, in meters, edge included. Required; at most
+	// 20015086, half the Earth's circumference.
+	RadiusMeters  int32 `protobuf:"varint,2,opt,name=radius_meters,json=radiusMeters" json:"radius_meters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RadiusRequest) Reset() {
+	*x = RadiusRequest{}
+	mi := &file_route_guide_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RadiusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RadiusRequest) ProtoMessage() {}
+
+func (x *RadiusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RadiusRequest.ProtoReflect.Descriptor instead.
+func (*RadiusRequest) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RadiusRequest) GetCenter() *Point {
+	if x != nil {
+		return x.Center
+	}
+	return nil
+}
+
+func (x *RadiusRequest) GetRadiusMeters() int32 {
+	if x != nil {
+		return x.RadiusMeters
+	}
+	return 0
+}
+
+type NearestFeature struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Feature *Feature               `protobuf:"bytes,1,opt,name=feature" json:"feature,omitempty"`
+	// The great-circle distance from the requested point, in meters.
+	DistanceMeters int32 `protobuf:"varint,2,opt,name=distance_meters,json=distanceMeters" json:"distance_meters,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NearestFeature) Reset() {
+	*x = NearestFeature{}
+	mi := &file_route_guide_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearestFeature) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearestFeature) ProtoMessage() {}
+
+func (x *NearestFeature) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearestFeature.ProtoReflect.Descriptor instead.
+func (*NearestFeature) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *NearestFeature) GetFeature() *Feature {
+	if x != nil {
+		return x.Feature
+	}
+	return nil
+}
+
+func (x *NearestFeature) GetDistanceMeters() int32 {
+	if x != nil {
+		return x.DistanceMeters
+	}
+	return 0
+}
+
+type NearestFeaturesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The nearest named features, closest first; ties are in dataset order.
+	// Fewer than k when the dataset has fewer named features.
+	Features      []*NearestFeature `protobuf:"bytes,1,rep,name=features" json:"features,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NearestFeaturesResponse) Reset() {
+	*x = NearestFeaturesResponse{}
+	mi := &file_route_guide_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NearestFeaturesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NearestFeaturesResponse) ProtoMessage() {}
+
+func (x *NearestFeaturesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NearestFeaturesResponse.ProtoReflect.Descriptor instead.
+func (*NearestFeaturesResponse) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *NearestFeaturesResponse) GetFeatures() []*NearestFeature {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type SubmitFeatureRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The feature to add, or to replace the feature at its location with.
+	Feature       *Feature `protobuf:"bytes,1,opt,name=feature" json:"feature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitFeatureRequest) Reset() {
+	*x = SubmitFeatureRequest{}
+	mi := &file_route_guide_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitFeatureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitFeatureRequest) ProtoMessage() {}
+
+func (x *SubmitFeatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitFeatureRequest.ProtoReflect.Descriptor instead.
+func (*SubmitFeatureRequest) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *SubmitFeatureRequest) GetFeature() *Feature {
+	if x != nil {
+		return x.Feature
+	}
+	return nil
+}
+
+type CreateFeatureRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The feature to add. Its location must not have a feature yet.
+	Feature       *Feature `protobuf:"bytes,1,opt,name=feature" json:"feature,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFeatureRequest) Reset() {
+	*x = CreateFeatureRequest{}
+	mi := &file_route_guide_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFeatureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFeatureRequest) ProtoMessage() {}
+
+func (x *CreateFeatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFeatureRequest.ProtoReflect.Descriptor instead.
+func (*CreateFeatureRequest) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CreateFeatureRequest) GetFeature() *Feature {
+	if x != nil {
+		return x.Feature
+	}
+	return nil
+}
+
+type UpdateFeatureRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The feature replacing the one at its location.
+	Feature       *Feature `protobuf:"bytes,1,opt,name=feature" json:"feature,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Point) Reset() {
-	*x = Point{}
-	mi := &file_route_guide_proto_msgTypes[0]
+func (x *UpdateFeatureRequest) Reset() {
+	*x = UpdateFeatureRequest{}
+	mi := &file_route_guide_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Point) String() string {
+func (x *UpdateFeatureRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Point) ProtoMessage() {}
+func (*UpdateFeatureRequest) ProtoMessage() {}
 
-func (x *Point) ProtoReflect() protoreflect.Message {
-	mi := &file_route_guide_proto_msgTypes[0]
+func (x *UpdateFeatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -58,52 +3408,89 @@ func (x *Point) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Point.ProtoReflect.Descriptor instead.
-func (*Point) Descriptor() ([]byte, []int) {
-	return file_route_guide_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use UpdateFeatureRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFeatureRequest) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *Point) GetLatitude() int32 {
+func (x *UpdateFeatureRequest) GetFeature() *Feature {
 	if x != nil {
-		return x.Latitude
+		return x.Feature
 	}
-	return 0
+	return nil
 }
 
-func (x *Point) GetLongitude() int32 {
+type DeleteFeatureRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The location of the feature to remove.
+	Location      *Point `protobuf:"bytes,1,opt,name=location" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFeatureRequest) Reset() {
+	*x = DeleteFeatureRequest{}
+	mi := &file_route_guide_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteFeatureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFeatureRequest) ProtoMessage() {}
+
+func (x *DeleteFeatureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[44]
 	if x != nil {
-		return x.Longitude
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-// A latitude-longitude rectangle, represented as two diagonally opposite
-// points "lo" and "hi".
-type Rectangle struct {
+// Deprecated: Use DeleteFeatureRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFeatureRequest) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *DeleteFeatureRequest) GetLocation() *Point {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+// A FeatureMutation is the outcome of a feature mutation RPC.
+type FeatureMutation struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// One corner of the rectangle.
-	Lo *Point `protobuf:"bytes,1,opt,name=lo" json:"lo,omitempty"`
-	// The other corner of the rectangle.
-	Hi            *Point `protobuf:"bytes,2,opt,name=hi" json:"hi,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// The feature created or updated, or the feature deleted.
+	Feature *Feature `protobuf:"bytes,1,opt,name=feature" json:"feature,omitempty"`
+	// The dataset version the change is part of.
+	DatasetVersion int64 `protobuf:"varint,2,opt,name=dataset_version,json=datasetVersion" json:"dataset_version,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *Rectangle) Reset() {
-	*x = Rectangle{}
-	mi := &file_route_guide_proto_msgTypes[1]
+func (x *FeatureMutation) Reset() {
+	*x = FeatureMutation{}
+	mi := &file_route_guide_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Rectangle) String() string {
+func (x *FeatureMutation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Rectangle) ProtoMessage() {}
+func (*FeatureMutation) ProtoMessage() {}
 
-func (x *Rectangle) ProtoReflect() protoreflect.Message {
-	mi := &file_route_guide_proto_msgTypes[1]
+func (x *FeatureMutation) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -114,53 +3501,64 @@ func (x *Rectangle) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Rectangle.ProtoReflect.Descriptor instead.
-func (*Rectangle) Descriptor() ([]byte, []int) {
-	return file_route_guide_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use FeatureMutation.ProtoReflect.Descriptor instead.
+func (*FeatureMutation) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *Rectangle) GetLo() *Point {
+func (x *FeatureMutation) GetFeature() *Feature {
 	if x != nil {
-		return x.Lo
+		return x.Feature
 	}
 	return nil
 }
 
-func (x *Rectangle) GetHi() *Point {
+func (x *FeatureMutation) GetDatasetVersion() int64 {
 	if x != nil {
-		return x.Hi
+		return x.DatasetVersion
 	}
-	return nil
+	return 0
 }
 
-// A feature names something at a given point.
-//
-// If a feature could not be named, the name is empty.
-type Feature struct {
+type FeatureSubmission struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name of the feature.
-	Name string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	// The point where the feature is detected.
-	Location      *Point `protobuf:"bytes,2,opt,name=location" json:"location,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Identifies the submission.
+	Id string `protobuf:"bytes,1,opt,name=id" json:"id,omitempty"`
+	// The submitted feature.
+	Feature *Feature `protobuf:"bytes,2,opt,name=feature" json:"feature,omitempty"`
+	// The submission's moderation state.
+	State SubmissionState `protobuf:"varint,3,opt,name=state,enum=routeguide.SubmissionState" json:"state,omitempty"`
+	// Who submitted the feature, "anonymous" for unauthenticated callers.
+	Submitter string `protobuf:"bytes,4,opt,name=submitter" json:"submitter,omitempty"`
+	// When the feature was submitted.
+	SubmittedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=submitted_at,json=submittedAt" json:"submitted_at,omitempty"`
+	// The admin who approved or rejected the submission.
+	Reviewer string `protobuf:"bytes,6,opt,name=reviewer" json:"reviewer,omitempty"`
+	// When the submission was approved or rejected.
+	ReviewedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=reviewed_at,json=reviewedAt" json:"reviewed_at,omitempty"`
+	// Why the submission was rejected.
+	Reason string `protobuf:"bytes,8,opt,name=reason" json:"reason,omitempty"`
+	// The dataset version the feature was applied in, once approved.
+	DatasetVersion int64 `protobuf:"varint,9,opt,name=dataset_version,json=datasetVersion" json:"dataset_version,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *Feature) Reset() {
-	*x = Feature{}
-	mi := &file_route_guide_proto_msgTypes[2]
+func (x *FeatureSubmission) Reset() {
+	*x = FeatureSubmission{}
+	mi := &file_route_guide_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Feature) String() string {
+func (x *FeatureSubmission) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Feature) ProtoMessage() {}
+func (*FeatureSubmission) ProtoMessage() {}
 
-func (x *Feature) ProtoReflect() protoreflect.Message {
-	mi := &file_route_guide_proto_msgTypes[2]
+func (x *FeatureSubmission) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -171,51 +3569,97 @@ func (x *Feature) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Feature.ProtoReflect.Descriptor instead.
-func (*Feature) Descriptor() ([]byte, []int) {
-	return file_route_guide_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use FeatureSubmission.ProtoReflect.Descriptor instead.
+func (*FeatureSubmission) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *Feature) GetName() string {
+func (x *FeatureSubmission) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Feature) GetLocation() *Point {
+func (x *FeatureSubmission) GetFeature() *Feature {
 	if x != nil {
-		return x.Location
+		return x.Feature
 	}
 	return nil
 }
 
-// A RouteNote is a message sent while at a given point.
-type RouteNote struct {
+func (x *FeatureSubmission) GetState() SubmissionState {
+	if x != nil {
+		return x.State
+	}
+	return SubmissionState_SUBMISSION_STATE_UNSPECIFIED
+}
+
+func (x *FeatureSubmission) GetSubmitter() string {
+	if x != nil {
+		return x.Submitter
+	}
+	return ""
+}
+
+func (x *FeatureSubmission) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+func (x *FeatureSubmission) GetReviewer() string {
+	if x != nil {
+		return x.Reviewer
+	}
+	return ""
+}
+
+func (x *FeatureSubmission) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *FeatureSubmission) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *FeatureSubmission) GetDatasetVersion() int64 {
+	if x != nil {
+		return x.DatasetVersion
+	}
+	return 0
+}
+
+type GetClientConfigRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The location from which the message is sent.
-	Location *Point `protobuf:"bytes,1,opt,name=location" json:"location,omitempty"`
-	// The message to be sent.
-	Message       string `protobuf:"bytes,2,opt,name=message" json:"message,omitempty"`
+	// The client's app version, e.g. "2.3.1", logged by the server.
+	ClientVersion string `protobuf:"bytes,1,opt,name=client_version,json=clientVersion" json:"client_version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RouteNote) Reset() {
-	*x = RouteNote{}
-	mi := &file_route_guide_proto_msgTypes[3]
+func (x *GetClientConfigRequest) Reset() {
+	*x = GetClientConfigRequest{}
+	mi := &file_route_guide_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RouteNote) String() string {
+func (x *GetClientConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RouteNote) ProtoMessage() {}
+func (*GetClientConfigRequest) ProtoMessage() {}
 
-func (x *RouteNote) ProtoReflect() protoreflect.Message {
-	mi := &file_route_guide_proto_msgTypes[3]
+func (x *GetClientConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -226,59 +3670,135 @@ func (x *RouteNote) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RouteNote.ProtoReflect.Descriptor instead.
-func (*RouteNote) Descriptor() ([]byte, []int) {
-	return file_route_guide_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use GetClientConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetClientConfigRequest) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *RouteNote) GetLocation() *Point {
+func (x *GetClientConfigRequest) GetClientVersion() string {
 	if x != nil {
-		return x.Location
+		return x.ClientVersion
+	}
+	return ""
+}
+
+// Settings recommended to clients. Unset fields mean the client should keep
+// its built-in default.
+type ClientConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How often WatchFeatures sends heartbeats; clients should consider a
+	// stream dead after missing a few.
+	HeartbeatInterval *durationpb.Duration `protobuf:"bytes,1,opt,name=heartbeat_interval,json=heartbeatInterval" json:"heartbeat_interval,omitempty"`
+	// The page size to request from paginated RPCs such as ListFeaturesPage.
+	PageSize int32 `protobuf:"varint,2,opt,name=page_size,json=pageSize" json:"page_size,omitempty"`
+	// How to retry failed calls.
+	Retry *RetryPolicy `protobuf:"bytes,3,opt,name=retry" json:"retry,omitempty"`
+	// Client features to turn on or off, by name.
+	FeatureFlags map[string]bool `protobuf:"bytes,4,rep,name=feature_flags,json=featureFlags" json:"feature_flags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// How long the client may use this config before fetching it again.
+	RefreshInterval *durationpb.Duration `protobuf:"bytes,5,opt,name=refresh_interval,json=refreshInterval" json:"refresh_interval,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ClientConfig) Reset() {
+	*x = ClientConfig{}
+	mi := &file_route_guide_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClientConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientConfig) ProtoMessage() {}
+
+func (x *ClientConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientConfig.ProtoReflect.Descriptor instead.
+func (*ClientConfig) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ClientConfig) GetHeartbeatInterval() *durationpb.Duration {
+	if x != nil {
+		return x.HeartbeatInterval
 	}
 	return nil
 }
 
-func (x *RouteNote) GetMessage() string {
+func (x *ClientConfig) GetPageSize() int32 {
 	if x != nil {
-		return x.Message
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-// A RouteSummary is received in response to a RecordRoute rpc.
-//
-// It contains the number of individual points received, the number of
-// detected features, and the total distance covered as the cumulative sum of
-// the distance between each point.
-type RouteSummary struct {
+func (x *ClientConfig) GetRetry() *RetryPolicy {
+	if x != nil {
+		return x.Retry
+	}
+	return nil
+}
+
+func (x *ClientConfig) GetFeatureFlags() map[string]bool {
+	if x != nil {
+		return x.FeatureFlags
+	}
+	return nil
+}
+
+func (x *ClientConfig) GetRefreshInterval() *durationpb.Duration {
+	if x != nil {
+		return x.RefreshInterval
+	}
+	return nil
+}
+
+// Exponential backoff settings for retrying failed calls.
+type RetryPolicy struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The number of points received.
-	PointCount int32 `protobuf:"varint,1,opt,name=point_count,json=pointCount" json:"point_count,omitempty"`
-	// The number of known features passed while traversing the route.
-	FeatureCount int32 `protobuf:"varint,2,opt,name=feature_count,json=featureCount" json:"feature_count,omitempty"`
-	// The distance covered in metres.
-	Distance int32 `protobuf:"varint,3,opt,name=distance" json:"distance,omitempty"`
-	// The duration of the traversal in seconds.
-	ElapsedTime   int32 `protobuf:"varint,4,opt,name=elapsed_time,json=elapsedTime" json:"elapsed_time,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// The maximum number of attempts of a call, including the first.
+	MaxAttempts int32 `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts" json:"max_attempts,omitempty"`
+	// The delay before the first retry.
+	InitialBackoff *durationpb.Duration `protobuf:"bytes,2,opt,name=initial_backoff,json=initialBackoff" json:"initial_backoff,omitempty"`
+	// The maximum delay between retries.
+	MaxBackoff *durationpb.Duration `protobuf:"bytes,3,opt,name=max_backoff,json=maxBackoff" json:"max_backoff,omitempty"`
+	// The factor the delay grows by after each retry.
+	BackoffMultiplier float64 `protobuf:"fixed64,4,opt,name=backoff_multiplier,json=backoffMultiplier" json:"backoff_multiplier,omitempty"`
+	// The status codes worth retrying, e.g. "UNAVAILABLE". Calls failing with
+	// RESOURCE_EXHAUSTED should wait at least their RetryInfo delay.
+	RetryableCodes []string `protobuf:"bytes,5,rep,name=retryable_codes,json=retryableCodes" json:"retryable_codes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *RouteSummary) Reset() {
-	*x = RouteSummary{}
-	mi := &file_route_guide_proto_msgTypes[4]
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	mi := &file_route_guide_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RouteSummary) String() string {
+func (x *RetryPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RouteSummary) ProtoMessage() {}
+func (*RetryPolicy) ProtoMessage() {}
 
-func (x *RouteSummary) ProtoReflect() protoreflect.Message {
-	mi := &file_route_guide_proto_msgTypes[4]
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_route_guide_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -289,70 +3809,352 @@ func (x *RouteSummary) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RouteSummary.ProtoReflect.Descriptor instead.
-func (*RouteSummary) Descriptor() ([]byte, []int) {
-	return file_route_guide_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_route_guide_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *RouteSummary) GetPointCount() int32 {
+func (x *RetryPolicy) GetMaxAttempts() int32 {
 	if x != nil {
-		return x.PointCount
+		return x.MaxAttempts
 	}
 	return 0
 }
 
-func (x *RouteSummary) GetFeatureCount() int32 {
+func (x *RetryPolicy) GetInitialBackoff() *durationpb.Duration {
 	if x != nil {
-		return x.FeatureCount
+		return x.InitialBackoff
 	}
-	return 0
+	return nil
 }
 
-func (x *RouteSummary) GetDistance() int32 {
+func (x *RetryPolicy) GetMaxBackoff() *durationpb.Duration {
 	if x != nil {
-		return x.Distance
+		return x.MaxBackoff
 	}
-	return 0
+	return nil
 }
 
-func (x *RouteSummary) GetElapsedTime() int32 {
+func (x *RetryPolicy) GetBackoffMultiplier() float64 {
 	if x != nil {
-		return x.ElapsedTime
+		return x.BackoffMultiplier
 	}
 	return 0
 }
 
+func (x *RetryPolicy) GetRetryableCodes() []string {
+	if x != nil {
+		return x.RetryableCodes
+	}
+	return nil
+}
+
 var File_route_guide_proto protoreflect.FileDescriptor
 
 const file_route_guide_proto_rawDesc = "" +
 	"\n" +
 	"\x11route_guide.proto\x12\n" +
-	"routeguide\"A\n" +
+	"routeguide\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"]\n" +
 	"\x05Point\x12\x1a\n" +
 	"\blatitude\x18\x01 \x01(\x05R\blatitude\x12\x1c\n" +
-	"\tlongitude\x18\x02 \x01(\x05R\tlongitude\"Q\n" +
+	"\tlongitude\x18\x02 \x01(\x05R\tlongitude\x12\f\n" +
+	"\x01x\x18\x03 \x01(\x01R\x01x\x12\f\n" +
+	"\x01y\x18\x04 \x01(\x01R\x01y\"\xbd\x01\n" +
 	"\tRectangle\x12!\n" +
 	"\x02lo\x18\x01 \x01(\v2\x11.routeguide.PointR\x02lo\x12!\n" +
-	"\x02hi\x18\x02 \x01(\v2\x11.routeguide.PointR\x02hi\"L\n" +
+	"\x02hi\x18\x02 \x01(\v2\x11.routeguide.PointR\x02hi\x127\n" +
+	"\vname_filter\x18\x03 \x01(\v2\x16.routeguide.NameFilterR\n" +
+	"nameFilter\x121\n" +
+	"\x14crosses_antimeridian\x18\x04 \x01(\bR\x13crossesAntimeridian\"\x98\x01\n" +
+	"\n" +
+	"NameFilter\x12\x1a\n" +
+	"\bcontains\x18\x01 \x01(\tR\bcontains\x12\x16\n" +
+	"\x06prefix\x18\x02 \x01(\tR\x06prefix\x12\x1d\n" +
+	"\n" +
+	"named_only\x18\x03 \x01(\bR\tnamedOnly\x127\n" +
+	"\tcollation\x18\x04 \x01(\x0e2\x19.routeguide.NameCollationR\tcollation\"4\n" +
+	"\aPolygon\x12)\n" +
+	"\x06points\x18\x01 \x03(\v2\x11.routeguide.PointR\x06points\"L\n" +
 	"\aFeature\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12-\n" +
-	"\blocation\x18\x02 \x01(\v2\x11.routeguide.PointR\blocation\"T\n" +
+	"\blocation\x18\x02 \x01(\v2\x11.routeguide.PointR\blocation\"\x8a\x01\n" +
+	"\x17ListFeaturesPageRequest\x123\n" +
+	"\trectangle\x18\x01 \x01(\v2\x15.routeguide.RectangleR\trectangle\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\x80\x01\n" +
+	"\vFeaturePage\x12/\n" +
+	"\bfeatures\x18\x01 \x03(\v2\x13.routeguide.FeatureR\bfeatures\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x03R\aversion\"\x85\x02\n" +
 	"\tRouteNote\x12-\n" +
 	"\blocation\x18\x01 \x01(\v2\x11.routeguide.PointR\blocation\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x93\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x16\n" +
+	"\x06author\x18\x03 \x01(\tR\x06author\x12\x0e\n" +
+	"\x02id\x18\x04 \x01(\tR\x02id\x12%\n" +
+	"\x0eschema_version\x18\x05 \x01(\rR\rschemaVersion\x12#\n" +
+	"\rhistory_token\x18\x06 \x01(\tR\fhistoryToken\x12;\n" +
+	"\vreceived_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"receivedAt\"\xde\x02\n" +
 	"\fRouteSummary\x12\x1f\n" +
 	"\vpoint_count\x18\x01 \x01(\x05R\n" +
 	"pointCount\x12#\n" +
 	"\rfeature_count\x18\x02 \x01(\x05R\ffeatureCount\x12\x1a\n" +
 	"\bdistance\x18\x03 \x01(\x05R\bdistance\x12!\n" +
-	"\felapsed_time\x18\x04 \x01(\x05R\velapsedTime2\x85\x02\n" +
+	"\felapsed_time\x18\x04 \x01(\x05R\velapsedTime\x126\n" +
+	"\tanomalies\x18\x05 \x03(\v2\x18.routeguide.RouteAnomalyR\tanomalies\x123\n" +
+	"\aprofile\x18\x06 \x01(\x0e2\x19.routeguide.TravelProfileR\aprofile\x12%\n" +
+	"\x0eestimated_time\x18\a \x01(\x05R\restimatedTime\x12\x1a\n" +
+	"\bcalories\x18\b \x01(\x05R\bcalories\x12\x19\n" +
+	"\broute_id\x18\t \x01(\tR\arouteId\"\xa6\x02\n" +
+	"\fRouteAnomaly\x121\n" +
+	"\x04kind\x18\x01 \x01(\x0e2\x1d.routeguide.RouteAnomaly.KindR\x04kind\x12\x1f\n" +
+	"\vpoint_index\x18\x02 \x01(\x05R\n" +
+	"pointIndex\x12%\n" +
+	"\x04from\x18\x03 \x01(\v2\x11.routeguide.PointR\x04from\x12!\n" +
+	"\x02to\x18\x04 \x01(\v2\x11.routeguide.PointR\x02to\x12\x1a\n" +
+	"\bdistance\x18\x05 \x01(\x05R\bdistance\x12\x1b\n" +
+	"\tspeed_kmh\x18\x06 \x01(\x01R\bspeedKmh\"?\n" +
+	"\x04Kind\x12\x14\n" +
+	"\x10KIND_UNSPECIFIED\x10\x00\x12\f\n" +
+	"\bTELEPORT\x10\x01\x12\x13\n" +
+	"\x0fEXCESSIVE_SPEED\x10\x02\"\xa4\x01\n" +
+	"\x10IsochroneRequest\x12)\n" +
+	"\x06center\x18\x01 \x01(\v2\x11.routeguide.PointR\x06center\x12\x1a\n" +
+	"\bduration\x18\x02 \x01(\x05R\bduration\x123\n" +
+	"\aprofile\x18\x03 \x01(\x0e2\x19.routeguide.TravelProfileR\aprofile\x12\x14\n" +
+	"\x05bands\x18\x04 \x01(\x05R\x05bands\"V\n" +
+	"\rIsochroneRing\x12\x1a\n" +
+	"\bduration\x18\x01 \x01(\x05R\bduration\x12)\n" +
+	"\x06points\x18\x02 \x03(\v2\x11.routeguide.PointR\x06points\"X\n" +
+	"\bRouteRef\x12\x10\n" +
+	"\x02id\x18\x01 \x01(\tH\x00R\x02id\x121\n" +
+	"\x06points\x18\x02 \x01(\v2\x17.routeguide.RoutePointsH\x00R\x06pointsB\a\n" +
+	"\x05route\"8\n" +
+	"\vRoutePoints\x12)\n" +
+	"\x06points\x18\x01 \x03(\v2\x11.routeguide.PointR\x06points\"\x8e\x01\n" +
+	"\x14CompareRoutesRequest\x12*\n" +
+	"\x05first\x18\x01 \x01(\v2\x14.routeguide.RouteRefR\x05first\x12,\n" +
+	"\x06second\x18\x02 \x01(\v2\x14.routeguide.RouteRefR\x06second\x12\x1c\n" +
+	"\ttolerance\x18\x03 \x01(\x05R\ttolerance\"\xe2\x01\n" +
+	"\x0fRouteComparison\x12\x18\n" +
+	"\aoverlap\x18\x01 \x01(\x01R\aoverlap\x12>\n" +
+	"\x11divergence_points\x18\x02 \x03(\v2\x11.routeguide.PointR\x10divergencePoints\x12%\n" +
+	"\x0edistance_delta\x18\x03 \x01(\x05R\rdistanceDelta\x12%\n" +
+	"\x0efirst_distance\x18\x04 \x01(\x05R\rfirstDistance\x12'\n" +
+	"\x0fsecond_distance\x18\x05 \x01(\x05R\x0esecondDistance\"\xbb\x01\n" +
+	"\x11TravelTimeRequest\x12'\n" +
+	"\x05start\x18\x01 \x01(\v2\x11.routeguide.PointR\x05start\x12#\n" +
+	"\x03end\x18\x02 \x01(\v2\x11.routeguide.PointR\x03end\x123\n" +
+	"\aprofile\x18\x03 \x01(\x0e2\x19.routeguide.TravelProfileR\aprofile\x12#\n" +
+	"\rsearch_radius\x18\x04 \x01(\x05R\fsearchRadius\"\xef\x01\n" +
+	"\x12TravelTimeEstimate\x12\x1a\n" +
+	"\bduration\x18\x01 \x01(\x05R\bduration\x12\x1a\n" +
+	"\bdistance\x18\x02 \x01(\x05R\bdistance\x12!\n" +
+	"\fsample_count\x18\x03 \x01(\x05R\vsampleCount\x12=\n" +
+	"\x06source\x18\x04 \x01(\x0e2%.routeguide.TravelTimeEstimate.SourceR\x06source\"?\n" +
+	"\x06Source\x12\x16\n" +
+	"\x12SOURCE_UNSPECIFIED\x10\x00\x12\x0e\n" +
+	"\n" +
+	"HISTORICAL\x10\x01\x12\r\n" +
+	"\tHEURISTIC\x10\x02\"9\n" +
+	"\x14WatchFeaturesRequest\x12!\n" +
+	"\fresume_token\x18\x01 \x01(\tR\vresumeToken\"\xcc\x02\n" +
+	"\fFeatureEvent\x121\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x1d.routeguide.FeatureEvent.TypeR\x04type\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x12#\n" +
+	"\rfeature_count\x18\x03 \x01(\x05R\ffeatureCount\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x127\n" +
+	"\tloaded_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bloadedAt\x12!\n" +
+	"\fresume_token\x18\x06 \x01(\tR\vresumeToken\"V\n" +
+	"\x04Type\x12\x14\n" +
+	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fDATASET_CURRENT\x10\x01\x12\x14\n" +
+	"\x10DATASET_REPLACED\x10\x02\x12\r\n" +
+	"\tHEARTBEAT\x10\x03\"\xb0\x01\n" +
+	"\x12NoteHistoryRequest\x12-\n" +
+	"\blocation\x18\x01 \x01(\v2\x11.routeguide.PointR\blocation\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\x12/\n" +
+	"\x05as_of\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x04asOf\"\x85\x01\n" +
+	"\x0fNoteHistoryPage\x12+\n" +
+	"\x05notes\x18\x01 \x03(\v2\x15.routeguide.RouteNoteR\x05notes\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x05R\ttotalSize\"M\n" +
+	"\vSyncRequest\x12!\n" +
+	"\fresume_token\x18\x01 \x01(\tR\vresumeToken\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"\xbf\x01\n" +
+	"\vSyncMessage\x126\n" +
+	"\bsnapshot\x18\x01 \x01(\v2\x18.routeguide.SnapshotPageH\x00R\bsnapshot\x120\n" +
+	"\x05delta\x18\x02 \x01(\v2\x18.routeguide.FeatureDeltaH\x00R\x05delta\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x03R\aversion\x12!\n" +
+	"\fresume_token\x18\x04 \x01(\tR\vresumeTokenB\t\n" +
+	"\apayload\"i\n" +
+	"\fSnapshotPage\x12/\n" +
+	"\bfeatures\x18\x01 \x03(\v2\x13.routeguide.FeatureR\bfeatures\x12\x14\n" +
+	"\x05first\x18\x02 \x01(\bR\x05first\x12\x12\n" +
+	"\x04last\x18\x03 \x01(\bR\x04last\"l\n" +
+	"\fFeatureDelta\x12/\n" +
+	"\bupserted\x18\x01 \x03(\v2\x13.routeguide.FeatureR\bupserted\x12+\n" +
+	"\aremoved\x18\x02 \x03(\v2\x11.routeguide.PointR\aremoved\"\xf7\x01\n" +
+	"\rBundleRequest\x12-\n" +
+	"\x06region\x18\x01 \x01(\v2\x15.routeguide.RectangleR\x06region\x12#\n" +
+	"\rinclude_tiles\x18\x02 \x01(\bR\fincludeTiles\x12\"\n" +
+	"\rmax_tile_zoom\x18\x03 \x01(\x05R\vmaxTileZoom\x12#\n" +
+	"\restimate_only\x18\x04 \x01(\bR\festimateOnly\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x03R\x06offset\x12\x12\n" +
+	"\x04etag\x18\x06 \x01(\tR\x04etag\x12\x1d\n" +
+	"\n" +
+	"chunk_size\x18\a \x01(\x05R\tchunkSize\"\xb0\x01\n" +
+	"\vBundleChunk\x12\x16\n" +
+	"\x06offset\x18\x01 \x01(\x03R\x06offset\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\x12\x1d\n" +
+	"\n" +
+	"total_size\x18\x03 \x01(\x03R\ttotalSize\x12\x12\n" +
+	"\x04etag\x18\x04 \x01(\tR\x04etag\x12#\n" +
+	"\rfeature_count\x18\x05 \x01(\x05R\ffeatureCount\x12\x1d\n" +
+	"\n" +
+	"tile_count\x18\x06 \x01(\x05R\ttileCount\"\x7f\n" +
+	"\x13ChatActivityRequest\x12-\n" +
+	"\x06region\x18\x01 \x01(\v2\x15.routeguide.RectangleR\x06region\x12\x12\n" +
+	"\x04zoom\x18\x02 \x01(\x05R\x04zoom\x12%\n" +
+	"\x0ewindow_minutes\x18\x03 \x01(\x05R\rwindowMinutes\"]\n" +
+	"\fChatActivity\x127\n" +
+	"\bclusters\x18\x01 \x03(\v2\x1b.routeguide.ActivityClusterR\bclusters\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\x9e\x01\n" +
+	"\x0fActivityCluster\x12\x15\n" +
+	"\x06tile_x\x18\x01 \x01(\x05R\x05tileX\x12\x15\n" +
+	"\x06tile_y\x18\x02 \x01(\x05R\x05tileY\x12)\n" +
+	"\x06center\x18\x03 \x01(\v2\x11.routeguide.PointR\x06center\x12\x14\n" +
+	"\x05count\x18\x04 \x01(\x03R\x05count\x12\x1c\n" +
+	"\tlocations\x18\x05 \x01(\x05R\tlocations\"B\n" +
+	"\x13DatasetStatsRequest\x12+\n" +
+	"\x11geohash_precision\x18\x01 \x01(\x05R\x10geohashPrecision\"?\n" +
+	"\rGeohashBucket\x12\x18\n" +
+	"\ageohash\x18\x01 \x01(\tR\ageohash\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\xb2\x03\n" +
+	"\fDatasetStats\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\x12\x16\n" +
+	"\x06source\x18\x02 \x01(\tR\x06source\x127\n" +
+	"\tloaded_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bloadedAt\x12(\n" +
+	"\x10load_duration_ms\x18\x04 \x01(\x03R\x0eloadDurationMs\x12#\n" +
+	"\rfeature_count\x18\x05 \x01(\x05R\ffeatureCount\x12-\n" +
+	"\x06bounds\x18\x06 \x01(\v2\x15.routeguide.RectangleR\x06bounds\x123\n" +
+	"\adensity\x18\a \x03(\v2\x19.routeguide.GeohashBucketR\adensity\x12'\n" +
+	"\x0fduplicate_count\x18\b \x01(\x05R\x0eduplicateCount\x12#\n" +
+	"\rskipped_count\x18\t \x01(\x05R\fskippedCount\x126\n" +
+	"\vload_errors\x18\n" +
+	" \x03(\v2\x15.routeguide.LoadErrorR\n" +
+	"loadErrors\"K\n" +
+	"\tLoadError\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\"\x85\x01\n" +
+	"\vSnapRequest\x12'\n" +
+	"\x05point\x18\x01 \x01(\v2\x11.routeguide.PointR\x05point\x12.\n" +
+	"\x13max_distance_meters\x18\x02 \x01(\x05R\x11maxDistanceMeters\x12\x1d\n" +
+	"\n" +
+	"named_only\x18\x03 \x01(\bR\tnamedOnly\"\xa7\x01\n" +
+	"\n" +
+	"SnapResult\x12\x18\n" +
+	"\asnapped\x18\x01 \x01(\bR\asnapped\x12'\n" +
+	"\x05point\x18\x02 \x01(\v2\x11.routeguide.PointR\x05point\x12-\n" +
+	"\afeature\x18\x03 \x01(\v2\x13.routeguide.FeatureR\afeature\x12'\n" +
+	"\x0fdistance_meters\x18\x04 \x01(\x05R\x0edistanceMeters\"O\n" +
+	"\x16NearestFeaturesRequest\x12'\n" +
+	"\x05point\x18\x01 \x01(\v2\x11.routeguide.PointR\x05point\x12\f\n" +
+	"\x01k\x18\x02 \x01(\x05R\x01k\"_\n" +
+	"\rRadiusRequest\x12)\n" +
+	"\x06center\x18\x01 \x01(\v2\x11.routeguide.PointR\x06center\x12#\n" +
+	"\rradius_meters\x18\x02 \x01(\x05R\fradiusMeters\"h\n" +
+	"\x0eNearestFeature\x12-\n" +
+	"\afeature\x18\x01 \x01(\v2\x13.routeguide.FeatureR\afeature\x12'\n" +
+	"\x0fdistance_meters\x18\x02 \x01(\x05R\x0edistanceMeters\"Q\n" +
+	"\x17NearestFeaturesResponse\x126\n" +
+	"\bfeatures\x18\x01 \x03(\v2\x1a.routeguide.NearestFeatureR\bfeatures\"E\n" +
+	"\x14SubmitFeatureRequest\x12-\n" +
+	"\afeature\x18\x01 \x01(\v2\x13.routeguide.FeatureR\afeature\"E\n" +
+	"\x14CreateFeatureRequest\x12-\n" +
+	"\afeature\x18\x01 \x01(\v2\x13.routeguide.FeatureR\afeature\"E\n" +
+	"\x14UpdateFeatureRequest\x12-\n" +
+	"\afeature\x18\x01 \x01(\v2\x13.routeguide.FeatureR\afeature\"E\n" +
+	"\x14DeleteFeatureRequest\x12-\n" +
+	"\blocation\x18\x01 \x01(\v2\x11.routeguide.PointR\blocation\"i\n" +
+	"\x0fFeatureMutation\x12-\n" +
+	"\afeature\x18\x01 \x01(\v2\x13.routeguide.FeatureR\afeature\x12'\n" +
+	"\x0fdataset_version\x18\x02 \x01(\x03R\x0edatasetVersion\"\xfc\x02\n" +
+	"\x11FeatureSubmission\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12-\n" +
+	"\afeature\x18\x02 \x01(\v2\x13.routeguide.FeatureR\afeature\x121\n" +
+	"\x05state\x18\x03 \x01(\x0e2\x1b.routeguide.SubmissionStateR\x05state\x12\x1c\n" +
+	"\tsubmitter\x18\x04 \x01(\tR\tsubmitter\x12=\n" +
+	"\fsubmitted_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\x12\x1a\n" +
+	"\breviewer\x18\x06 \x01(\tR\breviewer\x12;\n" +
+	"\vreviewed_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\x12\x16\n" +
+	"\x06reason\x18\b \x01(\tR\x06reason\x12'\n" +
+	"\x0fdataset_version\x18\t \x01(\x03R\x0edatasetVersion\"?\n" +
+	"\x16GetClientConfigRequest\x12%\n" +
+	"\x0eclient_version\x18\x01 \x01(\tR\rclientVersion\"\xfc\x02\n" +
+	"\fClientConfig\x12H\n" +
+	"\x12heartbeat_interval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x11heartbeatInterval\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12-\n" +
+	"\x05retry\x18\x03 \x01(\v2\x17.routeguide.RetryPolicyR\x05retry\x12O\n" +
+	"\rfeature_flags\x18\x04 \x03(\v2*.routeguide.ClientConfig.FeatureFlagsEntryR\ffeatureFlags\x12D\n" +
+	"\x10refresh_interval\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x0frefreshInterval\x1a?\n" +
+	"\x11FeatureFlagsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\bR\x05value:\x028\x01\"\x88\x02\n" +
+	"\vRetryPolicy\x12!\n" +
+	"\fmax_attempts\x18\x01 \x01(\x05R\vmaxAttempts\x12B\n" +
+	"\x0finitial_backoff\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\x0einitialBackoff\x12:\n" +
+	"\vmax_backoff\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"maxBackoff\x12-\n" +
+	"\x12backoff_multiplier\x18\x04 \x01(\x01R\x11backoffMultiplier\x12'\n" +
+	"\x0fretryable_codes\x18\x05 \x03(\tR\x0eretryableCodes*\x83\x01\n" +
+	"\rTravelProfile\x12\x1e\n" +
+	"\x1aTRAVEL_PROFILE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16TRAVEL_PROFILE_WALKING\x10\x01\x12\x1a\n" +
+	"\x16TRAVEL_PROFILE_CYCLING\x10\x02\x12\x1a\n" +
+	"\x16TRAVEL_PROFILE_DRIVING\x10\x03*m\n" +
+	"\rNameCollation\x12\x18\n" +
+	"\x14NAME_COLLATION_EXACT\x10\x00\x12#\n" +
+	"\x1fNAME_COLLATION_CASE_INSENSITIVE\x10\x01\x12\x1d\n" +
+	"\x19NAME_COLLATION_NORMALIZED\x10\x02*\x8f\x01\n" +
+	"\x0fSubmissionState\x12 \n" +
+	"\x1cSUBMISSION_STATE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18SUBMISSION_STATE_PENDING\x10\x01\x12\x1d\n" +
+	"\x19SUBMISSION_STATE_APPROVED\x10\x02\x12\x1d\n" +
+	"\x19SUBMISSION_STATE_REJECTED\x10\x032\xc8\x0e\n" +
 	"\n" +
 	"RouteGuide\x126\n" +
 	"\n" +
-	"GetFeature\x12\x11.routeguide.Point\x1a\x13.routeguide.Feature\"\x00\x12>\n" +
-	"\fListFeatures\x12\x15.routeguide.Rectangle\x1a\x13.routeguide.Feature\"\x000\x01\x12>\n" +
+	"GetFeature\x12\x11.routeguide.Point\x1a\x13.routeguide.Feature\"\x00\x12:\n" +
+	"\x0eGetFeatureFast\x12\x11.routeguide.Point\x1a\x13.routeguide.Feature\"\x00\x12>\n" +
+	"\fListFeatures\x12\x15.routeguide.Rectangle\x1a\x13.routeguide.Feature\"\x000\x01\x12R\n" +
+	"\x10ListFeaturesPage\x12#.routeguide.ListFeaturesPageRequest\x1a\x17.routeguide.FeaturePage\"\x00\x12>\n" +
 	"\vRecordRoute\x12\x11.routeguide.Point\x1a\x18.routeguide.RouteSummary\"\x00(\x01\x12?\n" +
-	"\tRouteChat\x12\x15.routeguide.RouteNote\x1a\x15.routeguide.RouteNote\"\x00(\x010\x01Br\n" +
+	"\tRouteChat\x12\x15.routeguide.RouteNote\x1a\x15.routeguide.RouteNote\"\x00(\x010\x01\x12P\n" +
+	"\x0fListNoteHistory\x12\x1e.routeguide.NoteHistoryRequest\x1a\x1b.routeguide.NoteHistoryPage\"\x00\x12O\n" +
+	"\x10ComputeIsochrone\x12\x1c.routeguide.IsochroneRequest\x1a\x19.routeguide.IsochroneRing\"\x000\x01\x12P\n" +
+	"\rCompareRoutes\x12 .routeguide.CompareRoutesRequest\x1a\x1b.routeguide.RouteComparison\"\x00\x12U\n" +
+	"\x12EstimateTravelTime\x12\x1d.routeguide.TravelTimeRequest\x1a\x1e.routeguide.TravelTimeEstimate\"\x00\x12O\n" +
+	"\rWatchFeatures\x12 .routeguide.WatchFeaturesRequest\x1a\x18.routeguide.FeatureEvent\"\x000\x01\x12D\n" +
+	"\fSyncFeatures\x12\x17.routeguide.SyncRequest\x1a\x17.routeguide.SyncMessage\"\x000\x01\x12N\n" +
+	"\x0fGetDatasetStats\x12\x1f.routeguide.DatasetStatsRequest\x1a\x18.routeguide.DatasetStats\"\x00\x12N\n" +
+	"\x0fGetChatActivity\x12\x1f.routeguide.ChatActivityRequest\x1a\x18.routeguide.ChatActivity\"\x00\x12N\n" +
+	"\x14DownloadRegionBundle\x12\x19.routeguide.BundleRequest\x1a\x17.routeguide.BundleChunk\"\x000\x01\x12I\n" +
+	"\x14SnapToNearestFeature\x12\x17.routeguide.SnapRequest\x1a\x16.routeguide.SnapResult\"\x00\x12\\\n" +
+	"\x0fNearestFeatures\x12\".routeguide.NearestFeaturesRequest\x1a#.routeguide.NearestFeaturesResponse\"\x00\x12Q\n" +
+	"\x14ListFeaturesInRadius\x12\x19.routeguide.RadiusRequest\x1a\x1a.routeguide.NearestFeature\"\x000\x01\x12E\n" +
+	"\x15ListFeaturesInPolygon\x12\x13.routeguide.Polygon\x1a\x13.routeguide.Feature\"\x000\x01\x12R\n" +
+	"\rSubmitFeature\x12 .routeguide.SubmitFeatureRequest\x1a\x1d.routeguide.FeatureSubmission\"\x00\x12P\n" +
+	"\rCreateFeature\x12 .routeguide.CreateFeatureRequest\x1a\x1b.routeguide.FeatureMutation\"\x00\x12P\n" +
+	"\rUpdateFeature\x12 .routeguide.UpdateFeatureRequest\x1a\x1b.routeguide.FeatureMutation\"\x00\x12P\n" +
+	"\rDeleteFeature\x12 .routeguide.DeleteFeatureRequest\x1a\x1b.routeguide.FeatureMutation\"\x00\x12Q\n" +
+	"\x0fGetClientConfig\x12\".routeguide.GetClientConfigRequest\x1a\x18.routeguide.ClientConfig\"\x00Br\n" +
 	"\x1bio.grpc.examples.routeguideB\x0fRouteGuideProtoP\x01Z;github.com/dvaldivia/grpc-swift-2-example/server/gen/protos\x92\x03\x02\b\x02b\beditionsp\xe8\a"
 
 var (
@@ -367,32 +4169,190 @@ func file_route_guide_proto_rawDescGZIP() []byte {
 	return file_route_guide_proto_rawDescData
 }
 
-var file_route_guide_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_route_guide_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_route_guide_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
 var file_route_guide_proto_goTypes = []any{
-	(*Point)(nil),        // 0: routeguide.Point
-	(*Rectangle)(nil),    // 1: routeguide.Rectangle
-	(*Feature)(nil),      // 2: routeguide.Feature
-	(*RouteNote)(nil),    // 3: routeguide.RouteNote
-	(*RouteSummary)(nil), // 4: routeguide.RouteSummary
+	(TravelProfile)(0),              // 0: routeguide.TravelProfile
+	(NameCollation)(0),              // 1: routeguide.NameCollation
+	(SubmissionState)(0),            // 2: routeguide.SubmissionState
+	(RouteAnomaly_Kind)(0),          // 3: routeguide.RouteAnomaly.Kind
+	(TravelTimeEstimate_Source)(0),  // 4: routeguide.TravelTimeEstimate.Source
+	(FeatureEvent_Type)(0),          // 5: routeguide.FeatureEvent.Type
+	(*Point)(nil),                   // 6: routeguide.Point
+	(*Rectangle)(nil),               // 7: routeguide.Rectangle
+	(*NameFilter)(nil),              // 8: routeguide.NameFilter
+	(*Polygon)(nil),                 // 9: routeguide.Polygon
+	(*Feature)(nil),                 // 10: routeguide.Feature
+	(*ListFeaturesPageRequest)(nil), // 11: routeguide.ListFeaturesPageRequest
+	(*FeaturePage)(nil),             // 12: routeguide.FeaturePage
+	(*RouteNote)(nil),               // 13: routeguide.RouteNote
+	(*RouteSummary)(nil),            // 14: routeguide.RouteSummary
+	(*RouteAnomaly)(nil),            // 15: routeguide.RouteAnomaly
+	(*IsochroneRequest)(nil),        // 16: routeguide.IsochroneRequest
+	(*IsochroneRing)(nil),           // 17: routeguide.IsochroneRing
+	(*RouteRef)(nil),                // 18: routeguide.RouteRef
+	(*RoutePoints)(nil),             // 19: routeguide.RoutePoints
+	(*CompareRoutesRequest)(nil),    // 20: routeguide.CompareRoutesRequest
+	(*RouteComparison)(nil),         // 21: routeguide.RouteComparison
+	(*TravelTimeRequest)(nil),       // 22: routeguide.TravelTimeRequest
+	(*TravelTimeEstimate)(nil),      // 23: routeguide.TravelTimeEstimate
+	(*WatchFeaturesRequest)(nil),    // 24: routeguide.WatchFeaturesRequest
+	(*FeatureEvent)(nil),            // 25: routeguide.FeatureEvent
+	(*NoteHistoryRequest)(nil),      // 26: routeguide.NoteHistoryRequest
+	(*NoteHistoryPage)(nil),         // 27: routeguide.NoteHistoryPage
+	(*SyncRequest)(nil),             // 28: routeguide.SyncRequest
+	(*SyncMessage)(nil),             // 29: routeguide.SyncMessage
+	(*SnapshotPage)(nil),            // 30: routeguide.SnapshotPage
+	(*FeatureDelta)(nil),            // 31: routeguide.FeatureDelta
+	(*BundleRequest)(nil),           // 32: routeguide.BundleRequest
+	(*BundleChunk)(nil),             // 33: routeguide.BundleChunk
+	(*ChatActivityRequest)(nil),     // 34: routeguide.ChatActivityRequest
+	(*ChatActivity)(nil),            // 35: routeguide.ChatActivity
+	(*ActivityCluster)(nil),         // 36: routeguide.ActivityCluster
+	(*DatasetStatsRequest)(nil),     // 37: routeguide.DatasetStatsRequest
+	(*GeohashBucket)(nil),           // 38: routeguide.GeohashBucket
+	(*DatasetStats)(nil),            // 39: routeguide.DatasetStats
+	(*LoadError)(nil),               // 40: routeguide.LoadError
+	(*SnapRequest)(nil),             // 41: routeguide.SnapRequest
+	(*SnapResult)(nil),              // 42: routeguide.SnapResult
+	(*NearestFeaturesRequest)(nil),  // 43: routeguide.NearestFeaturesRequest
+	(*RadiusRequest)(nil),           // 44: routeguide.RadiusRequest
+	(*NearestFeature)(nil),          // 45: routeguide.NearestFeature
+	(*NearestFeaturesResponse)(nil), // 46: routeguide.NearestFeaturesResponse
+	(*SubmitFeatureRequest)(nil),    // 47: routeguide.SubmitFeatureRequest
+	(*CreateFeatureRequest)(nil),    // 48: routeguide.CreateFeatureRequest
+	(*UpdateFeatureRequest)(nil),    // 49: routeguide.UpdateFeatureRequest
+	(*DeleteFeatureRequest)(nil),    // 50: routeguide.DeleteFeatureRequest
+	(*FeatureMutation)(nil),         // 51: routeguide.FeatureMutation
+	(*FeatureSubmission)(nil),       // 52: routeguide.FeatureSubmission
+	(*GetClientConfigRequest)(nil),  // 53: routeguide.GetClientConfigRequest
+	(*ClientConfig)(nil),            // 54: routeguide.ClientConfig
+	(*RetryPolicy)(nil),             // 55: routeguide.RetryPolicy
+	nil,                             // 56: routeguide.ClientConfig.FeatureFlagsEntry
+	(*timestamppb.Timestamp)(nil),   // 57: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),     // 58: google.protobuf.Duration
 }
 var file_route_guide_proto_depIdxs = []int32{
-	0, // 0: routeguide.Rectangle.lo:type_name -> routeguide.Point
-	0, // 1: routeguide.Rectangle.hi:type_name -> routeguide.Point
-	0, // 2: routeguide.Feature.location:type_name -> routeguide.Point
-	0, // 3: routeguide.RouteNote.location:type_name -> routeguide.Point
-	0, // 4: routeguide.RouteGuide.GetFeature:input_type -> routeguide.Point
-	1, // 5: routeguide.RouteGuide.ListFeatures:input_type -> routeguide.Rectangle
-	0, // 6: routeguide.RouteGuide.RecordRoute:input_type -> routeguide.Point
-	3, // 7: routeguide.RouteGuide.RouteChat:input_type -> routeguide.RouteNote
-	2, // 8: routeguide.RouteGuide.GetFeature:output_type -> routeguide.Feature
-	2, // 9: routeguide.RouteGuide.ListFeatures:output_type -> routeguide.Feature
-	4, // 10: routeguide.RouteGuide.RecordRoute:output_type -> routeguide.RouteSummary
-	3, // 11: routeguide.RouteGuide.RouteChat:output_type -> routeguide.RouteNote
-	8, // [8:12] is the sub-list for method output_type
-	4, // [4:8] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	6,  // 0: routeguide.Rectangle.lo:type_name -> routeguide.Point
+	6,  // 1: routeguide.Rectangle.hi:type_name -> routeguide.Point
+	8,  // 2: routeguide.Rectangle.name_filter:type_name -> routeguide.NameFilter
+	1,  // 3: routeguide.NameFilter.collation:type_name -> routeguide.NameCollation
+	6,  // 4: routeguide.Polygon.points:type_name -> routeguide.Point
+	6,  // 5: routeguide.Feature.location:type_name -> routeguide.Point
+	7,  // 6: routeguide.ListFeaturesPageRequest.rectangle:type_name -> routeguide.Rectangle
+	10, // 7: routeguide.FeaturePage.features:type_name -> routeguide.Feature
+	6,  // 8: routeguide.RouteNote.location:type_name -> routeguide.Point
+	57, // 9: routeguide.RouteNote.received_at:type_name -> google.protobuf.Timestamp
+	15, // 10: routeguide.RouteSummary.anomalies:type_name -> routeguide.RouteAnomaly
+	0,  // 11: routeguide.RouteSummary.profile:type_name -> routeguide.TravelProfile
+	3,  // 12: routeguide.RouteAnomaly.kind:type_name -> routeguide.RouteAnomaly.Kind
+	6,  // 13: routeguide.RouteAnomaly.from:type_name -> routeguide.Point
+	6,  // 14: routeguide.RouteAnomaly.to:type_name -> routeguide.Point
+	6,  // 15: routeguide.IsochroneRequest.center:type_name -> routeguide.Point
+	0,  // 16: routeguide.IsochroneRequest.profile:type_name -> routeguide.TravelProfile
+	6,  // 17: routeguide.IsochroneRing.points:type_name -> routeguide.Point
+	19, // 18: routeguide.RouteRef.points:type_name -> routeguide.RoutePoints
+	6,  // 19: routeguide.RoutePoints.points:type_name -> routeguide.Point
+	18, // 20: routeguide.CompareRoutesRequest.first:type_name -> routeguide.RouteRef
+	18, // 21: routeguide.CompareRoutesRequest.second:type_name -> routeguide.RouteRef
+	6,  // 22: routeguide.RouteComparison.divergence_points:type_name -> routeguide.Point
+	6,  // 23: routeguide.TravelTimeRequest.start:type_name -> routeguide.Point
+	6,  // 24: routeguide.TravelTimeRequest.end:type_name -> routeguide.Point
+	0,  // 25: routeguide.TravelTimeRequest.profile:type_name -> routeguide.TravelProfile
+	4,  // 26: routeguide.TravelTimeEstimate.source:type_name -> routeguide.TravelTimeEstimate.Source
+	5,  // 27: routeguide.FeatureEvent.type:type_name -> routeguide.FeatureEvent.Type
+	57, // 28: routeguide.FeatureEvent.loaded_at:type_name -> google.protobuf.Timestamp
+	6,  // 29: routeguide.NoteHistoryRequest.location:type_name -> routeguide.Point
+	57, // 30: routeguide.NoteHistoryRequest.as_of:type_name -> google.protobuf.Timestamp
+	13, // 31: routeguide.NoteHistoryPage.notes:type_name -> routeguide.RouteNote
+	30, // 32: routeguide.SyncMessage.snapshot:type_name -> routeguide.SnapshotPage
+	31, // 33: routeguide.SyncMessage.delta:type_name -> routeguide.FeatureDelta
+	10, // 34: routeguide.SnapshotPage.features:type_name -> routeguide.Feature
+	10, // 35: routeguide.FeatureDelta.upserted:type_name -> routeguide.Feature
+	6,  // 36: routeguide.FeatureDelta.removed:type_name -> routeguide.Point
+	7,  // 37: routeguide.BundleRequest.region:type_name -> routeguide.Rectangle
+	7,  // 38: routeguide.ChatActivityRequest.region:type_name -> routeguide.Rectangle
+	36, // 39: routeguide.ChatActivity.clusters:type_name -> routeguide.ActivityCluster
+	6,  // 40: routeguide.ActivityCluster.center:type_name -> routeguide.Point
+	57, // 41: routeguide.DatasetStats.loaded_at:type_name -> google.protobuf.Timestamp
+	7,  // 42: routeguide.DatasetStats.bounds:type_name -> routeguide.Rectangle
+	38, // 43: routeguide.DatasetStats.density:type_name -> routeguide.GeohashBucket
+	40, // 44: routeguide.DatasetStats.load_errors:type_name -> routeguide.LoadError
+	6,  // 45: routeguide.SnapRequest.point:type_name -> routeguide.Point
+	6,  // 46: routeguide.SnapResult.point:type_name -> routeguide.Point
+	10, // 47: routeguide.SnapResult.feature:type_name -> routeguide.Feature
+	6,  // 48: routeguide.NearestFeaturesRequest.point:type_name -> routeguide.Point
+	6,  // 49: routeguide.RadiusRequest.center:type_name -> routeguide.Point
+	10, // 50: routeguide.NearestFeature.feature:type_name -> routeguide.Feature
+	45, // 51: routeguide.NearestFeaturesResponse.features:type_name -> routeguide.NearestFeature
+	10, // 52: routeguide.SubmitFeatureRequest.feature:type_name -> routeguide.Feature
+	10, // 53: routeguide.CreateFeatureRequest.feature:type_name -> routeguide.Feature
+	10, // 54: routeguide.UpdateFeatureRequest.feature:type_name -> routeguide.Feature
+	6,  // 55: routeguide.DeleteFeatureRequest.location:type_name -> routeguide.Point
+	10, // 56: routeguide.FeatureMutation.feature:type_name -> routeguide.Feature
+	10, // 57: routeguide.FeatureSubmission.feature:type_name -> routeguide.Feature
+	2,  // 58: routeguide.FeatureSubmission.state:type_name -> routeguide.SubmissionState
+	57, // 59: routeguide.FeatureSubmission.submitted_at:type_name -> google.protobuf.Timestamp
+	57, // 60: routeguide.FeatureSubmission.reviewed_at:type_name -> google.protobuf.Timestamp
+	58, // 61: routeguide.ClientConfig.heartbeat_interval:type_name -> google.protobuf.Duration
+	55, // 62: routeguide.ClientConfig.retry:type_name -> routeguide.RetryPolicy
+	56, // 63: routeguide.ClientConfig.feature_flags:type_name -> routeguide.ClientConfig.FeatureFlagsEntry
+	58, // 64: routeguide.ClientConfig.refresh_interval:type_name -> google.protobuf.Duration
+	58, // 65: routeguide.RetryPolicy.initial_backoff:type_name -> google.protobuf.Duration
+	58, // 66: routeguide.RetryPolicy.max_backoff:type_name -> google.protobuf.Duration
+	6,  // 67: routeguide.RouteGuide.GetFeature:input_type -> routeguide.Point
+	6,  // 68: routeguide.RouteGuide.GetFeatureFast:input_type -> routeguide.Point
+	7,  // 69: routeguide.RouteGuide.ListFeatures:input_type -> routeguide.Rectangle
+	11, // 70: routeguide.RouteGuide.ListFeaturesPage:input_type -> routeguide.ListFeaturesPageRequest
+	6,  // 71: routeguide.RouteGuide.RecordRoute:input_type -> routeguide.Point
+	13, // 72: routeguide.RouteGuide.RouteChat:input_type -> routeguide.RouteNote
+	26, // 73: routeguide.RouteGuide.ListNoteHistory:input_type -> routeguide.NoteHistoryRequest
+	16, // 74: routeguide.RouteGuide.ComputeIsochrone:input_type -> routeguide.IsochroneRequest
+	20, // 75: routeguide.RouteGuide.CompareRoutes:input_type -> routeguide.CompareRoutesRequest
+	22, // 76: routeguide.RouteGuide.EstimateTravelTime:input_type -> routeguide.TravelTimeRequest
+	24, // 77: routeguide.RouteGuide.WatchFeatures:input_type -> routeguide.WatchFeaturesRequest
+	28, // 78: routeguide.RouteGuide.SyncFeatures:input_type -> routeguide.SyncRequest
+	37, // 79: routeguide.RouteGuide.GetDatasetStats:input_type -> routeguide.DatasetStatsRequest
+	34, // 80: routeguide.RouteGuide.GetChatActivity:input_type -> routeguide.ChatActivityRequest
+	32, // 81: routeguide.RouteGuide.DownloadRegionBundle:input_type -> routeguide.BundleRequest
+	41, // 82: routeguide.RouteGuide.SnapToNearestFeature:input_type -> routeguide.SnapRequest
+	43, // 83: routeguide.RouteGuide.NearestFeatures:input_type -> routeguide.NearestFeaturesRequest
+	44, // 84: routeguide.RouteGuide.ListFeaturesInRadius:input_type -> routeguide.RadiusRequest
+	9,  // 85: routeguide.RouteGuide.ListFeaturesInPolygon:input_type -> routeguide.Polygon
+	47, // 86: routeguide.RouteGuide.SubmitFeature:input_type -> routeguide.SubmitFeatureRequest
+	48, // 87: routeguide.RouteGuide.CreateFeature:input_type -> routeguide.CreateFeatureRequest
+	49, // 88: routeguide.RouteGuide.UpdateFeature:input_type -> routeguide.UpdateFeatureRequest
+	50, // 89: routeguide.RouteGuide.DeleteFeature:input_type -> routeguide.DeleteFeatureRequest
+	53, // 90: routeguide.RouteGuide.GetClientConfig:input_type -> routeguide.GetClientConfigRequest
+	10, // 91: routeguide.RouteGuide.GetFeature:output_type -> routeguide.Feature
+	10, // 92: routeguide.RouteGuide.GetFeatureFast:output_type -> routeguide.Feature
+	10, // 93: routeguide.RouteGuide.ListFeatures:output_type -> routeguide.Feature
+	12, // 94: routeguide.RouteGuide.ListFeaturesPage:output_type -> routeguide.FeaturePage
+	14, // 95: routeguide.RouteGuide.RecordRoute:output_type -> routeguide.RouteSummary
+	13, // 96: routeguide.RouteGuide.RouteChat:output_type -> routeguide.RouteNote
+	27, // 97: routeguide.RouteGuide.ListNoteHistory:output_type -> routeguide.NoteHistoryPage
+	17, // 98: routeguide.RouteGuide.ComputeIsochrone:output_type -> routeguide.IsochroneRing
+	21, // 99: routeguide.RouteGuide.CompareRoutes:output_type -> routeguide.RouteComparison
+	23, // 100: routeguide.RouteGuide.EstimateTravelTime:output_type -> routeguide.TravelTimeEstimate
+	25, // 101: routeguide.RouteGuide.WatchFeatures:output_type -> routeguide.FeatureEvent
+	29, // 102: routeguide.RouteGuide.SyncFeatures:output_type -> routeguide.SyncMessage
+	39, // 103: routeguide.RouteGuide.GetDatasetStats:output_type -> routeguide.DatasetStats
+	35, // 104: routeguide.RouteGuide.GetChatActivity:output_type -> routeguide.ChatActivity
+	33, // 105: routeguide.RouteGuide.DownloadRegionBundle:output_type -> routeguide.BundleChunk
+	42, // 106: routeguide.RouteGuide.SnapToNearestFeature:output_type -> routeguide.SnapResult
+	46, // 107: routeguide.RouteGuide.NearestFeatures:output_type -> routeguide.NearestFeaturesResponse
+	45, // 108: routeguide.RouteGuide.ListFeaturesInRadius:output_type -> routeguide.NearestFeature
+	10, // 109: routeguide.RouteGuide.ListFeaturesInPolygon:output_type -> routeguide.Feature
+	52, // 110: routeguide.RouteGuide.SubmitFeature:output_type -> routeguide.FeatureSubmission
+	51, // 111: routeguide.RouteGuide.CreateFeature:output_type -> routeguide.FeatureMutation
+	51, // 112: routeguide.RouteGuide.UpdateFeature:output_type -> routeguide.FeatureMutation
+	51, // 113: routeguide.RouteGuide.DeleteFeature:output_type -> routeguide.FeatureMutation
+	54, // 114: routeguide.RouteGuide.GetClientConfig:output_type -> routeguide.ClientConfig
+	91, // [91:115] is the sub-list for method output_type
+	67, // [67:91] is the sub-list for method input_type
+	67, // [67:67] is the sub-list for extension type_name
+	67, // [67:67] is the sub-list for extension extendee
+	0,  // [0:67] is the sub-list for field type_name
 }
 
 func init() { file_route_guide_proto_init() }
@@ -400,18 +4360,27 @@ func file_route_guide_proto_init() {
 	if File_route_guide_proto != nil {
 		return
 	}
+	file_route_guide_proto_msgTypes[12].OneofWrappers = []any{
+		(*RouteRef_Id)(nil),
+		(*RouteRef_Points)(nil),
+	}
+	file_route_guide_proto_msgTypes[23].OneofWrappers = []any{
+		(*SyncMessage_Snapshot)(nil),
+		(*SyncMessage_Delta)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_route_guide_proto_rawDesc), len(file_route_guide_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      6,
+			NumMessages:   51,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_route_guide_proto_goTypes,
 		DependencyIndexes: file_route_guide_proto_depIdxs,
+		EnumInfos:         file_route_guide_proto_enumTypes,
 		MessageInfos:      file_route_guide_proto_msgTypes,
 	}.Build()
 	File_route_guide_proto = out.File
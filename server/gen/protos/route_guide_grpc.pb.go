@@ -19,10 +19,30 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RouteGuide_GetFeature_FullMethodName   = "/routeguide.RouteGuide/GetFeature"
-	RouteGuide_ListFeatures_FullMethodName = "/routeguide.RouteGuide/ListFeatures"
-	RouteGuide_RecordRoute_FullMethodName  = "/routeguide.RouteGuide/RecordRoute"
-	RouteGuide_RouteChat_FullMethodName    = "/routeguide.RouteGuide/RouteChat"
+	RouteGuide_GetFeature_FullMethodName            = "/routeguide.RouteGuide/GetFeature"
+	RouteGuide_GetFeatureFast_FullMethodName        = "/routeguide.RouteGuide/GetFeatureFast"
+	RouteGuide_ListFeatures_FullMethodName          = "/routeguide.RouteGuide/ListFeatures"
+	RouteGuide_ListFeaturesPage_FullMethodName      = "/routeguide.RouteGuide/ListFeaturesPage"
+	RouteGuide_RecordRoute_FullMethodName           = "/routeguide.RouteGuide/RecordRoute"
+	RouteGuide_RouteChat_FullMethodName             = "/routeguide.RouteGuide/RouteChat"
+	RouteGuide_ListNoteHistory_FullMethodName       = "/routeguide.RouteGuide/ListNoteHistory"
+	RouteGuide_ComputeIsochrone_FullMethodName      = "/routeguide.RouteGuide/ComputeIsochrone"
+	RouteGuide_CompareRoutes_FullMethodName         = "/routeguide.RouteGuide/CompareRoutes"
+	RouteGuide_EstimateTravelTime_FullMethodName    = "/routeguide.RouteGuide/EstimateTravelTime"
+	RouteGuide_WatchFeatures_FullMethodName         = "/routeguide.RouteGuide/WatchFeatures"
+	RouteGuide_SyncFeatures_FullMethodName          = "/routeguide.RouteGuide/SyncFeatures"
+	RouteGuide_GetDatasetStats_FullMethodName       = "/routeguide.RouteGuide/GetDatasetStats"
+	RouteGuide_GetChatActivity_FullMethodName       = "/routeguide.RouteGuide/GetChatActivity"
+	RouteGuide_DownloadRegionBundle_FullMethodName  = "/routeguide.RouteGuide/DownloadRegionBundle"
+	RouteGuide_SnapToNearestFeature_FullMethodName  = "/routeguide.RouteGuide/SnapToNearestFeature"
+	RouteGuide_NearestFeatures_FullMethodName       = "/routeguide.RouteGuide/NearestFeatures"
+	RouteGuide_ListFeaturesInRadius_FullMethodName  = "/routeguide.RouteGuide/ListFeaturesInRadius"
+	RouteGuide_ListFeaturesInPolygon_FullMethodName = "/routeguide.RouteGuide/ListFeaturesInPolygon"
+	RouteGuide_SubmitFeature_FullMethodName         = "/routeguide.RouteGuide/SubmitFeature"
+	RouteGuide_CreateFeature_FullMethodName         = "/routeguide.RouteGuide/CreateFeature"
+	RouteGuide_UpdateFeature_FullMethodName         = "/routeguide.RouteGuide/UpdateFeature"
+	RouteGuide_DeleteFeature_FullMethodName         = "/routeguide.RouteGuide/DeleteFeature"
+	RouteGuide_GetClientConfig_FullMethodName       = "/routeguide.RouteGuide/GetClientConfig"
 )
 
 // RouteGuideClient is the client API for RouteGuide service.
@@ -38,13 +58,27 @@ type RouteGuideClient interface {
 	// A feature with an empty name is returned if there's no feature at the given
 	// position.
 	GetFeature(ctx context.Context, in *Point, opts ...grpc.CallOption) (*Feature, error)
+	// A simple RPC with server-side hedging.
+	//
+	// Behaves like GetFeature, but the lookup is fanned out to several storage
+	// replicas and the first successful response wins. Slow or failing replicas
+	// are cancelled once an answer is available.
+	GetFeatureFast(ctx context.Context, in *Point, opts ...grpc.CallOption) (*Feature, error)
 	// A server-to-client streaming RPC.
 	//
 	// Obtains the Features available within the given Rectangle.  Results are
 	// streamed rather than returned at once (e.g. in a response message with a
 	// repeated field), as the rectangle may cover a large area and contain a
-	// huge number of features.
+	// huge number of features. A stream sends the features of the dataset
+	// version current when it started, whatever changes meanwhile. The
+	// rectangle's name_filter narrows them down by name.
 	ListFeatures(ctx context.Context, in *Rectangle, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Feature], error)
+	// A simple RPC.
+	//
+	// Lists the features within a rectangle a page at a time, ordered by
+	// location. Page tokens remain valid when the dataset changes between
+	// pages: features present throughout are neither skipped nor repeated.
+	ListFeaturesPage(ctx context.Context, in *ListFeaturesPageRequest, opts ...grpc.CallOption) (*FeaturePage, error)
 	// A client-to-server streaming RPC.
 	//
 	// Accepts a stream of Points on a route being traversed, returning a
@@ -55,6 +89,107 @@ type RouteGuideClient interface {
 	// Accepts a stream of RouteNotes sent while a route is being traversed,
 	// while receiving other RouteNotes (e.g. from other users).
 	RouteChat(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[RouteNote, RouteNote], error)
+	// A simple RPC.
+	//
+	// Returns a page of the notes ever sent at a location, oldest first,
+	// including notes that are no longer replayed by RouteChat.
+	ListNoteHistory(ctx context.Context, in *NoteHistoryRequest, opts ...grpc.CallOption) (*NoteHistoryPage, error)
+	// A server-to-client streaming RPC.
+	//
+	// Computes the area reachable from a center point within a time budget,
+	// travelling straight lines between known features with the given profile.
+	// The area is streamed as closed polygon rings, one per time band, from the
+	// smallest band to the full duration.
+	ComputeIsochrone(ctx context.Context, in *IsochroneRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[IsochroneRing], error)
+	// A simple RPC.
+	//
+	// Compares two routes, each given either as the ID of a route previously
+	// recorded with RecordRoute or as an inline list of points.
+	CompareRoutes(ctx context.Context, in *CompareRoutesRequest, opts ...grpc.CallOption) (*RouteComparison, error)
+	// A simple RPC.
+	//
+	// Estimates how long it takes to travel between two points with a given
+	// profile, based on previously recorded routes passing near both points.
+	// Falls back to a straight-line heuristic when there is no history.
+	EstimateTravelTime(ctx context.Context, in *TravelTimeRequest, opts ...grpc.CallOption) (*TravelTimeEstimate, error)
+	// A server-to-client streaming RPC.
+	//
+	// Streams an event describing the current dataset, followed by an event
+	// every time the server swaps in a new dataset (e.g. after a scheduled
+	// refresh), so clients know when cached features are stale.
+	WatchFeatures(ctx context.Context, in *WatchFeaturesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FeatureEvent], error)
+	// A server-to-client streaming RPC.
+	//
+	// Keeps an offline copy of the features in sync: streams a paged snapshot
+	// of the dataset, then a delta every time it changes. Every message that
+	// leaves the copy consistent carries a resume token, so a reconnecting
+	// client only receives what it missed.
+	SyncFeatures(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SyncMessage], error)
+	// A simple RPC.
+	//
+	// Summarizes the dataset being served (size, extent, density, duplicates
+	// and how long it took to load) so clients and operators can sanity-check
+	// what was loaded.
+	GetDatasetStats(ctx context.Context, in *DatasetStatsRequest, opts ...grpc.CallOption) (*DatasetStats, error)
+	// A simple RPC.
+	//
+	// Counts the notes recently posted with RouteChat in a region, clustered
+	// into the map tiles of a zoom level, so clients can render busy
+	// locations on a map.
+	GetChatActivity(ctx context.Context, in *ChatActivityRequest, opts ...grpc.CallOption) (*ChatActivity, error)
+	// A server-to-client streaming RPC.
+	//
+	// Streams a gzip-compressed tar archive of the features in a region, and
+	// optionally their vector tiles, for offline use. An interrupted download
+	// can be resumed from any offset as long as the bundle hasn't changed.
+	DownloadRegionBundle(ctx context.Context, in *BundleRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BundleChunk], error)
+	// A simple RPC.
+	//
+	// Snaps a point to the nearest feature within a distance, so apps can
+	// anchor user pins to known places.
+	SnapToNearestFeature(ctx context.Context, in *SnapRequest, opts ...grpc.CallOption) (*SnapResult, error)
+	// A simple RPC.
+	//
+	// Returns the named features nearest to a point, closest first, with their
+	// distances, wherever they are.
+	NearestFeatures(ctx context.Context, in *NearestFeaturesRequest, opts ...grpc.CallOption) (*NearestFeaturesResponse, error)
+	// A server-to-client streaming RPC.
+	//
+	// Obtains the features within a distance of a point, closest first, with
+	// their distances, e.g. to show the features near a user.
+	ListFeaturesInRadius(ctx context.Context, in *RadiusRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NearestFeature], error)
+	// A server-to-client streaming RPC.
+	//
+	// Obtains the Features within the given Polygon, edges included, in the
+	// same order as ListFeatures. A polygon whose edges cross is rejected with
+	// INVALID_ARGUMENT.
+	ListFeaturesInPolygon(ctx context.Context, in *Polygon, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Feature], error)
+	// A simple RPC.
+	//
+	// Submits a new or changed feature. Submissions from admins are applied
+	// right away; others are queued as PENDING until an admin approves or
+	// rejects them with the Admin service, and don't appear in queries
+	// meanwhile.
+	SubmitFeature(ctx context.Context, in *SubmitFeatureRequest, opts ...grpc.CallOption) (*FeatureSubmission, error)
+	// A simple RPC.
+	//
+	// Adds a feature at a location that has none. Admin only; the change is
+	// applied right away, without review.
+	CreateFeature(ctx context.Context, in *CreateFeatureRequest, opts ...grpc.CallOption) (*FeatureMutation, error)
+	// A simple RPC.
+	//
+	// Replaces the feature at a location. Admin only.
+	UpdateFeature(ctx context.Context, in *UpdateFeatureRequest, opts ...grpc.CallOption) (*FeatureMutation, error)
+	// A simple RPC.
+	//
+	// Removes the feature at a location. Admin only.
+	DeleteFeature(ctx context.Context, in *DeleteFeatureRequest, opts ...grpc.CallOption) (*FeatureMutation, error)
+	// A simple RPC.
+	//
+	// Returns the settings the server recommends to clients, so they can be
+	// tuned centrally without an app release. Clients should fetch it at
+	// startup and again after its refresh_interval.
+	GetClientConfig(ctx context.Context, in *GetClientConfigRequest, opts ...grpc.CallOption) (*ClientConfig, error)
 }
 
 type routeGuideClient struct {
@@ -75,6 +210,16 @@ func (c *routeGuideClient) GetFeature(ctx context.Context, in *Point, opts ...gr
 	return out, nil
 }
 
+func (c *routeGuideClient) GetFeatureFast(ctx context.Context, in *Point, opts ...grpc.CallOption) (*Feature, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Feature)
+	err := c.cc.Invoke(ctx, RouteGuide_GetFeatureFast_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *routeGuideClient) ListFeatures(ctx context.Context, in *Rectangle, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Feature], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &RouteGuide_ServiceDesc.Streams[0], RouteGuide_ListFeatures_FullMethodName, cOpts...)
@@ -94,6 +239,16 @@ func (c *routeGuideClient) ListFeatures(ctx context.Context, in *Rectangle, opts
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type RouteGuide_ListFeaturesClient = grpc.ServerStreamingClient[Feature]
 
+func (c *routeGuideClient) ListFeaturesPage(ctx context.Context, in *ListFeaturesPageRequest, opts ...grpc.CallOption) (*FeaturePage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeaturePage)
+	err := c.cc.Invoke(ctx, RouteGuide_ListFeaturesPage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *routeGuideClient) RecordRoute(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[Point, RouteSummary], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &RouteGuide_ServiceDesc.Streams[1], RouteGuide_RecordRoute_FullMethodName, cOpts...)
@@ -120,132 +275,915 @@ func (c *routeGuideClient) RouteChat(ctx context.Context, opts ...grpc.CallOptio
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type RouteGuide_RouteChatClient = grpc.BidiStreamingClient[RouteNote, RouteNote]
 
-// RouteGuideServer is the server API for RouteGuide service.
-// All implementations must embed UnimplementedRouteGuideServer
-// for forward compatibility.
-//
-// Interface exported by the server.
-type RouteGuideServer interface {
-	// A simple RPC.
-	//
-	// Obtains the feature at a given position.
-	//
-	// A feature with an empty name is returned if there's no feature at the given
-	// position.
-	GetFeature(context.Context, *Point) (*Feature, error)
-	// A server-to-client streaming RPC.
-	//
-	// Obtains the Features available within the given Rectangle.  Results are
-	// streamed rather than returned at once (e.g. in a response message with a
-	// repeated field), as the rectangle may cover a large area and contain a
-	// huge number of features.
-	ListFeatures(*Rectangle, grpc.ServerStreamingServer[Feature]) error
-	// A client-to-server streaming RPC.
-	//
-	// Accepts a stream of Points on a route being traversed, returning a
-	// RouteSummary when traversal is completed.
-	RecordRoute(grpc.ClientStreamingServer[Point, RouteSummary]) error
-	// A Bidirectional streaming RPC.
-	//
-	// Accepts a stream of RouteNotes sent while a route is being traversed,
-	// while receiving other RouteNotes (e.g. from other users).
-	RouteChat(grpc.BidiStreamingServer[RouteNote, RouteNote]) error
-	mustEmbedUnimplementedRouteGuideServer()
+func (c *routeGuideClient) ListNoteHistory(ctx context.Context, in *NoteHistoryRequest, opts ...grpc.CallOption) (*NoteHistoryPage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NoteHistoryPage)
+	err := c.cc.Invoke(ctx, RouteGuide_ListNoteHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedRouteGuideServer must be embedded to have
-// forward compatible implementations.
-//
-// NOTE: this should be embedded by value instead of pointer to avoid a nil
-// pointer dereference when methods are called.
-type UnimplementedRouteGuideServer struct{}
-
-func (UnimplementedRouteGuideServer) GetFeature(context.Context, *Point) (*Feature, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetFeature not implemented")
+func (c *routeGuideClient) ComputeIsochrone(ctx context.Context, in *IsochroneRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[IsochroneRing], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RouteGuide_ServiceDesc.Streams[3], RouteGuide_ComputeIsochrone_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[IsochroneRequest, IsochroneRing]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedRouteGuideServer) ListFeatures(*Rectangle, grpc.ServerStreamingServer[Feature]) error {
-	return status.Errorf(codes.Unimplemented, "method ListFeatures not implemented")
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RouteGuide_ComputeIsochroneClient = grpc.ServerStreamingClient[IsochroneRing]
+
+func (c *routeGuideClient) CompareRoutes(ctx context.Context, in *CompareRoutesRequest, opts ...grpc.CallOption) (*RouteComparison, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RouteComparison)
+	err := c.cc.Invoke(ctx, RouteGuide_CompareRoutes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRouteGuideServer) RecordRoute(grpc.ClientStreamingServer[Point, RouteSummary]) error {
-	return status.Errorf(codes.Unimplemented, "method RecordRoute not implemented")
+
+func (c *routeGuideClient) EstimateTravelTime(ctx context.Context, in *TravelTimeRequest, opts ...grpc.CallOption) (*TravelTimeEstimate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TravelTimeEstimate)
+	err := c.cc.Invoke(ctx, RouteGuide_EstimateTravelTime_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedRouteGuideServer) RouteChat(grpc.BidiStreamingServer[RouteNote, RouteNote]) error {
-	return status.Errorf(codes.Unimplemented, "method RouteChat not implemented")
+
+func (c *routeGuideClient) WatchFeatures(ctx context.Context, in *WatchFeaturesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[FeatureEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RouteGuide_ServiceDesc.Streams[4], RouteGuide_WatchFeatures_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchFeaturesRequest, FeatureEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedRouteGuideServer) mustEmbedUnimplementedRouteGuideServer() {}
-func (UnimplementedRouteGuideServer) testEmbeddedByValue()                    {}
 
-// UnsafeRouteGuideServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to RouteGuideServer will
-// result in compilation errors.
-type UnsafeRouteGuideServer interface {
-	mustEmbedUnimplementedRouteGuideServer()
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RouteGuide_WatchFeaturesClient = grpc.ServerStreamingClient[FeatureEvent]
+
+func (c *routeGuideClient) SyncFeatures(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[SyncMessage], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RouteGuide_ServiceDesc.Streams[5], RouteGuide_SyncFeatures_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[SyncRequest, SyncMessage]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func RegisterRouteGuideServer(s grpc.ServiceRegistrar, srv RouteGuideServer) {
-	// If the following call pancis, it indicates UnimplementedRouteGuideServer was
-	// embedded by pointer and is nil.  This will cause panics if an
-	// unimplemented method is ever invoked, so we test this at initialization
-	// time to prevent it from happening at runtime later due to I/O.
-	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
-		t.testEmbeddedByValue()
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RouteGuide_SyncFeaturesClient = grpc.ServerStreamingClient[SyncMessage]
+
+func (c *routeGuideClient) GetDatasetStats(ctx context.Context, in *DatasetStatsRequest, opts ...grpc.CallOption) (*DatasetStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DatasetStats)
+	err := c.cc.Invoke(ctx, RouteGuide_GetDatasetStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	s.RegisterService(&RouteGuide_ServiceDesc, srv)
+	return out, nil
 }
 
-func _RouteGuide_GetFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Point)
-	if err := dec(in); err != nil {
+func (c *routeGuideClient) GetChatActivity(ctx context.Context, in *ChatActivityRequest, opts ...grpc.CallOption) (*ChatActivity, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChatActivity)
+	err := c.cc.Invoke(ctx, RouteGuide_GetChatActivity_FullMethodName, in, out, cOpts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(RouteGuideServer).GetFeature(ctx, in)
+	return out, nil
+}
+
+func (c *routeGuideClient) DownloadRegionBundle(ctx context.Context, in *BundleRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[BundleChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RouteGuide_ServiceDesc.Streams[6], RouteGuide_DownloadRegionBundle_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: RouteGuide_GetFeature_FullMethodName,
+	x := &grpc.GenericClientStream[BundleRequest, BundleChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RouteGuideServer).GetFeature(ctx, req.(*Point))
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return x, nil
 }
 
-func _RouteGuide_ListFeatures_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(Rectangle)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RouteGuide_DownloadRegionBundleClient = grpc.ServerStreamingClient[BundleChunk]
+
+func (c *routeGuideClient) SnapToNearestFeature(ctx context.Context, in *SnapRequest, opts ...grpc.CallOption) (*SnapResult, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SnapResult)
+	err := c.cc.Invoke(ctx, RouteGuide_SnapToNearestFeature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
 	}
-	return srv.(RouteGuideServer).ListFeatures(m, &grpc.GenericServerStream[Rectangle, Feature]{ServerStream: stream})
+	return out, nil
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type RouteGuide_ListFeaturesServer = grpc.ServerStreamingServer[Feature]
+func (c *routeGuideClient) NearestFeatures(ctx context.Context, in *NearestFeaturesRequest, opts ...grpc.CallOption) (*NearestFeaturesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NearestFeaturesResponse)
+	err := c.cc.Invoke(ctx, RouteGuide_NearestFeatures_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
 
-func _RouteGuide_RecordRoute_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(RouteGuideServer).RecordRoute(&grpc.GenericServerStream[Point, RouteSummary]{ServerStream: stream})
+func (c *routeGuideClient) ListFeaturesInRadius(ctx context.Context, in *RadiusRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[NearestFeature], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RouteGuide_ServiceDesc.Streams[7], RouteGuide_ListFeaturesInRadius_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[RadiusRequest, NearestFeature]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type RouteGuide_RecordRouteServer = grpc.ClientStreamingServer[Point, RouteSummary]
+type RouteGuide_ListFeaturesInRadiusClient = grpc.ServerStreamingClient[NearestFeature]
 
-func _RouteGuide_RouteChat_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(RouteGuideServer).RouteChat(&grpc.GenericServerStream[RouteNote, RouteNote]{ServerStream: stream})
+func (c *routeGuideClient) ListFeaturesInPolygon(ctx context.Context, in *Polygon, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Feature], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RouteGuide_ServiceDesc.Streams[8], RouteGuide_ListFeaturesInPolygon_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Polygon, Feature]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type RouteGuide_RouteChatServer = grpc.BidiStreamingServer[RouteNote, RouteNote]
+type RouteGuide_ListFeaturesInPolygonClient = grpc.ServerStreamingClient[Feature]
 
-// RouteGuide_ServiceDesc is the grpc.ServiceDesc for RouteGuide service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var RouteGuide_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "routeguide.RouteGuide",
-	HandlerType: (*RouteGuideServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetFeature",
-			Handler:    _RouteGuide_GetFeature_Handler,
+func (c *routeGuideClient) SubmitFeature(ctx context.Context, in *SubmitFeatureRequest, opts ...grpc.CallOption) (*FeatureSubmission, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeatureSubmission)
+	err := c.cc.Invoke(ctx, RouteGuide_SubmitFeature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGuideClient) CreateFeature(ctx context.Context, in *CreateFeatureRequest, opts ...grpc.CallOption) (*FeatureMutation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeatureMutation)
+	err := c.cc.Invoke(ctx, RouteGuide_CreateFeature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGuideClient) UpdateFeature(ctx context.Context, in *UpdateFeatureRequest, opts ...grpc.CallOption) (*FeatureMutation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeatureMutation)
+	err := c.cc.Invoke(ctx, RouteGuide_UpdateFeature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGuideClient) DeleteFeature(ctx context.Context, in *DeleteFeatureRequest, opts ...grpc.CallOption) (*FeatureMutation, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FeatureMutation)
+	err := c.cc.Invoke(ctx, RouteGuide_DeleteFeature_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *routeGuideClient) GetClientConfig(ctx context.Context, in *GetClientConfigRequest, opts ...grpc.CallOption) (*ClientConfig, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ClientConfig)
+	err := c.cc.Invoke(ctx, RouteGuide_GetClientConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RouteGuideServer is the server API for RouteGuide service.
+// All implementations must embed UnimplementedRouteGuideServer
+// for forward compatibility.
+//
+// Interface exported by the server.
+type RouteGuideServer interface {
+	// A simple RPC.
+	//
+	// Obtains the feature at a given position.
+	//
+	// A feature with an empty name is returned if there's no feature at the given
+	// position.
+	GetFeature(context.Context, *Point) (*Feature, error)
+	// A simple RPC with server-side hedging.
+	//
+	// Behaves like GetFeature, but the lookup is fanned out to several storage
+	// replicas and the first successful response wins. Slow or failing replicas
+	// are cancelled once an answer is available.
+	GetFeatureFast(context.Context, *Point) (*Feature, error)
+	// A server-to-client streaming RPC.
+	//
+	// Obtains the Features available within the given Rectangle.  Results are
+	// streamed rather than returned at once (e.g. in a response message with a
+	// repeated field), as the rectangle may cover a large area and contain a
+	// huge number of features. A stream sends the features of the dataset
+	// version current when it started, whatever changes meanwhile. The
+	// rectangle's name_filter narrows them down by name.
+	ListFeatures(*Rectangle, grpc.ServerStreamingServer[Feature]) error
+	// A simple RPC.
+	//
+	// Lists the features within a rectangle a page at a time, ordered by
+	// location. Page tokens remain valid when the dataset changes between
+	// pages: features present throughout are neither skipped nor repeated.
+	ListFeaturesPage(context.Context, *ListFeaturesPageRequest) (*FeaturePage, error)
+	// A client-to-server streaming RPC.
+	//
+	// Accepts a stream of Points on a route being traversed, returning a
+	// RouteSummary when traversal is completed.
+	RecordRoute(grpc.ClientStreamingServer[Point, RouteSummary]) error
+	// A Bidirectional streaming RPC.
+	//
+	// Accepts a stream of RouteNotes sent while a route is being traversed,
+	// while receiving other RouteNotes (e.g. from other users).
+	RouteChat(grpc.BidiStreamingServer[RouteNote, RouteNote]) error
+	// A simple RPC.
+	//
+	// Returns a page of the notes ever sent at a location, oldest first,
+	// including notes that are no longer replayed by RouteChat.
+	ListNoteHistory(context.Context, *NoteHistoryRequest) (*NoteHistoryPage, error)
+	// A server-to-client streaming RPC.
+	//
+	// Computes the area reachable from a center point within a time budget,
+	// travelling straight lines between known features with the given profile.
+	// The area is streamed as closed polygon rings, one per time band, from the
+	// smallest band to the full duration.
+	ComputeIsochrone(*IsochroneRequest, grpc.ServerStreamingServer[IsochroneRing]) error
+	// A simple RPC.
+	//
+	// Compares two routes, each given either as the ID of a route previously
+	// recorded with RecordRoute or as an inline list of points.
+	CompareRoutes(context.Context, *CompareRoutesRequest) (*RouteComparison, error)
+	// A simple RPC.
+	//
+	// Estimates how long it takes to travel between two points with a given
+	// profile, based on previously recorded routes passing near both points.
+	// Falls back to a straight-line heuristic when there is no history.
+	EstimateTravelTime(context.Context, *TravelTimeRequest) (*TravelTimeEstimate, error)
+	// A server-to-client streaming RPC.
+	//
+	// Streams an event describing the current dataset, followed by an event
+	// every time the server swaps in a new dataset (e.g. after a scheduled
+	// refresh), so clients know when cached features are stale.
+	WatchFeatures(*WatchFeaturesRequest, grpc.ServerStreamingServer[FeatureEvent]) error
+	// A server-to-client streaming RPC.
+	//
+	// Keeps an offline copy of the features in sync: streams a paged snapshot
+	// of the dataset, then a delta every time it changes. Every message that
+	// leaves the copy consistent carries a resume token, so a reconnecting
+	// client only receives what it missed.
+	SyncFeatures(*SyncRequest, grpc.ServerStreamingServer[SyncMessage]) error
+	// A simple RPC.
+	//
+	// Summarizes the dataset being served (size, extent, density, duplicates
+	// and how long it took to load) so clients and operators can sanity-check
+	// what was loaded.
+	GetDatasetStats(context.Context, *DatasetStatsRequest) (*DatasetStats, error)
+	// A simple RPC.
+	//
+	// Counts the notes recently posted with RouteChat in a region, clustered
+	// into the map tiles of a zoom level, so clients can render busy
+	// locations on a map.
+	GetChatActivity(context.Context, *ChatActivityRequest) (*ChatActivity, error)
+	// A server-to-client streaming RPC.
+	//
+	// Streams a gzip-compressed tar archive of the features in a region, and
+	// optionally their vector tiles, for offline use. An interrupted download
+	// can be resumed from any offset as long as the bundle hasn't changed.
+	DownloadRegionBundle(*BundleRequest, grpc.ServerStreamingServer[BundleChunk]) error
+	// A simple RPC.
+	//
+	// Snaps a point to the nearest feature within a distance, so apps can
+	// anchor user pins to known places.
+	SnapToNearestFeature(context.Context, *SnapRequest) (*SnapResult, error)
+	// A simple RPC.
+	//
+	// Returns the named features nearest to a point, closest first, with their
+	// distances, wherever they are.
+	NearestFeatures(context.Context, *NearestFeaturesRequest) (*NearestFeaturesResponse, error)
+	// A server-to-client streaming RPC.
+	//
+	// Obtains the features within a distance of a point, closest first, with
+	// their distances, e.g. to show the features near a user.
+	ListFeaturesInRadius(*RadiusRequest, grpc.ServerStreamingServer[NearestFeature]) error
+	// A server-to-client streaming RPC.
+	//
+	// Obtains the Features within the given Polygon, edges included, in the
+	// same order as ListFeatures. A polygon whose edges cross is rejected with
+	// INVALID_ARGUMENT.
+	ListFeaturesInPolygon(*Polygon, grpc.ServerStreamingServer[Feature]) error
+	// A simple RPC.
+	//
+	// Submits a new or changed feature. Submissions from admins are applied
+	// right away; others are queued as PENDING until an admin approves or
+	// rejects them with the Admin service, and don't appear in queries
+	// meanwhile.
+	SubmitFeature(context.Context, *SubmitFeatureRequest) (*FeatureSubmission, error)
+	// A simple RPC.
+	//
+	// Adds a feature at a location that has none. Admin only; the change is
+	// applied right away, without review.
+	CreateFeature(context.Context, *CreateFeatureRequest) (*FeatureMutation, error)
+	// A simple RPC.
+	//
+	// Replaces the feature at a location. Admin only.
+	UpdateFeature(context.Context, *UpdateFeatureRequest) (*FeatureMutation, error)
+	// A simple RPC.
+	//
+	// Removes the feature at a location. Admin only.
+	DeleteFeature(context.Context, *DeleteFeatureRequest) (*FeatureMutation, error)
+	// A simple RPC.
+	//
+	// Returns the settings the server recommends to clients, so they can be
+	// tuned centrally without an app release. Clients should fetch it at
+	// startup and again after its refresh_interval.
+	GetClientConfig(context.Context, *GetClientConfigRequest) (*ClientConfig, error)
+	mustEmbedUnimplementedRouteGuideServer()
+}
+
+// UnimplementedRouteGuideServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRouteGuideServer struct{}
+
+func (UnimplementedRouteGuideServer) GetFeature(context.Context, *Point) (*Feature, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeature not implemented")
+}
+func (UnimplementedRouteGuideServer) GetFeatureFast(context.Context, *Point) (*Feature, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeatureFast not implemented")
+}
+func (UnimplementedRouteGuideServer) ListFeatures(*Rectangle, grpc.ServerStreamingServer[Feature]) error {
+	return status.Errorf(codes.Unimplemented, "method ListFeatures not implemented")
+}
+func (UnimplementedRouteGuideServer) ListFeaturesPage(context.Context, *ListFeaturesPageRequest) (*FeaturePage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFeaturesPage not implemented")
+}
+func (UnimplementedRouteGuideServer) RecordRoute(grpc.ClientStreamingServer[Point, RouteSummary]) error {
+	return status.Errorf(codes.Unimplemented, "method RecordRoute not implemented")
+}
+func (UnimplementedRouteGuideServer) RouteChat(grpc.BidiStreamingServer[RouteNote, RouteNote]) error {
+	return status.Errorf(codes.Unimplemented, "method RouteChat not implemented")
+}
+func (UnimplementedRouteGuideServer) ListNoteHistory(context.Context, *NoteHistoryRequest) (*NoteHistoryPage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNoteHistory not implemented")
+}
+func (UnimplementedRouteGuideServer) ComputeIsochrone(*IsochroneRequest, grpc.ServerStreamingServer[IsochroneRing]) error {
+	return status.Errorf(codes.Unimplemented, "method ComputeIsochrone not implemented")
+}
+func (UnimplementedRouteGuideServer) CompareRoutes(context.Context, *CompareRoutesRequest) (*RouteComparison, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareRoutes not implemented")
+}
+func (UnimplementedRouteGuideServer) EstimateTravelTime(context.Context, *TravelTimeRequest) (*TravelTimeEstimate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateTravelTime not implemented")
+}
+func (UnimplementedRouteGuideServer) WatchFeatures(*WatchFeaturesRequest, grpc.ServerStreamingServer[FeatureEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchFeatures not implemented")
+}
+func (UnimplementedRouteGuideServer) SyncFeatures(*SyncRequest, grpc.ServerStreamingServer[SyncMessage]) error {
+	return status.Errorf(codes.Unimplemented, "method SyncFeatures not implemented")
+}
+func (UnimplementedRouteGuideServer) GetDatasetStats(context.Context, *DatasetStatsRequest) (*DatasetStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDatasetStats not implemented")
+}
+func (UnimplementedRouteGuideServer) GetChatActivity(context.Context, *ChatActivityRequest) (*ChatActivity, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChatActivity not implemented")
+}
+func (UnimplementedRouteGuideServer) DownloadRegionBundle(*BundleRequest, grpc.ServerStreamingServer[BundleChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadRegionBundle not implemented")
+}
+func (UnimplementedRouteGuideServer) SnapToNearestFeature(context.Context, *SnapRequest) (*SnapResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnapToNearestFeature not implemented")
+}
+func (UnimplementedRouteGuideServer) NearestFeatures(context.Context, *NearestFeaturesRequest) (*NearestFeaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NearestFeatures not implemented")
+}
+func (UnimplementedRouteGuideServer) ListFeaturesInRadius(*RadiusRequest, grpc.ServerStreamingServer[NearestFeature]) error {
+	return status.Errorf(codes.Unimplemented, "method ListFeaturesInRadius not implemented")
+}
+func (UnimplementedRouteGuideServer) ListFeaturesInPolygon(*Polygon, grpc.ServerStreamingServer[Feature]) error {
+	return status.Errorf(codes.Unimplemented, "method ListFeaturesInPolygon not implemented")
+}
+func (UnimplementedRouteGuideServer) SubmitFeature(context.Context, *SubmitFeatureRequest) (*FeatureSubmission, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitFeature not implemented")
+}
+func (UnimplementedRouteGuideServer) CreateFeature(context.Context, *CreateFeatureRequest) (*FeatureMutation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFeature not implemented")
+}
+func (UnimplementedRouteGuideServer) UpdateFeature(context.Context, *UpdateFeatureRequest) (*FeatureMutation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFeature not implemented")
+}
+func (UnimplementedRouteGuideServer) DeleteFeature(context.Context, *DeleteFeatureRequest) (*FeatureMutation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFeature not implemented")
+}
+func (UnimplementedRouteGuideServer) GetClientConfig(context.Context, *GetClientConfigRequest) (*ClientConfig, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClientConfig not implemented")
+}
+func (UnimplementedRouteGuideServer) mustEmbedUnimplementedRouteGuideServer() {}
+func (UnimplementedRouteGuideServer) testEmbeddedByValue()                    {}
+
+// UnsafeRouteGuideServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RouteGuideServer will
+// result in compilation errors.
+type UnsafeRouteGuideServer interface {
+	mustEmbedUnimplementedRouteGuideServer()
+}
+
+func RegisterRouteGuideServer(s grpc.ServiceRegistrar, srv RouteGuideServer) {
+	// If the following call pancis, it indicates UnimplementedRouteGuideServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RouteGuide_ServiceDesc, srv)
+}
+
+func _RouteGuide_GetFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Point)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGuideServer).GetFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGuide_GetFeature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGuideServer).GetFeature(ctx, req.(*Point))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGuide_GetFeatureFast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Point)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGuideServer).GetFeatureFast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGuide_GetFeatureFast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGuideServer).GetFeatureFast(ctx, req.(*Point))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGuide_ListFeatures_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Rectangle)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RouteGuideServer).ListFeatures(m, &grpc.GenericServerStream[Rectangle, Feature]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RouteGuide_ListFeaturesServer = grpc.ServerStreamingServer[Feature]
+
+func _RouteGuide_ListFeaturesPage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFeaturesPageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGuideServer).ListFeaturesPage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGuide_ListFeaturesPage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGuideServer).ListFeaturesPage(ctx, req.(*ListFeaturesPageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGuide_RecordRoute_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RouteGuideServer).RecordRoute(&grpc.GenericServerStream[Point, RouteSummary]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RouteGuide_RecordRouteServer = grpc.ClientStreamingServer[Point, RouteSummary]
+
+func _RouteGuide_RouteChat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(RouteGuideServer).RouteChat(&grpc.GenericServerStream[RouteNote, RouteNote]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RouteGuide_RouteChatServer = grpc.BidiStreamingServer[RouteNote, RouteNote]
+
+func _RouteGuide_ListNoteHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NoteHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGuideServer).ListNoteHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGuide_ListNoteHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGuideServer).ListNoteHistory(ctx, req.(*NoteHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGuide_ComputeIsochrone_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(IsochroneRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RouteGuideServer).ComputeIsochrone(m, &grpc.GenericServerStream[IsochroneRequest, IsochroneRing]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RouteGuide_ComputeIsochroneServer = grpc.ServerStreamingServer[IsochroneRing]
+
+func _RouteGuide_CompareRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGuideServer).CompareRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGuide_CompareRoutes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGuideServer).CompareRoutes(ctx, req.(*CompareRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGuide_EstimateTravelTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TravelTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGuideServer).EstimateTravelTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGuide_EstimateTravelTime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGuideServer).EstimateTravelTime(ctx, req.(*TravelTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGuide_WatchFeatures_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchFeaturesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RouteGuideServer).WatchFeatures(m, &grpc.GenericServerStream[WatchFeaturesRequest, FeatureEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RouteGuide_WatchFeaturesServer = grpc.ServerStreamingServer[FeatureEvent]
+
+func _RouteGuide_SyncFeatures_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SyncRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RouteGuideServer).SyncFeatures(m, &grpc.GenericServerStream[SyncRequest, SyncMessage]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RouteGuide_SyncFeaturesServer = grpc.ServerStreamingServer[SyncMessage]
+
+func _RouteGuide_GetDatasetStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DatasetStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGuideServer).GetDatasetStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGuide_GetDatasetStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGuideServer).GetDatasetStats(ctx, req.(*DatasetStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGuide_GetChatActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChatActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGuideServer).GetChatActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGuide_GetChatActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGuideServer).GetChatActivity(ctx, req.(*ChatActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGuide_DownloadRegionBundle_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BundleRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RouteGuideServer).DownloadRegionBundle(m, &grpc.GenericServerStream[BundleRequest, BundleChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RouteGuide_DownloadRegionBundleServer = grpc.ServerStreamingServer[BundleChunk]
+
+func _RouteGuide_SnapToNearestFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGuideServer).SnapToNearestFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGuide_SnapToNearestFeature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGuideServer).SnapToNearestFeature(ctx, req.(*SnapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGuide_NearestFeatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NearestFeaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGuideServer).NearestFeatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGuide_NearestFeatures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGuideServer).NearestFeatures(ctx, req.(*NearestFeaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGuide_ListFeaturesInRadius_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RadiusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RouteGuideServer).ListFeaturesInRadius(m, &grpc.GenericServerStream[RadiusRequest, NearestFeature]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RouteGuide_ListFeaturesInRadiusServer = grpc.ServerStreamingServer[NearestFeature]
+
+func _RouteGuide_ListFeaturesInPolygon_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Polygon)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RouteGuideServer).ListFeaturesInPolygon(m, &grpc.GenericServerStream[Polygon, Feature]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RouteGuide_ListFeaturesInPolygonServer = grpc.ServerStreamingServer[Feature]
+
+func _RouteGuide_SubmitFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitFeatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGuideServer).SubmitFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGuide_SubmitFeature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGuideServer).SubmitFeature(ctx, req.(*SubmitFeatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGuide_CreateFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFeatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGuideServer).CreateFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGuide_CreateFeature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGuideServer).CreateFeature(ctx, req.(*CreateFeatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGuide_UpdateFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFeatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGuideServer).UpdateFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGuide_UpdateFeature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGuideServer).UpdateFeature(ctx, req.(*UpdateFeatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGuide_DeleteFeature_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFeatureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGuideServer).DeleteFeature(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGuide_DeleteFeature_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGuideServer).DeleteFeature(ctx, req.(*DeleteFeatureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RouteGuide_GetClientConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClientConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RouteGuideServer).GetClientConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RouteGuide_GetClientConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RouteGuideServer).GetClientConfig(ctx, req.(*GetClientConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RouteGuide_ServiceDesc is the grpc.ServiceDesc for RouteGuide service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RouteGuide_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "routeguide.RouteGuide",
+	HandlerType: (*RouteGuideServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetFeature",
+			Handler:    _RouteGuide_GetFeature_Handler,
+		},
+		{
+			MethodName: "GetFeatureFast",
+			Handler:    _RouteGuide_GetFeatureFast_Handler,
+		},
+		{
+			MethodName: "ListFeaturesPage",
+			Handler:    _RouteGuide_ListFeaturesPage_Handler,
+		},
+		{
+			MethodName: "ListNoteHistory",
+			Handler:    _RouteGuide_ListNoteHistory_Handler,
+		},
+		{
+			MethodName: "CompareRoutes",
+			Handler:    _RouteGuide_CompareRoutes_Handler,
+		},
+		{
+			MethodName: "EstimateTravelTime",
+			Handler:    _RouteGuide_EstimateTravelTime_Handler,
+		},
+		{
+			MethodName: "GetDatasetStats",
+			Handler:    _RouteGuide_GetDatasetStats_Handler,
+		},
+		{
+			MethodName: "GetChatActivity",
+			Handler:    _RouteGuide_GetChatActivity_Handler,
+		},
+		{
+			MethodName: "SnapToNearestFeature",
+			Handler:    _RouteGuide_SnapToNearestFeature_Handler,
+		},
+		{
+			MethodName: "NearestFeatures",
+			Handler:    _RouteGuide_NearestFeatures_Handler,
+		},
+		{
+			MethodName: "SubmitFeature",
+			Handler:    _RouteGuide_SubmitFeature_Handler,
+		},
+		{
+			MethodName: "CreateFeature",
+			Handler:    _RouteGuide_CreateFeature_Handler,
+		},
+		{
+			MethodName: "UpdateFeature",
+			Handler:    _RouteGuide_UpdateFeature_Handler,
+		},
+		{
+			MethodName: "DeleteFeature",
+			Handler:    _RouteGuide_DeleteFeature_Handler,
+		},
+		{
+			MethodName: "GetClientConfig",
+			Handler:    _RouteGuide_GetClientConfig_Handler,
 		},
 	},
 	Streams: []grpc.StreamDesc{
@@ -265,6 +1203,36 @@ var RouteGuide_ServiceDesc = grpc.ServiceDesc{
 			ServerStreams: true,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ComputeIsochrone",
+			Handler:       _RouteGuide_ComputeIsochrone_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchFeatures",
+			Handler:       _RouteGuide_WatchFeatures_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SyncFeatures",
+			Handler:       _RouteGuide_SyncFeatures_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DownloadRegionBundle",
+			Handler:       _RouteGuide_DownloadRegionBundle_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListFeaturesInRadius",
+			Handler:       _RouteGuide_ListFeaturesInRadius_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListFeaturesInPolygon",
+			Handler:       _RouteGuide_ListFeaturesInPolygon_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "route_guide.proto",
 }
@@ -0,0 +1,222 @@
+// Package geo holds the geometry shared by the RouteGuide server and its
+// command-line client. Coordinates are E7 integers, degrees multiplied by
+// 10^7, as in pb.Point.
+package geo
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+)
+
+const (
+	// E7PerDegree is the number of E7 units in a degree
+	E7PerDegree = 1e7
+	// MaxLatitude and MaxLongitude bound valid coordinates, in E7 units
+	MaxLatitude  = 90 * E7PerDegree
+	MaxLongitude = 180 * E7PerDegree
+
+	// EarthRadiusMeters is the mean radius of the Earth
+	EarthRadiusMeters = 6371000
+	// MetersPerDegree is the length of a degree of latitude, in meters
+	MetersPerDegree = 111320
+)
+
+// Degrees converts an E7 coordinate to degrees
+func Degrees(e7 int32) float64 {
+	return float64(e7) / E7PerDegree
+}
+
+// E7 converts degrees to the nearest E7 coordinate
+func E7(degrees float64) int32 {
+	return int32(math.Round(degrees * E7PerDegree))
+}
+
+// Radians converts degrees to radians
+func Radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+// webMercatorRadius is the radius of the sphere Web Mercator projects, in meters
+const webMercatorRadius = 6378137
+
+// MaxWebMercator bounds Web Mercator (EPSG:3857) coordinates, in meters: the
+// projection maps the world to a square of this half-width
+const MaxWebMercator = math.Pi * webMercatorRadius
+
+// WebMercator projects a point to Web Mercator easting and northing in
+// meters. Latitudes beyond about 85.05 degrees, which the projection never
+// reaches, are clamped to its edge.
+func WebMercator(point *pb.Point) (x, y float64) {
+	x = webMercatorRadius * Radians(Degrees(point.Longitude))
+	y = webMercatorRadius * math.Log(math.Tan(math.Pi/4+Radians(Degrees(point.Latitude))/2))
+	return x, max(-MaxWebMercator, min(MaxWebMercator, y))
+}
+
+// FromWebMercator converts Web Mercator easting and northing in meters to
+// the nearest point
+func FromWebMercator(x, y float64) (*pb.Point, error) {
+	// Negated so NaN is out of range too
+	if !(math.Abs(x) <= MaxWebMercator) || !(math.Abs(y) <= MaxWebMercator) {
+		return nil, fmt.Errorf("web mercator coordinates (%v, %v) out of range", x, y)
+	}
+	lon := x / webMercatorRadius * 180 / math.Pi
+	lat := (2*math.Atan(math.Exp(y/webMercatorRadius)) - math.Pi/2) * 180 / math.Pi
+	return &pb.Point{Latitude: E7(lat), Longitude: E7(lon)}, nil
+}
+
+// FromDegrees converts a longitude and latitude in degrees to the nearest
+// point
+func FromDegrees(lon, lat float64) (*pb.Point, error) {
+	if !(math.Abs(lat) <= 90) || !(math.Abs(lon) <= 180) {
+		return nil, fmt.Errorf("coordinates (%v, %v) out of range", lon, lat)
+	}
+	return &pb.Point{Latitude: E7(lat), Longitude: E7(lon)}, nil
+}
+
+// Key returns a string identifying a point's location, for use as a map key
+func Key(point *pb.Point) string {
+	return fmt.Sprintf("%d,%d", point.Latitude, point.Longitude)
+}
+
+// ValidatePoint checks that a point is present and has valid coordinates
+func ValidatePoint(point *pb.Point) error {
+	if point == nil {
+		return errors.New("missing location")
+	}
+	if point.Latitude < -MaxLatitude || point.Latitude > MaxLatitude {
+		return fmt.Errorf("latitude %d out of range", point.Latitude)
+	}
+	if point.Longitude < -MaxLongitude || point.Longitude > MaxLongitude {
+		return fmt.Errorf("longitude %d out of range", point.Longitude)
+	}
+	return nil
+}
+
+// Distance returns the great-circle distance between two points in meters,
+// using the haversine formula
+func Distance(p1, p2 *pb.Point) int32 {
+	lat1 := Radians(Degrees(p1.Latitude))
+	lat2 := Radians(Degrees(p2.Latitude))
+	lon1 := Radians(Degrees(p1.Longitude))
+	lon2 := Radians(Degrees(p2.Longitude))
+
+	dlat := lat2 - lat1
+	dlon := lon2 - lon1
+
+	a := math.Sin(dlat/2)*math.Sin(dlat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*
+			math.Sin(dlon/2)*math.Sin(dlon/2)
+	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+
+	return int32(EarthRadiusMeters * c)
+}
+
+// Bounds is a rectangle with South <= North, spanning eastwards from West to
+// East. When West > East it crosses the antimeridian, holding the longitudes
+// from West to 180 degrees and from -180 degrees to East. Edges are
+// inclusive, so a rectangle whose corners are equal holds exactly one
+// location, and one whose corners share a latitude or longitude is a line.
+// Containment follows its S2 rectangle (see Rect), so 180 and -180 degrees
+// are the same meridian.
+type Bounds struct {
+	South, West, North, East int32
+}
+
+// Canonical returns the bounds of a rectangle, whichever way round its
+// corners are given. With CrossesAntimeridian set the longitudes instead
+// span eastwards from Lo to Hi, so a rectangle whose Lo longitude is greater
+// than its Hi longitude crosses the antimeridian. Missing corners count as
+// the origin; use ValidateRectangle to reject them.
+func Canonical(rect *pb.Rectangle) Bounds {
+	lo, hi := rect.GetLo(), rect.GetHi()
+	b := Bounds{
+		South: min(lo.GetLatitude(), hi.GetLatitude()),
+		West:  min(lo.GetLongitude(), hi.GetLongitude()),
+		North: max(lo.GetLatitude(), hi.GetLatitude()),
+		East:  max(lo.GetLongitude(), hi.GetLongitude()),
+	}
+	if rect.GetCrossesAntimeridian() {
+		b.West, b.East = lo.GetLongitude(), hi.GetLongitude()
+	}
+	return b
+}
+
+// ValidateRectangle checks that a rectangle and both its corners are
+// present, with valid coordinates. With CrossesAntimeridian set, one from
+// 180 to -180 degrees is the antimeridian itself, under both its longitudes.
+func ValidateRectangle(rect *pb.Rectangle) error {
+	if rect == nil {
+		return errors.New("missing rectangle")
+	}
+	if rect.Lo == nil || rect.Hi == nil {
+		return errors.New("missing corner")
+	}
+	if err := ValidatePoint(rect.Lo); err != nil {
+		return fmt.Errorf("lo: %v", err)
+	}
+	if err := ValidatePoint(rect.Hi); err != nil {
+		return fmt.Errorf("hi: %v", err)
+	}
+	return nil
+}
+
+// Contains reports whether a point lies within the bounds, edges included
+func (b Bounds) Contains(point *pb.Point) bool {
+	return b.Rect().ContainsLatLng(LatLng(point))
+}
+
+// Intersects reports whether two bounds share at least one location, edges
+// included
+func (b Bounds) Intersects(o Bounds) bool {
+	return b.Rect().Intersects(o.Rect())
+}
+
+// Wraps reports whether the bounds cross the antimeridian
+func (b Bounds) Wraps() bool {
+	return b.West > b.East
+}
+
+// Split returns the bounds as one or two that don't cross the antimeridian,
+// for lookups comparing longitudes as written: bounds crossing it as one part
+// reaching 180 degrees and one reaching -180 degrees, and bounds reaching
+// one of those longitudes along with the antimeridian under the other
+func (b Bounds) Split() []Bounds {
+	meridian := func(lon int32) Bounds { return Bounds{South: b.South, West: lon, North: b.North, East: lon} }
+	switch {
+	case b.West == -MaxLongitude && b.East == MaxLongitude:
+		return []Bounds{b}
+	case b.Wraps():
+		return []Bounds{
+			{South: b.South, West: b.West, North: b.North, East: MaxLongitude},
+			{South: b.South, West: -MaxLongitude, North: b.North, East: b.East},
+		}
+	case b.East == MaxLongitude:
+		return []Bounds{b, meridian(-MaxLongitude)}
+	case b.West == -MaxLongitude:
+		return []Bounds{b, meridian(MaxLongitude)}
+	}
+	return []Bounds{b}
+}
+
+// IsPoint reports whether the bounds hold a single location
+func (b Bounds) IsPoint() bool {
+	return b.South == b.North && b.West == b.East
+}
+
+// Rectangle returns the bounds as a rectangle from its south-west (Lo) to
+// its north-east (Hi) corner, crossing the antimeridian as the bounds do
+func (b Bounds) Rectangle() *pb.Rectangle {
+	return &pb.Rectangle{
+		Lo:                  &pb.Point{Latitude: b.South, Longitude: b.West},
+		Hi:                  &pb.Point{Latitude: b.North, Longitude: b.East},
+		CrossesAntimeridian: b.Wraps(),
+	}
+}
+
+// Contains reports whether a point lies within a rectangle, edges included
+func Contains(rect *pb.Rectangle, point *pb.Point) bool {
+	return Canonical(rect).Contains(point)
+}
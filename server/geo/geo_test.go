@@ -0,0 +1,340 @@
+package geo
+
+import (
+	"math"
+	"slices"
+	"testing"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+)
+
+func point(lat, lon int32) *pb.Point {
+	return &pb.Point{Latitude: lat, Longitude: lon}
+}
+
+// crossing returns a rectangle spanning eastwards from lo to hi
+func crossing(lo, hi *pb.Point) *pb.Rectangle {
+	return &pb.Rectangle{Lo: lo, Hi: hi, CrossesAntimeridian: true}
+}
+
+func TestCanonical(t *testing.T) {
+	// Corners may come either way round; with CrossesAntimeridian only
+	// latitudes may, and longitudes run east from Lo
+	ordered := Bounds{South: 10, West: -20, North: 30, East: 40}
+	tests := []struct {
+		rect *pb.Rectangle
+		want Bounds
+	}{
+		{&pb.Rectangle{Lo: point(10, -20), Hi: point(30, 40)}, ordered},
+		{&pb.Rectangle{Lo: point(30, 40), Hi: point(10, -20)}, ordered},
+		{&pb.Rectangle{Lo: point(10, 40), Hi: point(30, -20)}, ordered},
+		{&pb.Rectangle{Lo: point(30, -20), Hi: point(10, 40)}, ordered},
+		{&pb.Rectangle{Lo: point(30, -20), Hi: point(10, 40), CrossesAntimeridian: true}, ordered},
+		{&pb.Rectangle{Lo: point(10, 40), Hi: point(30, -20), CrossesAntimeridian: true}, Bounds{South: 10, West: 40, North: 30, East: -20}},
+		{&pb.Rectangle{Lo: point(30, 40), Hi: point(10, -20), CrossesAntimeridian: true}, Bounds{South: 10, West: 40, North: 30, East: -20}},
+	}
+	for _, tt := range tests {
+		if got := Canonical(tt.rect); got != tt.want {
+			t.Errorf("Canonical(%v) = %+v, want %+v", tt.rect, got, tt.want)
+		}
+		if wraps := tt.want.West > tt.want.East; tt.want.Wraps() != wraps {
+			t.Errorf("%+v.Wraps() = %v, want %v", tt.want, !wraps, wraps)
+		}
+	}
+}
+
+func TestCanonicalMissingCorners(t *testing.T) {
+	if got := Canonical(nil); got != (Bounds{}) {
+		t.Errorf("Canonical(nil) = %+v, want zero bounds", got)
+	}
+	got := Canonical(&pb.Rectangle{Hi: point(10, 20)})
+	if want := (Bounds{North: 10, East: 20}); got != want {
+		t.Errorf("Canonical without Lo = %+v, want %+v", got, want)
+	}
+}
+
+func TestContains(t *testing.T) {
+	rect := &pb.Rectangle{Lo: point(30, 40), Hi: point(10, -20)}
+	tests := []struct {
+		point *pb.Point
+		want  bool
+	}{
+		{point(20, 0), true},
+		{point(10, -20), true}, // corners are inclusive
+		{point(30, 40), true},
+		{point(10, 0), true}, // so are edges
+		{point(9, 0), false},
+		{point(31, 0), false},
+		{point(20, -21), false},
+		{point(20, 41), false},
+	}
+	for _, tt := range tests {
+		if got := Contains(rect, tt.point); got != tt.want {
+			t.Errorf("Contains(%v) = %v, want %v", tt.point, got, tt.want)
+		}
+	}
+}
+
+func TestContainsAtExtremes(t *testing.T) {
+	// Polar caps hold the poles, and bands along the antimeridian hold the
+	// points on the 180th meridian whichever sign they're written with
+	tests := []struct {
+		rect  *pb.Rectangle
+		point *pb.Point
+		want  bool
+	}{
+		{&pb.Rectangle{Lo: point(800000000, -MaxLongitude), Hi: point(MaxLatitude, MaxLongitude)}, point(MaxLatitude, 0), true},
+		{&pb.Rectangle{Lo: point(800000000, -MaxLongitude), Hi: point(MaxLatitude, MaxLongitude)}, point(MaxLatitude, -MaxLongitude), true},
+		{&pb.Rectangle{Lo: point(-MaxLatitude, -MaxLongitude), Hi: point(-800000000, MaxLongitude)}, point(-MaxLatitude, 123), true},
+		{&pb.Rectangle{Lo: point(-MaxLatitude, -MaxLongitude), Hi: point(-800000000, MaxLongitude)}, point(-799999999, 0), false},
+		{&pb.Rectangle{Lo: point(-100, 1790000000), Hi: point(100, MaxLongitude)}, point(0, MaxLongitude), true},
+		{&pb.Rectangle{Lo: point(-100, -MaxLongitude), Hi: point(100, -1790000000)}, point(0, -MaxLongitude), true},
+		{&pb.Rectangle{Lo: point(-100, 1790000000), Hi: point(100, MaxLongitude)}, point(0, -MaxLongitude), true},
+		{&pb.Rectangle{Lo: point(-100, -MaxLongitude), Hi: point(100, -1790000000)}, point(0, MaxLongitude), true},
+		{&pb.Rectangle{Lo: point(-100, 1790000000), Hi: point(100, 1799999999)}, point(0, -MaxLongitude), false},
+		{&pb.Rectangle{Lo: point(-100, MaxLongitude), Hi: point(100, MaxLongitude)}, point(0, -MaxLongitude), true},
+		// Corners are ordered by default: these span the Greenwich side
+		{&pb.Rectangle{Lo: point(-100, 1700000000), Hi: point(100, -1700000000)}, point(0, 0), true},
+		{&pb.Rectangle{Lo: point(-100, 1700000000), Hi: point(100, -1700000000)}, point(0, MaxLongitude), false},
+		// Crossing the antimeridian, they span the 20 degrees across it
+		{crossing(point(-100, 1700000000), point(100, -1700000000)), point(0, 0), false},
+		{crossing(point(-100, 1700000000), point(100, -1700000000)), point(0, MaxLongitude), true},
+		{crossing(point(-100, 1700000000), point(100, -1700000000)), point(0, -MaxLongitude), true},
+		{crossing(point(-100, 1700000000), point(100, -1700000000)), point(0, 1700000000), true},
+		{crossing(point(-100, 1700000000), point(100, -1700000000)), point(0, -1700000000), true},
+		{crossing(point(-100, 1700000000), point(100, -1700000000)), point(0, 1699999999), false},
+		{crossing(point(-100, 1700000000), point(100, -1700000000)), point(0, -1699999999), false},
+		{crossing(point(-100, 1700000000), point(100, -1700000000)), point(101, MaxLongitude), false},
+		// From 180 to -180 is the antimeridian alone, under both longitudes
+		{crossing(point(-100, MaxLongitude), point(100, -MaxLongitude)), point(0, MaxLongitude), true},
+		{crossing(point(-100, MaxLongitude), point(100, -MaxLongitude)), point(0, -MaxLongitude), true},
+		{crossing(point(-100, MaxLongitude), point(100, -MaxLongitude)), point(0, 0), false},
+	}
+	for _, tt := range tests {
+		if got := Contains(tt.rect, tt.point); got != tt.want {
+			t.Errorf("Contains(%v, %v) = %v, want %v", tt.rect, tt.point, got, tt.want)
+		}
+	}
+}
+
+func TestDegenerateBounds(t *testing.T) {
+	single := Canonical(&pb.Rectangle{Lo: point(5, 5), Hi: point(5, 5)})
+	if !single.IsPoint() {
+		t.Errorf("bounds with equal corners aren't a point")
+	}
+	if !single.Contains(point(5, 5)) || single.Contains(point(5, 6)) || single.Contains(point(6, 5)) {
+		t.Errorf("bounds with equal corners must contain exactly their corner")
+	}
+
+	line := Canonical(&pb.Rectangle{Lo: point(5, 0), Hi: point(5, 10)})
+	if line.IsPoint() {
+		t.Errorf("line bounds are a point")
+	}
+	if !line.Contains(point(5, 3)) || line.Contains(point(4, 3)) {
+		t.Errorf("line bounds must contain only points on the line")
+	}
+}
+
+func TestIntersects(t *testing.T) {
+	b := Bounds{South: 0, West: 0, North: 10, East: 10}
+	tests := []struct {
+		o    Bounds
+		want bool
+	}{
+		{Bounds{South: 5, West: 5, North: 15, East: 15}, true},
+		{Bounds{South: 2, West: 2, North: 3, East: 3}, true},     // inside
+		{Bounds{South: -5, West: -5, North: 15, East: 15}, true}, // around
+		{Bounds{South: 10, West: 10, North: 20, East: 20}, true}, // sharing a corner
+		{Bounds{South: 11, West: 0, North: 20, East: 10}, false},
+		{Bounds{South: 0, West: -10, North: 10, East: -1}, false},
+		{Bounds{South: 0, West: 5, North: 10, East: -170}, true},  // across the antimeridian, from inside
+		{Bounds{South: 0, West: 170, North: 10, East: 5}, true},   // across the antimeridian, into it
+		{Bounds{South: 0, West: 170, North: 10, East: -1}, false}, // around it the other way
+	}
+	for _, tt := range tests {
+		if got := b.Intersects(tt.o); got != tt.want {
+			t.Errorf("Intersects(%+v) = %v, want %v", tt.o, got, tt.want)
+		}
+		if back := tt.o.Intersects(b); back != tt.want {
+			t.Errorf("Intersects isn't symmetric for %+v", tt.o)
+		}
+	}
+}
+
+func TestBoundsRectangle(t *testing.T) {
+	b := Bounds{South: -1, West: -2, North: 3, East: 4}
+	if got := Canonical(b.Rectangle()); got != b {
+		t.Errorf("Canonical(Rectangle()) = %+v, want %+v", got, b)
+	}
+	wrapping := Bounds{South: -1, West: 4, North: 3, East: -2}
+	if got := Canonical(wrapping.Rectangle()); got != wrapping {
+		t.Errorf("Canonical(Rectangle()) = %+v, want %+v", got, wrapping)
+	}
+	rect := b.Rectangle()
+	if rect.Lo.Latitude != -1 || rect.Lo.Longitude != -2 || rect.Hi.Latitude != 3 || rect.Hi.Longitude != 4 || rect.CrossesAntimeridian {
+		t.Errorf("Rectangle() = %v, want Lo at the south-west corner", rect)
+	}
+}
+
+func TestValidateRectangle(t *testing.T) {
+	tests := []struct {
+		name  string
+		rect  *pb.Rectangle
+		valid bool
+	}{
+		{"valid", &pb.Rectangle{Lo: point(-MaxLatitude, -MaxLongitude), Hi: point(MaxLatitude, MaxLongitude)}, true},
+		{"equal corners", &pb.Rectangle{Lo: point(1, 1), Hi: point(1, 1)}, true},
+		{"across the antimeridian", crossing(point(-10, 1700000000), point(10, -1700000000)), true},
+		{"the antimeridian", crossing(point(-10, MaxLongitude), point(10, -MaxLongitude)), true},
+		{"nil", nil, false},
+		{"missing lo", &pb.Rectangle{Hi: point(1, 1)}, false},
+		{"missing hi", &pb.Rectangle{Lo: point(1, 1)}, false},
+		{"latitude out of range", &pb.Rectangle{Lo: point(MaxLatitude+1, 0), Hi: point(0, 0)}, false},
+		{"longitude out of range", &pb.Rectangle{Lo: point(0, 0), Hi: point(0, -MaxLongitude-1)}, false},
+	}
+	for _, tt := range tests {
+		if err := ValidateRectangle(tt.rect); (err == nil) != tt.valid {
+			t.Errorf("%s: ValidateRectangle() = %v, want valid %v", tt.name, err, tt.valid)
+		}
+	}
+}
+
+func TestValidatePoint(t *testing.T) {
+	if err := ValidatePoint(nil); err == nil {
+		t.Errorf("ValidatePoint(nil) succeeded")
+	}
+	if err := ValidatePoint(point(MaxLatitude, -MaxLongitude)); err != nil {
+		t.Errorf("ValidatePoint at the limits: %v", err)
+	}
+	if err := ValidatePoint(point(-MaxLatitude-1, 0)); err == nil {
+		t.Errorf("ValidatePoint accepted a latitude below -90")
+	}
+}
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		name   string
+		p1, p2 *pb.Point
+		want   int32 // meters
+	}{
+		{"same point", point(407838351, -746143763), point(407838351, -746143763), 0},
+		{"degree of latitude", point(0, 0), point(E7PerDegree, 0), 111194},
+		{"degree of longitude at the equator", point(0, 0), point(0, E7PerDegree), 111194},
+		{"pole to pole", point(-MaxLatitude, 0), point(MaxLatitude, 0), 20015086},
+		{"across the antimeridian", point(0, -179*E7PerDegree), point(0, 179*E7PerDegree), 222389},
+	}
+	for _, tt := range tests {
+		got := Distance(tt.p1, tt.p2)
+		if diff := got - tt.want; diff < -1 || diff > 1 {
+			t.Errorf("%s: Distance() = %d, want %d", tt.name, got, tt.want)
+		}
+		if back := Distance(tt.p2, tt.p1); back != got {
+			t.Errorf("%s: Distance isn't symmetric: %d and %d", tt.name, got, back)
+		}
+	}
+}
+
+func TestUnitConversions(t *testing.T) {
+	if got := Degrees(407838351); got != 40.7838351 {
+		t.Errorf("Degrees(407838351) = %v, want 40.7838351", got)
+	}
+	for _, e7 := range []int32{0, 1, -1, 407838351, -746143763, MaxLatitude, -MaxLongitude} {
+		if got := E7(Degrees(e7)); got != e7 {
+			t.Errorf("E7(Degrees(%d)) = %d", e7, got)
+		}
+	}
+	if got := E7(0.00000005); got != 1 {
+		t.Errorf("E7 rounds 0.5 E7 units to %d, want 1", got)
+	}
+	if got := Radians(180); got != 3.141592653589793 {
+		t.Errorf("Radians(180) = %v, want pi", got)
+	}
+}
+
+func TestWebMercator(t *testing.T) {
+	tests := []struct {
+		name string
+		p    *pb.Point
+		x, y float64
+	}{
+		{"origin", point(0, 0), 0, 0},
+		{"antimeridian", point(0, MaxLongitude), MaxWebMercator, 0},
+		{"edge of the projection", point(850511288, 0), 0, MaxWebMercator},
+		{"north pole", point(MaxLatitude, 0), 0, MaxWebMercator},
+		{"south pole", point(-MaxLatitude, 0), 0, -MaxWebMercator},
+		{"new york", point(407838351, -746143763), -8306034.4, 4980509.5},
+	}
+	for _, tt := range tests {
+		x, y := WebMercator(tt.p)
+		if math.Abs(x-tt.x) > 0.1 || math.Abs(y-tt.y) > 0.1 {
+			t.Errorf("%s: WebMercator() = (%v, %v), want (%v, %v)", tt.name, x, y, tt.x, tt.y)
+		}
+	}
+
+	for _, p := range []*pb.Point{point(0, 0), point(407838351, -746143763), point(-850000000, MaxLongitude)} {
+		back, err := FromWebMercator(WebMercator(p))
+		if err != nil {
+			t.Errorf("FromWebMercator(WebMercator(%v)): %v", p, err)
+			continue
+		}
+		if back.Latitude != p.Latitude || back.Longitude != p.Longitude {
+			t.Errorf("FromWebMercator(WebMercator(%v)) = %v", p, back)
+		}
+	}
+	for _, xy := range [][2]float64{{MaxWebMercator + 1, 0}, {0, -MaxWebMercator - 1}, {math.NaN(), 0}} {
+		if _, err := FromWebMercator(xy[0], xy[1]); err == nil {
+			t.Errorf("FromWebMercator(%v, %v) succeeded", xy[0], xy[1])
+		}
+	}
+}
+
+func TestFromDegrees(t *testing.T) {
+	p, err := FromDegrees(-74.6143763, 40.7838351)
+	if err != nil || p.Latitude != 407838351 || p.Longitude != -746143763 {
+		t.Errorf("FromDegrees() = %v, %v", p, err)
+	}
+	for _, lonLat := range [][2]float64{{180.1, 0}, {0, -90.1}, {0, math.Inf(1)}, {math.NaN(), 0}} {
+		if _, err := FromDegrees(lonLat[0], lonLat[1]); err == nil {
+			t.Errorf("FromDegrees(%v, %v) succeeded", lonLat[0], lonLat[1])
+		}
+	}
+}
+
+func TestKey(t *testing.T) {
+	if got := Key(point(407838351, -746143763)); got != "407838351,-746143763" {
+		t.Errorf("Key() = %q", got)
+	}
+}
+
+func TestSplit(t *testing.T) {
+	// The parts hold, with longitudes compared as written, what the bounds
+	// hold
+	tests := []struct {
+		name string
+		b    Bounds
+		want []Bounds
+	}{
+		{"ordered", Bounds{-1, -2, 3, 4}, []Bounds{{-1, -2, 3, 4}}},
+		{"across the antimeridian", Bounds{-1, 4, 3, -2}, []Bounds{{-1, 4, 3, MaxLongitude}, {-1, -MaxLongitude, 3, -2}}},
+		{"reaching 180", Bounds{-1, 4, 3, MaxLongitude}, []Bounds{{-1, 4, 3, MaxLongitude}, {-1, -MaxLongitude, 3, -MaxLongitude}}},
+		{"reaching -180", Bounds{-1, -MaxLongitude, 3, 4}, []Bounds{{-1, -MaxLongitude, 3, 4}, {-1, MaxLongitude, 3, MaxLongitude}}},
+		{"the antimeridian", Bounds{-1, MaxLongitude, 3, -MaxLongitude}, []Bounds{{-1, MaxLongitude, 3, MaxLongitude}, {-1, -MaxLongitude, 3, -MaxLongitude}}},
+		{"around the world", Bounds{-1, -MaxLongitude, 3, MaxLongitude}, []Bounds{{-1, -MaxLongitude, 3, MaxLongitude}}},
+	}
+	for _, tt := range tests {
+		got := tt.b.Split()
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s: Split() = %+v, want %+v", tt.name, got, tt.want)
+		}
+		for _, lon := range []int32{-MaxLongitude, -2, 0, 4, MaxLongitude} {
+			p := point(1, lon)
+			inPart := false
+			for _, part := range got {
+				inPart = inPart || (part.West <= lon && lon <= part.East)
+			}
+			if inPart != tt.b.Contains(p) {
+				t.Errorf("%s: a part holds %v = %v, Contains() = %v", tt.name, p, inPart, !inPart)
+			}
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package geo
+
+import (
+	"cmp"
+	"fmt"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+)
+
+// MaxPolygonVertices caps the vertices of a polygon, which validation checks
+// pairwise for crossing edges
+const MaxPolygonVertices = 1000
+
+// Polygon is a simple polygon ring: its edges join consecutive vertices and
+// the last vertex back to the first, and never cross. Edges are straight in
+// latitude-longitude space, as with Bounds, and part of the polygon.
+type Polygon []*pb.Point
+
+// NewPolygon checks that points form a simple polygon ring and returns it.
+// A last point equal to the first is dropped, so closed rings are accepted.
+func NewPolygon(points []*pb.Point) (Polygon, error) {
+	if n := len(points); n > 1 && Key(points[0]) == Key(points[n-1]) {
+		points = points[:n-1]
+	}
+	if len(points) < 3 {
+		return nil, fmt.Errorf("a polygon needs at least 3 vertices, got %d", len(points))
+	}
+	if len(points) > MaxPolygonVertices {
+		return nil, fmt.Errorf("a polygon has at most %d vertices, got %d", MaxPolygonVertices, len(points))
+	}
+	for i, p := range points {
+		if err := ValidatePoint(p); err != nil {
+			return nil, fmt.Errorf("vertex %d: %v", i, err)
+		}
+	}
+
+	ring := Polygon(points)
+	n := len(ring)
+	for i := range n {
+		if Key(ring[i]) == Key(ring[(i+1)%n]) {
+			return nil, fmt.Errorf("vertex %d repeats vertex %d", (i+1)%n, i)
+		}
+	}
+	for i := range n {
+		// Consecutive edges only meet at their shared vertex, unless the
+		// second doubles back along the first
+		a, b, c := ring[i], ring[(i+1)%n], ring[(i+2)%n]
+		if orientation(a, b, c) == 0 && (onSegment(a, b, c) || onSegment(b, c, a)) {
+			return nil, fmt.Errorf("edge %d doubles back over edge %d", (i+1)%n, i)
+		}
+	}
+	for i := range n {
+		for j := i + 2; j < n; j++ {
+			if i == 0 && j == n-1 {
+				continue // adjacent, through the closing edge
+			}
+			if segmentsIntersect(ring[i], ring[i+1], ring[j], ring[(j+1)%n]) {
+				return nil, fmt.Errorf("the polygon intersects itself: edges %d and %d cross", i, j)
+			}
+		}
+	}
+	return ring, nil
+}
+
+// Bounds returns the smallest bounds holding the polygon
+func (ring Polygon) Bounds() Bounds {
+	b := Bounds{South: ring[0].Latitude, West: ring[0].Longitude, North: ring[0].Latitude, East: ring[0].Longitude}
+	for _, p := range ring[1:] {
+		b.South = min(b.South, p.Latitude)
+		b.West = min(b.West, p.Longitude)
+		b.North = max(b.North, p.Latitude)
+		b.East = max(b.East, p.Longitude)
+	}
+	return b
+}
+
+// Contains reports whether a point lies within the polygon, edges included
+func (ring Polygon) Contains(point *pb.Point) bool {
+	// Count the edges a ray from point towards the east crosses
+	inside := false
+	for i, a := range ring {
+		b := ring[(i+1)%len(ring)]
+		o := orientation(a, b, point)
+		if o == 0 && onSegment(a, b, point) {
+			return true
+		}
+		// Vertices on the ray count with the edge above them only, so a ray
+		// through a vertex crosses once or not at all
+		if (a.Latitude > point.Latitude) != (b.Latitude > point.Latitude) && (b.Latitude > a.Latitude) == (o > 0) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// orientation returns 1 if c is to the left of the line from a to b
+// (counterclockwise, with longitude as x and latitude as y), -1 if it is to
+// the right and 0 if the three points are collinear. Each product is at most
+// 360° by 180° in E7 units, which fits in an int64, so the result is exact.
+func orientation(a, b, c *pb.Point) int {
+	return cmp.Compare(
+		(int64(b.Longitude)-int64(a.Longitude))*(int64(c.Latitude)-int64(a.Latitude)),
+		(int64(b.Latitude)-int64(a.Latitude))*(int64(c.Longitude)-int64(a.Longitude)),
+	)
+}
+
+// onSegment reports whether c, collinear with a and b, lies between them
+func onSegment(a, b, c *pb.Point) bool {
+	return min(a.Longitude, b.Longitude) <= c.Longitude && c.Longitude <= max(a.Longitude, b.Longitude) &&
+		min(a.Latitude, b.Latitude) <= c.Latitude && c.Latitude <= max(a.Latitude, b.Latitude)
+}
+
+// segmentsIntersect reports whether the segments p1-p2 and q1-q2 share a
+// point, ends included
+func segmentsIntersect(p1, p2, q1, q2 *pb.Point) bool {
+	o1, o2 := orientation(p1, p2, q1), orientation(p1, p2, q2)
+	o3, o4 := orientation(q1, q2, p1), orientation(q1, q2, p2)
+	if o1*o2 < 0 && o3*o4 < 0 {
+		return true
+	}
+	return (o1 == 0 && onSegment(p1, p2, q1)) || (o2 == 0 && onSegment(p1, p2, q2)) ||
+		(o3 == 0 && onSegment(q1, q2, p1)) || (o4 == 0 && onSegment(q1, q2, p2))
+}
@@ -0,0 +1,119 @@
+package geo
+
+import (
+	"strings"
+	"testing"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+)
+
+// ring builds polygon vertices from latitude, longitude pairs
+func ring(coords ...int32) []*pb.Point {
+	var points []*pb.Point
+	for i := 0; i < len(coords); i += 2 {
+		points = append(points, point(coords[i], coords[i+1]))
+	}
+	return points
+}
+
+func TestPolygonContains(t *testing.T) {
+	// A U shape open to the north, its notch spanning latitudes 10 to 30 and
+	// longitudes 10 to 20
+	u, err := NewPolygon(ring(0, 0, 0, 30, 30, 30, 30, 20, 10, 20, 10, 10, 30, 10, 30, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		point *pb.Point
+		want  bool
+	}{
+		{point(5, 15), true},
+		{point(20, 5), true},
+		{point(20, 25), true},
+		{point(20, 15), false}, // in the notch
+		{point(0, 0), true},    // vertices are part of the polygon
+		{point(0, 17), true},   // so are edges
+		{point(15, 10), true},
+		{point(10, 15), true},
+		{point(10, 5), true}, // rays through vertices
+		{point(10, 25), true},
+		{point(10, -5), false},
+		{point(30, -5), false},
+		{point(30, 5), true},
+		{point(10, 35), false},
+		{point(-1, 15), false},
+		{point(31, 5), false},
+	}
+	for _, tt := range tests {
+		if got := u.Contains(tt.point); got != tt.want {
+			t.Errorf("Contains(%v) = %v, want %v", tt.point, got, tt.want)
+		}
+	}
+	if got, want := u.Bounds(), (Bounds{South: 0, West: 0, North: 30, East: 30}); got != want {
+		t.Errorf("Bounds() = %v, want %v", got, want)
+	}
+}
+
+func TestPolygonContainsAtExtremes(t *testing.T) {
+	// Products of E7 differences spanning the whole globe must not overflow
+	world, err := NewPolygon(ring(-MaxLatitude, -MaxLongitude, -MaxLatitude, MaxLongitude, MaxLatitude, MaxLongitude, MaxLatitude, -MaxLongitude))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range []*pb.Point{point(0, 0), point(MaxLatitude, MaxLongitude), point(-MaxLatitude, 0), point(1, -MaxLongitude+1)} {
+		if !world.Contains(p) {
+			t.Errorf("Contains(%v) = false for a polygon covering the globe", p)
+		}
+	}
+	thin, err := NewPolygon(ring(-MaxLatitude, -MaxLongitude, MaxLatitude, MaxLongitude, MaxLatitude, MaxLongitude-1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if thin.Contains(point(1, 0)) || !thin.Contains(point(0, 0)) {
+		t.Errorf("Contains() is wrong along a diagonal spanning the globe")
+	}
+}
+
+func TestNewPolygon(t *testing.T) {
+	valid := [][]*pb.Point{
+		ring(0, 0, 0, 10, 10, 0),
+		ring(0, 0, 0, 10, 10, 0, 0, 0),    // closed
+		ring(0, 0, 0, 5, 0, 10, 10, 10),   // collinear vertices along an edge
+		ring(0, 0, 10, 0, 10, 10, 0, 10),  // clockwise
+		ring(0, 0, 20, 10, 0, 20, 10, 10), // concave
+	}
+	for _, points := range valid {
+		if _, err := NewPolygon(points); err != nil {
+			t.Errorf("NewPolygon(%v) failed: %v", points, err)
+		}
+	}
+
+	invalid := []struct {
+		points []*pb.Point
+		err    string
+	}{
+		{nil, "at least 3"},
+		{ring(0, 0, 0, 10), "at least 3"},
+		{ring(0, 0, 0, 10, 0, 0), "at least 3"},
+		{ring(0, 0, 0, 10, 10, 10, 91e7, 0), "latitude"},
+		{ring(0, 0, 0, 10, 0, 10, 10, 10), "repeats"},
+		{ring(0, 0, 0, 10, 0, 5), "doubles back"},                               // all on a line
+		{ring(0, 0, 10, 10, 0, 10, 10, 0), "intersects itself"},                 // a bow tie
+		{ring(0, 0, 0, 20, 10, 20, 0, 10, 10, 0), "intersects itself"},          // touching at a vertex
+		{ring(0, 0, 0, 20, 10, 10, 20, 20, 20, 0, 10, 10), "intersects itself"}, // a figure eight
+	}
+	for _, tt := range invalid {
+		_, err := NewPolygon(tt.points)
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("NewPolygon(%v) = %v, want an error about %q", tt.points, err, tt.err)
+		}
+	}
+
+	many := make([]*pb.Point, MaxPolygonVertices+1)
+	for i := range many {
+		many[i] = point(int32(i), int32(i*i))
+	}
+	if _, err := NewPolygon(many); err == nil || !strings.Contains(err.Error(), "at most") {
+		t.Errorf("NewPolygon() with %d vertices = %v, want an error", len(many), err)
+	}
+}
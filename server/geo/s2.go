@@ -0,0 +1,56 @@
+package geo
+
+import (
+	"math"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/golang/geo/r1"
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+)
+
+// MaxCellLevel is the level of the smallest S2 cells, about a centimeter
+// across
+const MaxCellLevel = s2.MaxLevel
+
+// LatLng converts a point to an S2 latitude and longitude
+func LatLng(point *pb.Point) s2.LatLng {
+	return s2.LatLngFromDegrees(Degrees(point.Latitude), Degrees(point.Longitude))
+}
+
+// CellID returns the leaf S2 cell holding a point
+func CellID(point *pb.Point) s2.CellID {
+	return s2.CellIDFromLatLng(LatLng(point))
+}
+
+// Rect returns the bounds as an S2 latitude-longitude rectangle. Its edges
+// are converted the same way as points (see LatLng), so it holds exactly the
+// locations within the bounds, edges included.
+func (b Bounds) Rect() s2.Rect {
+	sw, ne := LatLng(&pb.Point{Latitude: b.South, Longitude: b.West}), LatLng(&pb.Point{Latitude: b.North, Longitude: b.East})
+	lng := s1.IntervalFromEndpoints(sw.Lng.Radians(), ne.Lng.Radians())
+	if b.West == MaxLongitude && b.East == -MaxLongitude {
+		// From 180 to -180 degrees is the antimeridian, not the empty
+		// interval S2 reads it as
+		lng = s1.Interval{Lo: math.Pi, Hi: math.Pi}
+	}
+	return s2.Rect{Lat: r1.Interval{Lo: sw.Lat.Radians(), Hi: ne.Lat.Radians()}, Lng: lng}
+}
+
+// Region returns the bounds' Rect one E7 unit wider on every side, so that
+// cells covering it hold the locations on its edges despite rounding. It may
+// hold locations just outside: check candidates with Contains.
+func (b Bounds) Region() s2.Rect {
+	margin := Radians(1 / E7PerDegree)
+	rect := b.Rect()
+	lat := rect.Lat.Expanded(margin).Intersection(r1.Interval{Lo: -math.Pi / 2, Hi: math.Pi / 2})
+	return s2.Rect{Lat: lat, Lng: rect.Lng.Expanded(margin)}
+}
+
+// Covering returns about maxCells S2 cells, none smaller than maxLevel,
+// covering the bounds' Region. Cell ranges of the covering answer the
+// bounds from features sorted by CellID.
+func (b Bounds) Covering(maxCells, maxLevel int) s2.CellUnion {
+	coverer := &s2.RegionCoverer{MaxLevel: maxLevel, LevelMod: 1, MaxCells: maxCells}
+	return coverer.Covering(b.Region())
+}
@@ -0,0 +1,111 @@
+package geo
+
+import (
+	"testing"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+)
+
+func TestRegionHoldsBounds(t *testing.T) {
+	tests := []struct {
+		name   string
+		rect   *pb.Rectangle
+		inside []*pb.Point
+	}{
+		{"ordered", &pb.Rectangle{Lo: point(10, -20), Hi: point(30, 40)}, []*pb.Point{point(10, -20), point(30, 40), point(20, 0)}},
+		{"a single location", &pb.Rectangle{Lo: point(5, 5), Hi: point(5, 5)}, []*pb.Point{point(5, 5)}},
+		{"the north pole", &pb.Rectangle{Lo: point(800000000, -MaxLongitude), Hi: point(MaxLatitude, MaxLongitude)}, []*pb.Point{point(MaxLatitude, 0), point(800000000, MaxLongitude)}},
+		{"across the antimeridian", crossing(point(-100, 1700000000), point(100, -1700000000)), []*pb.Point{point(0, MaxLongitude), point(0, -MaxLongitude), point(100, 1700000000), point(-100, -1700000000)}},
+		{"the antimeridian", crossing(point(-100, MaxLongitude), point(100, -MaxLongitude)), []*pb.Point{point(0, MaxLongitude), point(0, -MaxLongitude)}},
+		{"around the world", crossing(point(0, 1), point(10, 0)), []*pb.Point{point(0, 1), point(5, MaxLongitude), point(10, 0)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := Canonical(tt.rect)
+			region := b.Region()
+			covering := b.Covering(8, MaxCellLevel)
+			for _, p := range tt.inside {
+				if !region.ContainsLatLng(LatLng(p)) {
+					t.Errorf("Region() = %v doesn't hold %v", region, p)
+				}
+				if !covering.ContainsCellID(CellID(p)) {
+					t.Errorf("Covering() doesn't hold the cell of %v", p)
+				}
+			}
+		})
+	}
+}
+
+func TestRegionCrossingAntimeridian(t *testing.T) {
+	region := Canonical(crossing(point(-100, 1700000000), point(100, -1700000000))).Region()
+	if !region.Lng.IsInverted() {
+		t.Errorf("Region() = %v, want longitudes across the antimeridian", region)
+	}
+	if region.ContainsLatLng(LatLng(point(0, 0))) {
+		t.Errorf("Region() = %v holds Greenwich", region)
+	}
+	ordered := Canonical(&pb.Rectangle{Lo: point(-100, 1700000000), Hi: point(100, -1700000000)}).Region()
+	if !ordered.ContainsLatLng(LatLng(point(0, 0))) {
+		t.Errorf("Region() = %v of ordered corners doesn't hold Greenwich", ordered)
+	}
+}
+
+func TestCoveringSize(t *testing.T) {
+	b := Canonical(&pb.Rectangle{Lo: point(400000000, -750000000), Hi: point(415000000, -730000000)})
+	if got := len(b.Covering(4, MaxCellLevel)); got == 0 || got > 4 {
+		t.Errorf("Covering(4) has %d cells, want 1 to 4", got)
+	}
+	for _, cell := range b.Covering(100, 6) {
+		if cell.Level() > 6 {
+			t.Errorf("Covering(100, 6) has a cell of level %d", cell.Level())
+		}
+	}
+}
+
+func TestRectCrossingAntimeridian(t *testing.T) {
+	tests := []struct {
+		name     string
+		rect     *pb.Rectangle
+		inverted bool // the longitudes wrap across the antimeridian
+		inside   []*pb.Point
+		outside  []*pb.Point
+	}{
+		{"across the antimeridian", crossing(point(-100, 1700000000), point(100, -1700000000)), true,
+			[]*pb.Point{point(-100, 1700000000), point(100, -1700000000), point(0, MaxLongitude), point(0, -MaxLongitude)},
+			[]*pb.Point{point(0, 0), point(0, 1699999999), point(0, -1699999999), point(101, MaxLongitude)}},
+		{"the antimeridian", crossing(point(-100, MaxLongitude), point(100, -MaxLongitude)), false,
+			[]*pb.Point{point(0, MaxLongitude), point(0, -MaxLongitude)},
+			[]*pb.Point{point(0, 0), point(0, 1799999999), point(0, -1799999999)}},
+		{"from -180", crossing(point(-100, -MaxLongitude), point(100, -1700000000)), true,
+			[]*pb.Point{point(0, MaxLongitude), point(0, -MaxLongitude), point(0, -1700000000)},
+			[]*pb.Point{point(0, 1799999999), point(0, -1699999999)}},
+		{"around the world", crossing(point(0, 1), point(10, 0)), true,
+			[]*pb.Point{point(0, 1), point(5, MaxLongitude), point(10, 0)},
+			[]*pb.Point{point(11, 0)}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := Canonical(tt.rect)
+			rect := b.Rect()
+			if rect.IsEmpty() || rect.Lng.IsInverted() != tt.inverted {
+				t.Errorf("Rect() = %v, want inverted longitudes %v", rect, tt.inverted)
+			}
+			for _, p := range tt.inside {
+				if !b.Contains(p) || !rect.ContainsLatLng(LatLng(p)) {
+					t.Errorf("%v doesn't hold %v", rect, p)
+				}
+			}
+			for _, p := range tt.outside {
+				if b.Contains(p) {
+					t.Errorf("%v holds %v", rect, p)
+				}
+			}
+		})
+	}
+
+	// Bounds meeting on the antimeridian touch, whichever sign they reach it with
+	east, west := Bounds{South: 0, West: 1700000000, North: 10, East: MaxLongitude}, Bounds{South: 0, West: -MaxLongitude, North: 10, East: -1700000000}
+	if !east.Intersects(west) || !west.Intersects(east) {
+		t.Errorf("%+v and %+v don't intersect on the antimeridian", east, west)
+	}
+}
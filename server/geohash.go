@@ -0,0 +1,42 @@
+package main
+
+// geohashAlphabet is the base32 alphabet used by geohashes
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// encodeGeohash returns the geohash of a point with the given number of characters
+func encodeGeohash(lat, lon float64, precision int) string {
+	latLo, latHi := -90.0, 90.0
+	lonLo, lonHi := -180.0, 180.0
+
+	hash := make([]byte, 0, precision)
+	var bits, ch int
+	even := true // geohashes interleave bits starting with longitude
+	for len(hash) < precision {
+		if even {
+			mid := (lonLo + lonHi) / 2
+			ch <<= 1
+			if lon >= mid {
+				ch |= 1
+				lonLo = mid
+			} else {
+				lonHi = mid
+			}
+		} else {
+			mid := (latLo + latHi) / 2
+			ch <<= 1
+			if lat >= mid {
+				ch |= 1
+				latLo = mid
+			} else {
+				latHi = mid
+			}
+		}
+		even = !even
+
+		if bits++; bits == 5 {
+			hash = append(hash, geohashAlphabet[ch])
+			bits, ch = 0, 0
+		}
+	}
+	return string(hash)
+}
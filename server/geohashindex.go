@@ -0,0 +1,106 @@
+package main
+
+import (
+	"math"
+	"slices"
+
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+)
+
+// geohashShards partitions a dataset's features into buckets by the geohash
+// prefix of their location, so a rectangle query only scans the buckets of
+// the cells it overlaps
+type geohashShards struct {
+	features  []*featureRecord
+	precision int
+	buckets   map[string]*geohashBucket
+}
+
+// geohashBucket holds the features sharing a geohash prefix
+type geohashBucket struct {
+	bounds    geo.Bounds // of the features, tighter than the geohash cell
+	positions []int      // positions in the dataset, ascending
+}
+
+// buildGeohashShards buckets features by geohash prefixes of precision
+// characters
+func buildGeohashShards(features []*featureRecord, precision int) *geohashShards {
+	g := &geohashShards{features: features, precision: precision, buckets: make(map[string]*geohashBucket)}
+	for i, feature := range features {
+		loc := feature.Location
+		hash := encodeGeohash(geo.Degrees(loc.Latitude), geo.Degrees(loc.Longitude), precision)
+		bucket := g.buckets[hash]
+		if bucket == nil {
+			bucket = &geohashBucket{bounds: pointBounds(loc)}
+			g.buckets[hash] = bucket
+		}
+		bucket.bounds = unionBounds(bucket.bounds, pointBounds(loc))
+		bucket.positions = append(bucket.positions, i)
+	}
+	return g
+}
+
+// cellSize returns the height and width of the geohash cells, in degrees.
+// Geohashes interleave bits starting with longitude, so longitude gets the
+// extra bit of odd totals.
+func (g *geohashShards) cellSize() (lat, lon float64) {
+	bits := 5 * g.precision
+	return 180 / math.Exp2(float64(bits/2)), 360 / math.Exp2(float64((bits+1)/2))
+}
+
+// search returns, in ascending order, the positions of the features within
+// bounds, edges included
+func (g *geohashShards) search(bounds geo.Bounds) []int {
+	var positions []int
+	scan := func(bucket *geohashBucket) {
+		if !bucket.bounds.Intersects(bounds) {
+			return
+		}
+		for _, pos := range bucket.positions {
+			if bounds.Contains(g.features[pos].Location) {
+				positions = append(positions, pos)
+			}
+		}
+	}
+
+	height, width := g.cellSize()
+	cell := func(degrees, origin, size, cells float64) int {
+		return int(min(math.Floor((degrees-origin)/size), cells-1))
+	}
+	latCells, lonCells := 180/height, 360/width
+	south := cell(geo.Degrees(bounds.South), -90, height, latCells)
+	north := cell(geo.Degrees(bounds.North), -90, height, latCells)
+	// Cells are numbered by longitude as written, so each side of the
+	// antimeridian is walked on its own
+	parts := bounds.Split()
+	var cells int64
+	for _, part := range parts {
+		columns := cell(geo.Degrees(part.East), -180, width, lonCells) - cell(geo.Degrees(part.West), -180, width, lonCells) + 1
+		cells += int64(north-south+1) * int64(columns)
+	}
+
+	// Walking the buckets is cheaper than looking up more cells than there
+	// are buckets
+	if cells > int64(len(g.buckets)) {
+		for _, bucket := range g.buckets {
+			scan(bucket)
+		}
+	} else {
+		for _, part := range parts {
+			west := cell(geo.Degrees(part.West), -180, width, lonCells)
+			east := cell(geo.Degrees(part.East), -180, width, lonCells)
+			for lat := south; lat <= north; lat++ {
+				for lon := west; lon <= east; lon++ {
+					// Hash the center of the cell, safely away from its edges
+					hash := encodeGeohash(-90+(float64(lat)+0.5)*height, -180+(float64(lon)+0.5)*width, g.precision)
+					if bucket := g.buckets[hash]; bucket != nil {
+						scan(bucket)
+					}
+				}
+			}
+		}
+	}
+	// The parts may share cells
+	slices.Sort(positions)
+	return slices.Compact(positions)
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errReplicaFailed is returned by a simulated replica that decided to fail
+var errReplicaFailed = errors.New("replica failed")
+
+// featureReplica simulates a storage replica holding a copy of the features.
+// Each lookup takes a random amount of time up to maxLatency and fails with
+// probability failureRate, which is enough to demonstrate hedged requests.
+type featureReplica struct {
+	name        string
+	maxLatency  time.Duration
+	failureRate float64
+	breaker     *circuitBreaker // skips the replica while it keeps failing
+}
+
+// newReplicas creates n simulated replicas with the given latency and failure characteristics
+func newReplicas(n int, maxLatency time.Duration, failureRate float64, breaker breakerConfig) []*featureReplica {
+	replicas := make([]*featureReplica, n)
+	for i := range replicas {
+		name := fmt.Sprintf("replica-%d", i)
+		replicas[i] = &featureReplica{
+			name:        name,
+			maxLatency:  maxLatency,
+			failureRate: failureRate,
+			breaker:     breaker.new(name),
+		}
+	}
+	return replicas
+}
+
+// lookup finds the feature at point on the replica, failing fast with
+// errBreakerOpen while the replica's circuit breaker is open
+func (r *featureReplica) lookup(ctx context.Context, features FeatureStore, point *pb.Point, at time.Time) (*featureRecord, error) {
+	if err := r.breaker.allow(); err != nil {
+		return nil, err
+	}
+	feature, err := r.query(ctx, features, point, at)
+	r.breaker.done(err)
+	return feature, err
+}
+
+// query simulates the replica's latency and failures, then finds the feature at point
+func (r *featureReplica) query(ctx context.Context, features FeatureStore, point *pb.Point, at time.Time) (*featureRecord, error) {
+	var delay time.Duration
+	if r.maxLatency > 0 {
+		delay = rand.N(r.maxLatency)
+	}
+
+	select {
+	case <-time.After(delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	if rand.Float64() < r.failureRate {
+		return nil, errReplicaFailed
+	}
+
+	return features.FeatureAt(ctx, point, at)
+}
+
+// replicaResult carries the outcome of a single replica lookup
+type replicaResult struct {
+	replica *featureReplica
+	feature *featureRecord
+	err     error
+}
+
+// GetFeatureFast returns the feature at the given point using hedged replica lookups (unary RPC)
+func (s *routeGuideServer) GetFeatureFast(ctx context.Context, point *pb.Point) (*pb.Feature, error) {
+	logger := loggerFrom(ctx)
+	logger.Info("GetFeatureFast called", "lat", point.Latitude, "lon", point.Longitude)
+
+	if len(s.replicas) == 0 {
+		return nil, status.Error(codes.Unavailable, "no replicas configured")
+	}
+
+	at, err := queryTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+	features, err := s.featuresFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Cancelling the context stops the replicas that lost the race
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan replicaResult, len(s.replicas))
+	launch := func(r *featureReplica) {
+		go func() {
+			feature, err := r.lookup(ctx, features, point, at)
+			results <- replicaResult{replica: r, feature: feature, err: err}
+		}()
+	}
+
+	// Start with the first replica and hedge to the next one every hedgeDelay,
+	// or immediately when a replica fails
+	var launched, failed, open int
+	var hedge <-chan time.Time
+	next := func() {
+		launch(s.replicas[launched])
+		launched++
+		hedge = nil
+		if launched < len(s.replicas) {
+			hedge = time.After(s.hedgeDelay)
+		}
+	}
+	next()
+
+	for failed < len(s.replicas) {
+		select {
+		case res := <-results:
+			if res.err == nil {
+				logger.Info("GetFeatureFast answered", "replica", res.replica.name, "launched", launched, "replicas", len(s.replicas))
+				if res.feature != nil {
+					s.popularity.hit(geo.Key(res.feature.Location), time.Now())
+					return res.feature.localized(s.requestLocales(ctx)), nil
+				}
+				return &pb.Feature{Location: point}, nil
+			}
+
+			failed++
+			if errors.Is(res.err, errBreakerOpen) {
+				open++
+			}
+			logger.Warn("Replica failed", "replica", res.replica.name, "error", res.err)
+			if launched < len(s.replicas) {
+				next()
+			}
+		case <-hedge:
+			next()
+		case <-ctx.Done():
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+	}
+
+	if open == len(s.replicas) {
+		return nil, s.replicas[0].breaker.unavailable()
+	}
+	return nil, status.Errorf(codes.Unavailable, "all %d replicas failed", len(s.replicas))
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"hash"
+	"sync"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// idempotencyKeyHeader is the metadata key clients send with RecordRoute
+	// so a retried stream returns the summary of the first one that committed
+	idempotencyKeyHeader = "idempotency-key"
+	// maxIdempotencyKeyLength bounds the keys clients may send
+	maxIdempotencyKeyLength = 255
+	// maxCommits caps the remembered commits; the oldest are forgotten first
+	maxCommits = 10000
+)
+
+// idempotencyKey returns the caller's idempotency key, scoped to its
+// principal so callers can't see each other's summaries, or "" if it sent none
+func idempotencyKey(ctx context.Context) (string, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(idempotencyKeyHeader)
+	if len(values) == 0 || values[0] == "" {
+		return "", nil
+	}
+	if len(values[0]) > maxIdempotencyKeyLength {
+		return "", status.Errorf(codes.InvalidArgument, "%s may be at most %d bytes", idempotencyKeyHeader, maxIdempotencyKeyLength)
+	}
+	principal := ""
+	if p := principalFromContext(ctx); p != nil {
+		principal = p.Name
+	}
+	return principal + "\x00" + values[0], nil
+}
+
+// routeFingerprint hashes the points of a route, to tell a retry from a
+// different route reusing the same idempotency key
+type routeFingerprint struct {
+	hash hash.Hash
+}
+
+func newRouteFingerprint() *routeFingerprint {
+	return &routeFingerprint{hash: sha256.New()}
+}
+
+// add hashes the next point of the route
+func (f *routeFingerprint) add(point *pb.Point) {
+	b, _ := proto.MarshalOptions{Deterministic: true}.Marshal(point)
+	f.hash.Write(b)
+}
+
+// sum returns the fingerprint of the points added so far
+func (f *routeFingerprint) sum() [sha256.Size]byte {
+	var sum [sha256.Size]byte
+	f.hash.Sum(sum[:0])
+	return sum
+}
+
+// routeCommit is a RecordRoute commit remembered for its idempotency key
+type routeCommit struct {
+	fingerprint [sha256.Size]byte
+	committedAt time.Time
+	done        chan struct{} // closed once summary is set
+	summary     *pb.RouteSummary
+}
+
+// commitStore remembers the summaries of RecordRoute commits by idempotency
+// key for ttl, so retried streams get the original summary rather than
+// recording the route twice
+type commitStore struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	commits map[string]*routeCommit
+	order   []string // keys, oldest first
+}
+
+// newCommitStore creates a store remembering commits for ttl
+func newCommitStore(ttl time.Duration) *commitStore {
+	return &commitStore{ttl: ttl, commits: make(map[string]*routeCommit)}
+}
+
+// commit runs produce once per key and returns its summary, or, for a key
+// already committed, the stored summary with replayed set. A commit for a key
+// still being committed waits for the first one. It fails if the key was
+// committed with a different route.
+func (cs *commitStore) commit(ctx context.Context, key string, fingerprint [sha256.Size]byte, produce func() *pb.RouteSummary) (summary *pb.RouteSummary, replayed bool, err error) {
+	now := time.Now()
+	cs.mu.Lock()
+	cs.expire(now)
+	c := cs.commits[key]
+	if c == nil {
+		c = &routeCommit{fingerprint: fingerprint, committedAt: now, done: make(chan struct{})}
+		cs.commits[key] = c
+		cs.order = append(cs.order, key)
+		for len(cs.order) > maxCommits {
+			delete(cs.commits, cs.order[0])
+			cs.order = cs.order[1:]
+		}
+		cs.mu.Unlock()
+
+		c.summary = produce()
+		close(c.done)
+		return c.summary, false, nil
+	}
+	cs.mu.Unlock()
+
+	if c.fingerprint != fingerprint {
+		return nil, false, status.Errorf(codes.FailedPrecondition, "%s was already used for a different route", idempotencyKeyHeader)
+	}
+	select {
+	case <-c.done:
+	case <-ctx.Done():
+		return nil, false, status.FromContextError(ctx.Err()).Err()
+	}
+	return proto.Clone(c.summary).(*pb.RouteSummary), true, nil
+}
+
+// expire forgets commits older than ttl. cs.mu must be held.
+func (cs *commitStore) expire(now time.Time) {
+	for len(cs.order) > 0 {
+		c := cs.commits[cs.order[0]]
+		if now.Sub(c.committedAt) < cs.ttl {
+			return
+		}
+		delete(cs.commits, cs.order[0])
+		cs.order = cs.order[1:]
+	}
+}
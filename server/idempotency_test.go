@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecordRouteIdempotencyKeys(t *testing.T) {
+	s, err := newServer("features.json", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.routes = newRouteStore(100)
+	s.commits = newCommitStore(time.Hour)
+	route := []*pb.Point{{Latitude: 409146138, Longitude: -746188906}, {Latitude: 409146138, Longitude: -746100000}}
+	other := []*pb.Point{{Latitude: 409146138, Longitude: -746188906}}
+
+	// The steps run in order, against the same commits
+	tests := []struct {
+		name     string
+		caller   string
+		key      string
+		points   []*pb.Point
+		code     codes.Code
+		replayOf string // step whose summary is returned, "" for a new one
+	}{
+		{name: "first commit", caller: "alice", key: "k1", points: route},
+		{name: "retry", caller: "alice", key: "k1", points: route, replayOf: "first commit"},
+		{name: "second retry", caller: "alice", key: "k1", points: route, replayOf: "first commit"},
+		{name: "key reused for another route", caller: "alice", key: "k1", points: other, code: codes.FailedPrecondition},
+		{name: "same key from another caller", caller: "bob", key: "k1", points: route},
+		{name: "other caller retries", caller: "bob", key: "k1", points: route, replayOf: "same key from another caller"},
+		{name: "another key", caller: "alice", key: "k2", points: route},
+		{name: "no key", caller: "alice", points: route},
+		{name: "no key again", caller: "alice", points: route},
+		{name: "key too long", caller: "alice", key: strings.Repeat("k", maxIdempotencyKeyLength+1), points: route, code: codes.InvalidArgument},
+	}
+	summaries := make(map[string]*pb.RouteSummary)
+	seen := make(map[string]bool)
+	for _, tt := range tests {
+		ctx := context.WithValue(context.Background(), principalKey{}, &Principal{Name: tt.caller})
+		if tt.key != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(idempotencyKeyHeader, tt.key))
+		}
+		summary, err := recordRoute(s, ctx, tt.points...)
+		if status.Code(err) != tt.code {
+			t.Fatalf("%s: RecordRoute() = %v, want %v", tt.name, err, tt.code)
+		}
+		if err != nil {
+			continue
+		}
+		summaries[tt.name] = summary
+
+		if tt.replayOf == "" {
+			if seen[summary.RouteId] {
+				t.Errorf("%s: RecordRoute() returned route %s again, want a new route", tt.name, summary.RouteId)
+			}
+			seen[summary.RouteId] = true
+			continue
+		}
+		if want := summaries[tt.replayOf]; summary.RouteId != want.RouteId || summary.Distance != want.Distance {
+			t.Errorf("%s: RecordRoute() = route %s, want the summary of %q, route %s", tt.name, summary.RouteId, tt.replayOf, want.RouteId)
+		}
+	}
+
+	// Replays don't record the route again
+	if got := len(s.routes.list()); got != len(seen) {
+		t.Errorf("%d routes stored, want %d", got, len(seen))
+	}
+}
+
+func TestCommitStoreForgetsExpiredKeys(t *testing.T) {
+	cs := newCommitStore(10 * time.Millisecond)
+	ctx := context.Background()
+	fingerprint := sha256.Sum256([]byte("route"))
+	produced := 0
+	produce := func() *pb.RouteSummary {
+		produced++
+		return &pb.RouteSummary{PointCount: int32(produced)}
+	}
+
+	tests := []struct {
+		wait     time.Duration
+		replayed bool
+		produced int
+	}{
+		{0, false, 1},
+		{0, true, 1},
+		{20 * time.Millisecond, false, 2},
+		{0, true, 2},
+	}
+	for i, tt := range tests {
+		time.Sleep(tt.wait)
+		summary, replayed, err := cs.commit(ctx, "key", fingerprint, produce)
+		if err != nil || replayed != tt.replayed || summary.PointCount != int32(tt.produced) {
+			t.Errorf("commit %d = %v, replayed %v, %v; want summary %d, replayed %v", i, summary, replayed, err, tt.produced, tt.replayed)
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// IDGenerator creates the identifiers of stored objects: recorded routes,
+// route notes and feature submissions
+type IDGenerator interface {
+	NewID() string
+}
+
+// newIDGenerator returns the generator for a strategy: "random" (default),
+// "uuidv7", "snowflake" or "sequential". node tells apart servers sharing a
+// backend and is only used by snowflake.
+func newIDGenerator(strategy string, node int) (IDGenerator, error) {
+	switch strategy {
+	case "", "random":
+		return randomIDs{}, nil
+	case "uuidv7":
+		return &uuidV7IDs{}, nil
+	case "snowflake":
+		if node < 0 || node >= 1<<snowflakeNodeBits {
+			return nil, fmt.Errorf("snowflake node ID %d is not between 0 and %d", node, 1<<snowflakeNodeBits-1)
+		}
+		return &snowflakeIDs{node: int64(node)}, nil
+	case "sequential":
+		return &sequentialIDs{}, nil
+	default:
+		return nil, fmt.Errorf("unknown ID strategy %q (available: random, uuidv7, snowflake, sequential)", strategy)
+	}
+}
+
+// randomIDs generates 64 random bits as 16 hex digits. They don't sort.
+type randomIDs struct{}
+
+func (randomIDs) NewID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// uuidV7IDs generates RFC 9562 version 7 UUIDs, which sort by creation time.
+// IDs created in the same millisecond sort by a counter.
+type uuidV7IDs struct {
+	mu     sync.Mutex
+	lastMs int64
+	seq    uint16 // 12-bit counter within lastMs
+}
+
+func (g *uuidV7IDs) NewID() string {
+	g.mu.Lock()
+	ms := time.Now().UnixMilli()
+	if ms <= g.lastMs {
+		// Same millisecond, or the clock went back: keep counting from the last one
+		ms = g.lastMs
+		g.seq++
+		if g.seq >= 1<<12 {
+			ms++
+			g.seq = 0
+		}
+	} else {
+		g.seq = 0
+	}
+	g.lastMs = ms
+	seq := g.seq
+	g.mu.Unlock()
+
+	var u [16]byte
+	rand.Read(u[8:])
+	binary.BigEndian.PutUint64(u[:8], uint64(ms)<<16)
+	binary.BigEndian.PutUint16(u[6:8], 0x7000|seq)
+	u[8] = u[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:16])
+}
+
+const (
+	// snowflakeEpoch is the origin of snowflake timestamps
+	snowflakeEpoch = 1704067200000 // 2024-01-01T00:00:00Z, in milliseconds
+	// snowflakeNodeBits is the width of a snowflake's node ID
+	snowflakeNodeBits = 10
+	// snowflakeSeqBits is the width of a snowflake's per-millisecond counter
+	snowflakeSeqBits = 12
+)
+
+// snowflakeIDs generates 64-bit IDs made of a millisecond timestamp, a node
+// ID and a counter, as 16 hex digits. They sort by creation time and stay
+// unique across up to 1024 nodes.
+type snowflakeIDs struct {
+	node int64
+
+	mu     sync.Mutex
+	lastMs int64
+	seq    int64
+}
+
+func (g *snowflakeIDs) NewID() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	ms := time.Now().UnixMilli() - snowflakeEpoch
+	if ms <= g.lastMs {
+		ms = g.lastMs
+		g.seq++
+		if g.seq >= 1<<snowflakeSeqBits {
+			ms++
+			g.seq = 0
+		}
+	} else {
+		g.seq = 0
+	}
+	g.lastMs = ms
+	return fmt.Sprintf("%016x", ms<<(snowflakeNodeBits+snowflakeSeqBits)|g.node<<snowflakeSeqBits|g.seq)
+}
+
+// sequentialIDs numbers objects from 1 as 16 zero-padded digits. The count
+// restarts with the process, so they only suit single-server, in-memory use
+// such as tests.
+type sequentialIDs struct {
+	mu   sync.Mutex
+	next int64
+}
+
+func (g *sequentialIDs) NewID() string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.next++
+	return fmt.Sprintf("%016d", g.next)
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+)
+
+// indexGeohashPrecision, when set, makes feature indexes answer rectangle
+// queries from geohash buckets of this many characters instead of an R-tree.
+// It is set from --index-geohash-precision before any index is built.
+var indexGeohashPrecision int
+
+// indexS2Cells, when set, makes feature indexes answer rectangle queries
+// from features sorted by S2 cell, scanning the cells of coverings of this
+// many cells, instead of an R-tree. It is set from --index-s2-cells before
+// any index is built.
+var indexS2Cells int
+
+// featureIndex speeds up point lookups and rectangle queries over a dataset's
+// features. It stores positions in the dataset so queries can return features
+// in dataset order.
+type featureIndex struct {
+	byPoint map[string][]int // exact locations, the common case of lookups
+	tree    *rtree           // for rectangles, unless shards or cells is set
+	shards  *geohashShards   // for rectangles with indexGeohashPrecision
+	cells   *s2Cells         // for rectangles with indexS2Cells
+	order   []int            // positions sorted by featureKey, for paging
+}
+
+// buildFeatureIndex indexes features, calling progress (if not nil) with the
+// number of features indexed so far
+func buildFeatureIndex(features []*featureRecord, progress func(done int)) *featureIndex {
+	idx := &featureIndex{
+		byPoint: make(map[string][]int, len(features)),
+	}
+	for i, feature := range features {
+		key := geo.Key(feature.Location)
+		idx.byPoint[key] = append(idx.byPoint[key], i)
+
+		if progress != nil && (i+1)%1000 == 0 {
+			progress(i + 1)
+		}
+	}
+	switch {
+	case indexGeohashPrecision > 0:
+		idx.shards = buildGeohashShards(features, indexGeohashPrecision)
+	case indexS2Cells > 0:
+		idx.cells = buildS2Cells(features, indexS2Cells)
+	default:
+		idx.tree = buildRTree(features)
+	}
+	idx.order = sortFeatureOrder(features)
+	if progress != nil {
+		progress(len(features))
+	}
+	return idx
+}
+
+// atPoint returns the positions of the features located exactly at point
+func (idx *featureIndex) atPoint(point *pb.Point) []int {
+	return idx.byPoint[geo.Key(point)]
+}
+
+// inRect returns, in ascending order, the positions of the features within
+// rect
+func (idx *featureIndex) inRect(rect *pb.Rectangle) []int {
+	if idx.shards != nil {
+		return idx.shards.search(geo.Canonical(rect))
+	}
+	if idx.cells != nil {
+		return idx.cells.search(geo.Canonical(rect))
+	}
+	return idx.tree.search(geo.Canonical(rect))
+}
+
+// buildIndex indexes the dataset's features, making later lookups use the index
+func (d *dataset) buildIndex(progress func(done int)) {
+	d.index.Store(buildFeatureIndex(d.features, progress))
+}
+
+// sortedOrder returns the positions of the features sorted by featureKey,
+// from the index once built
+func (d *dataset) sortedOrder() []int {
+	if idx := d.index.Load(); idx != nil {
+		return idx.order
+	}
+	return sortFeatureOrder(d.features)
+}
+
+// atPoint returns the features located exactly at point, using the index once built
+func (d *dataset) atPoint(point *pb.Point) []*featureRecord {
+	idx := d.index.Load()
+	if idx == nil {
+		var matches []*featureRecord
+		for _, feature := range d.features {
+			if feature.Location.Latitude == point.Latitude && feature.Location.Longitude == point.Longitude {
+				matches = append(matches, feature)
+			}
+		}
+		return matches
+	}
+
+	positions := idx.atPoint(point)
+	matches := make([]*featureRecord, len(positions))
+	for i, pos := range positions {
+		matches[i] = d.features[pos]
+	}
+	return matches
+}
+
+// inRect returns, in dataset order, the features within rect, using the index once built
+func (d *dataset) inRect(rect *pb.Rectangle) []*featureRecord {
+	var matches []*featureRecord
+	if idx := d.index.Load(); idx != nil {
+		for _, pos := range idx.inRect(rect) {
+			matches = append(matches, d.features[pos])
+		}
+		return matches
+	}
+
+	bounds := geo.Canonical(rect)
+	for _, feature := range d.features {
+		if bounds.Contains(feature.Location) {
+			matches = append(matches, feature)
+		}
+	}
+	return matches
+}
@@ -0,0 +1,125 @@
+package main
+
+import (
+	"fmt"
+	"maps"
+	"math/rand"
+	"slices"
+	"testing"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+)
+
+// syntheticDataset returns a dataset of n features clustered around a few
+// cities, as real datasets are, without an index
+func syntheticDataset(n int) *dataset {
+	rng := rand.New(rand.NewSource(1))
+	cities := []*pb.Point{
+		{Latitude: 407128000, Longitude: -740060000},  // New York
+		{Latitude: 515074000, Longitude: -1278000},    // London
+		{Latitude: 356762000, Longitude: 1396503000},  // Tokyo
+		{Latitude: -338688000, Longitude: 1512093000}, // Sydney
+	}
+	d := &dataset{}
+	for i := 0; i < n; i++ {
+		var loc *pb.Point
+		if i%10 == 0 {
+			// Scattered features
+			loc = &pb.Point{Latitude: int32(rng.Intn(1.6e9) - 8e8), Longitude: int32(rng.Intn(3.6e9) - 1.8e9)}
+		} else {
+			city := cities[rng.Intn(len(cities))]
+			loc = &pb.Point{
+				Latitude:  city.Latitude + int32(rng.NormFloat64()*1e6),
+				Longitude: city.Longitude + int32(rng.NormFloat64()*1e6),
+			}
+		}
+		d.features = append(d.features, &featureRecord{Feature: &pb.Feature{Name: fmt.Sprint(i), Location: loc}})
+	}
+	return d
+}
+
+// benchmarkRects are rectangles from a street to a continent
+var benchmarkRects = map[string]*pb.Rectangle{
+	"street":    {Lo: &pb.Point{Latitude: 407000000, Longitude: -740200000}, Hi: &pb.Point{Latitude: 407200000, Longitude: -740000000}},
+	"city":      {Lo: &pb.Point{Latitude: 400000000, Longitude: -750000000}, Hi: &pb.Point{Latitude: 415000000, Longitude: -730000000}},
+	"country":   {Lo: &pb.Point{Latitude: 300000000, Longitude: 1300000000}, Hi: &pb.Point{Latitude: 400000000, Longitude: 1450000000}},
+	"continent": {Lo: &pb.Point{Latitude: 250000000, Longitude: -1250000000}, Hi: &pb.Point{Latitude: 500000000, Longitude: -650000000}},
+}
+
+// withIndex indexes a copy of d with indexGeohashPrecision set to precision
+// and indexS2Cells to cells
+func withIndex(d *dataset, precision, cells int) *dataset {
+	savedPrecision, savedCells := indexGeohashPrecision, indexS2Cells
+	defer func() { indexGeohashPrecision, indexS2Cells = savedPrecision, savedCells }()
+	indexGeohashPrecision, indexS2Cells = precision, cells
+
+	indexed := &dataset{features: d.features}
+	indexed.buildIndex(nil)
+	return indexed
+}
+
+func TestIndexesMatchLinearScan(t *testing.T) {
+	linear := syntheticDataset(20000)
+	// Features on the poles and on both sides of the antimeridian
+	for _, loc := range []*pb.Point{
+		{Latitude: 900000000, Longitude: 0}, {Latitude: -900000000, Longitude: 0}, {Latitude: 600000000, Longitude: -1800000000},
+		{Latitude: 0, Longitude: 1800000000}, {Latitude: 0, Longitude: -1800000000}, {Latitude: 100000000, Longitude: 0},
+	} {
+		linear.features = append(linear.features, &featureRecord{Feature: &pb.Feature{Name: geo.Key(loc), Location: loc}})
+	}
+	onIt := &pb.Rectangle{Lo: &pb.Point{Latitude: 0, Longitude: 1800000000}, Hi: &pb.Point{Latitude: 0, Longitude: 1800000000}}
+	if got := linear.inRect(onIt); len(got) != 2 {
+		t.Fatalf("a point on the antimeridian holds %d features, want those written with either sign", len(got))
+	}
+	indexes := []struct{ precision, cells int }{{0, 0}, {1, 0}, {3, 0}, {5, 0}, {maxGeohashPrecision, 0}, {0, 1}, {0, 8}, {0, 64}}
+	for _, ix := range indexes {
+		indexed := withIndex(linear, ix.precision, ix.cells)
+		// A single-point rectangle on a feature exercises the edges
+		exact := &pb.Rectangle{Lo: linear.features[42].Location, Hi: linear.features[42].Location}
+		// The Pacific, from Tokyo east across the antimeridian, and a thin
+		// band around the world the other way
+		pacific := &pb.Rectangle{Lo: &pb.Point{Latitude: -400000000, Longitude: 1390000000}, Hi: &pb.Point{Latitude: 400000000, Longitude: -1500000000}, CrossesAntimeridian: true}
+		band := &pb.Rectangle{Lo: &pb.Point{Latitude: 0, Longitude: 1}, Hi: &pb.Point{Latitude: 100000000, Longitude: 0}, CrossesAntimeridian: true}
+		// The north pole, up to its edge
+		arctic := &pb.Rectangle{Lo: &pb.Point{Latitude: 600000000, Longitude: -1800000000}, Hi: &pb.Point{Latitude: 900000000, Longitude: 1800000000}}
+		// Rectangles reaching the antimeridian from either side, and the
+		// antimeridian itself, hold the features on it under both signs
+		fiji := &pb.Rectangle{Lo: &pb.Point{Latitude: -200000000, Longitude: 1770000000}, Hi: &pb.Point{Latitude: 650000000, Longitude: 1800000000}}
+		samoa := &pb.Rectangle{Lo: &pb.Point{Latitude: -200000000, Longitude: -1800000000}, Hi: &pb.Point{Latitude: 650000000, Longitude: -1700000000}}
+		antimeridian := &pb.Rectangle{Lo: &pb.Point{Latitude: -900000000, Longitude: 1800000000}, Hi: &pb.Point{Latitude: 900000000, Longitude: -1800000000}, CrossesAntimeridian: true}
+		for _, rect := range append(slices.Collect(maps.Values(benchmarkRects)), exact, pacific, band, arctic, fiji, samoa, antimeridian, onIt) {
+			want := linear.inRect(rect)
+			if got := indexed.inRect(rect); !slices.Equal(got, want) {
+				t.Errorf("precision %d, %d S2 cells, %v: found %d features, want %d", ix.precision, ix.cells, rect, len(got), len(want))
+			}
+		}
+	}
+}
+
+// BenchmarkInRect compares the linear scan, the R-tree and geohash buckets
+// of a few precisions and sorted S2 cells, run with go test -bench InRect
+func BenchmarkInRect(b *testing.B) {
+	for _, n := range []int{1000, 100000} {
+		linear := syntheticDataset(n)
+		indexes := []struct {
+			name string
+			d    *dataset
+		}{
+			{"linear", linear},
+			{"rtree", withIndex(linear, 0, 0)},
+			{"geohash-3", withIndex(linear, 3, 0)},
+			{"geohash-5", withIndex(linear, 5, 0)},
+			{"s2-8", withIndex(linear, 0, 8)},
+		}
+		for _, size := range []string{"street", "city", "country", "continent"} {
+			for _, index := range indexes {
+				b.Run(fmt.Sprintf("n=%d/%s/%s", n, size, index.name), func(b *testing.B) {
+					for b.Loop() {
+						index.d.inRect(benchmarkRects[size])
+					}
+				})
+			}
+		}
+	}
+}
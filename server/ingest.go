@@ -0,0 +1,95 @@
+package main
+
+import (
+	"expvar"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ingestMetrics publishes live RecordRoute ingestion gauges on /debug/vars:
+// totals across streams, and points, bytes and rate per open stream
+var ingestMetrics = expvar.NewMap("record_route")
+
+// routeIngest tracks the RecordRoute streams in progress
+type routeIngest struct {
+	mu      sync.Mutex
+	streams map[string]*ingestStream // by stream ID
+	nextID  atomic.Int64
+
+	active      expvar.Int
+	pointsTotal expvar.Int
+	bytesTotal  expvar.Int
+}
+
+// ingestStream holds the gauges of one RecordRoute stream
+type ingestStream struct {
+	started time.Time
+	points  atomic.Int64
+	bytes   atomic.Int64
+}
+
+// ingest is the process-wide RecordRoute ingestion tracker
+var ingest = newRouteIngest()
+
+// newRouteIngest creates a tracker and publishes its metrics
+func newRouteIngest() *routeIngest {
+	ri := &routeIngest{streams: make(map[string]*ingestStream)}
+	ingestMetrics.Set("active_streams", &ri.active)
+	ingestMetrics.Set("points_total", &ri.pointsTotal)
+	ingestMetrics.Set("bytes_total", &ri.bytesTotal)
+	ingestMetrics.Set("streams", expvar.Func(ri.snapshot))
+	return ri
+}
+
+// start registers a new stream. The returned functions record a received
+// point of the given encoded size, and unregister the stream.
+func (ri *routeIngest) start() (received func(size int), done func()) {
+	id := strconv.FormatInt(ri.nextID.Add(1), 10)
+	stream := &ingestStream{started: time.Now()}
+
+	ri.mu.Lock()
+	ri.streams[id] = stream
+	ri.mu.Unlock()
+	ri.active.Add(1)
+
+	received = func(size int) {
+		stream.points.Add(1)
+		stream.bytes.Add(int64(size))
+		ri.pointsTotal.Add(1)
+		ri.bytesTotal.Add(int64(size))
+	}
+	done = func() {
+		ri.mu.Lock()
+		delete(ri.streams, id)
+		ri.mu.Unlock()
+		ri.active.Add(-1)
+	}
+	return received, done
+}
+
+// snapshot reports the gauges of every open stream, as an expvar.Func
+func (ri *routeIngest) snapshot() any {
+	type gauges struct {
+		Points          int64   `json:"points"`
+		Bytes           int64   `json:"bytes"`
+		PointsPerSecond float64 `json:"points_per_second"`
+		AgeSeconds      float64 `json:"age_seconds"`
+	}
+
+	ri.mu.Lock()
+	defer ri.mu.Unlock()
+
+	now := time.Now()
+	out := make(map[string]gauges, len(ri.streams))
+	for id, stream := range ri.streams {
+		age := now.Sub(stream.started).Seconds()
+		g := gauges{Points: stream.points.Load(), Bytes: stream.bytes.Load(), AgeSeconds: age}
+		if age > 0 {
+			g.PointsPerSecond = float64(g.Points) / age
+		}
+		out[id] = g
+	}
+	return out
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"math"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// maxIsochroneDuration caps the time budget of a single isochrone request
+	maxIsochroneDuration = 24 * 60 * 60
+	// maxIsochroneBands caps the number of rings streamed per request
+	maxIsochroneBands = 12
+	// isochroneVertices is the number of bearings sampled per ring
+	isochroneVertices = 72
+	// detourFactor accounts for real paths being longer than straight lines
+	detourFactor = 1.3
+)
+
+// ComputeIsochrone streams the rings reachable from a point within a time budget (server streaming RPC)
+func (s *routeGuideServer) ComputeIsochrone(req *pb.IsochroneRequest, stream pb.RouteGuide_ComputeIsochroneServer) error {
+	logger := loggerFrom(stream.Context())
+	logger.Info("ComputeIsochrone called", "duration_seconds", req.Duration, "profile", req.Profile, "bands", req.Bands)
+
+	if req.Center == nil {
+		return status.Error(codes.InvalidArgument, "center is required")
+	}
+	if req.Duration <= 0 || req.Duration > maxIsochroneDuration {
+		return status.Errorf(codes.InvalidArgument, "duration must be between 1 and %d seconds", maxIsochroneDuration)
+	}
+	profile, ok := travelProfiles[req.Profile]
+	if !ok {
+		return status.Error(codes.InvalidArgument, "a travel profile is required")
+	}
+	bands := req.Bands
+	if bands <= 0 {
+		bands = 1
+	}
+	if bands > maxIsochroneBands {
+		return status.Errorf(codes.InvalidArgument, "at most %d bands are supported", maxIsochroneBands)
+	}
+
+	at, err := queryTime(stream.Context())
+	if err != nil {
+		return err
+	}
+	features, err := s.featuresFor(stream.Context())
+	if err != nil {
+		return err
+	}
+	speed := profile.speedKmh * 1000 / 3600 / detourFactor // effective metres per second
+
+	// The graph's nodes are the center plus every valid feature within
+	// reach: no path to a farther one is short enough
+	reach := int32(min(speed*float64(req.Duration), maxDistanceMeters))
+	found, err := featuresWithin(stream.Context(), features, req.Center, reach, func(f *featureRecord) bool { return f.activeAt(at) })
+	if err != nil {
+		return err
+	}
+	nodes := []*pb.Point{req.Center}
+	for _, nearby := range found {
+		nodes = append(nodes, nearby.feature.Location)
+	}
+
+	times := travelTimes(nodes, speed, float64(profile.limits.maxSegmentMeters))
+
+	for band := int32(1); band <= bands; band++ {
+		if err := stream.Context().Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+
+		budget := float64(req.Duration) * float64(band) / float64(bands)
+		ring := &pb.IsochroneRing{
+			Duration: int32(budget),
+			Points:   isochroneRing(nodes, times, budget, speed),
+		}
+		if err := stream.Send(ring); err != nil {
+			return err
+		}
+	}
+
+	logger.Info("ComputeIsochrone completed", "rings", bands, "nodes", len(nodes))
+	return nil
+}
+
+// travelTimes returns the shortest travel time in seconds from nodes[0] to every
+// node, moving in straight hops no longer than maxHop metres (Dijkstra over the
+// implicit complete graph). Unreachable nodes get +Inf.
+func travelTimes(nodes []*pb.Point, speed, maxHop float64) []float64 {
+	times := make([]float64, len(nodes))
+	done := make([]bool, len(nodes))
+	for i := range times {
+		times[i] = math.Inf(1)
+	}
+	times[0] = 0
+
+	for range nodes {
+		// Pick the closest unvisited node
+		next := -1
+		for i := range nodes {
+			if !done[i] && (next < 0 || times[i] < times[next]) {
+				next = i
+			}
+		}
+		if next < 0 || math.IsInf(times[next], 1) {
+			break
+		}
+		done[next] = true
+
+		for i := range nodes {
+			if done[i] {
+				continue
+			}
+			hop := float64(geo.Distance(nodes[next], nodes[i]))
+			if maxHop > 0 && hop > maxHop {
+				continue
+			}
+			if t := times[next] + hop/speed; t < times[i] {
+				times[i] = t
+			}
+		}
+	}
+
+	return times
+}
+
+// isochroneRing returns a closed ring around the area reachable within budget
+// seconds. Every reached node contributes a disc of its remaining budget; the
+// ring samples, for evenly spaced bearings from the center, the furthest
+// distance covered by any disc.
+func isochroneRing(nodes []*pb.Point, times []float64, budget, speed float64) []*pb.Point {
+	center := nodes[0]
+
+	type disc struct{ x, y, r float64 }
+	var discs []disc
+	for i, node := range nodes {
+		if times[i] > budget {
+			continue
+		}
+		x, y := toLocal(center, node)
+		discs = append(discs, disc{x: x, y: y, r: (budget - times[i]) * speed})
+	}
+
+	ring := make([]*pb.Point, 0, isochroneVertices+1)
+	for v := 0; v < isochroneVertices; v++ {
+		bearing := 2 * math.Pi * float64(v) / isochroneVertices
+		ux, uy := math.Sin(bearing), math.Cos(bearing)
+
+		// Furthest intersection of the ray with any disc
+		var extent float64
+		for _, d := range discs {
+			along := d.x*ux + d.y*uy
+			across2 := d.x*d.x + d.y*d.y - along*along
+			if across2 > d.r*d.r {
+				continue
+			}
+			extent = math.Max(extent, along+math.Sqrt(d.r*d.r-across2))
+		}
+
+		ring = append(ring, fromLocal(center, extent*ux, extent*uy))
+	}
+
+	// Close the ring
+	return append(ring, ring[0])
+}
+
+// toLocal projects p onto a plane tangent at origin, returning metres east and north
+func toLocal(origin, p *pb.Point) (x, y float64) {
+	lat0 := geo.Radians(geo.Degrees(origin.Latitude))
+	x = geo.Radians(geo.Degrees(p.Longitude-origin.Longitude)) * math.Cos(lat0) * geo.EarthRadiusMeters
+	y = geo.Radians(geo.Degrees(p.Latitude-origin.Latitude)) * geo.EarthRadiusMeters
+	return x, y
+}
+
+// fromLocal is the inverse of toLocal
+func fromLocal(origin *pb.Point, x, y float64) *pb.Point {
+	lat0 := geo.Radians(geo.Degrees(origin.Latitude))
+	dLat := y / geo.EarthRadiusMeters * 180 / math.Pi
+	dLon := x / (geo.EarthRadiusMeters * math.Cos(lat0)) * 180 / math.Pi
+	return &pb.Point{
+		Latitude:  origin.Latitude + geo.E7(dLat),
+		Longitude: origin.Longitude + geo.E7(dLon),
+	}
+}
@@ -0,0 +1,410 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// jobMetrics publishes the state of every background job on /debug/vars
+var jobMetrics = expvar.NewMap("jobs")
+
+// errJobRunning is returned when a job is started while it already runs
+var errJobRunning = errors.New("job is already running")
+
+// jobConfig holds the settings of the built-in jobs
+type jobConfig struct {
+	retention    time.Duration // age past which notes and routes are deleted
+	snapshotDir  string
+	snapshotKeep int    // snapshots kept in snapshotDir
+	accessLog    string // access log rolled up, or empty
+	rollupFile   string
+}
+
+// job is a maintenance task run in the background on a schedule, or on
+// demand through RunJob. A job never runs twice at once.
+type job struct {
+	name     string
+	run      func(ctx context.Context) (string, error) // returns what it did
+	spec     string                                    // from --jobs, empty when unscheduled
+	schedule schedule
+
+	mu           sync.Mutex
+	running      bool
+	runs         int64
+	failures     int64
+	next         time.Time
+	lastStarted  time.Time
+	lastDuration time.Duration
+	lastResult   string
+	lastError    string
+	lastSuccess  time.Time
+}
+
+// jobScheduler runs the built-in jobs: retention, snapshot, compaction and
+// analytics-rollup. Every job can be run through RunJob; those given a
+// schedule in --jobs also run on it, each after a random delay of up to
+// jitter so that replicas sharing a schedule don't all run at once.
+type jobScheduler struct {
+	jitter time.Duration
+	jobs   []*job // by name
+}
+
+// newJobScheduler creates the built-in jobs of s and schedules them as spec,
+// a comma-separated list of name=schedule pairs, says
+func newJobScheduler(s *routeGuideServer, spec string, jitter time.Duration, cfg jobConfig) (*jobScheduler, error) {
+	js := &jobScheduler{jitter: jitter}
+	js.add("analytics-rollup", func(ctx context.Context) (string, error) { return rollupUsage(cfg) })
+	js.add("compaction", func(ctx context.Context) (string, error) { return compactNotes(s) })
+	js.add("retention", func(ctx context.Context) (string, error) { return deleteExpired(ctx, s, cfg) })
+	js.add("snapshot", func(ctx context.Context) (string, error) { return snapshotDataset(s, cfg) })
+
+	specs, err := parseJobSpecs(spec)
+	if err != nil {
+		return nil, err
+	}
+	for name, spec := range specs {
+		j := js.find(name)
+		if j == nil {
+			return nil, fmt.Errorf("unknown job %q (available: analytics-rollup, compaction, retention, snapshot)", name)
+		}
+		if j.schedule, err = parseSchedule(spec); err != nil {
+			return nil, fmt.Errorf("job %s: %v", name, err)
+		}
+		j.spec = spec
+	}
+	return js, nil
+}
+
+// parseJobSpecs parses "name=schedule" pairs separated by commas. As cron
+// expressions hold commas too, a part without "=" continues the previous
+// schedule, e.g. "snapshot=0 3,15 * * *".
+func parseJobSpecs(spec string) (map[string]string, error) {
+	specs := make(map[string]string)
+	if strings.TrimSpace(spec) == "" {
+		return specs, nil
+	}
+	last := ""
+	for _, part := range strings.Split(spec, ",") {
+		name, schedule, ok := strings.Cut(part, "=")
+		if !ok {
+			if last == "" {
+				return nil, fmt.Errorf("invalid job %q: expected name=schedule", part)
+			}
+			specs[last] += "," + part
+			continue
+		}
+		name = strings.TrimSpace(name)
+		if _, ok := specs[name]; ok {
+			return nil, fmt.Errorf("job %s is scheduled twice", name)
+		}
+		specs[name] = strings.TrimSpace(schedule)
+		last = name
+	}
+	return specs, nil
+}
+
+// add registers a job
+func (js *jobScheduler) add(name string, run func(ctx context.Context) (string, error)) {
+	j := &job{name: name, run: run}
+	js.jobs = append(js.jobs, j)
+	jobMetrics.Set(name, expvar.Func(j.metrics))
+}
+
+// find returns the job with the given name, or nil
+func (js *jobScheduler) find(name string) *job {
+	i := slices.IndexFunc(js.jobs, func(j *job) bool { return j.name == name })
+	if i < 0 {
+		return nil
+	}
+	return js.jobs[i]
+}
+
+// start runs the scheduled jobs until ctx is cancelled
+func (js *jobScheduler) start(ctx context.Context) {
+	for _, j := range js.jobs {
+		if j.schedule != nil {
+			slog.Info("Scheduling job", "job", j.name, "schedule", j.spec)
+			go js.loop(ctx, j)
+		}
+	}
+}
+
+// loop runs a job on its schedule until ctx is cancelled
+func (js *jobScheduler) loop(ctx context.Context, j *job) {
+	for {
+		next := j.schedule.next(time.Now())
+		j.mu.Lock()
+		j.next = next
+		j.mu.Unlock()
+		if next.IsZero() {
+			slog.Warn("Job has no future runs, stopping", "job", j.name)
+			return
+		}
+
+		delay := time.Until(next)
+		if js.jitter > 0 {
+			delay += rand.N(js.jitter)
+		}
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return
+		}
+		if err := j.execute(ctx); errors.Is(err, errJobRunning) {
+			slog.Warn("Skipping job: it is still running", "job", j.name)
+		}
+	}
+}
+
+// execute runs the job once, recording the outcome. A panic fails the run
+// instead of taking the server down.
+func (j *job) execute(ctx context.Context) (err error) {
+	j.mu.Lock()
+	if j.running {
+		j.mu.Unlock()
+		return errJobRunning
+	}
+	j.running = true
+	start := time.Now()
+	j.lastStarted = start
+	j.mu.Unlock()
+
+	var result string
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+		elapsed := time.Since(start)
+		j.mu.Lock()
+		defer j.mu.Unlock()
+		j.running = false
+		j.runs++
+		j.lastDuration = elapsed
+		j.lastResult = result
+		j.lastError = ""
+		if err != nil {
+			j.failures++
+			j.lastError = err.Error()
+			loggerFrom(ctx).Error("Job failed", "job", j.name, "elapsed", elapsed.Round(time.Millisecond), "error", err)
+			return
+		}
+		j.lastSuccess = time.Now()
+		loggerFrom(ctx).Info("Job finished", "job", j.name, "elapsed", elapsed.Round(time.Millisecond), "result", result)
+	}()
+	result, err = j.run(ctx)
+	return err
+}
+
+// status describes the job
+func (j *job) status() *pb.JobStatus {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	st := &pb.JobStatus{
+		Name:       j.name,
+		Schedule:   j.spec,
+		Running:    j.running,
+		Runs:       j.runs,
+		Failures:   j.failures,
+		LastResult: j.lastResult,
+		LastError:  j.lastError,
+	}
+	if !j.next.IsZero() {
+		st.NextRun = timestamppb.New(j.next)
+	}
+	if !j.lastStarted.IsZero() {
+		st.LastStarted = timestamppb.New(j.lastStarted)
+		st.LastDuration = durationpb.New(j.lastDuration)
+	}
+	if !j.lastSuccess.IsZero() {
+		st.LastSuccess = timestamppb.New(j.lastSuccess)
+	}
+	return st
+}
+
+// metrics reports the job's state, as an expvar.Func
+func (j *job) metrics() any {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	m := map[string]any{
+		"running":               j.running,
+		"runs_total":            j.runs,
+		"failures_total":        j.failures,
+		"last_duration_seconds": j.lastDuration.Seconds(),
+	}
+	if !j.lastSuccess.IsZero() {
+		m["last_success_unix"] = j.lastSuccess.Unix()
+	}
+	return m
+}
+
+// ListJobs lists the background jobs (unary RPC)
+func (a *adminServer) ListJobs(ctx context.Context, req *pb.ListJobsRequest) (*pb.ListJobsResponse, error) {
+	resp := &pb.ListJobsResponse{}
+	for _, j := range a.server.jobs.jobs {
+		resp.Jobs = append(resp.Jobs, j.status())
+	}
+	return resp, nil
+}
+
+// RunJob runs a background job now and waits for it to finish (unary RPC).
+// A failed run is reported in the returned status, not as an error.
+func (a *adminServer) RunJob(ctx context.Context, req *pb.RunJobRequest) (*pb.JobStatus, error) {
+	j := a.server.jobs.find(req.Name)
+	if j == nil {
+		return nil, status.Errorf(codes.NotFound, "unknown job %q", req.Name)
+	}
+	loggerFrom(ctx).Info("RunJob called", "job", j.name)
+	if err := j.execute(ctx); errors.Is(err, errJobRunning) {
+		return nil, status.Errorf(codes.Aborted, "job %s is already running", j.name)
+	}
+	return j.status(), nil
+}
+
+// deleteExpired deletes the route notes and recorded routes older than the
+// retention period
+func deleteExpired(ctx context.Context, s *routeGuideServer, cfg jobConfig) (string, error) {
+	cutoff := time.Now().Add(-cfg.retention)
+	// Routes first: they expire even while the notes are read-only
+	routes := s.routes.deleteBefore(cutoff)
+	notes, err := s.notes.DeleteBefore(ctx, cutoff)
+	if err != nil {
+		return fmt.Sprintf("deleted %d notes and %d routes", notes, routes), err
+	}
+	return fmt.Sprintf("deleted %d notes and %d routes received before %s", notes, routes, cutoff.UTC().Format(time.RFC3339)), nil
+}
+
+// compactNotes deletes the notes evicted since the notes database was last
+// compacted and shrinks its file
+func compactNotes(s *routeGuideServer) (string, error) {
+	notes, ok := s.notes.(*memoryNoteStore)
+	if !ok || notes.db == nil {
+		return "skipped: no --notes-db to compact", nil
+	}
+	c, err := notes.compact()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("compacted the notes database to %d notes, dropping %d evicted notes and %d bytes", c.kept, c.dropped, c.reclaimed), nil
+}
+
+// snapshotDataset writes the served dataset to a features file in the
+// snapshot directory, unless the latest snapshot already holds it, then
+// deletes the oldest snapshots beyond the ones kept
+func snapshotDataset(s *routeGuideServer, cfg jobConfig) (string, error) {
+	d := s.current()
+	records := make([]featureJSON, len(d.features))
+	for i, feature := range d.features {
+		records[i] = featureJSON{
+			Location: feature.Location,
+			Name:     feature.Name,
+			Names:    feature.names,
+			Windows:  feature.windows,
+		}
+	}
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(cfg.snapshotDir, 0o755); err != nil {
+		return "", err
+	}
+
+	// Snapshot names sort by time
+	snapshots, err := filepath.Glob(filepath.Join(cfg.snapshotDir, "features-*.json"))
+	if err != nil {
+		return "", err
+	}
+	result := ""
+	if len(snapshots) > 0 {
+		if latest, err := os.ReadFile(snapshots[len(snapshots)-1]); err == nil && bytes.Equal(latest, data) {
+			result = fmt.Sprintf("dataset version %d is already in %s", d.version, snapshots[len(snapshots)-1])
+		}
+	}
+	if result == "" {
+		path := filepath.Join(cfg.snapshotDir, "features-"+time.Now().UTC().Format("20060102T150405Z")+".json")
+		if err := writeFileAtomic(path, data); err != nil {
+			return "", err
+		}
+		snapshots = append(snapshots, path)
+		result = fmt.Sprintf("wrote %d features of dataset version %d to %s", len(records), d.version, path)
+	}
+
+	pruned := 0
+	for len(snapshots) > cfg.snapshotKeep {
+		if err := os.Remove(snapshots[0]); err != nil {
+			return result, err
+		}
+		snapshots = snapshots[1:]
+		pruned++
+	}
+	if pruned > 0 {
+		result += fmt.Sprintf(", deleted %d old snapshots", pruned)
+	}
+	return result, nil
+}
+
+// rollupUsage aggregates the access log into the daily usage CSV the report
+// command writes
+func rollupUsage(cfg jobConfig) (string, error) {
+	if cfg.accessLog == "" {
+		return "skipped: no --access-log to roll up", nil
+	}
+	in, err := os.Open(cfg.accessLog)
+	if err != nil {
+		return "", err
+	}
+	defer in.Close()
+	report := newUsageReport()
+	if err := report.read(in); err != nil {
+		return "", err
+	}
+	days := report.summary(10)
+	var out bytes.Buffer
+	if err := writeUsageCSV(&out, days); err != nil {
+		return "", err
+	}
+	if err := writeFileAtomic(cfg.rollupFile, out.Bytes()); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("rolled up %d days of usage into %s, skipping %d malformed lines", len(days), cfg.rollupFile, report.skipped), nil
+}
+
+// writeFileAtomic replaces the file at path with data, so that readers see
+// either the old content or the new one
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".job-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
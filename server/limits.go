@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// streamLimits bounds the resources a single client-streaming call may consume
+type streamLimits struct {
+	maxRoutePoints   int32         // points accepted per RecordRoute stream, 0 for unlimited
+	maxRouteDuration time.Duration // lifetime of a RecordRoute stream, 0 for unlimited
+	retryDelay       time.Duration // backoff suggested to clients that hit a limit
+}
+
+// resourceExhausted builds a RESOURCE_EXHAUSTED status carrying a QuotaFailure
+// describing the limit that was hit and a RetryInfo telling the client how
+// long to back off before trying again
+func resourceExhausted(subject, description string, retryDelay time.Duration) error {
+	st := status.New(codes.ResourceExhausted, description)
+
+	detailed, err := st.WithDetails(
+		&errdetails.QuotaFailure{
+			Violations: []*errdetails.QuotaFailure_Violation{{
+				Subject:     subject,
+				Description: description,
+			}},
+		},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(retryDelay)},
+	)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// received is a message, or the error that ended the stream, read by receiveAll
+type received[T any] struct {
+	value T
+	err   error
+}
+
+// receiveAll calls recv in a new goroutine until it fails, delivering every
+// result on the returned channel. The goroutine exits once ctx, the stream's
+// context, is done, which gRPC guarantees after the handler returns.
+func receiveAll[T any](ctx context.Context, recv func() (T, error)) <-chan received[T] {
+	ch := make(chan received[T])
+	go func() {
+		for {
+			value, err := recv()
+			select {
+			case ch <- received[T]{value: value, err: err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	return ch
+}
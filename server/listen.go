@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+)
+
+// unixScheme prefixes --listen addresses of Unix domain sockets
+const unixScheme = "unix://"
+
+// listen opens the server's listener: a Unix domain socket for
+// "unix:///path/to/socket" addresses, TCP otherwise. A socket file left
+// behind by a server that didn't shut down cleanly is removed first; the
+// listener removes its own file when closed.
+func listen(addr string) (net.Listener, error) {
+	path, ok := strings.CutPrefix(addr, unixScheme)
+	if !ok {
+		return net.Listen("tcp", addr)
+	}
+
+	if info, err := os.Lstat(path); err == nil && info.Mode()&os.ModeSocket != 0 {
+		if conn, err := net.Dial("unix", path); err == nil {
+			conn.Close()
+			return nil, fmt.Errorf("%s is in use by another server", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, err
+		}
+	}
+	return net.Listen("unix", path)
+}
+
+// listenAddress returns the address the server listens on, from --listen
+// or --port
+func listenAddress() string {
+	if *listenAddr != "" {
+		return *listenAddr
+	}
+	return fmt.Sprintf(":%d", *port)
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// acceptLanguageKey is the metadata key clients use to request localized names
+const acceptLanguageKey = "accept-language"
+
+// normalizeLocale canonicalizes a language tag for lookups ("pt_BR" -> "pt-br")
+func normalizeLocale(tag string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+}
+
+// parseAcceptLanguage parses an Accept-Language style header value such as
+// "fr-CA, fr;q=0.9, en;q=0.8" into locales ordered by preference. Each
+// regional tag is followed by its base language so "fr-ca" falls back to "fr".
+func parseAcceptLanguage(value string) []string {
+	type weighted struct {
+		tag string
+		q   float64
+	}
+
+	var tags []weighted
+	for _, part := range strings.Split(value, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		tag = normalizeLocale(tag)
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		q := 1.0
+		if v, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if parsed, err := strconv.ParseFloat(v, 64); err == nil {
+				q = parsed
+			}
+		}
+		if q <= 0 {
+			continue
+		}
+		tags = append(tags, weighted{tag: tag, q: q})
+	}
+
+	// Stable sort keeps the client's order for equal weights
+	slices.SortStableFunc(tags, func(a, b weighted) int {
+		return cmp.Compare(b.q, a.q)
+	})
+
+	var locales []string
+	for _, t := range tags {
+		locales = append(locales, t.tag)
+		if base, _, ok := strings.Cut(t.tag, "-"); ok {
+			locales = append(locales, base)
+		}
+	}
+	return locales
+}
+
+// requestLocales returns the locales requested by the caller, most preferred
+// first, followed by the server's default locale
+func (s *routeGuideServer) requestLocales(ctx context.Context) []string {
+	var locales []string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		for _, value := range md.Get(acceptLanguageKey) {
+			locales = append(locales, parseAcceptLanguage(value)...)
+		}
+	}
+	if s.defaultLocale != "" {
+		locales = append(locales, s.defaultLocale)
+	}
+	return locales
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// regionKey is the response metadata key carrying the server's region
+	regionKey = "server-region"
+	// zoneKey is the response metadata key carrying the server's zone
+	zoneKey = "server-zone"
+)
+
+// locality is where the server runs, so clients of multi-instance
+// deployments can check which instance answered them
+type locality struct {
+	region string
+	zone   string
+}
+
+// metadata returns the response headers announcing the locality, nil if it
+// isn't set
+func (l locality) metadata() metadata.MD {
+	md := metadata.MD{}
+	if l.region != "" {
+		md.Set(regionKey, l.region)
+	}
+	if l.zone != "" {
+		md.Set(zoneKey, l.zone)
+	}
+	if len(md) == 0 {
+		return nil
+	}
+	return md
+}
+
+// unaryInterceptor returns the locality in the response headers of unary calls
+func (l locality) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	grpc.SetHeader(ctx, l.metadata())
+	return handler(ctx, req)
+}
+
+// streamInterceptor returns the locality in the response headers of streams
+func (l locality) streamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ss.SetHeader(l.metadata())
+	return handler(srv, ss)
+}
@@ -0,0 +1,133 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+	"sync/atomic"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// configureLogging installs the default slog logger, writing records of at
+// least level ("debug", "info", "warn" or "error") to w in format ("text" or
+// "json") and to serverLogs. Lines written with the log package go through it
+// at the info level.
+func configureLogging(w io.Writer, level, format string) error {
+	var lvl slog.Level
+	if err := lvl.UnmarshalText([]byte(level)); err != nil {
+		return fmt.Errorf("unknown log level %q (available: debug, info, warn, error)", level)
+	}
+	opts := &slog.HandlerOptions{Level: lvl}
+
+	var handler slog.Handler
+	switch format {
+	case "text":
+		handler = slog.NewTextHandler(w, opts)
+	case "json":
+		handler = slog.NewJSONHandler(w, opts)
+	default:
+		return fmt.Errorf("unknown log format %q (available: text, json)", format)
+	}
+	slog.SetDefault(slog.New(&hubHandler{Handler: handler, hub: serverLogs}))
+	return nil
+}
+
+// hubHandler publishes log records to a logHub, for TailLogs, before passing
+// them on to the handler writing them out
+type hubHandler struct {
+	slog.Handler
+	hub   *logHub
+	attrs []slog.Attr // added with WithAttrs
+}
+
+func (h *hubHandler) Handle(ctx context.Context, r slog.Record) error {
+	entry := &pb.LogEntry{Time: timestamppb.New(r.Time)}
+	var message strings.Builder
+	message.WriteString(r.Message)
+	addAttr := func(a slog.Attr) bool {
+		switch a.Key {
+		case "method":
+			entry.Method = a.Value.String()
+		case "request_id":
+			entry.RequestId = a.Value.String()
+		default:
+			fmt.Fprintf(&message, " %s=%v", a.Key, a.Value)
+		}
+		return true
+	}
+	for _, a := range h.attrs {
+		addAttr(a)
+	}
+	r.Attrs(addAttr)
+	entry.Message = message.String()
+	h.hub.publish(entry)
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *hubHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &hubHandler{
+		Handler: h.Handler.WithAttrs(attrs),
+		hub:     h.hub,
+		attrs:   append(h.attrs[:len(h.attrs):len(h.attrs)], attrs...),
+	}
+}
+
+func (h *hubHandler) WithGroup(name string) slog.Handler {
+	return &hubHandler{Handler: h.Handler.WithGroup(name), hub: h.hub, attrs: h.attrs}
+}
+
+// streamIDs numbers the streams opened since the server started
+var streamIDs atomic.Uint64
+
+// loggerKey is the context key of a call's logger
+type loggerKey struct{}
+
+// streamIDKey is the context key of a stream's ID
+type streamIDKey struct{}
+
+// withStreamID returns a context carrying the ID of a stream
+func withStreamID(ctx context.Context, id uint64) context.Context {
+	return context.WithValue(ctx, streamIDKey{}, id)
+}
+
+// streamID returns the ID tagging the log lines of the stream ctx belongs
+// to, or 0 outside streams
+func streamID(ctx context.Context) uint64 {
+	id, _ := ctx.Value(streamIDKey{}).(uint64)
+	return id
+}
+
+// callLogger returns a logger tagging records with a call's method, peer and
+// request ID, and for streams (streamID > 0) the stream ID
+func callLogger(ctx context.Context, fullMethod string, streamID uint64) *slog.Logger {
+	attrs := []any{slog.String("method", fullMethod)}
+	if p, ok := peer.FromContext(ctx); ok {
+		attrs = append(attrs, slog.String("peer", p.Addr.String()))
+	}
+	if streamID > 0 {
+		attrs = append(attrs, slog.Uint64("stream_id", streamID))
+	}
+	if id := requestID(ctx); id != "" {
+		attrs = append(attrs, slog.String("request_id", id))
+	}
+	return slog.Default().With(attrs...)
+}
+
+// withLogger returns a context carrying a call's logger
+func withLogger(ctx context.Context, logger *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, logger)
+}
+
+// loggerFrom returns the logger of the call ctx belongs to, or the default
+// logger outside calls
+func loggerFrom(ctx context.Context) *slog.Logger {
+	if logger, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+		return logger
+	}
+	return slog.Default()
+}
@@ -0,0 +1,202 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// logBacklogSize is how many recent log entries TailLogs can replay
+	logBacklogSize = 512
+	// logSubscriberBuffer is how many entries a TailLogs stream may fall
+	// behind before it is dropped
+	logSubscriberBuffer = 256
+)
+
+// logHub keeps recent log entries and fans new ones out to TailLogs streams.
+// It receives every log record through hubHandler, and also the call entries
+// of logUnaryInterceptor/logStreamInterceptor.
+type logHub struct {
+	mu          sync.Mutex
+	recent      []*pb.LogEntry // ring buffer of the last logBacklogSize entries
+	next        int            // position of the next entry in recent
+	subscribers map[chan *pb.LogEntry]struct{}
+}
+
+// serverLogs captures the server's log output
+var serverLogs = newLogHub()
+
+// newLogHub creates an empty hub
+func newLogHub() *logHub {
+	return &logHub{subscribers: make(map[chan *pb.LogEntry]struct{})}
+}
+
+// publish records an entry and sends it to every subscriber without
+// blocking, dropping subscribers whose buffer is full
+func (h *logHub) publish(entry *pb.LogEntry) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.recent) < logBacklogSize {
+		h.recent = append(h.recent, entry)
+	} else {
+		h.recent[h.next] = entry
+	}
+	h.next = (h.next + 1) % logBacklogSize
+
+	for ch := range h.subscribers {
+		select {
+		case ch <- entry:
+		default:
+			delete(h.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// subscribe returns up to backlog recent entries and a channel of new ones.
+// The channel is closed if the subscriber falls too far behind or when
+// unsubscribe is called.
+func (h *logHub) subscribe(backlog int) (recent []*pb.LogEntry, entries <-chan *pb.LogEntry, unsubscribe func()) {
+	ch := make(chan *pb.LogEntry, logSubscriberBuffer)
+	if backlog < 0 {
+		backlog = 0
+	}
+
+	h.mu.Lock()
+	// The oldest entry is at next once the ring buffer is full; until then
+	// next is the end of the buffer
+	ordered := make([]*pb.LogEntry, 0, len(h.recent))
+	ordered = append(ordered, h.recent[h.next:]...)
+	ordered = append(ordered, h.recent[:h.next]...)
+	if backlog < len(ordered) {
+		ordered = ordered[len(ordered)-backlog:]
+	}
+	h.subscribers[ch] = struct{}{}
+	h.mu.Unlock()
+
+	return ordered, ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if _, ok := h.subscribers[ch]; ok {
+			delete(h.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+// logFilter selects the entries a TailLogs stream is interested in
+type logFilter struct {
+	method    string // full method name, empty for any
+	short     string // method name without the service, empty for any
+	requestID string // empty for any
+}
+
+// matches reports whether an entry passes the filter. Lines logged without a
+// call's logger aren't tagged with its method; they match a method filter when
+// they start with the method's name, as handlers' logs do ("GetFeature
+// called: ...").
+func (f logFilter) matches(entry *pb.LogEntry) bool {
+	if f.requestID != "" && entry.RequestId != f.requestID {
+		return false
+	}
+	if f.method == "" {
+		return true
+	}
+	if entry.Method != "" {
+		return entry.Method == f.method
+	}
+	return strings.HasPrefix(entry.Message, f.short+" ")
+}
+
+// TailLogs streams the server's log entries (server streaming RPC)
+func (a *adminServer) TailLogs(req *pb.TailLogsRequest, stream pb.Admin_TailLogsServer) error {
+	loggerFrom(stream.Context()).Info("TailLogs called", "filter_method", req.Method, "filter_request_id", req.RequestId, "backlog", req.Backlog)
+
+	filter := logFilter{requestID: req.RequestId}
+	if req.Method != "" {
+		filter.method = req.Method
+		if !strings.HasPrefix(filter.method, "/") {
+			filter.method = "/" + pb.RouteGuide_ServiceDesc.ServiceName + "/" + req.Method
+		}
+		filter.short = filter.method[strings.LastIndex(filter.method, "/")+1:]
+	}
+
+	recent, entries, unsubscribe := serverLogs.subscribe(int(req.Backlog))
+	defer unsubscribe()
+
+	for _, entry := range recent {
+		if filter.matches(entry) {
+			if err := stream.Send(entry); err != nil {
+				return err
+			}
+		}
+	}
+	if err := stream.SendHeader(nil); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case entry, ok := <-entries:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "log tail fell too far behind")
+			}
+			if filter.matches(entry) {
+				if err := stream.Send(entry); err != nil {
+					return err
+				}
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// logCall publishes an entry recording the end of a call. Call entries only
+// go to TailLogs, to keep stderr readable.
+func logCall(ctx context.Context, fullMethod string, start time.Time, err error) {
+	code := status.Code(err)
+	message := fullMethod + " finished: " + code.String()
+	if err != nil {
+		message += ": " + status.Convert(err).Message()
+	}
+	serverLogs.publish(&pb.LogEntry{
+		Time:      timestamppb.Now(),
+		Message:   message,
+		Method:    fullMethod,
+		RequestId: requestID(ctx),
+		Code:      code.String(),
+		Duration:  durationpb.New(time.Since(start)),
+	})
+}
+
+// logUnaryInterceptor gives unary calls their logger and records their
+// outcome for TailLogs
+func logUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	start := time.Now()
+	ctx = withLogger(ctx, callLogger(ctx, info.FullMethod, 0))
+	resp, err := handler(ctx, req)
+	logCall(ctx, info.FullMethod, start, err)
+	return resp, err
+}
+
+// logStreamInterceptor gives streams their logger, tagged with a stream ID,
+// and records their outcome for TailLogs
+func logStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	id := streamIDs.Add(1)
+	ctx := withLogger(withStreamID(ss.Context(), id), callLogger(ss.Context(), info.FullMethod, id))
+	err := handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+	logCall(ss.Context(), info.FullMethod, start, err)
+	return err
+}
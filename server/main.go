@@ -1,63 +1,582 @@
 package main
 
 import (
+	"context"
+	"crypto/rsa"
+	"crypto/tls"
+	"errors"
 	"flag"
 	"fmt"
 	"log"
-	"net"
+	"log/slog"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
 var (
+	configFile   = flag.String("config", "", "YAML file of settings keyed by flag name, e.g. \"port: 50051\"; flags given on the command line override it")
 	port         = flag.Int("port", 50051, "The server port")
+	listenAddr   = flag.String("listen", "", "Address to listen on, host:port or unix:///path/to/socket (overrides --port)")
+	tlsCert      = flag.String("tls-cert", "", "PEM certificate file to serve TLS with (plaintext when empty; requires --tls-key)")
+	tlsKey       = flag.String("tls-key", "", "PEM private key file of --tls-cert")
+	tlsReload    = flag.Duration("tls-reload-interval", time.Minute, "How often to check --tls-cert, --tls-key and --client-ca for rotated certificates (0 to reload on SIGHUP only)")
+	clientCA     = flag.String("client-ca", "", "PEM CA certificates verifying required client certificates (mutual TLS; requires --tls-cert)")
 	featuresFile = flag.String("features", "features.json", "Path to features JSON file")
+	geohashShard = flag.Int("index-geohash-precision", 0, "Answer rectangle queries from buckets of features sharing a geohash prefix of this many characters instead of an R-tree (0 for the R-tree)")
+	s2Covering   = flag.Int("index-s2-cells", 0, "Answer rectangle queries from features sorted by S2 cell, scanning coverings of about this many cells, instead of an R-tree (0 for the R-tree)")
+	featureWatch = flag.Duration("features-watch-interval", 2*time.Second, "How often to poll --features for changes where file system notifications are unavailable, and to retry a change held back while the dataset is read-only (0 to stop reloading it)")
+	strictLoad   = flag.Bool("strict-load", false, "Fail dataset loads on the first malformed feature instead of skipping malformed features")
+	candidate    = flag.String("candidate-features", "", "Path to a candidate features JSON file served to a share of traffic for A/B rollouts")
+	candidatePct = flag.Float64("candidate-percent", 0, "Percentage of callers served from --candidate-features unless they pick a dataset")
+	replicas     = flag.Int("replicas", 3, "Number of simulated replicas used by GetFeatureFast")
+	replicaDelay = flag.Duration("replica-latency", 50*time.Millisecond, "Maximum simulated latency of a replica lookup")
+	replicaFail  = flag.Float64("replica-failure-rate", 0.1, "Probability that a simulated replica lookup fails")
+	breakerFails = flag.Int("breaker-failures", 5, "Consecutive failures after which calls to a replica or the features source are suspended (0 to disable)")
+	breakerCool  = flag.Duration("breaker-cooldown", 30*time.Second, "How long a tripped circuit breaker suspends calls before probing again")
+	hedgeDelay   = flag.Duration("hedge-delay", 10*time.Millisecond, "Delay before GetFeatureFast hedges to another replica")
+	locale       = flag.String("default-locale", "en", "Locale of the default feature names, used when a caller's accept-language has no match")
+	maxSpeed     = flag.Float64("max-speed-kmh", 300, "Speed above which a RecordRoute segment is flagged as an anomaly")
+	maxSegment   = flag.Int("max-segment-meters", 100000, "Distance between consecutive RecordRoute points above which the segment is flagged as a teleport")
+	minInterval  = flag.Duration("min-speed-interval", time.Second, "Shortest time between two RecordRoute points over which speed is measured")
+	routePoints  = flag.Int("max-route-points", 10000, "Maximum points per RecordRoute stream (0 for unlimited)")
+	routeTime    = flag.Duration("max-route-duration", 10*time.Minute, "Maximum lifetime of a RecordRoute stream (0 for unlimited)")
+	liveNotes    = flag.Int("max-live-notes", 100, "Notes per location replayed by RouteChat; older ones are archived (0 for unlimited)")
+	archiveNotes = flag.Int("max-archived-notes", 10000, "Archived notes kept per location for ListNoteHistory (0 for unlimited)")
+	notesDB      = flag.String("notes-db", "", "File persisting route notes across restarts (notes are kept in memory only when empty)")
+	notesLocked  = flag.String("notes-db-locked", "fail", "What to do when another server holds --notes-db: fail to start, or read-only to serve its notes without writing it")
+	replayMax    = flag.Int("chat-replay-max", 500, "Notes RouteChat replays at once per location; the client loads later ones by sending back a history_token (0 for unlimited)")
+	replayBatch  = flag.Int("chat-replay-batch", 50, "Notes RouteChat replays between pauses (0 for no pauses)")
+	replayPause  = flag.Duration("chat-replay-pause", 10*time.Millisecond, "Pause between the batches of notes RouteChat replays")
+	idStrategy   = flag.String("id-strategy", "random", "How route, note and feature submission IDs are generated: random, uuidv7, snowflake or sequential")
+	nodeID       = flag.Int("node-id", 0, "ID of this server (0-1023) embedded in snowflake IDs")
+	commitTTL    = flag.Duration("idempotency-ttl", 24*time.Hour, "How long a RecordRoute idempotency-key returns the summary of its first commit (0 to ignore keys)")
+	storedRoutes = flag.Int("max-stored-routes", 1000, "Number of recorded routes kept in memory for CompareRoutes and EstimateTravelTime")
+	adminToken   = flag.String("admin-token", "", "Static bearer token granting the admin role")
+	jwtSecret    = flag.String("jwt-secret", "", "HMAC secret verifying HS256 JWT bearer tokens (JWT auth is disabled when empty)")
+	jwtKeyFile   = flag.String("jwt-public-key", "", "PEM RSA public key (or certificate) verifying RS256 JWT bearer tokens")
+	requireAuth  = flag.Bool("require-auth", false, "Reject calls without credentials with UNAUTHENTICATED (health checks and reflection stay open)")
+	authExempt   = flag.String("auth-exempt", "", "RouteGuide methods open to callers without credentials under --require-auth, e.g. GetFeature,ListFeatures")
+	jwtIssuer    = flag.String("jwt-issuer", "", "Required iss claim of JWT bearer tokens (any issuer when empty)")
+	jwtAudience  = flag.String("jwt-audience", "", "Required aud claim of JWT bearer tokens (any audience when empty)")
+	apiKeysFile  = flag.String("api-keys-file", "", "JSON file of API keys ([{\"key\", \"name\", \"roles\"}]) accepted in x-api-key metadata")
+	revokedFile  = flag.String("revoked-credentials", "", "File of revoked credentials, one sha256:<hex>, jti:<id> or principal:<name> per line, rejected even when valid; reloaded when it changes")
+	revokedPoll  = flag.Duration("revoked-credentials-interval", 5*time.Second, "How often --revoked-credentials and --revoked-credentials-redis are checked for changes")
+	region       = flag.String("region", "", "Region this server runs in, returned in server-region response metadata and GetServerInfo")
+	zone         = flag.String("zone", "", "Zone this server runs in, returned in server-zone response metadata and GetServerInfo")
+	drainTime    = flag.Duration("shutdown-drain", 0, "How long to keep serving after reporting NOT_SERVING on shutdown, before draining connections")
+	reflect      = flag.Bool("reflection", false, "Register the gRPC server reflection service, letting tools like grpcurl discover the services")
+	logLevel     = flag.String("log-level", "info", "Minimum level of logged records: debug, info, warn or error")
+	logFormat    = flag.String("log-format", "text", "Log output format: text (key=value) or json")
+	oversizedMsg = flag.Int("oversized-message-bytes", 1<<20, "Size of a request or response message, in bytes, above which it is logged and counted in the oversized message metrics (0 to disable)")
+	accessFile   = flag.String("access-log", "", "File to append a JSON line to per call, for the report command (disabled when empty)")
+	tileCacheTTL = flag.Duration("tile-cache-ttl", 5*time.Minute, "How long generated vector tiles are cached")
+	canaryList   = flag.String("canary-methods", "", "Comma-separated RouteGuide methods (GetFeature, ListFeatures) to shadow with their canary implementation")
+	canaryPct    = flag.Float64("canary-sample-percent", 100, "Percentage of calls to --canary-methods that are shadowed")
+	redactFields = flag.String("redact-fields", "", "Comma-separated fully-qualified fields (e.g. routeguide.RouteNote.message) stripped from responses to non-admin callers")
+	flagsFile    = flag.String("feature-flags", "", "JSON file of feature flag states ({\"broadcast_chat\": true}) overriding FEATURE_* environment variables; reloaded when it changes")
+	clientConfig = flag.String("client-config", "", "JSON file of ClientConfig settings (e.g. {\"pageSize\": 50, \"featureFlags\": {...}}) overriding the defaults GetClientConfig recommends; reloaded when it changes")
+	heartbeat    = flag.Duration("heartbeat-interval", 30*time.Second, "How often WatchFeatures sends a heartbeat to clients that negotiated supports-heartbeats")
+	halfLife     = flag.Duration("popularity-half-life", 24*time.Hour, "Time after which a feature lookup counts half as much towards its popularity score")
+	compressList = flag.String("compress-methods", "*", "RouteGuide methods whose responses are gzip-compressed for clients that negotiate supports-compression (comma-separated, \"*\" for all)")
+	timeoutList  = flag.String("method-timeouts", "", "Comma-separated Method=duration caps on how long calls may run on the server, e.g. GetFeature=5s,RouteChat=1h; \"*=duration\" caps all other methods")
+	rateLimits   = flag.String("rate-limits", "", "Comma-separated Method=N/unit limits on how often each caller may call a method, e.g. GetFeature=10/s,RecordRoute=2/m; \"*=N/unit\" limits each other method")
+	streamQuotas = flag.String("max-streams-per-caller", "", "Comma-separated Method=N caps on the RouteGuide streams each principal may have open at once, e.g. RouteChat=3; \"*=N\" caps all their streams")
+	sessionTTL   = flag.Duration("session-ttl", 10*time.Minute, "How long an idle RouteChat/WatchFeatures session can be restored with its session token")
+	memLimitMB   = flag.Int("memory-limit-mb", 0, "Soft memory limit in MiB, like GOMEMLIMIT, near which the server sheds load (0 to use GOMEMLIMIT)")
+	memPressure  = flag.Float64("memory-pressure-ratio", 0.9, "Share of the memory limit in use at which new streams are rejected and caches are freed")
+	memInterval  = flag.Duration("memory-check-interval", 5*time.Second, "How often memory use is checked against the memory limit")
+	bulkWorkers  = flag.Int("low-priority-workers", 2, "Calls running at once among bulk exports and imports and calls sent with \"priority: low\" metadata; the rest queue")
+	tileCacheMax = flag.Int("tile-cache-size", 1024, "Maximum number of cached vector tiles")
+	rectCacheMax = flag.Int("list-cache-size", 256, "Maximum number of ListFeatures rectangles whose features are cached (disabled when 0)")
+	rectCacheTTL = flag.Duration("list-cache-ttl", time.Minute, "How long the features found in a ListFeatures rectangle are cached")
+	depTimeout   = flag.Duration("dependency-timeout", 0, "How long to retry unreachable dependencies (--notes-redis, --postgis-dsn) at startup before exiting (0 to keep retrying)")
+	warmupZoom   = flag.Int("warmup-tile-zoom", -1, "Pre-render vector tiles holding features up to this zoom at startup (-1 to disable)")
+	jobSpecs     = flag.String("jobs", "", "Comma-separated name=schedule background jobs to run (retention, snapshot, compaction, analytics-rollup), e.g. retention=@daily,snapshot=0 3 * * *")
+	jobJitter    = flag.Duration("job-jitter", time.Minute, "Maximum random delay added to every scheduled job run, spreading replicas sharing a schedule (0 to disable)")
+	retainFor    = flag.Duration("retention-max-age", 30*24*time.Hour, "Age of route notes and recorded routes past which the retention job deletes them")
+	snapshotDir  = flag.String("snapshot-dir", "snapshots", "Directory the snapshot job writes features JSON snapshots of the served dataset to")
+	snapshotKeep = flag.Int("snapshot-keep", 7, "Number of snapshots kept in --snapshot-dir; the snapshot job deletes older ones")
+	rollupFile   = flag.String("rollup-file", "usage-rollup.csv", "CSV file the analytics-rollup job writes the daily usage of --access-log to")
 )
 
 func main() {
 	flag.Parse()
+	if *configFile != "" {
+		if err := loadConfigFile(flag.CommandLine, *configFile); err != nil {
+			log.Fatalf("Failed to load config %s: %v", *configFile, err)
+		}
+	}
+	if flag.NArg() > 0 {
+		if err := runCommand(flag.Args()); err != nil {
+			log.Fatalf("%s: %v", flag.Arg(0), err)
+		}
+		return
+	}
+	if err := configureLogging(os.Stderr, *logLevel, *logFormat); err != nil {
+		log.Fatalf("Failed to configure logging: %v", err)
+	}
 
-	log.Printf("Starting RouteGuide gRPC server...")
+	slog.Info("Starting RouteGuide gRPC server")
 
-	// Create TCP listener
-	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", *port))
-	if err != nil {
-		log.Fatalf("Failed to listen on port %d: %v", *port, err)
+	if *geohashShard < 0 || *geohashShard > maxGeohashPrecision {
+		log.Fatalf("--index-geohash-precision must be between 0 and %d", maxGeohashPrecision)
+	}
+	if *s2Covering < 0 {
+		log.Fatal("--index-s2-cells must not be negative")
 	}
+	if *geohashShard > 0 && *s2Covering > 0 {
+		log.Fatal("--index-geohash-precision and --index-s2-cells are mutually exclusive")
+	}
+	indexGeohashPrecision = *geohashShard
+	indexS2Cells = *s2Covering
 
 	// Create RouteGuide server instance
-	routeGuideServer, err := newServer(*featuresFile)
+	routeGuideServer, err := newServer(*featuresFile, *strictLoad)
 	if err != nil {
 		log.Fatalf("Failed to create server: %v", err)
 	}
+	routeGuideServer.defaultLocale = normalizeLocale(*locale)
+	routeGuideServer.anomalyLimits = anomalyLimits{
+		maxSpeedKmh:      *maxSpeed,
+		maxSegmentMeters: int32(*maxSegment),
+		minInterval:      *minInterval,
+	}
+	routeGuideServer.streamLimits = streamLimits{
+		maxRoutePoints:   int32(*routePoints),
+		maxRouteDuration: *routeTime,
+		retryDelay:       time.Second,
+	}
+	var notesDatabase *noteDB
+	var notesLock *dataLock
+	// Extensions may keep the notes elsewhere, e.g. in Redis
+	notes := newMemoryNoteStore(*liveNotes, *archiveNotes)
+	if *notesDB != "" {
+		if *notesLocked != "fail" && *notesLocked != "read-only" {
+			log.Fatalf("--notes-db-locked must be fail or read-only, not %q", *notesLocked)
+		}
+		// bbolt locks the database only while it is open, and a
+		// compaction briefly closes it to swap in the compacted file
+		notesLock, err = lockDataFile(*notesDB)
+		switch {
+		case errors.Is(err, errDataLocked) && *notesLocked == "read-only":
+			loaded, loadErr := loadNoteDBSnapshot(*notesDB, notes)
+			if loadErr != nil {
+				log.Fatalf("Failed to load notes database: %v", loadErr)
+			}
+			slog.Warn("Loaded route notes, new notes are rejected", "count", loaded, "file", *notesDB)
+			notes.readOnly = err.Error()
+		case err != nil:
+			log.Fatalf("Failed to open notes database: %v", err)
+		default:
+			if notesDatabase, err = openNoteDB(*notesDB, notes); err != nil {
+				log.Fatalf("Failed to open notes database: %v", err)
+			}
+		}
+	}
+	routeGuideServer.notes = notes
+	routeGuideServer.chatReplay = chatReplay{
+		max:   *replayMax,
+		batch: *replayBatch,
+		pause: *replayPause,
+	}
+	routeGuideServer.sessions = newSessionStore(*sessionTTL)
+	routeGuideServer.routes = newRouteStore(*storedRoutes)
+	if *commitTTL > 0 {
+		routeGuideServer.commits = newCommitStore(*commitTTL)
+	}
+	ids, err := newIDGenerator(*idStrategy, *nodeID)
+	if err != nil {
+		log.Fatalf("Failed to configure ID generation: %v", err)
+	}
+	routeGuideServer.ids = ids
+	routeGuideServer.popularity = newPopularityTracker(*halfLife)
+	routeGuideServer.tiles = newTileCache(*tileCacheMax, *tileCacheTTL)
+	routeGuideServer.rects = newRectCache(*rectCacheMax, *rectCacheTTL)
+	breakers := breakerConfig{threshold: *breakerFails, cooldown: *breakerCool}
+	if *replicas < 1 {
+		log.Fatalf("--replicas must be at least 1")
+	}
+	routeGuideServer.replicas = newReplicas(*replicas, *replicaDelay, *replicaFail, breakers)
+	routeGuideServer.hedgeDelay = *hedgeDelay
+	routeGuideServer.heartbeatInterval = *heartbeat
+	routeGuideServer.locality = locality{region: *region, zone: *zone}
+	clientConfigs, err := newClientConfigSource(routeGuideServer.defaultClientConfig(), *clientConfig)
+	if err != nil {
+		log.Fatalf("Failed to load client config: %v", err)
+	}
+	routeGuideServer.clientConfig = clientConfigs
+	flags, err := newFeatureFlags(*flagsFile)
+	if err != nil {
+		log.Fatalf("Failed to load feature flags: %v", err)
+	}
+	flags.publish()
+	routeGuideServer.flags = flags
+	if *candidate != "" {
+		if *candidatePct < 0 || *candidatePct > 100 {
+			log.Fatalf("--candidate-percent must be between 0 and 100")
+		}
+		if err := routeGuideServer.loadCandidate(*candidate, *candidatePct); err != nil {
+			log.Fatalf("Failed to load candidate features: %v", err)
+		}
+	}
+
+	// Start the background work, which runs until shutdown
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if *featureWatch > 0 {
+		watcher, err := newFeatureFileWatcher(routeGuideServer, *featureWatch)
+		if err != nil {
+			log.Fatalf("Failed to watch features file: %v", err)
+		}
+		go watcher.watch(ctx)
+	}
+	go flags.watch(ctx)
+	if *retainFor <= 0 {
+		log.Fatalf("--retention-max-age must be positive")
+	}
+	if *snapshotKeep < 1 {
+		log.Fatalf("--snapshot-keep must be at least 1")
+	}
+	if *jobJitter < 0 {
+		log.Fatalf("--job-jitter must not be negative")
+	}
+	jobs, err := newJobScheduler(routeGuideServer, *jobSpecs, *jobJitter, jobConfig{
+		retention:    *retainFor,
+		snapshotDir:  *snapshotDir,
+		snapshotKeep: *snapshotKeep,
+		accessLog:    *accessFile,
+		rollupFile:   *rollupFile,
+	})
+	if err != nil {
+		log.Fatalf("Invalid --jobs: %v", err)
+	}
+	routeGuideServer.jobs = jobs
+	jobs.start(ctx)
+
+	// Start the optional subsystems built into the binary
+	extensions := &extensionHost{server: routeGuideServer, ctx: ctx, breakers: breakers}
+	if err := startExtensions(extensions); err != nil {
+		log.Fatalf("Failed to start %v", err)
+	}
 
 	// Create gRPC server
-	grpcServer := grpc.NewServer()
+	compression, err := parseCompressionPolicy(*compressList)
+	if err != nil {
+		log.Fatalf("Failed to configure compression: %v", err)
+	}
+	// Extensions' interceptors run before the metrics, which take exemplars
+	// from the spans extensions' stats handlers start
+	unaryInterceptors := append([]grpc.UnaryServerInterceptor{requestIDUnaryInterceptor, routeGuideServer.localizeUnaryInterceptor}, extensions.unary...)
+	streamInterceptors := append([]grpc.StreamServerInterceptor{requestIDStreamInterceptor, routeGuideServer.localizeStreamInterceptor}, extensions.stream...)
+	if *oversizedMsg < 0 {
+		log.Fatalf("--oversized-message-bytes must not be negative")
+	}
+	serverMetrics.oversized = *oversizedMsg
+	subsystems.enable("metrics")
+	unaryInterceptors = append(unaryInterceptors, serverMetrics.unaryInterceptor)
+	streamInterceptors = append(streamInterceptors, serverMetrics.streamInterceptor)
+	unaryInterceptors = append(unaryInterceptors, logUnaryInterceptor, recoveryUnaryInterceptor)
+	streamInterceptors = append(streamInterceptors, logStreamInterceptor, recoveryStreamInterceptor)
+	if *region != "" || *zone != "" {
+		unaryInterceptors = append(unaryInterceptors, routeGuideServer.locality.unaryInterceptor)
+		streamInterceptors = append(streamInterceptors, routeGuideServer.locality.streamInterceptor)
+		slog.Info("Serving from region", "region", *region, "zone", *zone)
+	}
+	if *timeoutList != "" {
+		timeouts, err := parseMethodTimeouts(*timeoutList)
+		if err != nil {
+			log.Fatalf("Failed to configure method timeouts: %v", err)
+		}
+		unaryInterceptors = append(unaryInterceptors, timeouts.unaryInterceptor)
+		streamInterceptors = append(streamInterceptors, timeouts.streamInterceptor)
+		slog.Info("Limiting method durations", "timeouts", *timeoutList)
+	}
+	if guard := newMemoryGuard(routeGuideServer, *memLimitMB, *memPressure, *memInterval); guard != nil {
+		streamInterceptors = append(streamInterceptors, guard.streamInterceptor)
+		go guard.run(ctx)
+		slog.Info("Watching memory use", "limit_mib", guard.limit>>20)
+	}
+	unaryInterceptors = append(unaryInterceptors, capabilitiesUnaryInterceptor(compression))
+	streamInterceptors = append(streamInterceptors, capabilitiesStreamInterceptor(compression))
+	authProviders, err := configureAuth()
+	if err != nil {
+		log.Fatalf("Failed to configure authentication: %v", err)
+	}
+	if *requireAuth && len(authProviders) == 0 {
+		log.Fatalf("--require-auth needs an authentication provider, e.g. --jwt-secret or --api-keys-file")
+	}
+	if *authExempt != "" && !*requireAuth {
+		log.Fatalf("--auth-exempt only applies with --require-auth")
+	}
+	exempt, err := parseAuthExemptions(*authExempt)
+	if err != nil {
+		log.Fatalf("Invalid --auth-exempt: %v", err)
+	}
+	var revoked *revocationList
+	if *revokedFile != "" || extensions.revoked != nil {
+		if len(authProviders) == 0 {
+			log.Fatalf("Revoked credentials need an authentication provider")
+		}
+		if *revokedPoll <= 0 {
+			log.Fatalf("--revoked-credentials-interval must be positive")
+		}
+		if revoked, err = newRevocationList(*revokedFile, extensions.revoked); err != nil {
+			log.Fatalf("Failed to load revoked credentials: %v", err)
+		}
+		go revoked.watch(ctx, *revokedPoll)
+	}
+	policy := authPolicy{required: *requireAuth, exempt: exempt}
+	unaryInterceptors = append(unaryInterceptors, authUnaryInterceptor(authProviders, revoked, policy))
+	streamInterceptors = append(streamInterceptors, authStreamInterceptor(authProviders, revoked, policy))
+	var access *accessLog
+	if *accessFile != "" {
+		access, err = openAccessLog(*accessFile)
+		if err != nil {
+			log.Fatalf("Failed to open access log: %v", err)
+		}
+		unaryInterceptors = append(unaryInterceptors, access.unaryInterceptor)
+		streamInterceptors = append(streamInterceptors, access.streamInterceptor)
+		slog.Info("Logging calls", "file", *accessFile)
+	}
+	if *rateLimits != "" {
+		limiter, err := parseRateLimits(*rateLimits)
+		if err != nil {
+			log.Fatalf("Failed to configure rate limits: %v", err)
+		}
+		unaryInterceptors = append(unaryInterceptors, limiter.unaryInterceptor)
+		streamInterceptors = append(streamInterceptors, limiter.streamInterceptor)
+		slog.Info("Rate limiting calls per caller", "limits", *rateLimits)
+	}
+	if len(authProviders) > 0 {
+		// Let the Admin service list connections and terminate streams
+		routeGuideServer.connections = newConnTracker()
+		streamInterceptors = append(streamInterceptors, routeGuideServer.connections.streamInterceptor)
+	}
+	if *streamQuotas != "" {
+		quota, err := parseStreamQuota(*streamQuotas, time.Second)
+		if err != nil {
+			log.Fatalf("Failed to configure stream limits: %v", err)
+		}
+		streamInterceptors = append(streamInterceptors, quota.streamInterceptor)
+		slog.Info("Limiting open streams per caller", "limits", *streamQuotas)
+	}
+	if *bulkWorkers < 1 {
+		log.Fatalf("--low-priority-workers must be at least 1")
+	}
+	priority := newPriorityPool(*bulkWorkers)
+	unaryInterceptors = append(unaryInterceptors, priority.unaryInterceptor)
+	streamInterceptors = append(streamInterceptors, priority.streamInterceptor)
+	if *redactFields != "" {
+		redaction, err := newRedactionPolicy(*redactFields)
+		if err != nil {
+			log.Fatalf("Failed to configure redaction: %v", err)
+		}
+		unaryInterceptors = append(unaryInterceptors, redaction.unaryInterceptor)
+		streamInterceptors = append(streamInterceptors, redaction.streamInterceptor)
+		slog.Info("Redacting fields from non-admin responses", "fields", *redactFields)
+	}
+	unaryInterceptors = append(unaryInterceptors, crsUnaryInterceptor)
+	streamInterceptors = append(streamInterceptors, crsStreamInterceptor)
+	if *canaryList != "" {
+		canary, err := routeGuideServer.newCanary(*canaryList, *canaryPct)
+		if err != nil {
+			log.Fatalf("Failed to configure canary: %v", err)
+		}
+		unaryInterceptors = append(unaryInterceptors, canary.unaryInterceptor)
+		streamInterceptors = append(streamInterceptors, canary.streamInterceptor)
+		slog.Info("Shadowing methods with canary implementations", "methods", *canaryList)
+	}
+	serverOptions := []grpc.ServerOption{
+		grpc.StatsHandler(compressionStats{}),
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	}
+	if routeGuideServer.connections != nil {
+		serverOptions = append(serverOptions, grpc.StatsHandler(routeGuideServer.connections))
+	}
+	for _, handler := range extensions.statsHandlers {
+		serverOptions = append(serverOptions, grpc.StatsHandler(handler))
+	}
+	creds, err := configureTLS(ctx)
+	if err != nil {
+		log.Fatalf("Failed to configure TLS: %v", err)
+	}
+	if creds != nil {
+		serverOptions = append(serverOptions, grpc.Creds(creds))
+	}
+	grpcServer := grpc.NewServer(serverOptions...)
 
 	// Register RouteGuide service
 	pb.RegisterRouteGuideServer(grpcServer, routeGuideServer)
 
-	log.Printf("Server listening on port %d", *port)
-	log.Printf("Features loaded from: %s", *featuresFile)
+	// Register Admin service only when it can be protected
+	if len(authProviders) > 0 {
+		pb.RegisterAdminServer(grpcServer, newAdminServer(routeGuideServer))
+		slog.Info("Admin service enabled")
+	}
+
+	// Let tools such as grpcurl discover the services at runtime
+	if *reflect {
+		reflection.Register(grpcServer)
+		slog.Info("Server reflection enabled")
+	}
+
+	// Report NOT_SERVING until the startup warm-up has finished
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	routeGuideServer.health = healthServer
+	subsystems.attachHealth(healthServer)
+	for _, service := range []string{"", pb.RouteGuide_ServiceDesc.ServiceName} {
+		healthServer.SetServingStatus(service, healthpb.HealthCheckResponse_NOT_SERVING)
+	}
+
+	warmupTasks := []*warmupTask{indexWarmupTask("feature_index", routeGuideServer.current())}
+	if d := routeGuideServer.ab.candidate.Load(); d != nil {
+		warmupTasks = append(warmupTasks, indexWarmupTask("candidate_index", d))
+	}
+	if *warmupZoom >= 0 {
+		warmupTasks = append(warmupTasks, routeGuideServer.tileWarmupTask(*warmupZoom))
+	}
+	dependencies := extensions.dependencies
+	if revoked != nil && revoked.shared != nil {
+		// Authenticated calls are refused until the set loads
+		dependencies = append(dependencies, dependency{name: "revocation_" + revoked.shared.name(), check: revoked.checkShared})
+	}
+	for _, dep := range dependencies {
+		warmupTasks = append(warmupTasks, dependencyWarmupTask(ctx, dep, *depTimeout))
+	}
+	go runWarmup(warmupTasks, func() {
+		for _, service := range []string{"", pb.RouteGuide_ServiceDesc.ServiceName} {
+			healthServer.SetServingStatus(service, healthpb.HealthCheckResponse_SERVING)
+		}
+		routeGuideServer.reportWritesHealth()
+	})
+
+	// Create the TCP or Unix domain socket listener last, so a startup
+	// failure above doesn't leave a socket file behind
+	lis, err := listen(listenAddress())
+	if err != nil {
+		log.Fatalf("Failed to listen on %s: %v", listenAddress(), err)
+	}
+	slog.Info("Server listening", "address", listenAddress())
+	slog.Info("Features loaded", "file", *featuresFile)
 
 	// Setup graceful shutdown
+	stopped := make(chan struct{})
 	go func() {
+		defer close(stopped)
 		sigChan := make(chan os.Signal, 1)
 		signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 		<-sigChan
 
-		log.Println("Received shutdown signal, stopping server...")
+		slog.Info("Received shutdown signal, stopping server")
+		// Report NOT_SERVING first, giving load balancers and clients
+		// watching health the drain period to move away
+		healthServer.Shutdown()
+		if *drainTime > 0 {
+			slog.Info("Draining before closing connections (signal again to skip)", "drain", *drainTime)
+			select {
+			case <-time.After(*drainTime):
+			case <-sigChan:
+			}
+		}
+		cancel()
 		grpcServer.GracefulStop()
-		log.Println("Server stopped gracefully")
+		extensions.stop()
+		if notesDatabase != nil {
+			if err := notesDatabase.close(); err != nil {
+				slog.Error("Failed to close notes database", "error", err)
+			}
+		}
+		if notesLock != nil {
+			notesLock.unlock()
+		}
+		if access != nil {
+			access.close()
+		}
+		slog.Info("Server stopped gracefully")
 	}()
 
 	// Start serving
-	log.Println("RouteGuide server is ready to accept requests")
+	slog.Info("RouteGuide server is ready to accept requests")
 	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatalf("Failed to serve: %v", err)
 	}
+	<-stopped
+}
+
+// configureAuth builds the authentication providers enabled by the flags, in
+// the order they are tried
+func configureAuth() ([]AuthProvider, error) {
+	var providers []AuthProvider
+	if *adminToken != "" {
+		providers = append(providers, newStaticTokenProvider(*adminToken, &Principal{Name: "admin", Roles: []string{adminRole}}))
+		slog.Info("Static admin token enabled")
+	}
+	if *jwtSecret != "" || *jwtKeyFile != "" {
+		var secret []byte
+		if *jwtSecret != "" {
+			secret = []byte(*jwtSecret)
+		}
+		var rsaKey *rsa.PublicKey
+		if *jwtKeyFile != "" {
+			key, err := loadRSAPublicKey(*jwtKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("loading JWT public key: %v", err)
+			}
+			rsaKey = key
+		}
+		providers = append(providers, newJWTProvider(secret, rsaKey, *jwtIssuer, *jwtAudience))
+		slog.Info("JWT authentication enabled")
+	}
+	if *apiKeysFile != "" {
+		keys, err := loadAPIKeyProvider(*apiKeysFile)
+		if err != nil {
+			return nil, fmt.Errorf("loading API keys: %v", err)
+		}
+		providers = append(providers, keys)
+		slog.Info("Loaded API keys", "count", len(keys.keys), "file", *apiKeysFile)
+	}
+	if *clientCA != "" {
+		// Last, so credentials sent in metadata take precedence
+		providers = append(providers, clientCertProvider{})
+		slog.Info("Client certificate authentication enabled")
+	}
+	return providers, nil
+}
+
+// configureTLS builds the server's transport credentials from the TLS
+// flags, or returns nil to serve plaintext. The certificate and client CAs
+// are reloaded when their files change until ctx is cancelled.
+func configureTLS(ctx context.Context) (credentials.TransportCredentials, error) {
+	if *tlsCert == "" && *tlsKey == "" {
+		if *clientCA != "" {
+			return nil, fmt.Errorf("--client-ca requires --tls-cert and --tls-key")
+		}
+		return nil, nil
+	}
+	if *tlsCert == "" || *tlsKey == "" {
+		return nil, fmt.Errorf("--tls-cert and --tls-key must be set together")
+	}
+	certs, err := newCertReloader(*tlsCert, *tlsKey, *clientCA)
+	if err != nil {
+		return nil, err
+	}
+	go certs.watch(ctx, *tlsReload)
+	config := &tls.Config{GetCertificate: certs.getCertificate}
+	if *clientCA != "" {
+		config.GetConfigForClient = certs.configForClient
+		slog.Info("Requiring client certificates", "client_ca", *clientCA)
+	}
+	slog.Info("Serving TLS", "cert", *tlsCert)
+	return credentials.NewTLS(config), nil
 }
@@ -0,0 +1,142 @@
+package main
+
+import (
+	"context"
+	"expvar"
+	"log/slog"
+	"math"
+	"runtime/debug"
+	"runtime/metrics"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// memoryMetrics publishes memory pressure gauges and counters on /debug/vars
+var memoryMetrics = expvar.NewMap("memory")
+
+// memoryRecoveryRatio is the share of the pressure threshold memory use must
+// fall below for the pressure to be over, so the guard doesn't flap
+const memoryRecoveryRatio = 0.9
+
+// memoryGuard watches the process' memory use against the Go memory limit
+// (GOMEMLIMIT or --memory-limit-mb). Once use crosses the pressure
+// threshold, it frees what the server can afford to lose, and rejects new
+// streams until use falls back, so the process degrades before the OOM
+// killer steps in.
+type memoryGuard struct {
+	server    *routeGuideServer
+	limit     uint64        // the Go memory limit, in bytes
+	threshold uint64        // use at which the pressure starts, in bytes
+	interval  time.Duration // how often use is sampled
+
+	pressure atomic.Bool
+
+	usage        expvar.Int
+	events       expvar.Int
+	shedStreams  expvar.Int
+	evictedTiles expvar.Int
+	evictedNotes expvar.Int
+}
+
+// newMemoryGuard creates a guard for the server, or returns nil if no memory
+// limit is set. A positive limitMB sets the Go memory limit; otherwise the
+// GOMEMLIMIT one is used. Pressure starts when use reaches ratio of the limit.
+func newMemoryGuard(s *routeGuideServer, limitMB int, ratio float64, interval time.Duration) *memoryGuard {
+	if limitMB > 0 {
+		debug.SetMemoryLimit(int64(limitMB) << 20)
+	}
+	limit := debug.SetMemoryLimit(-1)
+	if limit == math.MaxInt64 {
+		return nil
+	}
+
+	g := &memoryGuard{
+		server:    s,
+		limit:     uint64(limit),
+		threshold: uint64(float64(limit) * ratio),
+		interval:  interval,
+	}
+	memoryMetrics.Set("limit_bytes", expvar.Func(func() any { return g.limit }))
+	memoryMetrics.Set("threshold_bytes", expvar.Func(func() any { return g.threshold }))
+	memoryMetrics.Set("usage_bytes", &g.usage)
+	memoryMetrics.Set("under_pressure", expvar.Func(func() any { return g.pressure.Load() }))
+	memoryMetrics.Set("pressure_events_total", &g.events)
+	memoryMetrics.Set("shed_streams_total", &g.shedStreams)
+	memoryMetrics.Set("evicted_tiles_total", &g.evictedTiles)
+	memoryMetrics.Set("evicted_notes_total", &g.evictedNotes)
+	return g
+}
+
+// memoryInUse returns the memory counted against the Go memory limit
+func memoryInUse() uint64 {
+	samples := []metrics.Sample{
+		{Name: "/memory/classes/total:bytes"},
+		{Name: "/memory/classes/heap/released:bytes"},
+	}
+	metrics.Read(samples)
+	return samples[0].Value.Uint64() - samples[1].Value.Uint64()
+}
+
+// run samples memory use until ctx is cancelled
+func (g *memoryGuard) run(ctx context.Context) {
+	ticker := time.NewTicker(g.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			g.check(memoryInUse())
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// check reacts to a sample of memory use
+func (g *memoryGuard) check(used uint64) {
+	g.usage.Set(int64(used))
+	switch {
+	case !g.pressure.Load() && used >= g.threshold:
+		g.pressure.Store(true)
+		g.events.Add(1)
+		slog.Warn("Memory pressure, shedding new streams and freeing caches", "used_mib", used>>20, "limit_mib", g.limit>>20)
+		g.relieve()
+	case g.pressure.Load() && used < uint64(float64(g.threshold)*memoryRecoveryRatio):
+		g.pressure.Store(false)
+		slog.Info("Memory pressure over", "used_mib", used>>20)
+	}
+}
+
+// relieve drops the memory the server can rebuild or do without: cached
+// tiles and ListFeatures results, and archived route notes
+func (g *memoryGuard) relieve() {
+	s := g.server
+	if s.tiles != nil {
+		tiles := s.tiles.purge()
+		g.evictedTiles.Add(int64(tiles))
+		slog.Info("Memory pressure: evicted cached tiles", "count", tiles)
+	}
+	if s.rects != nil {
+		rects := s.rects.purge()
+		slog.Info("Memory pressure: evicted cached ListFeatures results", "count", rects)
+	}
+	if s.notes != nil {
+		if notes, err := s.notes.DropArchived(context.Background()); err == nil {
+			g.evictedNotes.Add(int64(notes))
+			slog.Info("Memory pressure: evicted archived notes", "count", notes)
+		}
+	}
+	debug.FreeOSMemory()
+}
+
+// streamInterceptor rejects new streams while memory is under pressure;
+// streams already open carry on
+func (g *memoryGuard) streamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if g.pressure.Load() {
+		g.shedStreams.Add(1)
+		loggerFrom(ss.Context()).Warn("Rejected stream: memory pressure")
+		return resourceExhausted("memory", "the server is low on memory, try again later", g.interval)
+	}
+	return handler(srv, ss)
+}
@@ -0,0 +1,303 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// latencyBuckets are the upper bounds, in seconds, of the handling time
+// histogram buckets
+var latencyBuckets = []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 300}
+
+// sizeBuckets are the upper bounds, in bytes, of the message size histogram
+// buckets
+var sizeBuckets = []float64{64, 256, 1024, 4096, 16384, 65536, 262144, 1 << 20, 4 << 20, 16 << 20}
+
+// rpcKey identifies a method in metric labels
+type rpcKey struct {
+	service, method, kind string
+}
+
+// rpcLabels are the labels of every per-method metric
+var rpcLabels = []string{"grpc_service", "grpc_method", "grpc_type"}
+
+// rpcMetrics collects per-method call, status code, latency, message and
+// message size metrics for the Prometheus /metrics endpoint
+type rpcMetrics struct {
+	started           *prometheus.CounterVec
+	handled           *prometheus.CounterVec // with a grpc_code label
+	received          *prometheus.CounterVec // messages received from clients
+	sent              *prometheus.CounterVec // messages sent to clients
+	oversizedReceived *prometheus.CounterVec // messages over oversized
+	oversizedSent     *prometheus.CounterVec
+	handling          *prometheus.HistogramVec
+	receivedSizes     *prometheus.HistogramVec
+	sentSizes         *prometheus.HistogramVec
+
+	// oversized is the message size, in bytes, above which messages are
+	// logged and counted; 0 disables it. Set it before serving.
+	oversized int
+}
+
+// metricsRegistry holds the metrics served on /metrics
+var metricsRegistry = prometheus.NewRegistry()
+
+// serverMetrics is the process-wide RPC metrics collector
+var serverMetrics = newRPCMetrics(metricsRegistry)
+
+func init() {
+	metricsRegistry.MustRegister(expvarCollector{}, collectors.NewGoCollector())
+}
+
+// newRPCMetrics creates the RPC metrics and registers them with reg
+func newRPCMetrics(reg prometheus.Registerer) *rpcMetrics {
+	counter := func(name, help string, extra ...string) *prometheus.CounterVec {
+		c := prometheus.NewCounterVec(prometheus.CounterOpts{Name: name, Help: help}, append(slices.Clone(rpcLabels), extra...))
+		reg.MustRegister(c)
+		return c
+	}
+	histogram := func(name, help string, buckets []float64) *prometheus.HistogramVec {
+		h := prometheus.NewHistogramVec(prometheus.HistogramOpts{Name: name, Help: help, Buckets: buckets}, rpcLabels)
+		reg.MustRegister(h)
+		return h
+	}
+	return &rpcMetrics{
+		started:           counter("grpc_server_started_total", "RPCs started on the server."),
+		handled:           counter("grpc_server_handled_total", "RPCs completed on the server, by status code.", "grpc_code"),
+		received:          counter("grpc_server_msg_received_total", "Messages received from clients."),
+		sent:              counter("grpc_server_msg_sent_total", "Messages sent to clients."),
+		oversizedReceived: counter("grpc_server_oversized_msg_received_total", "Messages received from clients over the oversized message threshold."),
+		oversizedSent:     counter("grpc_server_oversized_msg_sent_total", "Messages sent to clients over the oversized message threshold."),
+		handling:          histogram("grpc_server_handling_seconds", "Time taken to handle RPCs, until the handler returned.", latencyBuckets),
+		receivedSizes:     histogram("grpc_server_msg_received_bytes", "Sizes of the messages received from clients, serialized.", sizeBuckets),
+		sentSizes:         histogram("grpc_server_msg_sent_bytes", "Sizes of the messages sent to clients, serialized.", sizeBuckets),
+	}
+}
+
+// newRPCKey splits a full method name into metric labels
+func newRPCKey(fullMethod string, clientStream, serverStream bool) rpcKey {
+	service, method, _ := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+	kind := "unary"
+	switch {
+	case clientStream && serverStream:
+		kind = "bidi_stream"
+	case clientStream:
+		kind = "client_stream"
+	case serverStream:
+		kind = "server_stream"
+	}
+	return rpcKey{service: service, method: method, kind: kind}
+}
+
+// labels returns the label values of a method, followed by extra ones
+func (k rpcKey) labels(extra ...string) []string {
+	return append([]string{k.service, k.method, k.kind}, extra...)
+}
+
+// start counts a call as started
+func (m *rpcMetrics) start(key rpcKey) {
+	m.started.WithLabelValues(key.labels()...).Inc()
+}
+
+// finish records the outcome and handling time of a call, linking its
+// latency bucket to the call's trace if its span was sampled
+func (m *rpcMetrics) finish(ctx context.Context, key rpcKey, err error, elapsed time.Duration) {
+	m.handled.WithLabelValues(key.labels(status.Code(err).String())...).Inc()
+	observer := m.handling.WithLabelValues(key.labels()...)
+	if sc := trace.SpanContextFromContext(ctx); sc.IsSampled() {
+		observer.(prometheus.ExemplarObserver).ObserveWithExemplar(elapsed.Seconds(), prometheus.Labels{"trace_id": sc.TraceID().String()})
+		return
+	}
+	observer.Observe(elapsed.Seconds())
+}
+
+// message counts a message received from a client or, if sent, sent to
+// one, with its size, logging it if oversized
+func (m *rpcMetrics) message(ctx context.Context, key rpcKey, msg any, sent bool) {
+	size := 0
+	if pm, ok := msg.(proto.Message); ok {
+		size = proto.Size(pm)
+	}
+	oversized := m.oversized > 0 && size > m.oversized
+	count, sizes, over := m.received, m.receivedSizes, m.oversizedReceived
+	if sent {
+		count, sizes, over = m.sent, m.sentSizes, m.oversizedSent
+	}
+	count.WithLabelValues(key.labels()...).Inc()
+	sizes.WithLabelValues(key.labels()...).Observe(float64(size))
+	if oversized {
+		over.WithLabelValues(key.labels()...).Inc()
+		direction := "received"
+		if sent {
+			direction = "sent"
+		}
+		// The call's logger isn't in ctx yet, so make one with its tags
+		callLogger(ctx, "/"+key.service+"/"+key.method, streamID(ctx)).Warn("Oversized message",
+			"direction", direction, "bytes", size, "threshold", m.oversized, "type", fmt.Sprintf("%T", msg))
+	}
+}
+
+// unaryInterceptor counts unary calls
+func (m *rpcMetrics) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	key := newRPCKey(info.FullMethod, false, false)
+	subsystems.run("metrics", func() {
+		m.start(key)
+		m.message(ctx, key, req, false)
+	})
+	start := time.Now()
+	resp, err := handler(ctx, req)
+	subsystems.run("metrics", func() {
+		if err == nil {
+			m.message(ctx, key, resp, true)
+		}
+		m.finish(ctx, key, err, time.Since(start))
+	})
+	return resp, err
+}
+
+// streamInterceptor counts streams and the messages they carry
+func (m *rpcMetrics) streamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	key := newRPCKey(info.FullMethod, info.IsClientStream, info.IsServerStream)
+	if !subsystems.run("metrics", func() { m.start(key) }) {
+		return handler(srv, ss)
+	}
+	start := time.Now()
+	err := handler(srv, &countingStream{ServerStream: ss, metrics: m, key: key})
+	subsystems.run("metrics", func() { m.finish(ss.Context(), key, err, time.Since(start)) })
+	return err
+}
+
+// countingStream counts the messages sent and received on a stream, and
+// their sizes
+type countingStream struct {
+	grpc.ServerStream
+	metrics *rpcMetrics
+	key     rpcKey
+}
+
+func (c *countingStream) SendMsg(msg any) error {
+	err := c.ServerStream.SendMsg(msg)
+	if err == nil {
+		subsystems.run("metrics", func() { c.metrics.message(c.Context(), c.key, msg, true) })
+	}
+	return err
+}
+
+func (c *countingStream) RecvMsg(msg any) error {
+	err := c.ServerStream.RecvMsg(msg)
+	if err == nil {
+		subsystems.run("metrics", func() { c.metrics.message(c.Context(), c.key, msg, false) })
+	}
+	return err
+}
+
+// metricsErrorLog logs the metrics that fail to be collected; the rest are
+// still served
+var metricsErrorLog = slog.NewLogLogger(slog.Default().Handler(), slog.LevelWarn)
+
+// metricsHandler serves the metrics to Prometheus scrapers, in the
+// OpenMetrics format to those accepting it, as Prometheus does when storing
+// exemplars
+func metricsHandler() http.Handler {
+	return promhttp.HandlerFor(metricsRegistry, promhttp.HandlerOpts{
+		EnableOpenMetrics: true,
+		ErrorHandling:     promhttp.ContinueOnError,
+		ErrorLog:          metricsErrorLog,
+	})
+}
+
+// expvarFamilies are the /debug/vars maps also exported on /metrics, as
+// routeguide_<var>_<key>. Keys ending in _total are counters, other numbers
+// and booleans gauges; values that aren't are left out. A map with labels
+// holds an entry per key, split into the label values: a map of fields, e.g.
+// per job, or a single value named metric, e.g. rejections per method.
+var expvarFamilies = []struct {
+	name   string
+	labels []string                  // label names, nil if unlabeled
+	split  func(key string) []string // label values of a key
+	metric string                    // name of the single values of a labeled map
+}{
+	{name: "record_route"},
+	{name: "memory"},
+	{name: "outbox"},
+	{name: "notes_db"},
+	{name: "jobs", labels: []string{"job"}, split: func(key string) []string { return []string{key} }},
+	{name: "rate_limits", metric: "routeguide_rate_limited_calls_total", labels: []string{"grpc_service", "grpc_method"}, split: func(key string) []string {
+		service, method, _ := strings.Cut(strings.TrimPrefix(key, "/"), "/")
+		return []string{service, method}
+	}},
+}
+
+// expvarCollector collects the gauges and counters of expvarFamilies on
+// every scrape. Maps of subsystems that aren't compiled in or enabled are
+// skipped. The metrics it collects depend on the maps' keys, so it describes
+// none up front.
+type expvarCollector struct{}
+
+func (expvarCollector) Describe(chan<- *prometheus.Desc) {}
+
+func (expvarCollector) Collect(ch chan<- prometheus.Metric) {
+	add := func(name, source string, labels, values []string, value any) {
+		var v float64
+		switch value := value.(type) {
+		case float64:
+			v = value
+		case bool:
+			if value {
+				v = 1
+			}
+		default:
+			return
+		}
+		kind := prometheus.GaugeValue
+		if strings.HasSuffix(name, "_total") {
+			kind = prometheus.CounterValue
+		}
+		desc := prometheus.NewDesc(name, source+" on /debug/vars.", labels, nil)
+		metric, err := prometheus.NewConstMetric(desc, kind, v, values...)
+		if err != nil {
+			metric = prometheus.NewInvalidMetric(desc, err)
+		}
+		ch <- metric
+	}
+	for _, family := range expvarFamilies {
+		m, ok := expvar.Get(family.name).(*expvar.Map)
+		if !ok {
+			continue
+		}
+		prefix := "routeguide_" + family.name + "_"
+		m.Do(func(kv expvar.KeyValue) {
+			// Every expvar.Var renders itself as JSON
+			var value any
+			if json.Unmarshal([]byte(kv.Value.String()), &value) != nil {
+				return
+			}
+			switch {
+			case family.labels == nil:
+				add(prefix+kv.Key, family.name+"."+kv.Key, nil, nil, value)
+			case family.metric != "":
+				add(family.metric, family.name, family.labels, family.split(kv.Key), value)
+			default:
+				fields, _ := value.(map[string]any)
+				for field, v := range fields {
+					add(prefix+field, family.name+".*."+field, family.labels, family.split(kv.Key), v)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/prometheus/common/expfmt"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+// scrapeMetrics requests /metrics with an Accept header and returns the response
+func scrapeMetrics(t *testing.T, accept string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest("GET", "/metrics", nil)
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	rec := httptest.NewRecorder()
+	metricsHandler().ServeHTTP(rec, req)
+	if rec.Code != 200 {
+		t.Fatalf("GET /metrics = %d: %s", rec.Code, rec.Body)
+	}
+	return rec
+}
+
+// labelsOf returns the labels of a metric by name
+func labelsOf(m *dto.Metric) map[string]string {
+	labels := make(map[string]string)
+	for _, pair := range m.GetLabel() {
+		labels[pair.GetName()] = pair.GetValue()
+	}
+	return labels
+}
+
+func TestMetricsScrapeParses(t *testing.T) {
+	rateLimitMetrics.Add("/routeguide.RouteGuide/GetFeature", 1)
+	info := &grpc.UnaryServerInfo{FullMethod: "/routeguide.RouteGuide/GetFeature"}
+	handler := func(ctx context.Context, req any) (any, error) { return &pb.Feature{Name: "Feature"}, nil }
+	if _, err := serverMetrics.unaryInterceptor(context.Background(), &pb.Point{}, info, handler); err != nil {
+		t.Fatal(err)
+	}
+
+	rec := scrapeMetrics(t, "")
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(rec.Body)
+	if err != nil {
+		t.Fatalf("/metrics doesn't parse: %v", err)
+	}
+
+	getFeature := map[string]string{"grpc_service": "routeguide.RouteGuide", "grpc_method": "GetFeature", "grpc_type": "unary"}
+	tests := []struct {
+		name   string
+		kind   dto.MetricType
+		labels map[string]string // a metric of the family has at least these
+	}{
+		{"grpc_server_started_total", dto.MetricType_COUNTER, getFeature},
+		{"grpc_server_handled_total", dto.MetricType_COUNTER, map[string]string{"grpc_method": "GetFeature", "grpc_code": "OK"}},
+		{"grpc_server_msg_received_total", dto.MetricType_COUNTER, getFeature},
+		{"grpc_server_msg_sent_total", dto.MetricType_COUNTER, getFeature},
+		{"grpc_server_handling_seconds", dto.MetricType_HISTOGRAM, getFeature},
+		{"grpc_server_msg_received_bytes", dto.MetricType_HISTOGRAM, getFeature},
+		{"grpc_server_msg_sent_bytes", dto.MetricType_HISTOGRAM, getFeature},
+		{"routeguide_record_route_active_streams", dto.MetricType_GAUGE, nil},
+		{"routeguide_rate_limited_calls_total", dto.MetricType_COUNTER, map[string]string{"grpc_service": "routeguide.RouteGuide", "grpc_method": "GetFeature"}},
+		{"go_goroutines", dto.MetricType_GAUGE, nil},
+	}
+	for _, tt := range tests {
+		family, ok := families[tt.name]
+		if !ok {
+			t.Errorf("/metrics lacks %s", tt.name)
+			continue
+		}
+		if family.GetType() != tt.kind {
+			t.Errorf("%s is a %v, want a %v", tt.name, family.GetType(), tt.kind)
+		}
+		found := false
+		for _, m := range family.GetMetric() {
+			labels := labelsOf(m)
+			found = true
+			for name, value := range tt.labels {
+				if labels[name] != value {
+					found = false
+				}
+			}
+			if found {
+				break
+			}
+		}
+		if !found {
+			t.Errorf("%s has no metric labeled %v", tt.name, tt.labels)
+		}
+	}
+
+	// The text format also lists the +Inf bucket
+	handling := families["grpc_server_handling_seconds"].GetMetric()[0].GetHistogram()
+	if len(handling.GetBucket()) != len(latencyBuckets)+1 || handling.GetSampleCount() == 0 {
+		t.Errorf("grpc_server_handling_seconds = %v, want %d buckets and a call", handling, len(latencyBuckets)+1)
+	}
+}
+
+func TestMetricsExemplarsFromSpans(t *testing.T) {
+	traceID := trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36}
+	spanID := trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7}
+	span := func(flags trace.TraceFlags) context.Context {
+		return trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{TraceID: traceID, SpanID: spanID, TraceFlags: flags}))
+	}
+	// Methods no other test calls, so their buckets only hold these calls
+	tests := []struct {
+		method   string
+		ctx      context.Context
+		exemplar bool
+	}{
+		{"ExemplarSampled", span(trace.FlagsSampled), true},
+		{"ExemplarUnsampled", span(0), false},
+		{"ExemplarUntraced", context.Background(), false},
+	}
+	for _, tt := range tests {
+		info := &grpc.UnaryServerInfo{FullMethod: "/routeguide.RouteGuide/" + tt.method}
+		handler := func(ctx context.Context, req any) (any, error) { return &pb.Feature{}, nil }
+		if _, err := serverMetrics.unaryInterceptor(tt.ctx, &pb.Point{}, info, handler); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rec := scrapeMetrics(t, "application/openmetrics-text; version=1.0.0")
+	if got := rec.Header().Get("Content-Type"); !strings.HasPrefix(got, "application/openmetrics-text") {
+		t.Fatalf("Content-Type = %q, want OpenMetrics", got)
+	}
+	body := rec.Body.String()
+	if !strings.HasSuffix(body, "# EOF\n") {
+		t.Errorf("OpenMetrics exposition doesn't end with # EOF")
+	}
+	for _, tt := range tests {
+		var exemplars []string
+		for line := range strings.Lines(body) {
+			if !strings.HasPrefix(line, "grpc_server_handling_seconds_bucket{") || !strings.Contains(line, `grpc_method="`+tt.method+`"`) {
+				continue
+			}
+			if _, exemplar, ok := strings.Cut(strings.TrimSpace(line), " # "); ok {
+				exemplars = append(exemplars, exemplar)
+			}
+		}
+		if !tt.exemplar {
+			if len(exemplars) != 0 {
+				t.Errorf("%s has exemplars %v, want none", tt.method, exemplars)
+			}
+			continue
+		}
+		if len(exemplars) != 1 || !strings.HasPrefix(exemplars[0], `{trace_id="`+traceID.String()+`"} `) {
+			t.Errorf("%s has exemplars %v, want one for trace %s", tt.method, exemplars, traceID)
+		}
+	}
+}
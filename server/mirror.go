@@ -0,0 +1,154 @@
+//go:build !minimal && !no_mirror
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"expvar"
+	"flag"
+	"fmt"
+	"log/slog"
+	"math/rand/v2"
+	"strings"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// mirrorMaxInFlight caps the mirrored calls waiting on the secondary;
+	// more are dropped rather than queued
+	mirrorMaxInFlight = 64
+	// mirrorTimeout bounds a mirrored call
+	mirrorTimeout = 5 * time.Second
+)
+
+var (
+	mirrorTarget  = flag.String("mirror-target", "", "Address of a secondary RouteGuide server unary calls are mirrored to, e.g. a new build under test (mirroring is disabled when empty)")
+	mirrorPercent = flag.Float64("mirror-percent", 100, "Percentage of unary RouteGuide calls mirrored to --mirror-target")
+	mirrorCA      = flag.String("mirror-tls-ca", "", "PEM CA certificates verifying --mirror-target over TLS (plaintext when empty)")
+)
+
+func init() {
+	registerExtension(extension{name: "mirror", start: startMirror})
+}
+
+// startMirror mirrors calls if --mirror-target is set
+func startMirror(h *extensionHost) error {
+	if *mirrorTarget == "" {
+		return nil
+	}
+	if *mirrorPercent < 0 || *mirrorPercent > 100 {
+		return fmt.Errorf("--mirror-percent must be between 0 and 100")
+	}
+	m, err := newMirror(*mirrorTarget, *mirrorCA, *mirrorPercent)
+	if err != nil {
+		return err
+	}
+	h.unary = append(h.unary, m.unaryInterceptor)
+	h.stops = append(h.stops, func() { m.conn.Close() })
+	slog.Info("Mirroring unary calls", "percent", m.percent, "target", *mirrorTarget)
+	return nil
+}
+
+// mirrorMetrics counts mirrored calls by the status code the secondary
+// returned, and dropped ones, on /debug/vars
+var mirrorMetrics = expvar.NewMap("mirror")
+
+// mirror sends copies of unary RouteGuide calls to a secondary server and
+// drops its responses. Callers only ever see the primary's response, and a
+// slow or failing secondary doesn't hold them up.
+type mirror struct {
+	conn     *grpc.ClientConn
+	percent  float64       // share of calls mirrored
+	inFlight chan struct{} // a slot per mirrored call in progress
+}
+
+// newMirror connects to the secondary server
+func newMirror(target, caFile string, percent float64) (*mirror, error) {
+	creds := insecure.NewCredentials()
+	if caFile != "" {
+		pool, err := loadCertPool(caFile)
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.NewTLS(&tls.Config{RootCAs: pool})
+	}
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, err
+	}
+	return &mirror{conn: conn, percent: percent, inFlight: make(chan struct{}, mirrorMaxInFlight)}, nil
+}
+
+// unaryInterceptor mirrors a sample of unary RouteGuide calls. The request
+// is copied before the primary handler runs, so the copy is what the caller
+// sent; the caller's metadata is forwarded with it.
+func (m *mirror) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	msg, ok := req.(proto.Message)
+	if ok && strings.HasPrefix(info.FullMethod, "/"+pb.RouteGuide_ServiceDesc.ServiceName+"/") && rand.Float64()*100 < m.percent {
+		m.send(ctx, info.FullMethod, proto.Clone(msg))
+	}
+	return handler(ctx, req)
+}
+
+// send makes the mirrored call in the background, unless too many are
+// already in progress
+func (m *mirror) send(ctx context.Context, method string, req proto.Message) {
+	select {
+	case m.inFlight <- struct{}{}:
+	default:
+		mirrorMetrics.Add("dropped", 1)
+		return
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	out := metadata.NewOutgoingContext(context.Background(), forwardedMetadata(md))
+	go func() {
+		defer func() { <-m.inFlight }()
+		ctx, cancel := context.WithTimeout(out, mirrorTimeout)
+		defer cancel()
+		err := m.conn.Invoke(ctx, method, req, &discardedReply{}, grpc.ForceCodec(discardCodec{}))
+		mirrorMetrics.Add(status.Code(err).String(), 1)
+	}()
+}
+
+// forwardedMetadata returns the caller's metadata without the headers the
+// transport sets for each connection
+func forwardedMetadata(md metadata.MD) metadata.MD {
+	out := metadata.MD{}
+	for key, values := range md {
+		if strings.HasPrefix(key, ":") || strings.HasPrefix(key, "grpc-") ||
+			key == "content-type" || key == "user-agent" {
+			continue
+		}
+		out[key] = values
+	}
+	return out
+}
+
+// discardedReply stands in for the response of a mirrored call
+type discardedReply struct{}
+
+// discardCodec sends protobuf requests and ignores responses, so mirrored
+// calls don't spend time decoding them
+type discardCodec struct{}
+
+func (discardCodec) Marshal(v any) ([]byte, error) {
+	return proto.Marshal(v.(proto.Message))
+}
+
+func (discardCodec) Unmarshal(data []byte, v any) error {
+	return nil
+}
+
+func (discardCodec) Name() string {
+	return "proto"
+}
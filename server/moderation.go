@@ -0,0 +1,237 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"sync"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxPendingSubmissions caps the feature submissions waiting for review
+const maxPendingSubmissions = 1000
+
+// moderationQueue holds the feature submissions waiting for an admin's review
+type moderationQueue struct {
+	mu      sync.Mutex // also serializes applying approved submissions and feature mutations
+	pending []*pb.FeatureSubmission
+}
+
+// newModerationQueue creates an empty moderation queue
+func newModerationQueue() *moderationQueue {
+	return &moderationQueue{}
+}
+
+// add queues a submission, failing if the queue is full
+func (q *moderationQueue) add(sub *pb.FeatureSubmission) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if len(q.pending) >= maxPendingSubmissions {
+		return status.Errorf(codes.ResourceExhausted, "%d feature submissions are already waiting for review", maxPendingSubmissions)
+	}
+	q.pending = append(q.pending, sub)
+	return nil
+}
+
+// list returns copies of the pending submissions, oldest first
+func (q *moderationQueue) list() []*pb.FeatureSubmission {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	out := make([]*pb.FeatureSubmission, len(q.pending))
+	for i, sub := range q.pending {
+		out[i] = proto.Clone(sub).(*pb.FeatureSubmission)
+	}
+	return out
+}
+
+// review takes the pending submission with the given ID out of the queue and
+// passes it to decide, which sets its outcome. The submission stays queued if
+// decide fails.
+func (q *moderationQueue) review(id string, decide func(sub *pb.FeatureSubmission) error) (*pb.FeatureSubmission, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for i, sub := range q.pending {
+		if sub.Id != id {
+			continue
+		}
+		reviewed := proto.Clone(sub).(*pb.FeatureSubmission)
+		if err := decide(reviewed); err != nil {
+			return nil, err
+		}
+		q.pending = append(q.pending[:i], q.pending[i+1:]...)
+		return reviewed, nil
+	}
+	return nil, status.Errorf(codes.NotFound, "no pending feature submission %q", id)
+}
+
+// SubmitFeature adds or changes a feature, subject to review unless the caller is an admin (unary RPC)
+func (s *routeGuideServer) SubmitFeature(ctx context.Context, req *pb.SubmitFeatureRequest) (*pb.FeatureSubmission, error) {
+	principal := principalFromContext(ctx)
+	submitter := "anonymous"
+	if principal != nil {
+		submitter = principal.Name
+	}
+	logger := loggerFrom(ctx)
+	logger.Info("SubmitFeature called", "by", submitter)
+
+	if err := checkFeatureArgument(req.Feature); err != nil {
+		return nil, err
+	}
+
+	now := timestamppb.Now()
+	sub := &pb.FeatureSubmission{
+		Id:          s.ids.NewID(),
+		Feature:     req.Feature,
+		State:       pb.SubmissionState_SUBMISSION_STATE_PENDING,
+		Submitter:   submitter,
+		SubmittedAt: now,
+	}
+
+	if principal.HasRole(adminRole) {
+		s.moderation.mu.Lock()
+		defer s.moderation.mu.Unlock()
+		if err := s.approveSubmission(ctx, sub, submitter); err != nil {
+			return nil, err
+		}
+		return sub, nil
+	}
+
+	if err := s.moderation.add(sub); err != nil {
+		return nil, err
+	}
+	logger.Info("Feature queued for review", "name", sub.Feature.Name, "location", geo.Key(sub.Feature.Location), "submission_id", sub.Id)
+	return sub, nil
+}
+
+// checkFeatureArgument validates a feature sent to be added or changed
+func checkFeatureArgument(feature *pb.Feature) error {
+	if feature == nil {
+		return status.Error(codes.InvalidArgument, "feature is required")
+	}
+	if err := geo.ValidatePoint(feature.Location); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid feature: %v", err)
+	}
+	if feature.Name == "" {
+		return status.Error(codes.InvalidArgument, "the feature must have a name")
+	}
+	return nil
+}
+
+// approveSubmission applies a submission to the dataset and marks it
+// approved by reviewer. s.moderation.mu must be held.
+func (s *routeGuideServer) approveSubmission(ctx context.Context, sub *pb.FeatureSubmission, reviewer string) error {
+	next, err := s.applyFeature(ctx, sub.Feature)
+	if err != nil {
+		return err
+	}
+
+	sub.State = pb.SubmissionState_SUBMISSION_STATE_APPROVED
+	sub.Reviewer = reviewer
+	sub.ReviewedAt = timestamppb.Now()
+	sub.DatasetVersion = next.version
+	loggerFrom(ctx).Info("Feature applied", "name", sub.Feature.Name, "location", geo.Key(sub.Feature.Location), "dataset_version", next.version)
+	return nil
+}
+
+// applyFeature adds feature to the dataset, replacing the feature at its
+// location, and writes it to the feature store if it keeps added features.
+// It returns the dataset swapped in. s.moderation.mu must be held.
+func (s *routeGuideServer) applyFeature(ctx context.Context, feature *pb.Feature) (*dataset, error) {
+	if err := s.checkWritable(); err != nil {
+		return nil, err
+	}
+	if writer, ok := s.featureStore.(FeatureWriter); ok {
+		if err := writer.PutFeature(ctx, feature); err != nil {
+			return nil, err
+		}
+	}
+	next := s.current().withFeature(feature)
+	s.swapDataset(next)
+	return next, nil
+}
+
+// withFeature returns a copy of the dataset with feature added, replacing
+// the feature at the same location if there is one
+func (d *dataset) withFeature(feature *pb.Feature) *dataset {
+	record := &featureRecord{Feature: proto.Clone(feature).(*pb.Feature)}
+	key := geo.Key(feature.Location)
+
+	next := &dataset{
+		source:     d.source,
+		loadedAt:   time.Now(),
+		skipped:    d.skipped,
+		loadErrors: d.loadErrors,
+		features:   make([]*featureRecord, 0, len(d.features)+1),
+	}
+	replaced := false
+	for _, f := range d.features {
+		if !replaced && geo.Key(f.Location) == key {
+			next.features = append(next.features, record)
+			replaced = true
+			continue
+		}
+		next.features = append(next.features, f)
+	}
+	if !replaced {
+		next.features = append(next.features, record)
+	}
+
+	// Derive a checksum distinct from the original's, so the swap isn't
+	// mistaken for a no-op
+	encoded, _ := proto.Marshal(feature)
+	next.checksum = sha256.Sum256(append(d.checksum[:], encoded...))
+	next.buildIndex(nil)
+	next.loadDuration = time.Since(next.loadedAt)
+	return next
+}
+
+// reviewer names the admin reviewing a submission
+func reviewer(ctx context.Context) string {
+	if principal := principalFromContext(ctx); principal != nil {
+		return principal.Name
+	}
+	return "admin"
+}
+
+// ListPendingFeatures lists the feature submissions waiting for review (unary RPC)
+func (a *adminServer) ListPendingFeatures(ctx context.Context, req *pb.ListPendingFeaturesRequest) (*pb.ListPendingFeaturesResponse, error) {
+	loggerFrom(ctx).Info("ListPendingFeatures called")
+	return &pb.ListPendingFeaturesResponse{Submissions: a.server.moderation.list()}, nil
+}
+
+// ApproveFeature applies a pending feature submission (unary RPC)
+func (a *adminServer) ApproveFeature(ctx context.Context, req *pb.ReviewFeatureRequest) (*pb.FeatureSubmission, error) {
+	loggerFrom(ctx).Info("ApproveFeature called", "submission_id", req.Id)
+	by := reviewer(ctx)
+	return a.server.moderation.review(req.Id, func(sub *pb.FeatureSubmission) error {
+		return a.server.approveSubmission(ctx, sub, by)
+	})
+}
+
+// RejectFeature turns down a pending feature submission (unary RPC)
+func (a *adminServer) RejectFeature(ctx context.Context, req *pb.ReviewFeatureRequest) (*pb.FeatureSubmission, error) {
+	logger := loggerFrom(ctx)
+	logger.Info("RejectFeature called", "submission_id", req.Id, "reason", req.Reason)
+	if req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "a reason is required to reject a submission")
+	}
+	by := reviewer(ctx)
+	sub, err := a.server.moderation.review(req.Id, func(sub *pb.FeatureSubmission) error {
+		sub.State = pb.SubmissionState_SUBMISSION_STATE_REJECTED
+		sub.Reviewer = by
+		sub.ReviewedAt = timestamppb.Now()
+		sub.Reason = req.Reason
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Feature submission rejected", "submission_id", sub.Id, "by", by)
+	return sub, nil
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreateFeature adds a feature at a location that has none (unary RPC, admin only)
+func (s *routeGuideServer) CreateFeature(ctx context.Context, req *pb.CreateFeatureRequest) (*pb.FeatureMutation, error) {
+	logger := loggerFrom(ctx)
+	logger.Info("CreateFeature called", "by", reviewer(ctx))
+	if err := checkFeatureArgument(req.Feature); err != nil {
+		return nil, err
+	}
+
+	s.moderation.mu.Lock()
+	defer s.moderation.mu.Unlock()
+	existing, err := s.featuresAt(ctx, req.Feature.Location)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) > 0 {
+		return nil, status.Errorf(codes.AlreadyExists, "there is already a feature at %s", geo.Key(req.Feature.Location))
+	}
+	next, err := s.applyFeature(ctx, req.Feature)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Feature created", "name", req.Feature.Name, "location", geo.Key(req.Feature.Location), "dataset_version", next.version)
+	return &pb.FeatureMutation{Feature: req.Feature, DatasetVersion: next.version}, nil
+}
+
+// UpdateFeature replaces the feature at a location (unary RPC, admin only)
+func (s *routeGuideServer) UpdateFeature(ctx context.Context, req *pb.UpdateFeatureRequest) (*pb.FeatureMutation, error) {
+	logger := loggerFrom(ctx)
+	logger.Info("UpdateFeature called", "by", reviewer(ctx))
+	if err := checkFeatureArgument(req.Feature); err != nil {
+		return nil, err
+	}
+
+	s.moderation.mu.Lock()
+	defer s.moderation.mu.Unlock()
+	existing, err := s.featuresAt(ctx, req.Feature.Location)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no feature at %s", geo.Key(req.Feature.Location))
+	}
+	next, err := s.applyFeature(ctx, req.Feature)
+	if err != nil {
+		return nil, err
+	}
+	logger.Info("Feature updated", "name", req.Feature.Name, "location", geo.Key(req.Feature.Location), "dataset_version", next.version)
+	return &pb.FeatureMutation{Feature: req.Feature, DatasetVersion: next.version}, nil
+}
+
+// DeleteFeature removes the feature at a location (unary RPC, admin only)
+func (s *routeGuideServer) DeleteFeature(ctx context.Context, req *pb.DeleteFeatureRequest) (*pb.FeatureMutation, error) {
+	logger := loggerFrom(ctx)
+	logger.Info("DeleteFeature called", "by", reviewer(ctx))
+	if err := geo.ValidatePoint(req.Location); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid location: %v", err)
+	}
+
+	s.moderation.mu.Lock()
+	defer s.moderation.mu.Unlock()
+	if err := s.checkWritable(); err != nil {
+		return nil, err
+	}
+	existing, err := s.featuresAt(ctx, req.Location)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no feature at %s", geo.Key(req.Location))
+	}
+	if writer, ok := s.featureStore.(FeatureWriter); ok {
+		if err := writer.DeleteFeature(ctx, req.Location); err != nil {
+			return nil, err
+		}
+	}
+	next := s.current().withoutFeature(req.Location)
+	s.swapDataset(next)
+	logger.Info("Feature deleted", "name", existing[0].Name, "location", geo.Key(req.Location), "dataset_version", next.version)
+	return &pb.FeatureMutation{Feature: existing[0].Feature, DatasetVersion: next.version}, nil
+}
+
+// featuresAt returns the features at location. With a feature store that
+// keeps the changes, such as PostGIS or SQLite, they are looked up there, as
+// that is what GetFeature serves.
+func (s *routeGuideServer) featuresAt(ctx context.Context, location *pb.Point) ([]*featureRecord, error) {
+	if _, ok := s.featureStore.(FeatureWriter); ok {
+		return s.featureStore.FeaturesIn(ctx, &pb.Rectangle{Lo: location, Hi: location})
+	}
+	return s.current().atPoint(location), nil
+}
+
+// withoutFeature returns a copy of the dataset without the features at
+// location
+func (d *dataset) withoutFeature(location *pb.Point) *dataset {
+	key := geo.Key(location)
+	next := &dataset{
+		source:     d.source,
+		loadedAt:   time.Now(),
+		skipped:    d.skipped,
+		loadErrors: d.loadErrors,
+		features:   make([]*featureRecord, 0, len(d.features)),
+	}
+	for _, f := range d.features {
+		if geo.Key(f.Location) != key {
+			next.features = append(next.features, f)
+		}
+	}
+
+	// As in withFeature, derive a checksum distinct from the original's
+	next.checksum = sha256.Sum256(append(d.checksum[:], "delete "+key...))
+	next.buildIndex(nil)
+	next.loadDuration = time.Since(next.loadedAt)
+	return next
+}
@@ -0,0 +1,187 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"slices"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultNearestFeatures is the k of a NearestFeatures request without one
+	defaultNearestFeatures = 10
+	// maxNearestFeatures caps the k of a NearestFeatures request
+	maxNearestFeatures = 100
+	// nearestSearchMeters is the radius NearestFeatures searches first,
+	// doubling it until it holds k features
+	nearestSearchMeters = 1000
+	// maxDistanceMeters is half the Earth's circumference, π times
+	// geo.EarthRadiusMeters rounded down: every point is within it
+	maxDistanceMeters = 20015086
+)
+
+// nearbyFeature is a feature and its distance from a point, in meters
+type nearbyFeature struct {
+	feature  *featureRecord
+	distance int32
+}
+
+// NearestFeatures returns the k named features nearest to a point (unary RPC)
+func (s *routeGuideServer) NearestFeatures(ctx context.Context, req *pb.NearestFeaturesRequest) (*pb.NearestFeaturesResponse, error) {
+	logger := loggerFrom(ctx)
+	logger.Info("NearestFeatures called", "k", req.K)
+
+	if err := geo.ValidatePoint(req.Point); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid point: %v", err)
+	}
+	k := int(req.K)
+	if k == 0 {
+		k = defaultNearestFeatures
+	}
+	if k < 1 || k > maxNearestFeatures {
+		return nil, status.Errorf(codes.InvalidArgument, "k must be between 1 and %d", maxNearestFeatures)
+	}
+
+	at, err := queryTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+	features, err := s.featuresFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	nearest, err := nearestFeatures(ctx, features, req.Point, k, at)
+	if err != nil {
+		return nil, err
+	}
+
+	locales := s.requestLocales(ctx)
+	resp := &pb.NearestFeaturesResponse{}
+	for _, nearby := range nearest {
+		resp.Features = append(resp.Features, &pb.NearestFeature{
+			Feature:        nearby.feature.localized(locales),
+			DistanceMeters: nearby.distance,
+		})
+	}
+	logger.Info("NearestFeatures completed", "found", len(resp.Features), "lat", req.Point.Latitude, "lon", req.Point.Longitude)
+	return resp, nil
+}
+
+// ListFeaturesInRadius lists the features within a distance of a point,
+// closest first (server streaming RPC)
+func (s *routeGuideServer) ListFeaturesInRadius(req *pb.RadiusRequest, stream pb.RouteGuide_ListFeaturesInRadiusServer) error {
+	if err := geo.ValidatePoint(req.Center); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid center: %v", err)
+	}
+	if req.RadiusMeters <= 0 || req.RadiusMeters > maxDistanceMeters {
+		return status.Errorf(codes.InvalidArgument, "radius_meters must be between 1 and %d", maxDistanceMeters)
+	}
+	logger := loggerFrom(stream.Context())
+	logger.Info("ListFeaturesInRadius called",
+		"lat", req.Center.Latitude, "lon", req.Center.Longitude, "radius_meters", req.RadiusMeters)
+
+	at, err := queryTime(stream.Context())
+	if err != nil {
+		return err
+	}
+	features, err := s.featuresFor(stream.Context())
+	if err != nil {
+		return err
+	}
+	found, err := featuresWithin(stream.Context(), features, req.Center, req.RadiusMeters, func(f *featureRecord) bool { return f.activeAt(at) })
+	if err != nil {
+		return err
+	}
+
+	locales := s.requestLocales(stream.Context())
+	for _, nearby := range found {
+		if err := stream.Send(&pb.NearestFeature{
+			Feature:        nearby.feature.localized(locales),
+			DistanceMeters: nearby.distance,
+		}); err != nil {
+			return err
+		}
+		logger.Debug("Sent feature", "name", nearby.feature.Name, "distance_meters", nearby.distance)
+	}
+
+	logger.Info("ListFeaturesInRadius completed", "sent", len(found))
+	return nil
+}
+
+// nearestFeatures returns the k named features active at time at nearest to
+// p, closest first. It searches ever wider circles through the spatial index
+// until one holds k features; every feature closer than the kth is then in
+// that circle too.
+func nearestFeatures(ctx context.Context, features FeatureStore, p *pb.Point, k int, at time.Time) ([]nearbyFeature, error) {
+	keep := func(f *featureRecord) bool { return f.Name != "" && f.activeAt(at) }
+	for meters := int32(nearestSearchMeters); ; meters = min(2*meters, maxDistanceMeters) {
+		found, err := featuresWithin(ctx, features, p, meters, keep)
+		if err != nil {
+			return nil, err
+		}
+		if len(found) >= k || meters == maxDistanceMeters {
+			return found[:min(k, len(found))], nil
+		}
+	}
+}
+
+// featuresWithin returns the features within meters of p that keep accepts,
+// closest first
+func featuresWithin(ctx context.Context, features FeatureStore, p *pb.Point, meters int32, keep func(*featureRecord) bool) ([]nearbyFeature, error) {
+	var found []nearbyFeature
+	for _, rect := range circleRects(p, meters) {
+		inRect, err := features.FeaturesIn(ctx, rect)
+		if err != nil {
+			return nil, err
+		}
+		for _, feature := range inRect {
+			if !keep(feature) {
+				continue
+			}
+			if distance := geo.Distance(p, feature.Location); distance <= meters {
+				found = append(found, nearbyFeature{feature, distance})
+			}
+		}
+	}
+	slices.SortStableFunc(found, func(a, b nearbyFeature) int { return cmp.Compare(a.distance, b.distance) })
+	return found, nil
+}
+
+// circleRects returns one or two rectangles covering every point within
+// meters of p: two when the circle crosses the antimeridian, one reaching
+// each side of it. Unlike snapBounds, they widen with the latitude across
+// the circle, and span every longitude around a pole the circle holds.
+func circleRects(p *pb.Point, meters int32) []*pb.Rectangle {
+	// One meter of slack absorbs Distance truncating to whole meters
+	radius := float64(meters+1) / geo.EarthRadiusMeters
+	lat := geo.Radians(geo.Degrees(p.Latitude))
+	south := geo.E7(max(-90, geo.Degrees(p.Latitude)-radius*180/math.Pi))
+	north := geo.E7(min(90, geo.Degrees(p.Latitude)+radius*180/math.Pi))
+	rect := func(west, east float64) *pb.Rectangle {
+		return &pb.Rectangle{
+			Lo: &pb.Point{Latitude: south, Longitude: geo.E7(west)},
+			Hi: &pb.Point{Latitude: north, Longitude: geo.E7(east)},
+		}
+	}
+	if lat+radius >= math.Pi/2 || lat-radius <= -math.Pi/2 {
+		return []*pb.Rectangle{rect(-180, 180)}
+	}
+
+	// The widest longitude difference within radius of latitude lat
+	spread := math.Asin(math.Sin(radius)/math.Cos(lat)) * 180 / math.Pi
+	lon := geo.Degrees(p.Longitude)
+	switch west, east := lon-spread, lon+spread; {
+	case west < -180:
+		return []*pb.Rectangle{rect(-180, east), rect(west+360, 180)}
+	case east > 180:
+		return []*pb.Rectangle{rect(west, 180), rect(-180, east-360)}
+	default:
+		return []*pb.Rectangle{rect(west, east)}
+	}
+}
@@ -0,0 +1,330 @@
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"expvar"
+	"fmt"
+	"io"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	bolt "go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+)
+
+// noteDBMetrics publishes the size and compactions of the notes database on
+// /debug/vars
+var noteDBMetrics = expvar.NewMap("notes_db")
+
+// notesBucket holds a nested bucket of notes per location, named by the
+// location's serialized point. Notes are keyed by a big-endian sequence
+// number, so a cursor walks each location's notes in the order received.
+var notesBucket = []byte("notes")
+
+// noteDBOpenTimeout bounds the wait for bbolt's own lock on the file, held
+// only while another process has it open
+const noteDBOpenTimeout = time.Second
+
+// noteDB persists route notes in a bbolt database so that chat history
+// survives restarts. Notes are written through as they are received, each
+// in its own transaction; cleared and expired notes are deleted as they go.
+// Notes evicted past the per-location caps stay in the file until the
+// compaction job deletes them and copies the database to a file without
+// their free pages.
+type noteDB struct {
+	path string
+	db   *bolt.DB
+
+	size          expvar.Int // bytes in the file
+	compactions   expvar.Int
+	droppedNotes  expvar.Int // evicted notes compactions removed from the file
+	reclaimed     expvar.Int // bytes compactions removed from the file
+	lastCompacted expvar.Int // Unix time of the last compaction
+}
+
+// openNoteDB opens the notes database at path, creating it if needed, and
+// loads the notes in it into ns, which persists the notes it receives from
+// then on
+func openNoteDB(path string, ns *memoryNoteStore) (*noteDB, error) {
+	db, err := bolt.Open(path, 0o644, &bolt.Options{Timeout: noteDBOpenTimeout})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(notesBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	loaded, err := loadNotes(db, ns)
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	d := &noteDB{path: path, db: db}
+	d.updateSize()
+	noteDBMetrics.Set("size_bytes", &d.size)
+	noteDBMetrics.Set("compactions_total", &d.compactions)
+	noteDBMetrics.Set("dropped_notes_total", &d.droppedNotes)
+	noteDBMetrics.Set("reclaimed_bytes_total", &d.reclaimed)
+	noteDBMetrics.Set("last_compaction_unix", &d.lastCompacted)
+	ns.db = d
+	slog.Info("Loaded route notes", "count", loaded, "file", path)
+	return d, nil
+}
+
+// loadNoteDBSnapshot loads the notes of the database at path into ns without
+// opening it for writing, and returns how many it read. The server holding
+// the database keeps bbolt's lock on it, so this reads a copy of the file:
+// notes being written meanwhile may be missing from it.
+func loadNoteDBSnapshot(path string, ns *memoryNoteStore) (int, error) {
+	src, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer src.Close()
+	tmp, err := os.CreateTemp("", "notes-snapshot-*.db")
+	if err != nil {
+		return 0, err
+	}
+	defer os.Remove(tmp.Name())
+	_, err = io.Copy(tmp, src)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	db, err := bolt.Open(tmp.Name(), 0o444, &bolt.Options{ReadOnly: true, Timeout: noteDBOpenTimeout})
+	if err != nil {
+		return 0, fmt.Errorf("%s: %v", path, err)
+	}
+	defer db.Close()
+	loaded, err := loadNotes(db, ns)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %v", path, err)
+	}
+	return loaded, nil
+}
+
+// loadNotes adds the notes in db to ns and returns how many it read
+func loadNotes(db *bolt.DB, ns *memoryNoteStore) (int, error) {
+	loaded := 0
+	err := db.View(func(tx *bolt.Tx) error {
+		notes := tx.Bucket(notesBucket)
+		if notes == nil {
+			return nil
+		}
+		return notes.ForEachBucket(func(key []byte) error {
+			return notes.Bucket(key).ForEach(func(seq, value []byte) error {
+				note := &pb.RouteNote{}
+				if err := proto.Unmarshal(value, note); err != nil {
+					return fmt.Errorf("note %d at %s: %v", binary.BigEndian.Uint64(seq), key, err)
+				}
+				ns.add(string(key), note)
+				loaded++
+				return nil
+			})
+		})
+	})
+	return loaded, err
+}
+
+// put stores a note received at the location key
+func (d *noteDB) put(key string, note *pb.RouteNote) error {
+	value, err := proto.Marshal(note)
+	if err != nil {
+		return err
+	}
+	err = d.db.Update(func(tx *bolt.Tx) error {
+		loc, err := tx.Bucket(notesBucket).CreateBucketIfNotExists([]byte(key))
+		if err != nil {
+			return err
+		}
+		seq, err := loc.NextSequence()
+		if err != nil {
+			return err
+		}
+		return loc.Put(binary.BigEndian.AppendUint64(nil, seq), value)
+	})
+	d.updateSize()
+	return err
+}
+
+// clear deletes the notes at the location key, or everywhere if key is empty
+func (d *noteDB) clear(key string) error {
+	err := d.db.Update(func(tx *bolt.Tx) error {
+		if key != "" {
+			err := tx.Bucket(notesBucket).DeleteBucket([]byte(key))
+			if errors.Is(err, bolt.ErrBucketNotFound) {
+				return nil
+			}
+			return err
+		}
+		if err := tx.DeleteBucket(notesBucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucket(notesBucket)
+		return err
+	})
+	d.updateSize()
+	return err
+}
+
+// deleteBefore deletes the notes received before cutoff
+func (d *noteDB) deleteBefore(cutoff time.Time) error {
+	err := d.db.Update(func(tx *bolt.Tx) error {
+		notes := tx.Bucket(notesBucket)
+		return notes.ForEachBucket(func(key []byte) error {
+			bucket := notes.Bucket(key)
+			var old [][]byte
+			c := bucket.Cursor()
+			// Notes are stored in time order, so the old ones come first
+			for seq, value := c.First(); seq != nil; seq, value = c.Next() {
+				note := &pb.RouteNote{}
+				if err := proto.Unmarshal(value, note); err != nil {
+					return err
+				}
+				if !note.ReceivedAt.AsTime().Before(cutoff) {
+					break
+				}
+				old = append(old, bytes.Clone(seq))
+			}
+			return deleteKeys(bucket, old)
+		})
+	})
+	d.updateSize()
+	return err
+}
+
+// deleteKeys deletes keys, collected from a cursor beforehand, from bucket
+func deleteKeys(bucket *bolt.Bucket, keys [][]byte) error {
+	for _, key := range keys {
+		if err := bucket.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// noteCompaction is the outcome of a compaction of the notes database
+type noteCompaction struct {
+	kept      int   // notes left in the database
+	dropped   int   // evicted notes deleted from it
+	reclaimed int64 // bytes the file shrank by
+}
+
+// compact deletes the notes ns no longer retains, the oldest of each
+// location beyond the ones retained there, then copies the database to a
+// new file holding only the pages in use. ns.mu must be held.
+func (d *noteDB) compact(ns *memoryNoteStore) (noteCompaction, error) {
+	kept, dropped := 0, 0
+	err := d.db.Update(func(tx *bolt.Tx) error {
+		notes := tx.Bucket(notesBucket)
+		var empty [][]byte
+		err := notes.ForEachBucket(func(key []byte) error {
+			retained := 0
+			if loc := ns.locations[string(key)]; loc != nil {
+				retained = len(loc.archived) + len(loc.live)
+			}
+			bucket := notes.Bucket(key)
+			stored := bucket.Stats().KeyN
+			var evicted [][]byte
+			c := bucket.Cursor()
+			for seq, _ := c.First(); seq != nil && stored-len(evicted) > retained; seq, _ = c.Next() {
+				evicted = append(evicted, bytes.Clone(seq))
+			}
+			dropped += len(evicted)
+			kept += stored - len(evicted)
+			if stored == len(evicted) {
+				empty = append(empty, bytes.Clone(key))
+				return nil
+			}
+			return deleteKeys(bucket, evicted)
+		})
+		if err != nil {
+			return err
+		}
+		for _, key := range empty {
+			if err := notes.DeleteBucket(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return noteCompaction{}, err
+	}
+
+	before := d.size.Value()
+	if err := d.rewrite(); err != nil {
+		return noteCompaction{}, err
+	}
+	c := noteCompaction{kept: kept, dropped: dropped, reclaimed: max(0, before-d.size.Value())}
+	d.compactions.Add(1)
+	d.droppedNotes.Add(int64(c.dropped))
+	d.reclaimed.Add(c.reclaimed)
+	d.lastCompacted.Set(time.Now().Unix())
+	return c, nil
+}
+
+// rewrite copies the database to a new file, leaving out its free pages,
+// and swaps the file in place of the current one
+func (d *noteDB) rewrite() error {
+	tmp, err := os.CreateTemp(filepath.Dir(d.path), ".notes-*.db")
+	if err != nil {
+		return err
+	}
+	tmp.Close()
+	defer os.Remove(tmp.Name())
+	dst, err := bolt.Open(tmp.Name(), 0o644, &bolt.Options{Timeout: noteDBOpenTimeout})
+	if err != nil {
+		return err
+	}
+	if err := bolt.Compact(dst, d.db, 1<<20); err != nil {
+		dst.Close()
+		return err
+	}
+	if err := dst.Close(); err != nil {
+		return err
+	}
+
+	// Closing the database releases bbolt's lock on it until it is open
+	// again; the data file lock keeps other servers out meanwhile
+	if err := d.db.Close(); err != nil {
+		return err
+	}
+	renameErr := os.Rename(tmp.Name(), d.path)
+	db, err := bolt.Open(d.path, 0o644, &bolt.Options{Timeout: noteDBOpenTimeout})
+	if err != nil {
+		return fmt.Errorf("reopening %s: %v", d.path, err)
+	}
+	d.db = db
+	d.updateSize()
+	return renameErr
+}
+
+// updateSize publishes the size of the file
+func (d *noteDB) updateSize() {
+	if info, err := os.Stat(d.path); err == nil {
+		d.size.Set(info.Size())
+	}
+}
+
+// close closes the database, which bbolt has already synced to disk
+func (d *noteDB) close() error {
+	return d.db.Close()
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/prometheus/common/expfmt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// openTestNoteDB opens the notes database at path into a new store
+func openTestNoteDB(t *testing.T, path string, maxLive, maxArchived int) (*memoryNoteStore, *noteDB) {
+	t.Helper()
+	ns := newMemoryNoteStore(maxLive, maxArchived)
+	db, err := openNoteDB(path, ns)
+	if err != nil {
+		t.Fatalf("openNoteDB() failed: %v", err)
+	}
+	return ns, db
+}
+
+// postNotes posts n notes at the point (lat, 1)
+func postNotes(t *testing.T, ns *memoryNoteStore, lat int32, n int) {
+	t.Helper()
+	point := &pb.Point{Latitude: lat, Longitude: 1}
+	for i := range n {
+		note := &pb.RouteNote{Location: point, Message: fmt.Sprintf("note %d", i)}
+		if _, _, _, err := ns.Exchange(context.Background(), fmt.Sprintf("%d,1", lat), note); err != nil {
+			t.Fatalf("Exchange() failed: %v", err)
+		}
+	}
+}
+
+// dumpMessages returns the messages of the notes a store retains
+func dumpMessages(t *testing.T, ns *memoryNoteStore) []string {
+	t.Helper()
+	notes, err := ns.Dump(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var messages []string
+	for _, note := range notes {
+		messages = append(messages, fmt.Sprintf("%d:%s", note.Location.Latitude, note.Message))
+	}
+	return messages
+}
+
+func TestNoteDBPersistsNotes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notes.db")
+	ns, db := openTestNoteDB(t, path, 0, 0)
+	postNotes(t, ns, 1, 2)
+	postNotes(t, ns, 2, 3)
+	if _, err := ns.Clear(context.Background(), "1,1"); err != nil {
+		t.Fatal(err)
+	}
+	want := dumpMessages(t, ns)
+	if err := db.close(); err != nil {
+		t.Fatal(err)
+	}
+
+	reopened, db := openTestNoteDB(t, path, 0, 0)
+	defer db.close()
+	if got := dumpMessages(t, reopened); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("reopened notes = %v, want %v", got, want)
+	}
+
+	// Another server reading the database while this one holds it
+	snapshot := newMemoryNoteStore(0, 0)
+	if loaded, err := loadNoteDBSnapshot(path, snapshot); err != nil || loaded != len(want) {
+		t.Errorf("loadNoteDBSnapshot() = %d, %v, want %d notes", loaded, err, len(want))
+	}
+}
+
+func TestNoteDBDeleteBefore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notes.db")
+	ns, db := openTestNoteDB(t, path, 0, 0)
+	postNotes(t, ns, 1, 2)
+	cutoff := time.Now()
+	time.Sleep(time.Millisecond)
+	postNotes(t, ns, 1, 1)
+	if n, err := ns.DeleteBefore(context.Background(), cutoff); err != nil || n != 2 {
+		t.Fatalf("DeleteBefore() = %d, %v, want 2", n, err)
+	}
+	db.close()
+
+	reopened, db := openTestNoteDB(t, path, 0, 0)
+	defer db.close()
+	if got := dumpMessages(t, reopened); fmt.Sprint(got) != "[1:note 0]" {
+		t.Errorf("notes after DeleteBefore = %v, want the last one only", got)
+	}
+}
+
+func TestDeleteBeforeReadOnly(t *testing.T) {
+	ns := newMemoryNoteStore(0, 0)
+	postNotes(t, ns, 1, 3)
+	want := dumpMessages(t, ns)
+	ns.readOnly = "locked by another server"
+	if _, err := ns.DeleteBefore(context.Background(), time.Now().Add(time.Hour)); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("DeleteBefore() on read-only notes = %v, want FAILED_PRECONDITION", err)
+	}
+	if got := dumpMessages(t, ns); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("notes after DeleteBefore() = %v, want %v kept", got, want)
+	}
+}
+
+func TestNoteDBCompaction(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notes.db")
+	ns, db := openTestNoteDB(t, path, 2, 3)
+	// 5 of the 40 notes are retained, the rest stay in the file until compacted
+	postNotes(t, ns, 1, 40)
+	want := dumpMessages(t, ns)
+	before := db.size.Value()
+
+	c, err := ns.compact()
+	if err != nil {
+		t.Fatalf("compact() failed: %v", err)
+	}
+	if c.kept != 5 || c.dropped != 35 {
+		t.Errorf("compact() kept %d notes and dropped %d, want 5 and 35", c.kept, c.dropped)
+	}
+	if db.compactions.Value() != 1 || db.droppedNotes.Value() != 35 {
+		t.Errorf("compactions = %d, dropped notes = %d, want 1 and 35", db.compactions.Value(), db.droppedNotes.Value())
+	}
+	if db.size.Value() > before || db.reclaimed.Value() != before-db.size.Value() {
+		t.Errorf("size %d after compacting %d bytes, reclaimed %d", db.size.Value(), before, db.reclaimed.Value())
+	}
+
+	// Scrapers see the compaction too
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(scrapeMetrics(t, "").Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scraped := map[string]float64{
+		"routeguide_notes_db_compactions_total":     1,
+		"routeguide_notes_db_dropped_notes_total":   35,
+		"routeguide_notes_db_reclaimed_bytes_total": float64(db.reclaimed.Value()),
+		"routeguide_notes_db_size_bytes":            float64(db.size.Value()),
+	}
+	for name, want := range scraped {
+		family, ok := families[name]
+		if !ok {
+			t.Errorf("/metrics lacks %s", name)
+			continue
+		}
+		m := family.GetMetric()[0]
+		if got := m.GetCounter().GetValue() + m.GetGauge().GetValue(); got != want {
+			t.Errorf("%s = %v, want %v", name, got, want)
+		}
+	}
+	if last := families["routeguide_notes_db_last_compaction_unix"]; last == nil || last.GetMetric()[0].GetGauge().GetValue() < float64(time.Now().Add(-time.Minute).Unix()) {
+		t.Errorf("routeguide_notes_db_last_compaction_unix = %v, want the time of the compaction", last)
+	}
+
+	// The database stays usable after the file is swapped
+	postNotes(t, ns, 2, 1)
+	want = append(want, "2:note 0")
+	db.close()
+	reopened, db := openTestNoteDB(t, path, 0, 0)
+	defer db.close()
+	if got := dumpMessages(t, reopened); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("notes after compaction = %v, want %v", got, want)
+	}
+}
+
+func TestCompactRouteNotes(t *testing.T) {
+	s, err := newServer("features.json", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	admin := newAdminServer(s)
+	ctx := context.Background()
+	if _, err := admin.CompactRouteNotes(ctx, &pb.CompactRouteNotesRequest{}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("CompactRouteNotes() without a notes database = %v, want FAILED_PRECONDITION", err)
+	}
+
+	ns, db := openTestNoteDB(t, filepath.Join(t.TempDir(), "notes.db"), 1, 1)
+	defer db.close()
+	s.notes = ns
+	postNotes(t, ns, 1, 10)
+	resp, err := admin.CompactRouteNotes(ctx, &pb.CompactRouteNotesRequest{})
+	if err != nil {
+		t.Fatalf("CompactRouteNotes() failed: %v", err)
+	}
+	if resp.KeptCount != 2 || resp.DroppedCount != 8 || resp.ReclaimedBytes != db.reclaimed.Value() {
+		t.Errorf("CompactRouteNotes() = %v, want 2 kept, 8 dropped and %d bytes reclaimed", resp, db.reclaimed.Value())
+	}
+
+	ns.readOnly = "locked by another server"
+	if _, err := admin.CompactRouteNotes(ctx, &pb.CompactRouteNotesRequest{}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("CompactRouteNotes() on read-only notes = %v, want FAILED_PRECONDITION", err)
+	}
+}
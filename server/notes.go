@@ -0,0 +1,449 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"maps"
+	"slices"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// defaultHistoryPageSize is used when a history request has no page size
+	defaultHistoryPageSize = 50
+	// maxHistoryPageSize caps the page size of a history request
+	maxHistoryPageSize = 500
+)
+
+// locationNotes holds the notes sent at one location. The full history is
+// archived ++ live; archived notes are no longer replayed by RouteChat.
+type locationNotes struct {
+	archived []*pb.RouteNote
+	live     []*pb.RouteNote
+	dropped  int // notes evicted from the front of the archive
+}
+
+// NoteStore stores the route notes sent at each location, counting
+// positions from the first note ever stored at a location. The memory store
+// serves a single server; the Redis store lets replicas share notes, so that
+// RouteChat replays the same notes whichever replica a client connects to.
+type NoteStore interface {
+	// Exchange stamps note with the time it was received and stores it at
+	// key, and returns the live notes stored before it, the position of the
+	// first of them and the position following note. Concurrent chatters
+	// see each other's notes exactly once.
+	Exchange(ctx context.Context, key string, note *pb.RouteNote) (prev []*pb.RouteNote, from, next int, err error)
+	// Since returns the live notes at key from position offset on, and the
+	// position following them
+	Since(ctx context.Context, key string, offset int) (notes []*pb.RouteNote, next int, err error)
+	// History returns up to limit notes at key starting at position offset,
+	// the position following the page (0 past the last note), and the
+	// number of notes still available. Evicted notes are skipped. Unless
+	// asOf is zero, notes received after it are left out.
+	History(ctx context.Context, key string, offset, limit int, asOf time.Time) (notes []*pb.RouteNote, next, total int, err error)
+	// Dump returns every retained note, grouped by location in key order
+	// and oldest first within a location
+	Dump(ctx context.Context) ([]*pb.RouteNote, error)
+	// Clear deletes the notes at key, or everywhere if key is empty, and
+	// returns how many it deleted. Deleted notes count as evicted, so that
+	// positions held by sessions and page tokens stay valid.
+	Clear(ctx context.Context, key string) (int, error)
+	// DeleteBefore deletes the notes received before cutoff, at every
+	// location, and returns how many it deleted. Like cleared notes, they
+	// count as evicted.
+	DeleteBefore(ctx context.Context, cutoff time.Time) (int, error)
+	// DropArchived evicts the archived notes held in this server's memory,
+	// keeping the live ones RouteChat replays, and returns how many it
+	// evicted
+	DropArchived(ctx context.Context) (int, error)
+}
+
+// memoryNoteStore keeps route notes per location in memory, capping how
+// many are replayed
+type memoryNoteStore struct {
+	mu          sync.Mutex
+	locations   map[string]*locationNotes
+	maxLive     int // notes replayed per location, 0 for unlimited
+	maxArchived int // archived notes kept per location, 0 for unlimited
+
+	db *noteDB // persists the notes, nil to keep them in memory only
+	// readOnly says why notes can't be persisted, e.g. because another
+	// server holds the notes database. New notes and clears are rejected
+	// while it is set.
+	readOnly string
+}
+
+// newMemoryNoteStore creates an empty note store
+func newMemoryNoteStore(maxLive, maxArchived int) *memoryNoteStore {
+	return &memoryNoteStore{
+		locations:   make(map[string]*locationNotes),
+		maxLive:     maxLive,
+		maxArchived: maxArchived,
+	}
+}
+
+// Exchange takes the notes to replay and stores the new one under the same
+// lock; the replay itself happens outside the lock. A note that can't be
+// persisted isn't stored.
+func (ns *memoryNoteStore) Exchange(ctx context.Context, key string, note *pb.RouteNote) (prev []*pb.RouteNote, from, next int, err error) {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	if ns.readOnly != "" {
+		return nil, 0, 0, ns.readOnlyError()
+	}
+	// Stamped under the lock, so that notes are stored in time order
+	note.ReceivedAt = timestamppb.Now()
+	if ns.db != nil {
+		if err := ns.db.put(key, note); err != nil {
+			return nil, 0, 0, err
+		}
+	}
+
+	if loc := ns.locations[key]; loc != nil {
+		prev = append(prev, loc.live...)
+		from = loc.end() - len(loc.live)
+	}
+	return prev, from, ns.add(key, note).end(), nil
+}
+
+// readOnlyError rejects a change to read-only notes
+func (ns *memoryNoteStore) readOnlyError() error {
+	return reasonError(codes.FailedPrecondition, "NOTES_READ_ONLY", map[string]string{"reason": ns.readOnly})
+}
+
+// add stores note at key, archiving and evicting older notes past the caps,
+// and returns the location. ns.mu must be held, unless nothing else uses ns
+// yet.
+func (ns *memoryNoteStore) add(key string, note *pb.RouteNote) *locationNotes {
+	loc := ns.locations[key]
+	if loc == nil {
+		loc = &locationNotes{}
+		ns.locations[key] = loc
+	}
+	loc.live = append(loc.live, note)
+	if ns.maxLive > 0 && len(loc.live) > ns.maxLive {
+		// Move the oldest live notes to the archive
+		overflow := len(loc.live) - ns.maxLive
+		loc.archived = append(loc.archived, loc.live[:overflow]...)
+		loc.live = append([]*pb.RouteNote(nil), loc.live[overflow:]...)
+	}
+	if ns.maxArchived > 0 && len(loc.archived) > ns.maxArchived {
+		overflow := len(loc.archived) - ns.maxArchived
+		loc.archived = append([]*pb.RouteNote(nil), loc.archived[overflow:]...)
+		loc.dropped += overflow
+	}
+	return loc
+}
+
+func (ns *memoryNoteStore) DropArchived(ctx context.Context) (int, error) {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	n := 0
+	for _, loc := range ns.locations {
+		n += len(loc.archived)
+		loc.dropped += len(loc.archived)
+		loc.archived = nil
+	}
+	return n, nil
+}
+
+func (ns *memoryNoteStore) Dump(ctx context.Context) ([]*pb.RouteNote, error) {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+	return ns.retained(), nil
+}
+
+// retained returns every retained note, like Dump. ns.mu must be held.
+func (ns *memoryNoteStore) retained() []*pb.RouteNote {
+	var notes []*pb.RouteNote
+	for _, key := range slices.Sorted(maps.Keys(ns.locations)) {
+		loc := ns.locations[key]
+		notes = append(notes, loc.archived...)
+		notes = append(notes, loc.live...)
+	}
+	return notes
+}
+
+// Clear deletes persisted notes too
+func (ns *memoryNoteStore) Clear(ctx context.Context, key string) (int, error) {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	if ns.readOnly != "" {
+		return 0, ns.readOnlyError()
+	}
+	n := 0
+	for k, loc := range ns.locations {
+		if key != "" && k != key {
+			continue
+		}
+		n += len(loc.archived) + len(loc.live)
+		loc.dropped = loc.end()
+		loc.archived, loc.live = nil, nil
+	}
+	if ns.db != nil && n > 0 {
+		return n, ns.db.clear(key)
+	}
+	return n, nil
+}
+
+// DeleteBefore deletes persisted notes too
+func (ns *memoryNoteStore) DeleteBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	if ns.readOnly != "" {
+		return 0, ns.readOnlyError()
+	}
+	// Notes are stored in time order, so the old ones come first
+	old := func(note *pb.RouteNote) bool { return !note.ReceivedAt.AsTime().Before(cutoff) }
+	n := 0
+	for _, loc := range ns.locations {
+		archived := len(loc.archived)
+		if i := slices.IndexFunc(loc.archived, old); i >= 0 {
+			archived = i
+		}
+		live := 0
+		if archived == len(loc.archived) {
+			live = len(loc.live)
+			if i := slices.IndexFunc(loc.live, old); i >= 0 {
+				live = i
+			}
+		}
+		loc.archived = loc.archived[archived:]
+		loc.live = loc.live[live:]
+		loc.dropped += archived + live
+		n += archived + live
+	}
+	if ns.db != nil && n > 0 {
+		return n, ns.db.deleteBefore(cutoff)
+	}
+	return n, nil
+}
+
+// compact deletes the evicted notes the notes database still holds and
+// shrinks its file. It does nothing without a database.
+func (ns *memoryNoteStore) compact() (noteCompaction, error) {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+	if ns.db == nil {
+		return noteCompaction{}, nil
+	}
+	return ns.db.compact(ns)
+}
+
+// end returns the position following the last note ever stored at a location
+func (loc *locationNotes) end() int {
+	return loc.dropped + len(loc.archived) + len(loc.live)
+}
+
+func (ns *memoryNoteStore) Since(ctx context.Context, key string, offset int) (notes []*pb.RouteNote, next int, err error) {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	loc := ns.locations[key]
+	if loc == nil {
+		return nil, offset, nil
+	}
+	liveStart := loc.end() - len(loc.live)
+	if offset < liveStart {
+		offset = liveStart
+	}
+	if offset < loc.end() {
+		notes = append(notes, loc.live[offset-liveStart:]...)
+	}
+	return notes, loc.end(), nil
+}
+
+// note returns the i-th note retained at a location, counting from the
+// oldest archived one
+func (loc *locationNotes) note(i int) *pb.RouteNote {
+	if i < len(loc.archived) {
+		return loc.archived[i]
+	}
+	return loc.live[i-len(loc.archived)]
+}
+
+func (ns *memoryNoteStore) History(ctx context.Context, key string, offset, limit int, asOf time.Time) (notes []*pb.RouteNote, next, total int, err error) {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	loc := ns.locations[key]
+	if loc == nil {
+		return nil, 0, 0, nil
+	}
+	notes, next, total = historyPage(len(loc.archived)+len(loc.live), loc.dropped, loc.note, offset, limit, asOf)
+	return notes, next, total, nil
+}
+
+// historyPage returns the page of a location's history History returns,
+// given the number of notes retained at the location, how many were evicted
+// before them and the i-th retained note
+func historyPage(retained, dropped int, note func(i int) *pb.RouteNote, offset, limit int, asOf time.Time) (notes []*pb.RouteNote, next, total int) {
+	if !asOf.IsZero() {
+		// Notes are stored in the order they were received
+		retained = sort.Search(retained, func(i int) bool {
+			return note(i).ReceivedAt.AsTime().After(asOf)
+		})
+	}
+	end := dropped + retained
+	if offset < dropped {
+		offset = dropped
+	}
+	for next = offset; next < end && len(notes) < limit; next++ {
+		notes = append(notes, note(next-dropped))
+	}
+
+	if next >= end {
+		next = 0
+	}
+	return notes, next, retained
+}
+
+// ListNoteHistory returns a page of the notes sent at a location (unary RPC)
+func (s *routeGuideServer) ListNoteHistory(ctx context.Context, req *pb.NoteHistoryRequest) (*pb.NoteHistoryPage, error) {
+	if req.Location == nil {
+		return nil, reasonError(codes.InvalidArgument, "LOCATION_REQUIRED", nil)
+	}
+
+	pageSize := int(req.PageSize)
+	switch {
+	case pageSize <= 0:
+		pageSize = defaultHistoryPageSize
+	case pageSize > maxHistoryPageSize:
+		pageSize = maxHistoryPageSize
+	}
+
+	offset := 0
+	if req.PageToken != "" {
+		var err error
+		if offset, err = decodePageToken(req.PageToken); err != nil {
+			return nil, reasonError(codes.InvalidArgument, "INVALID_PAGE_TOKEN", nil)
+		}
+	}
+
+	var asOf time.Time
+	if req.AsOf != nil {
+		if err := req.AsOf.CheckValid(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid as_of: %v", err)
+		}
+		asOf = req.AsOf.AsTime()
+	}
+
+	key := geo.Key(req.Location)
+	notes, next, total, err := s.notes.History(ctx, key, offset, pageSize, asOf)
+	if err != nil {
+		return nil, notesError(err)
+	}
+	logger := loggerFrom(ctx).With("location", key)
+	if !asOf.IsZero() {
+		logger = logger.With("as_of", asOf.Format(time.RFC3339))
+	}
+	logger.Info("ListNoteHistory returned notes", "returned", len(notes), "total", total)
+
+	page := &pb.NoteHistoryPage{
+		Notes:     notes,
+		TotalSize: int32(total),
+	}
+	if next > 0 {
+		page.NextPageToken = encodePageToken(next)
+	}
+	return page, nil
+}
+
+// DumpRouteNotes streams every stored route note (server-streaming RPC)
+func (a *adminServer) DumpRouteNotes(req *pb.DumpRouteNotesRequest, stream pb.Admin_DumpRouteNotesServer) error {
+	notes, err := a.server.notes.Dump(stream.Context())
+	if err != nil {
+		return notesError(err)
+	}
+	loggerFrom(stream.Context()).Info("DumpRouteNotes called", "count", len(notes))
+	for _, note := range notes {
+		if err := stream.Send(note); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClearRouteNotes deletes the route notes at a location, or everywhere
+// (unary RPC)
+func (a *adminServer) ClearRouteNotes(ctx context.Context, req *pb.ClearRouteNotesRequest) (*pb.ClearRouteNotesResponse, error) {
+	where := "everywhere"
+	key := ""
+	if req.Location != nil {
+		if err := geo.ValidatePoint(req.Location); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid location: %v", err)
+		}
+		key = geo.Key(req.Location)
+		where = "at " + key
+	}
+	cleared, err := a.server.notes.Clear(ctx, key)
+	if err != nil {
+		loggerFrom(ctx).Error("ClearRouteNotes failed", "cleared", cleared, "where", where, "error", err)
+		return nil, notesError(err)
+	}
+	loggerFrom(ctx).Info("ClearRouteNotes called", "cleared", cleared, "where", where)
+	return &pb.ClearRouteNotesResponse{ClearedCount: int32(cleared)}, nil
+}
+
+// CompactRouteNotes deletes the notes evicted from the notes database and
+// shrinks its file (unary RPC)
+func (a *adminServer) CompactRouteNotes(ctx context.Context, req *pb.CompactRouteNotesRequest) (*pb.CompactRouteNotesResponse, error) {
+	notes, ok := a.server.notes.(*memoryNoteStore)
+	if ok && notes.readOnly != "" {
+		return nil, notes.readOnlyError()
+	}
+	if !ok || notes.db == nil {
+		return nil, status.Error(codes.FailedPrecondition, "route notes aren't persisted: start the server with --notes-db")
+	}
+	c, err := notes.compact()
+	if err != nil {
+		loggerFrom(ctx).Error("CompactRouteNotes failed", "error", err)
+		return nil, notesError(err)
+	}
+	loggerFrom(ctx).Info("CompactRouteNotes called", "kept", c.kept, "dropped", c.dropped, "reclaimed_bytes", c.reclaimed)
+	return &pb.CompactRouteNotesResponse{
+		KeptCount:      int32(c.kept),
+		DroppedCount:   int32(c.dropped),
+		ReclaimedBytes: c.reclaimed,
+	}, nil
+}
+
+// notesError is the status of a call that failed to read or write the
+// note store
+func notesError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Errorf(codes.Unavailable, "route notes are unavailable: %v", err)
+}
+
+// encodePageToken turns a position into an opaque page token
+func encodePageToken(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+// decodePageToken is the inverse of encodePageToken
+func decodePageToken(token string) (int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, err
+	}
+	offset, err := strconv.Atoi(string(raw))
+	if err != nil || offset < 0 {
+		return 0, strconv.ErrSyntax
+	}
+	return offset, nil
+}
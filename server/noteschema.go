@@ -0,0 +1,50 @@
+package main
+
+import (
+	"expvar"
+	"fmt"
+	"log/slog"
+	"sync"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+)
+
+// noteSchemaVersion is the newest RouteNote schema version this server knows
+const noteSchemaVersion = 1
+
+// noteSchemaMetrics counts received notes per schema version, those newer
+// than the server's and those carrying fields it doesn't know, on /debug/vars
+var noteSchemaMetrics = expvar.NewMap("note_schema")
+
+// skewedVersions remembers the newer schema versions already logged, so
+// version skew is logged once per version rather than per note
+var skewedVersions sync.Map
+
+// noteVersion returns the schema version a note was written with
+func noteVersion(note *pb.RouteNote) uint32 {
+	if note.SchemaVersion == 0 {
+		return 1
+	}
+	return note.SchemaVersion
+}
+
+// observeNoteSchema records the schema version of a received note. Notes
+// from newer clients are accepted as they are: the fields this server doesn't
+// know are kept as unknown fields, so they are stored and relayed to other
+// clients unchanged.
+func observeNoteSchema(note *pb.RouteNote) {
+	version := noteVersion(note)
+	noteSchemaMetrics.Add(fmt.Sprintf("v%d", version), 1)
+	unknown := len(note.ProtoReflect().GetUnknown())
+	if unknown > 0 {
+		noteSchemaMetrics.Add("unknown_fields", 1)
+	}
+	if version <= noteSchemaVersion {
+		return
+	}
+	noteSchemaMetrics.Add("newer", 1)
+	if _, logged := skewedVersions.LoadOrStore(version, true); !logged {
+		slog.Warn("Received a RouteNote with a newer schema version, relaying its unknown fields as is",
+			"schema_version", version, "server_schema_version", noteSchemaVersion, "unknown_bytes", unknown)
+	}
+}
@@ -0,0 +1,286 @@
+//go:build !minimal && !no_webhooks
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// outboxMaxBackoff caps the delay between delivery attempts of an event
+	outboxMaxBackoff = 5 * time.Minute
+	// webhookTimeout bounds a single webhook delivery attempt
+	webhookTimeout = 10 * time.Second
+)
+
+var (
+	webhookURL = flag.String("webhook-url", "", "URL dataset change events are POSTed to as JSON (disabled when empty)")
+	outboxFile = flag.String("outbox-file", "outbox.json", "File persisting webhook events until they are delivered")
+)
+
+func init() {
+	registerExtension(extension{name: "webhooks", start: startWebhooks})
+}
+
+// startWebhooks delivers dataset change events if --webhook-url is set
+func startWebhooks(h *extensionHost) error {
+	if *webhookURL == "" {
+		return nil
+	}
+	outbox, err := openOutbox(*outboxFile, webhookDeliverer(*webhookURL))
+	if err != nil {
+		return fmt.Errorf("failed to open outbox: %v", err)
+	}
+	h.server.onChange = func(next *dataset, delta *featureDelta) {
+		subsystems.run("webhooks", func() { outbox.publishChange(next, delta) })
+	}
+	subsystems.enable("webhooks")
+	subsystems.goRun("webhooks", func() { outbox.run(h.ctx) })
+	slog.Info("Delivering dataset change events", "url", *webhookURL)
+	return nil
+}
+
+// outboxMetrics publishes event delivery counters on /debug/vars
+var outboxMetrics = expvar.NewMap("outbox")
+
+// outboxEvent is a feature-change event waiting to be delivered. Its ID is
+// sent along so receivers can drop the duplicates retries may cause.
+type outboxEvent struct {
+	ID           string    `json:"id"`
+	Type         string    `json:"type"`
+	Version      int64     `json:"version"`
+	Source       string    `json:"source"`
+	FeatureCount int       `json:"feature_count"`
+	Upserted     int       `json:"upserted"`
+	Removed      int       `json:"removed"`
+	CreatedAt    time.Time `json:"created_at"`
+	Attempts     int       `json:"attempts"`
+}
+
+// errPermanent marks delivery failures that retrying won't fix
+var errPermanent = errors.New("permanent delivery failure")
+
+// outbox persists events before they are delivered, so events raised while
+// the receiver is down survive until it is back, and across restarts.
+// Events are delivered one at a time, in order, retrying with exponential
+// backoff.
+type outbox struct {
+	path    string
+	deliver func(context.Context, *outboxEvent) error
+
+	mu      sync.Mutex
+	pending []*outboxEvent
+	wake    chan struct{}
+}
+
+// openOutbox loads the events still pending in the outbox file at path
+func openOutbox(path string, deliver func(context.Context, *outboxEvent) error) (*outbox, error) {
+	o := &outbox{path: path, deliver: deliver, wake: make(chan struct{}, 1)}
+
+	data, err := os.ReadFile(path)
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return nil, err
+	default:
+		if err := json.Unmarshal(data, &o.pending); err != nil {
+			return nil, fmt.Errorf("corrupt outbox %s: %v", path, err)
+		}
+	}
+	outboxMetrics.Set("pending", expvar.Func(func() any {
+		o.mu.Lock()
+		defer o.mu.Unlock()
+		return len(o.pending)
+	}))
+	return o, nil
+}
+
+// enqueue adds an event to the outbox, persisting it before returning
+func (o *outbox) enqueue(event *outboxEvent) error {
+	id := make([]byte, 16)
+	rand.Read(id)
+	event.ID = hex.EncodeToString(id)
+	event.CreatedAt = time.Now().UTC()
+
+	o.mu.Lock()
+	o.pending = append(o.pending, event)
+	err := o.persist()
+	o.mu.Unlock()
+	if err != nil {
+		return err
+	}
+
+	select {
+	case o.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// persist atomically rewrites the outbox file with the pending events. o.mu
+// must be held.
+func (o *outbox) persist() error {
+	data, err := json.Marshal(o.pending)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(o.path), ".outbox-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), o.path)
+}
+
+// head returns the oldest pending event, or nil
+func (o *outbox) head() *outboxEvent {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if len(o.pending) == 0 {
+		return nil
+	}
+	return o.pending[0]
+}
+
+// finish removes the oldest pending event, event, once it is delivered or dropped
+func (o *outbox) finish(event *outboxEvent) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if len(o.pending) > 0 && o.pending[0] == event {
+		o.pending = o.pending[1:]
+	}
+	if err := o.persist(); err != nil {
+		// The event may be delivered again after a restart, which its ID covers
+		slog.Error("Failed to update outbox", "file", o.path, "error", err)
+	}
+}
+
+// run delivers pending events until ctx is cancelled, or until deliveries
+// keep failing and webhooks are turned off. Pending events stay in the
+// outbox for the next start.
+func (o *outbox) run(ctx context.Context) {
+	for {
+		event := o.head()
+		if event == nil {
+			select {
+			case <-o.wake:
+				continue
+			case <-ctx.Done():
+				return
+			}
+		}
+
+		err := o.deliver(ctx, event)
+		switch {
+		case err == nil:
+			subsystems.succeed("webhooks")
+			outboxMetrics.Add("delivered_total", 1)
+			slog.Info("Delivered event", "type", event.Type, "event_id", event.ID, "dataset_version", event.Version)
+			o.finish(event)
+			continue
+		case errors.Is(err, errPermanent):
+			outboxMetrics.Add("dropped_total", 1)
+			slog.Error("Dropping event", "type", event.Type, "event_id", event.ID, "error", err)
+			o.finish(event)
+			continue
+		case ctx.Err() != nil:
+			return
+		}
+
+		o.mu.Lock()
+		event.Attempts++
+		o.mu.Unlock()
+		outboxMetrics.Add("failed_attempts_total", 1)
+		backoff := outboxMaxBackoff
+		if shift := event.Attempts - 1; shift < 16 && time.Second<<shift < backoff {
+			backoff = time.Second << shift
+		}
+		slog.Warn("Delivering event failed, retrying", "type", event.Type, "event_id", event.ID, "attempt", event.Attempts, "backoff", backoff, "error", err)
+		if !subsystems.fail("webhooks", err) {
+			return
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// webhookDeliverer posts events as JSON to url. Receivers acknowledge an
+// event with a 2xx status; other 4xx statuses, except 408 and 429, reject it
+// for good.
+func webhookDeliverer(url string) func(context.Context, *outboxEvent) error {
+	client := &http.Client{Timeout: webhookTimeout}
+	return func(ctx context.Context, event *outboxEvent) error {
+		body, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("%w: %v", errPermanent, err)
+		}
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+		if err != nil {
+			return fmt.Errorf("%w: %v", errPermanent, err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Idempotency-Key", event.ID)
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+		switch {
+		case resp.StatusCode >= 200 && resp.StatusCode < 300:
+			return nil
+		case resp.StatusCode >= 400 && resp.StatusCode < 500 &&
+			resp.StatusCode != http.StatusRequestTimeout && resp.StatusCode != http.StatusTooManyRequests:
+			return fmt.Errorf("%w: status %s", errPermanent, resp.Status)
+		default:
+			return fmt.Errorf("status %s", resp.Status)
+		}
+	}
+}
+
+// publishChange records a dataset change in the outbox
+func (o *outbox) publishChange(next *dataset, delta *featureDelta) {
+	err := o.enqueue(&outboxEvent{
+		Type:         "dataset.replaced",
+		Version:      next.version,
+		Source:       next.source,
+		FeatureCount: len(next.features),
+		Upserted:     len(delta.upserted),
+		Removed:      len(delta.removed),
+	})
+	if err != nil {
+		slog.Error("Failed to record the dataset version in the outbox", "dataset_version", next.version, "error", err)
+	}
+}
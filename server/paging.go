@@ -0,0 +1,227 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// defaultFeaturePageSize is used when a page request has no page size
+	defaultFeaturePageSize = 100
+	// maxFeaturePageSize caps the page size of a page request
+	maxFeaturePageSize = 1000
+)
+
+// featureKey orders features for paging. It only depends on a feature's
+// content, so it means the same thing in every dataset version.
+type featureKey struct {
+	lat, lon int32
+	name     string
+}
+
+// keyOf returns the paging key of a feature
+func keyOf(feature *featureRecord) featureKey {
+	return featureKey{lat: feature.Location.Latitude, lon: feature.Location.Longitude, name: feature.Name}
+}
+
+// compareKeys orders keys by latitude, longitude, then name
+func compareKeys(a, b featureKey) int {
+	return cmp.Or(cmp.Compare(a.lat, b.lat), cmp.Compare(a.lon, b.lon), strings.Compare(a.name, b.name))
+}
+
+// sortFeatureOrder returns the positions of features sorted by featureKey
+func sortFeatureOrder(features []*featureRecord) []int {
+	order := make([]int, len(features))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return compareKeys(keyOf(features[a]), keyOf(features[b]))
+	})
+	return order
+}
+
+// pageCursor is where a page of features ended. When the next page is read
+// from the same dataset version it resumes at pos in the sorted order;
+// otherwise it resumes after key, so a dataset swap between pages never
+// skips or repeats features present in both versions.
+type pageCursor struct {
+	version int64
+	pos     int
+	key     featureKey
+	query   uint64 // hash of the rectangle the cursor belongs to
+}
+
+// ListFeaturesPage returns a page of the features within a rectangle (unary RPC)
+func (s *routeGuideServer) ListFeaturesPage(ctx context.Context, req *pb.ListFeaturesPageRequest) (*pb.FeaturePage, error) {
+	rect := req.Rectangle
+	if err := geo.ValidateRectangle(rect); err != nil {
+		return nil, reasonError(codes.InvalidArgument, "INVALID_RECTANGLE", map[string]string{"detail": err.Error()})
+	}
+
+	pageSize := int(req.PageSize)
+	switch {
+	case pageSize <= 0:
+		pageSize = defaultFeaturePageSize
+	case pageSize > maxFeaturePageSize:
+		pageSize = maxFeaturePageSize
+	}
+
+	at, err := queryTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := s.featureStore.(datasetFeatureStore); !ok {
+		return s.storeFeaturePage(ctx, req, pageSize, at)
+	}
+	d, err := s.datasetFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// The order is sorted by latitude first, so the rectangle spans a
+	// contiguous run of it
+	order := d.sortedOrder()
+	bounds := geo.Canonical(rect)
+	start := sort.Search(len(order), func(i int) bool {
+		return d.features[order[i]].Location.Latitude >= bounds.South
+	})
+
+	query := rectHash(rect)
+	if req.PageToken != "" {
+		cursor, err := decodePageCursor(req.PageToken)
+		if err != nil || cursor.query != query {
+			return nil, reasonError(codes.InvalidArgument, "INVALID_PAGE_TOKEN", nil)
+		}
+		if cursor.version == d.version && cursor.pos <= len(order) {
+			start = cursor.pos
+		} else {
+			start = sort.Search(len(order), func(i int) bool {
+				return compareKeys(keyOf(d.features[order[i]]), cursor.key) > 0
+			})
+		}
+	}
+
+	locales := s.requestLocales(ctx)
+	page := &pb.FeaturePage{Version: d.version}
+	for i := start; i < len(order); i++ {
+		feature := d.features[order[i]]
+		if feature.Location.Latitude > bounds.North {
+			break
+		}
+		if !bounds.Contains(feature.Location) || !feature.activeAt(at) {
+			continue
+		}
+
+		page.Features = append(page.Features, feature.localized(locales))
+		if len(page.Features) == pageSize {
+			page.NextPageToken = encodePageCursor(pageCursor{
+				version: d.version,
+				pos:     i + 1,
+				key:     keyOf(feature),
+				query:   query,
+			})
+			break
+		}
+	}
+
+	loggerFrom(ctx).Info("ListFeaturesPage returned features", "count", len(page.Features), "dataset_version", d.version)
+	return page, nil
+}
+
+// storeFeaturePage reads a page of features from a feature store other than
+// the dataset, such as PostGIS or SQLite. The store has no sorted order to resume in,
+// so the features in the rectangle are sorted by key on every page and the
+// page resumes after the cursor's key. Its version is 0.
+func (s *routeGuideServer) storeFeaturePage(ctx context.Context, req *pb.ListFeaturesPageRequest, pageSize int, at time.Time) (*pb.FeaturePage, error) {
+	query := rectHash(req.Rectangle)
+	var after *featureKey
+	if req.PageToken != "" {
+		cursor, err := decodePageCursor(req.PageToken)
+		if err != nil || cursor.query != query {
+			return nil, reasonError(codes.InvalidArgument, "INVALID_PAGE_TOKEN", nil)
+		}
+		after = &cursor.key
+	}
+
+	inRect, err := s.featureStore.FeaturesIn(ctx, req.Rectangle)
+	if err != nil {
+		return nil, err
+	}
+	var features []*featureRecord
+	for _, feature := range inRect {
+		if feature.activeAt(at) && (after == nil || compareKeys(keyOf(feature), *after) > 0) {
+			features = append(features, feature)
+		}
+	}
+	slices.SortStableFunc(features, func(a, b *featureRecord) int { return compareKeys(keyOf(a), keyOf(b)) })
+
+	locales := s.requestLocales(ctx)
+	page := &pb.FeaturePage{}
+	for _, feature := range features[:min(pageSize, len(features))] {
+		page.Features = append(page.Features, feature.localized(locales))
+	}
+	if len(features) > pageSize {
+		page.NextPageToken = encodePageCursor(pageCursor{key: keyOf(features[pageSize-1]), query: query})
+	}
+	loggerFrom(ctx).Info("ListFeaturesPage returned features", "count", len(page.Features))
+	return page, nil
+}
+
+// rectHash identifies a rectangle, whichever corners it was given by
+func rectHash(rect *pb.Rectangle) uint64 {
+	bounds := geo.Canonical(rect)
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d,%d,%d,%d", bounds.South, bounds.West, bounds.North, bounds.East)
+	return h.Sum64()
+}
+
+// encodePageCursor turns a cursor into an opaque page token
+func encodePageCursor(c pageCursor) string {
+	raw := fmt.Sprintf("%d|%d|%d|%d|%d|%s", c.version, c.pos, c.query, c.key.lat, c.key.lon, c.key.name)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// decodePageCursor is the inverse of encodePageCursor
+func decodePageCursor(token string) (pageCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return pageCursor{}, err
+	}
+	parts := strings.SplitN(string(raw), "|", 6)
+	if len(parts) != 6 {
+		return pageCursor{}, strconv.ErrSyntax
+	}
+
+	var c pageCursor
+	var lat, lon int64
+	if c.version, err = strconv.ParseInt(parts[0], 10, 64); err != nil {
+		return pageCursor{}, err
+	}
+	if c.pos, err = strconv.Atoi(parts[1]); err != nil || c.pos < 0 {
+		return pageCursor{}, strconv.ErrSyntax
+	}
+	if c.query, err = strconv.ParseUint(parts[2], 10, 64); err != nil {
+		return pageCursor{}, err
+	}
+	if lat, err = strconv.ParseInt(parts[3], 10, 32); err != nil {
+		return pageCursor{}, err
+	}
+	if lon, err = strconv.ParseInt(parts[4], 10, 32); err != nil {
+		return pageCursor{}, err
+	}
+	c.key = featureKey{lat: int32(lat), lon: int32(lon), name: parts[5]}
+	return c, nil
+}
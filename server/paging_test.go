@@ -0,0 +1,120 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// pagingDataset builds a dataset holding a feature named "F<lat>" at (lat°, 1°)
+// for each latitude
+func pagingDataset(t *testing.T, lats ...int32) *dataset {
+	t.Helper()
+	records := make([]featureJSON, len(lats))
+	for i, lat := range lats {
+		records[i] = featureJSON{
+			Location: &pb.Point{Latitude: lat * 10000000, Longitude: 10000000},
+			Name:     fmt.Sprintf("F%d", lat),
+		}
+	}
+	data, err := json.Marshal(records)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := parseDataset(data, "test", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+// pageRectangle covers every feature of a paging dataset
+var pageRectangle = &pb.Rectangle{
+	Lo: &pb.Point{Latitude: 0, Longitude: 0},
+	Hi: &pb.Point{Latitude: 800000000, Longitude: 20000000},
+}
+
+func TestListFeaturesPageAcrossDatasetSwaps(t *testing.T) {
+	s, err := newServer("features.json", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.swapDataset(pagingDataset(t, 10, 20, 30, 40, 50, 60, 70))
+	ctx := context.Background()
+
+	// Each swap happens after the page with the same index is read. Features
+	// inserted before the cursor and deleted after it shift every position
+	// in the sorted order, so resuming by position would repeat or skip some.
+	swaps := map[int]*dataset{
+		// F15 goes in before the cursor (F30), F50 is deleted after it, F65 is new
+		0: pagingDataset(t, 10, 15, 20, 30, 40, 60, 65, 70),
+		// F5 and F35 go in before the cursor (F60), F70 is deleted
+		1: pagingDataset(t, 5, 10, 15, 20, 30, 35, 40, 60, 65),
+	}
+	seen := make(map[string]int)
+	var names []string
+	token := ""
+	for i := 0; ; i++ {
+		page, err := s.ListFeaturesPage(ctx, &pb.ListFeaturesPageRequest{Rectangle: pageRectangle, PageSize: 3, PageToken: token})
+		if err != nil {
+			t.Fatalf("ListFeaturesPage() page %d failed: %v", i, err)
+		}
+		for _, feature := range page.Features {
+			seen[feature.Name]++
+			names = append(names, feature.Name)
+		}
+		if next, ok := swaps[i]; ok {
+			s.swapDataset(next)
+		}
+		if token = page.NextPageToken; token == "" {
+			break
+		}
+	}
+
+	// Present in every version: exactly once. Inserted after the cursor:
+	// once. Inserted before the cursor or deleted before it was reached:
+	// never.
+	want := map[string]int{"F10": 1, "F20": 1, "F30": 1, "F40": 1, "F60": 1, "F65": 1}
+	if fmt.Sprint(seen) != fmt.Sprint(want) {
+		t.Errorf("paged features %v, want %v", names, want)
+	}
+}
+
+func TestListFeaturesPageTokenBoundToQuery(t *testing.T) {
+	s, err := newServer("features.json", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.swapDataset(pagingDataset(t, 10, 20, 30, 40))
+	ctx := context.Background()
+	first, err := s.ListFeaturesPage(ctx, &pb.ListFeaturesPageRequest{Rectangle: pageRectangle, PageSize: 2})
+	if err != nil || first.NextPageToken == "" {
+		t.Fatalf("ListFeaturesPage() = %v, %v, want a next page", first, err)
+	}
+
+	tests := []struct {
+		name string
+		rect *pb.Rectangle
+		code codes.Code
+	}{
+		{"same rectangle", pageRectangle, codes.OK},
+		{"corners swapped", &pb.Rectangle{Lo: pageRectangle.Hi, Hi: pageRectangle.Lo}, codes.OK},
+		{"other rectangle", &pb.Rectangle{Lo: pageRectangle.Lo, Hi: &pb.Point{Latitude: 350000000, Longitude: 20000000}}, codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := s.ListFeaturesPage(ctx, &pb.ListFeaturesPageRequest{Rectangle: tt.rect, PageSize: 2, PageToken: first.NextPageToken})
+			if status.Code(err) != tt.code {
+				t.Fatalf("ListFeaturesPage() with the first rectangle's token = %v, want %v", err, tt.code)
+			}
+			if err == nil && (len(page.Features) != 2 || page.Features[0].Name != "F30") {
+				t.Errorf("second page = %v, want F30 and F40", page.Features)
+			}
+		})
+	}
+}
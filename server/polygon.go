@@ -0,0 +1,61 @@
+package main
+
+import (
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListFeaturesInPolygon lists all features within a polygon (server streaming RPC)
+func (s *routeGuideServer) ListFeaturesInPolygon(req *pb.Polygon, stream pb.RouteGuide_ListFeaturesInPolygonServer) error {
+	polygon, err := geo.NewPolygon(req.Points)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid polygon: %v", err)
+	}
+	bounds := polygon.Bounds()
+	logger := loggerFrom(stream.Context())
+	logger.Info("ListFeaturesInPolygon called", "vertices", len(polygon),
+		"south", bounds.South, "west", bounds.West, "north", bounds.North, "east", bounds.East)
+
+	at, err := queryTime(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	order, err := requestOrder(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	// The store narrows the features down to the polygon's bounds, then the
+	// polygon's edges sort them out
+	candidates, err := s.featureStore.FeaturesIn(stream.Context(), bounds.Rectangle())
+	if err != nil {
+		return err
+	}
+	var features []*featureRecord
+	for _, feature := range candidates {
+		if polygon.Contains(feature.Location) {
+			features = append(features, feature)
+		}
+	}
+	if order == orderByPopularity {
+		s.popularity.sortByPopularity(features)
+	}
+
+	locales := s.requestLocales(stream.Context())
+	count := 0
+	for _, feature := range features {
+		if feature.activeAt(at) {
+			if err := stream.Send(feature.localized(locales)); err != nil {
+				return err
+			}
+			count++
+			logger.Debug("Sent feature", "name", feature.Name)
+		}
+	}
+
+	logger.Info("ListFeaturesInPolygon completed", "sent", count, "in_bounds", len(candidates))
+	return nil
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"math"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// orderByKey is the metadata key clients use to pick the order of ListFeatures results
+const orderByKey = "order-by"
+
+// orderByPopularity sorts results by decreasing popularity score
+const orderByPopularity = "popularity"
+
+// popularityScore is an exponentially decaying hit count as of a given time
+type popularityScore struct {
+	value   float64
+	updated time.Time
+}
+
+// popularityTracker scores features by how often, and how recently, they are
+// looked up. Every hit adds one to a feature's score, and scores halve every
+// half-life, so a feature that was popular long ago ranks below one that is
+// popular now.
+type popularityTracker struct {
+	mu       sync.Mutex
+	halfLife time.Duration
+	scores   map[string]popularityScore // keyed by serialized location
+}
+
+// newPopularityTracker creates a tracker whose scores halve every halfLife
+func newPopularityTracker(halfLife time.Duration) *popularityTracker {
+	return &popularityTracker{
+		halfLife: halfLife,
+		scores:   make(map[string]popularityScore),
+	}
+}
+
+// decayed returns a score's value at time now
+func (p *popularityTracker) decayed(score popularityScore, now time.Time) float64 {
+	elapsed := now.Sub(score.updated)
+	if elapsed <= 0 || p.halfLife <= 0 {
+		return score.value
+	}
+	return score.value * math.Exp2(-elapsed.Seconds()/p.halfLife.Seconds())
+}
+
+// hit records a lookup of the feature at key
+func (p *popularityTracker) hit(key string, now time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.scores[key] = popularityScore{
+		value:   p.decayed(p.scores[key], now) + 1,
+		updated: now,
+	}
+}
+
+// score returns the popularity of the feature at key at time now
+func (p *popularityTracker) score(key string, now time.Time) float64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.decayed(p.scores[key], now)
+}
+
+// sortByPopularity orders features by decreasing score, keeping the dataset
+// order among equally popular ones
+func (p *popularityTracker) sortByPopularity(features []*featureRecord) {
+	now := time.Now()
+	scores := make(map[*featureRecord]float64, len(features))
+	for _, feature := range features {
+		scores[feature] = p.score(geo.Key(feature.Location), now)
+	}
+	slices.SortStableFunc(features, func(a, b *featureRecord) int {
+		switch {
+		case scores[a] > scores[b]:
+			return -1
+		case scores[a] < scores[b]:
+			return 1
+		}
+		return 0
+	})
+}
+
+// requestOrder returns the result order the caller asked for in its order-by
+// metadata, or "" for dataset order
+func requestOrder(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get(orderByKey)) == 0 {
+		return "", nil
+	}
+
+	switch order := md.Get(orderByKey)[0]; order {
+	case "", orderByPopularity:
+		return order, nil
+	default:
+		return "", status.Errorf(codes.InvalidArgument, "unsupported %s %q", orderByKey, order)
+	}
+}
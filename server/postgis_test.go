@@ -0,0 +1,200 @@
+//go:build !minimal && !no_postgis
+
+package main
+
+import (
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/jackc/pgx/v5/pgproto3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakePGResult is what the fake server answers a query with
+type fakePGResult struct {
+	rows []string                // values of a single json column
+	tag  string                  // command tag
+	err  *pgproto3.ErrorResponse // sent instead of the rows
+}
+
+// startFakePG runs a PostgreSQL server speaking just enough of the simple
+// query protocol for pgx, without authentication, answering queries with
+// handle until the test ends. It returns the URL of the server.
+func startFakePG(t *testing.T, handle func(query string) fakePGResult) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveFakePG(conn, handle)
+		}
+	}()
+	// pgx interpolates the parameters into the query text in simple mode
+	return "postgres://routeguide@" + listener.Addr().String() + "/geo?sslmode=disable&default_query_exec_mode=simple_protocol"
+}
+
+// serveFakePG answers the queries of one connection
+func serveFakePG(conn net.Conn, handle func(query string) fakePGResult) {
+	defer conn.Close()
+	backend := pgproto3.NewBackend(conn, conn)
+	if _, err := backend.ReceiveStartupMessage(); err != nil {
+		return
+	}
+	backend.Send(&pgproto3.AuthenticationOk{})
+	backend.Send(&pgproto3.ParameterStatus{Name: "client_encoding", Value: "UTF8"})
+	backend.Send(&pgproto3.ParameterStatus{Name: "standard_conforming_strings", Value: "on"})
+	backend.Send(&pgproto3.ReadyForQuery{TxStatus: 'I'})
+	for {
+		if err := backend.Flush(); err != nil {
+			return
+		}
+		msg, err := backend.Receive()
+		if err != nil {
+			return
+		}
+		query, ok := msg.(*pgproto3.Query)
+		if !ok {
+			return
+		}
+		if strings.HasPrefix(query.String, "-- ping") {
+			backend.Send(&pgproto3.EmptyQueryResponse{})
+			backend.Send(&pgproto3.ReadyForQuery{TxStatus: 'I'})
+			continue
+		}
+
+		result := handle(query.String)
+		switch {
+		case result.err != nil:
+			backend.Send(result.err)
+		case result.rows != nil:
+			backend.Send(&pgproto3.RowDescription{Fields: []pgproto3.FieldDescription{
+				{Name: []byte("json_build_object"), DataTypeOID: 114, DataTypeSize: -1, TypeModifier: -1},
+			}})
+			for _, row := range result.rows {
+				backend.Send(&pgproto3.DataRow{Values: [][]byte{[]byte(row)}})
+			}
+			backend.Send(&pgproto3.CommandComplete{CommandTag: []byte(result.tag)})
+		default:
+			backend.Send(&pgproto3.CommandComplete{CommandTag: []byte(result.tag)})
+		}
+		backend.Send(&pgproto3.ReadyForQuery{TxStatus: 'I'})
+	}
+}
+
+func TestPostGISConnConfig(t *testing.T) {
+	tests := []struct {
+		dsn       string
+		plaintext bool // whether a connection may go without TLS
+	}{
+		{dsn: "postgres://routeguide:secret@db/geo?sslmode=prefer"},
+		{dsn: "postgres://routeguide:secret@db/geo?sslmode=require"},
+		{dsn: "postgres://routeguide@db/geo?sslmode=prefer", plaintext: true},
+		{dsn: "postgres://routeguide:secret@db/geo?sslmode=disable", plaintext: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.dsn, func(t *testing.T) {
+			config, err := postgisConnConfig(tt.dsn)
+			if err != nil {
+				t.Fatalf("postgisConnConfig() failed: %v", err)
+			}
+			plaintext := config.TLSConfig == nil
+			for _, fallback := range config.Fallbacks {
+				plaintext = plaintext || fallback.TLSConfig == nil
+			}
+			if plaintext != tt.plaintext {
+				t.Errorf("connections without TLS allowed = %v, want %v", plaintext, tt.plaintext)
+			}
+		})
+	}
+}
+
+func TestPostGISFeatureReads(t *testing.T) {
+	var queries []string
+	garbage := false
+	dsn := startFakePG(t, func(query string) fakePGResult {
+		queries = append(queries, query)
+		switch {
+		case garbage:
+			return fakePGResult{rows: []string{`{"location": 1}`}, tag: "SELECT 1"}
+		case strings.Contains(query, "ST_Covers"):
+			return fakePGResult{rows: []string{
+				`{"location": {"latitude": 1, "longitude": 2}, "name": "First"}`,
+				`{"location": {"latitude": 3, "longitude": 4}, "name": "Second", "windows": [{"until": "2000-01-01T00:00:00Z"}]}`,
+			}, tag: "SELECT 2"}
+		}
+		return fakePGResult{rows: []string{
+			`{"location": {"latitude": 1, "longitude": 2}, "name": "Expired", "windows": [{"until": "2000-01-01T00:00:00Z"}]}`,
+			`{"location": {"latitude": 1, "longitude": 2}, "name": "Current"}`,
+		}, tag: "SELECT 2"}
+	})
+	store, err := newPostGISFeatureStore(dsn, "features")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.db.Close()
+	ctx := context.Background()
+
+	feature, err := store.FeatureAt(ctx, &pb.Point{Latitude: 1, Longitude: 2}, time.Now())
+	if err != nil || feature == nil || feature.Name != "Current" {
+		t.Errorf("FeatureAt() = %v, %v, want the feature valid now", feature, err)
+	}
+	rect := &pb.Rectangle{Lo: &pb.Point{Latitude: 0, Longitude: 0}, Hi: &pb.Point{Latitude: 50000000, Longitude: 60000000}}
+	features, err := store.FeaturesIn(ctx, rect)
+	if err != nil || len(features) != 2 {
+		t.Fatalf("FeaturesIn() = %d features, %v, want both, whatever their windows", len(features), err)
+	}
+	if last := queries[len(queries)-1]; !strings.Contains(last, "FROM features") || !strings.Contains(last, "'60000000'") {
+		t.Errorf("FeaturesIn() query = %q, want the rectangle's coordinates", last)
+	}
+
+	garbage = true
+	if _, err := store.FeaturesIn(ctx, rect); status.Code(err) != codes.Internal {
+		t.Errorf("FeaturesIn() of a malformed row = %v, want INTERNAL", err)
+	}
+}
+
+func TestPostGISFeatureWrites(t *testing.T) {
+	var statements []string
+	fail := false
+	dsn := startFakePG(t, func(query string) fakePGResult {
+		if fail {
+			return fakePGResult{err: &pgproto3.ErrorResponse{Severity: "ERROR", Code: "25006", Message: "read-only transaction"}}
+		}
+		statements = append(statements, strings.Fields(query)[0])
+		return fakePGResult{tag: "INSERT 0 1"}
+	})
+	store, err := newPostGISFeatureStore(dsn, "features")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.db.Close()
+	ctx := context.Background()
+
+	location := &pb.Point{Latitude: 409146138, Longitude: -746188906}
+	if err := store.PutFeature(ctx, &pb.Feature{Name: "Berkshire Valley", Location: location}); err != nil {
+		t.Fatalf("PutFeature() failed: %v", err)
+	}
+	if err := store.DeleteFeature(ctx, location); err != nil {
+		t.Fatalf("DeleteFeature() failed: %v", err)
+	}
+	if want := []string{"WITH", "DELETE"}; strings.Join(statements, " ") != strings.Join(want, " ") {
+		t.Errorf("statements = %q, want %q", statements, want)
+	}
+
+	fail = true
+	if err := store.PutFeature(ctx, &pb.Feature{Name: "x", Location: location}); status.Code(err) != codes.Unavailable {
+		t.Errorf("PutFeature() of a failing write = %v, want UNAVAILABLE", err)
+	}
+}
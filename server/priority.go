@@ -0,0 +1,110 @@
+package main
+
+import (
+	"context"
+	"expvar"
+	"slices"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// priorityKey is the metadata key clients set to "low" to run a call as
+// background work
+const priorityKey = "priority"
+
+// priorityMetrics publishes the low-priority pool's gauges on /debug/vars
+var priorityMetrics = expvar.NewMap("priority")
+
+// bulkMethods are run at low priority whatever the client asks: region
+// exports and route imports
+var bulkMethods = []string{
+	"/" + pb.RouteGuide_ServiceDesc.ServiceName + "/DownloadRegionBundle",
+	"/" + pb.Admin_ServiceDesc.ServiceName + "/ReplayRoute",
+}
+
+// priorityPool runs low-priority calls on a few workers, queueing the rest,
+// so bulk work can't crowd out interactive calls such as GetFeature, which
+// always run right away
+type priorityPool struct {
+	slots chan struct{}
+
+	active   expvar.Int
+	waiting  expvar.Int
+	admitted expvar.Int
+}
+
+// newPriorityPool creates a pool running at most workers low-priority calls
+// at once
+func newPriorityPool(workers int) *priorityPool {
+	p := &priorityPool{slots: make(chan struct{}, workers)}
+	priorityMetrics.Set("workers", expvar.Func(func() any { return cap(p.slots) }))
+	priorityMetrics.Set("low_active", &p.active)
+	priorityMetrics.Set("low_waiting", &p.waiting)
+	priorityMetrics.Set("low_admitted_total", &p.admitted)
+	return p
+}
+
+// lowPriority reports whether a call is background work: a bulk method, or
+// one the client sent with "priority: low" metadata
+func lowPriority(ctx context.Context, fullMethod string) bool {
+	if slices.Contains(bulkMethods, fullMethod) {
+		return true
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(priorityKey)
+	return len(values) > 0 && values[0] == "low"
+}
+
+// acquire waits for a worker, failing if ctx is done first. Successful calls
+// must be paired with release.
+func (p *priorityPool) acquire(ctx context.Context, fullMethod string) error {
+	select {
+	case p.slots <- struct{}{}:
+	default:
+		loggerFrom(ctx).Info("Queueing low-priority call")
+		p.waiting.Add(1)
+		defer p.waiting.Add(-1)
+		select {
+		case p.slots <- struct{}{}:
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		}
+	}
+	p.active.Add(1)
+	p.admitted.Add(1)
+	return nil
+}
+
+// release frees the worker taken by acquire
+func (p *priorityPool) release() {
+	p.active.Add(-1)
+	<-p.slots
+}
+
+// unaryInterceptor runs low-priority unary calls on the pool
+func (p *priorityPool) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if !lowPriority(ctx, info.FullMethod) {
+		return handler(ctx, req)
+	}
+	if err := p.acquire(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	defer p.release()
+	return handler(ctx, req)
+}
+
+// streamInterceptor runs low-priority streams on the pool. Long-lived
+// streams hold their worker until they end.
+func (p *priorityPool) streamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !lowPriority(ss.Context(), info.FullMethod) {
+		return handler(srv, ss)
+	}
+	if err := p.acquire(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	defer p.release()
+	return handler(srv, ss)
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"strings"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// travelProfileKey is the metadata key selecting the travel profile of a RecordRoute stream
+const travelProfileKey = "travel-profile"
+
+// travelProfile describes how a mode of transport affects route statistics
+type travelProfile struct {
+	speedKmh  float64       // typical average speed, used for ETA
+	kcalPerKm float64       // energy spent per kilometre, zero when motorised
+	limits    anomalyLimits // thresholds for flagging impossible segments
+}
+
+// travelProfiles holds the built-in profiles. Segments received in a burst are
+// only checked against maxSegmentMeters, so it is sized for sparse GPS tracks.
+var travelProfiles = map[pb.TravelProfile]travelProfile{
+	pb.TravelProfile_TRAVEL_PROFILE_WALKING: {
+		speedKmh:  5,
+		kcalPerKm: 55,
+		limits:    anomalyLimits{maxSpeedKmh: 25, maxSegmentMeters: 5000},
+	},
+	pb.TravelProfile_TRAVEL_PROFILE_CYCLING: {
+		speedKmh:  18,
+		kcalPerKm: 30,
+		limits:    anomalyLimits{maxSpeedKmh: 80, maxSegmentMeters: 20000},
+	},
+	pb.TravelProfile_TRAVEL_PROFILE_DRIVING: {
+		speedKmh: 60,
+		limits:   anomalyLimits{maxSpeedKmh: 300, maxSegmentMeters: 100000},
+	},
+}
+
+// requestTravelProfile returns the travel profile requested in the stream metadata.
+// Profiles are matched by their short name ("walking") or full enum name.
+func requestTravelProfile(ctx context.Context) (pb.TravelProfile, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return pb.TravelProfile_TRAVEL_PROFILE_UNSPECIFIED, nil
+	}
+
+	values := md.Get(travelProfileKey)
+	if len(values) == 0 {
+		return pb.TravelProfile_TRAVEL_PROFILE_UNSPECIFIED, nil
+	}
+
+	name := strings.ToUpper(strings.TrimSpace(values[0]))
+	if v, ok := pb.TravelProfile_value[name]; ok {
+		return pb.TravelProfile(v), nil
+	}
+	if v, ok := pb.TravelProfile_value["TRAVEL_PROFILE_"+name]; ok {
+		return pb.TravelProfile(v), nil
+	}
+
+	return pb.TravelProfile_TRAVEL_PROFILE_UNSPECIFIED,
+		status.Errorf(codes.InvalidArgument, "unknown %s %q: expected walking, cycling or driving", travelProfileKey, values[0])
+}
+
+// applyTravelProfile fills in the profile-dependent statistics of a route summary
+func applyTravelProfile(summary *pb.RouteSummary, profile pb.TravelProfile) {
+	summary.Profile = profile
+
+	p, ok := travelProfiles[profile]
+	if !ok {
+		return
+	}
+
+	km := float64(summary.Distance) / 1000
+	summary.EstimatedTime = int32(km / p.speedKmh * 3600)
+	summary.Calories = int32(km * p.kcalPerKm)
+}
+
+// routeLimits returns the anomaly thresholds for a profile, falling back to the
+// server-wide limits when no profile was given
+func (s *routeGuideServer) routeLimits(profile pb.TravelProfile) anomalyLimits {
+	p, ok := travelProfiles[profile]
+	if !ok {
+		return s.anomalyLimits
+	}
+
+	limits := p.limits
+	limits.minInterval = s.anomalyLimits.minInterval
+	return limits
+}
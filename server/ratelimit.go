@@ -0,0 +1,198 @@
+package main
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// rateLimitMetrics counts the calls rejected by rate limits, by method, on
+// /debug/vars
+var rateLimitMetrics = expvar.NewMap("rate_limits")
+
+// rateLimitSweepInterval is how often buckets that refilled are forgotten
+const rateLimitSweepInterval = time.Minute
+
+// rateLimit allows count calls per period, in bursts of up to count
+type rateLimit struct {
+	count  int
+	period time.Duration
+}
+
+// parseRate parses N/unit, e.g. "10/s", "2/m" or "100/30s"
+func parseRate(value string) (rateLimit, error) {
+	n, unit, ok := strings.Cut(value, "/")
+	if !ok {
+		return rateLimit{}, fmt.Errorf("%q is not N/unit", value)
+	}
+	count, err := strconv.Atoi(n)
+	if err != nil || count <= 0 {
+		return rateLimit{}, fmt.Errorf("invalid count in %q", value)
+	}
+	if unit == "s" || unit == "m" || unit == "h" {
+		unit = "1" + unit
+	}
+	period, err := time.ParseDuration(unit)
+	if err != nil || period <= 0 {
+		return rateLimit{}, fmt.Errorf("invalid period in %q", value)
+	}
+	return rateLimit{count: count, period: period}, nil
+}
+
+// tokenBucket holds the calls a caller may still make, as of updated
+type tokenBucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// refill returns the bucket's tokens at time now
+func (b tokenBucket) refill(limit rateLimit, now time.Time) float64 {
+	rate := float64(limit.count) / limit.period.Seconds()
+	return min(float64(limit.count), b.tokens+now.Sub(b.updated).Seconds()*rate)
+}
+
+// rateLimitKey identifies a caller's bucket for a method
+type rateLimitKey struct {
+	caller string
+	method string
+}
+
+// rateLimiter limits how often each caller may call each method, with a
+// token bucket per caller and method. Streams count when they open.
+type rateLimiter struct {
+	methods  map[string]rateLimit // by full method name
+	fallback rateLimit            // for other methods, zero for no limit
+
+	mu        sync.Mutex
+	buckets   map[rateLimitKey]tokenBucket
+	lastSweep time.Time
+}
+
+// parseRateLimits parses a comma-separated list of Method=N/unit limits,
+// e.g. "GetFeature=10/s,RecordRoute=2/m". Methods are RouteGuide or Admin
+// method names; "*=N/unit" limits every other method, per method.
+func parseRateLimits(list string) (*rateLimiter, error) {
+	l := &rateLimiter{
+		methods:   make(map[string]rateLimit),
+		buckets:   make(map[rateLimitKey]tokenBucket),
+		lastSweep: time.Now(),
+	}
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("%q is not Method=N/unit", entry)
+		}
+		limit, err := parseRate(value)
+		if err != nil {
+			return nil, err
+		}
+		if name == "*" {
+			l.fallback = limit
+			continue
+		}
+		fullMethod, ok := lookupMethod(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown RouteGuide or Admin method %q", name)
+		}
+		l.methods[fullMethod] = limit
+	}
+	return l, nil
+}
+
+// take spends a token of the caller's bucket for a method. It returns the
+// limit applying and the calls left in the bucket. When the bucket is
+// empty, reset is how long until the next token; otherwise it is how long
+// until the bucket is full again.
+func (l *rateLimiter) take(caller, fullMethod string, now time.Time) (limit rateLimit, remaining int, reset time.Duration, ok bool) {
+	limit, found := l.methods[fullMethod]
+	if !found {
+		limit = l.fallback
+	}
+	if limit.count == 0 {
+		return limit, 0, 0, true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if now.Sub(l.lastSweep) >= rateLimitSweepInterval {
+		l.sweep(now)
+	}
+
+	key := rateLimitKey{caller: caller, method: fullMethod}
+	bucket, found := l.buckets[key]
+	tokens := float64(limit.count)
+	if found {
+		tokens = bucket.refill(limit, now)
+	}
+	rate := float64(limit.count) / limit.period.Seconds()
+	if tokens < 1 {
+		return limit, 0, time.Duration((1 - tokens) / rate * float64(time.Second)), false
+	}
+	tokens--
+	l.buckets[key] = tokenBucket{tokens: tokens, updated: now}
+	return limit, int(tokens), time.Duration((float64(limit.count) - tokens) / rate * float64(time.Second)), true
+}
+
+// sweep forgets the buckets that refilled, which behave like new ones.
+// l.mu must be held.
+func (l *rateLimiter) sweep(now time.Time) {
+	for key, bucket := range l.buckets {
+		limit, found := l.methods[key.method]
+		if !found {
+			limit = l.fallback
+		}
+		if bucket.refill(limit, now) >= float64(limit.count) {
+			delete(l.buckets, key)
+		}
+	}
+	l.lastSweep = now
+}
+
+// admit spends a token for a call and sets the ratelimit-* headers telling
+// the caller how many calls it has left, or returns RESOURCE_EXHAUSTED with
+// a RetryInfo detail and the ratelimit-* trailers telling it when to retry
+func (l *rateLimiter) admit(ctx context.Context, fullMethod string, setHeader, setTrailer func(metadata.MD)) error {
+	caller := accessClient(ctx)
+	limit, remaining, reset, ok := l.take(caller, fullMethod, time.Now())
+	if ok {
+		if limit.count > 0 {
+			// Sent with the first response or the status, whichever comes
+			// first
+			setHeader(quotaHint{limit: limit.count, remaining: remaining}.metadata(reset))
+		}
+		return nil
+	}
+	rateLimitMetrics.Add(fullMethod, 1)
+	loggerFrom(ctx).Warn("Rejected call: rate limit exceeded", "caller", caller)
+	setTrailer(quotaHint{limit: limit.count}.metadata(reset))
+	return resourceExhausted("principal:"+caller,
+		fmt.Sprintf("at most %d calls to %s per %s are allowed per caller", limit.count, fullMethod, limit.period), reset)
+}
+
+// unaryInterceptor rate limits unary calls. It must run after
+// authentication; anonymous callers are told apart by their address.
+func (l *rateLimiter) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := l.admit(ctx, info.FullMethod, func(md metadata.MD) { grpc.SetHeader(ctx, md) }, func(md metadata.MD) { grpc.SetTrailer(ctx, md) }); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// streamInterceptor rate limits the opening of streams
+func (l *rateLimiter) streamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := l.admit(ss.Context(), info.FullMethod, func(md metadata.MD) { ss.SetHeader(md) }, func(md metadata.MD) { ss.SetTrailer(md) }); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
@@ -0,0 +1,62 @@
+package main
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestRateLimitHints(t *testing.T) {
+	l, err := parseRateLimits("GetFeature=3/m")
+	if err != nil {
+		t.Fatal(err)
+	}
+	const method = "/routeguide.RouteGuide/GetFeature"
+	ctx := context.Background()
+
+	// Every admitted call tells the caller how many calls it has left
+	for _, want := range []string{"2", "1", "0"} {
+		var header, trailer metadata.MD
+		err := l.admit(ctx, method, func(md metadata.MD) { header = md }, func(md metadata.MD) { trailer = md })
+		if err != nil {
+			t.Fatalf("admit() failed: %v", err)
+		}
+		if trailer != nil {
+			t.Errorf("admitted call got trailers %v", trailer)
+		}
+		if got := header.Get(rateLimitLimitKey); len(got) != 1 || got[0] != "3" {
+			t.Errorf("ratelimit-limit = %v, want 3", got)
+		}
+		if got := header.Get(rateLimitRemainingKey); len(got) != 1 || got[0] != want {
+			t.Errorf("ratelimit-remaining = %v, want %s", got, want)
+		}
+		if got := header.Get(rateLimitResetKey); len(got) != 1 {
+			t.Errorf("ratelimit-reset = %v, want the seconds until the bucket is full", got)
+		}
+	}
+
+	// The call past the limit is rejected, with the hint in its trailers
+	var header, trailer metadata.MD
+	err = l.admit(ctx, method, func(md metadata.MD) { header = md }, func(md metadata.MD) { trailer = md })
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("admit() = %v, want RESOURCE_EXHAUSTED", err)
+	}
+	if header != nil {
+		t.Errorf("rejected call got headers %v", header)
+	}
+	if got := trailer.Get(rateLimitRemainingKey); len(got) != 1 || got[0] != "0" {
+		t.Errorf("ratelimit-remaining = %v, want 0", got)
+	}
+	if got := trailer.Get(rateLimitResetKey); len(got) != 1 || got[0] != "20" {
+		t.Errorf("ratelimit-reset = %v, want 20", got)
+	}
+
+	// Unlimited methods get no hints
+	err = l.admit(ctx, "/routeguide.RouteGuide/ListFeatures", func(md metadata.MD) { header = md }, nil)
+	if err != nil || header.Get(rateLimitLimitKey) != nil {
+		t.Errorf("admit() of an unlimited method = %v, headers %v", err, header)
+	}
+}
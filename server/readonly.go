@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/grpc/codes"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// writesHealthService is the health service reporting whether the dataset accepts changes
+var writesHealthService = pb.RouteGuide_ServiceDesc.ServiceName + "/writes"
+
+// readOnlyState records why and since when the dataset is read-only
+type readOnlyState struct {
+	reason string
+	since  time.Time
+}
+
+// checkWritable returns FAILED_PRECONDITION while the dataset is read-only.
+// Every dataset change must check it first.
+func (s *routeGuideServer) checkWritable() error {
+	if ro := s.readOnly.Load(); ro != nil {
+		return reasonError(codes.FailedPrecondition, "DATASET_READ_ONLY", map[string]string{"since": ro.since.Format(time.RFC3339), "reason": ro.reason})
+	}
+	return nil
+}
+
+// setReadOnly freezes the dataset with a reason, or unfreezes it if reason is empty
+func (s *routeGuideServer) setReadOnly(reason string) {
+	if reason == "" {
+		s.readOnly.Store(nil)
+		slog.Info("Dataset is writable again")
+	} else {
+		s.readOnly.Store(&readOnlyState{reason: reason, since: time.Now()})
+		slog.Warn("Dataset is read-only", "reason", reason)
+	}
+	s.reportWritesHealth()
+}
+
+// reportWritesHealth publishes the read-only state on the writes health service
+func (s *routeGuideServer) reportWritesHealth() {
+	if s.health == nil {
+		return
+	}
+	servingStatus := healthpb.HealthCheckResponse_SERVING
+	if s.readOnly.Load() != nil {
+		servingStatus = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	s.health.SetServingStatus(writesHealthService, servingStatus)
+}
+
+// serverInfo describes the server's state
+func (s *routeGuideServer) serverInfo() *pb.ServerInfo {
+	d := s.current()
+	info := &pb.ServerInfo{
+		StartedAt:          timestamppb.New(s.startedAt),
+		DatasetVersion:     d.version,
+		FeatureCount:       int32(len(d.features)),
+		Region:             s.locality.region,
+		Zone:               s.locality.zone,
+		FeatureFlags:       s.flags.snapshot(),
+		DegradedSubsystems: subsystems.snapshot(),
+	}
+	if ro := s.readOnly.Load(); ro != nil {
+		info.ReadOnly = true
+		info.ReadOnlyReason = ro.reason
+		info.ReadOnlySince = timestamppb.New(ro.since)
+	}
+	if notes, ok := s.notes.(*memoryNoteStore); ok {
+		info.NotesReadOnlyReason = notes.readOnly
+	}
+	return info
+}
+
+// GetServerInfo returns the server's state (unary RPC)
+func (a *adminServer) GetServerInfo(ctx context.Context, req *pb.ServerInfoRequest) (*pb.ServerInfo, error) {
+	loggerFrom(ctx).Info("GetServerInfo called")
+	return a.server.serverInfo(), nil
+}
+
+// SetReadOnly puts the dataset in or out of read-only mode (unary RPC)
+func (a *adminServer) SetReadOnly(ctx context.Context, req *pb.SetReadOnlyRequest) (*pb.ServerInfo, error) {
+	loggerFrom(ctx).Info("SetReadOnly called", "read_only", req.ReadOnly, "reason", req.Reason)
+
+	if !req.ReadOnly {
+		a.server.setReadOnly("")
+		return a.server.serverInfo(), nil
+	}
+	if req.Reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "a reason is required to make the dataset read-only")
+	}
+	a.server.setReadOnly(req.Reason)
+	return a.server.serverInfo(), nil
+}
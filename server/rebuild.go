@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"runtime"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// RebuildIndexes rebuilds the indexes of the served datasets and clears the
+// caches derived from them (unary RPC)
+func (a *adminServer) RebuildIndexes(ctx context.Context, req *pb.RebuildIndexesRequest) (*pb.RebuildIndexesResponse, error) {
+	s := a.server
+	before := liveHeap()
+	start := time.Now()
+
+	// Build aside, then swap: queries use the old index until Store
+	d := s.current()
+	datasets := []*dataset{d}
+	if candidate := s.ab.candidate.Load(); candidate != nil {
+		datasets = append(datasets, candidate)
+	}
+	featureCount := 0
+	for _, ds := range datasets {
+		ds.index.Store(buildFeatureIndex(ds.features, nil))
+		featureCount += len(ds.features)
+	}
+	resp := &pb.RebuildIndexesResponse{
+		DatasetVersion: d.version,
+		FeatureCount:   int32(featureCount),
+	}
+	if s.tiles != nil {
+		resp.ClearedTiles = int32(s.tiles.purge())
+	}
+	if s.rects != nil {
+		resp.ClearedListResults = int32(s.rects.purge())
+	}
+	elapsed := time.Since(start)
+
+	resp.Duration = durationpb.New(elapsed)
+	resp.HeapDeltaBytes = int64(liveHeap()) - int64(before)
+	loggerFrom(ctx).Info("RebuildIndexes called",
+		"indexed", featureCount, "dataset_version", d.version, "elapsed", elapsed.Round(time.Microsecond),
+		"cleared_tiles", resp.ClearedTiles, "cleared_list_results", resp.ClearedListResults, "heap_delta_kib", resp.HeapDeltaBytes>>10)
+	return resp, nil
+}
+
+// liveHeap returns the bytes of heap in use once garbage is collected
+func liveHeap() uint64 {
+	runtime.GC()
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	return stats.HeapAlloc
+}
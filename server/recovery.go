@@ -0,0 +1,38 @@
+package main
+
+import (
+	"context"
+	"expvar"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// panicsRecovered counts the handler panics turned into errors, on /debug/vars
+var panicsRecovered = expvar.NewInt("panics_recovered")
+
+// recoverCall turns a panic into an INTERNAL error, logging its stack trace.
+// It must be deferred by the interceptor running the call's handler.
+func recoverCall(ctx context.Context, err *error) {
+	r := recover()
+	if r == nil {
+		return
+	}
+	panicsRecovered.Add(1)
+	loggerFrom(ctx).Error("Recovered from panic in handler", "panic", r, "stack", string(debug.Stack()))
+	*err = reasonError(codes.Internal, "INTERNAL_ERROR", map[string]string{"request_id": requestID(ctx)})
+}
+
+// recoveryUnaryInterceptor keeps the server alive when a unary handler panics
+func recoveryUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	defer recoverCall(ctx, &err)
+	return handler(ctx, req)
+}
+
+// recoveryStreamInterceptor keeps the server alive when a stream handler
+// panics. Panics in goroutines the handler starts aren't recovered.
+func recoveryStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer recoverCall(ss.Context(), &err)
+	return handler(srv, ss)
+}
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"expvar"
+	"sync"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+)
+
+// rectCacheMetrics counts ListFeatures cache lookups, on /debug/vars
+var rectCacheMetrics = expvar.NewMap("list_features_cache")
+
+// rectKey identifies the features of a dataset version in a rectangle, with
+// its corners canonicalized so the same viewport given either way round
+// shares an entry
+type rectKey struct {
+	version int64
+	bounds  geo.Bounds
+}
+
+// rectCacheEntry is the features found in a rectangle and when they were
+type rectCacheEntry struct {
+	features []*featureRecord
+	created  time.Time
+}
+
+// rectCache caches the features ListFeatures finds in recently requested
+// rectangles for a limited time, up to a maximum count, since kiosk-style
+// clients keep asking for the same viewports
+type rectCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	max     int
+	entries map[rectKey]rectCacheEntry
+}
+
+// newRectCache creates a rectangle cache; max <= 0 disables it, as does a nil
+// cache
+func newRectCache(max int, ttl time.Duration) *rectCache {
+	return &rectCache{
+		ttl:     ttl,
+		max:     max,
+		entries: make(map[rectKey]rectCacheEntry),
+	}
+}
+
+// inRect returns the features of d in rect, from the cache if a fresh entry
+// is present. The slice is shared and must not be modified.
+func (c *rectCache) inRect(d *dataset, rect *pb.Rectangle) []*featureRecord {
+	if c == nil || c.max <= 0 {
+		return d.inRect(rect)
+	}
+	key := rectKey{version: d.version, bounds: geo.Canonical(rect)}
+	if features, ok := c.get(key); ok {
+		rectCacheMetrics.Add("hits", 1)
+		return features
+	}
+	rectCacheMetrics.Add("misses", 1)
+	features := d.inRect(rect)
+	c.put(key, features)
+	return features
+}
+
+// get returns cached features if they are present and fresh
+func (c *rectCache) get(key rectKey) ([]*featureRecord, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || time.Since(entry.created) > c.ttl {
+		return nil, false
+	}
+	return entry.features, true
+}
+
+// put stores features, dropping expired entries (or, failing that, arbitrary
+// ones) when full
+func (c *rectCache) put(key rectKey, features []*featureRecord) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= c.max {
+		for k, entry := range c.entries {
+			if time.Since(entry.created) > c.ttl {
+				delete(c.entries, k)
+			}
+		}
+		for k := range c.entries {
+			if len(c.entries) < c.max {
+				break
+			}
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = rectCacheEntry{features: features, created: time.Now()}
+}
+
+// purge empties the cache, returning how many entries it dropped
+func (c *rectCache) purge() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	n := len(c.entries)
+	c.entries = make(map[rectKey]rectCacheEntry)
+	return n
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// featureNameField is the field holding feature names
+const featureNameField protoreflect.FullName = "routeguide.Feature.name"
+
+// redactionPolicy strips configured fields from the responses sent to callers
+// without the admin role
+type redactionPolicy struct {
+	fields map[protoreflect.FullName]bool
+}
+
+// newRedactionPolicy parses a comma-separated list of fully-qualified field
+// names, such as routeguide.RouteNote.message
+func newRedactionPolicy(fieldList string) (*redactionPolicy, error) {
+	p := &redactionPolicy{fields: make(map[protoreflect.FullName]bool)}
+	for _, name := range strings.Split(fieldList, ",") {
+		name = strings.TrimSpace(name)
+		desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+		if err != nil {
+			return nil, fmt.Errorf("unknown field %q", name)
+		}
+		if _, ok := desc.(protoreflect.FieldDescriptor); !ok {
+			return nil, fmt.Errorf("%q is not a field", name)
+		}
+		p.fields[desc.FullName()] = true
+	}
+	return p, nil
+}
+
+// applies reports whether a caller's responses must be redacted
+func (p *redactionPolicy) applies(ctx context.Context) bool {
+	return !principalFromContext(ctx).HasRole(adminRole)
+}
+
+// redact returns a copy of m without the configured fields. Responses often
+// share messages with the dataset, so the original is never modified.
+func (p *redactionPolicy) redact(m any) any {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return m
+	}
+	msg = proto.Clone(msg)
+	p.strip(msg.ProtoReflect())
+	return msg
+}
+
+// strip clears the configured fields of m and of every message nested in it
+func (p *redactionPolicy) strip(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case p.fields[fd.FullName()]:
+			m.Clear(fd)
+		case fd.IsMap() && fd.MapValue().Message() != nil:
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				p.strip(mv.Message())
+				return true
+			})
+		case fd.IsList() && fd.Message() != nil:
+			list := v.List()
+			for i := 0; i < list.Len(); i++ {
+				p.strip(list.Get(i).Message())
+			}
+		case fd.Message() != nil && !fd.IsList() && !fd.IsMap():
+			p.strip(v.Message())
+		}
+		return true
+	})
+}
+
+// unaryInterceptor redacts unary responses
+func (p *redactionPolicy) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	if err != nil || !p.applies(ctx) {
+		return resp, err
+	}
+	return p.redact(resp), nil
+}
+
+// streamInterceptor redacts every message sent on a stream
+func (p *redactionPolicy) streamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !p.applies(ss.Context()) {
+		return handler(srv, ss)
+	}
+	return handler(srv, &redactingStream{ServerStream: ss, policy: p})
+}
+
+// redactingStream redacts the messages sent on the stream it wraps
+type redactingStream struct {
+	grpc.ServerStream
+	policy *redactionPolicy
+}
+
+func (r *redactingStream) SendMsg(m any) error {
+	return r.ServerStream.SendMsg(r.policy.redact(m))
+}
+
+// RecvMsg rejects name filters when feature names are redacted, as matching
+// them would reveal the names
+func (r *redactingStream) RecvMsg(m any) error {
+	if err := r.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if rect, ok := m.(*pb.Rectangle); ok && rect.NameFilter != nil && r.policy.fields[featureNameField] {
+		return status.Error(codes.PermissionDenied, "feature names are redacted, so they can't be filtered on")
+	}
+	return nil
+}
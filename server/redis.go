@@ -0,0 +1,103 @@
+//go:build !minimal && !no_redis
+
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var (
+	notesRedis   = flag.String("notes-redis", "", "Redis URL, redis://[:password@]host[:port][/db], of route notes shared by server replicas (notes are kept by this server when empty)")
+	revokedRedis = flag.String("revoked-credentials-redis", "", "Redis URL, redis://[:password@]host[:port][/db], of a set of revoked credentials shared by server replicas (routeguide:revoked-credentials)")
+)
+
+func init() {
+	registerExtension(extension{name: "redis", start: startRedis})
+}
+
+// startRedis keeps route notes in Redis if --notes-redis is set, and reads
+// revoked credentials from the Redis set if --revoked-credentials-redis is
+func startRedis(h *extensionHost) error {
+	if *notesRedis != "" {
+		if *notesDB != "" {
+			return errors.New("--notes-redis and --notes-db are mutually exclusive: Redis already persists the notes")
+		}
+		store, err := newRedisNoteStore(*notesRedis, *liveNotes, *archiveNotes)
+		if err != nil {
+			return fmt.Errorf("invalid --notes-redis: %v", err)
+		}
+		h.server.notes = store
+		h.dependencies = append(h.dependencies, dependency{name: "notes_redis", check: store.ping})
+		h.stops = append(h.stops, func() { store.redis.Close() })
+		slog.Info("Keeping route notes in Redis", "key_prefix", redisNotesPrefix)
+	}
+	if *revokedRedis != "" {
+		set, err := newRedisRevocationSet(*revokedRedis)
+		if err != nil {
+			return fmt.Errorf("invalid --revoked-credentials-redis: %v", err)
+		}
+		h.revoked = set
+		h.stops = append(h.stops, func() { set.redis.Close() })
+	}
+	return nil
+}
+
+// newRedisClient creates a client of the server at a
+// redis://[:password@]host[:port][/db] URL, or rediss:// for TLS. It
+// connects on the first command.
+func newRedisClient(rawURL string) (*redis.Client, error) {
+	opts, err := redis.ParseURL(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	return redis.NewClient(opts), nil
+}
+
+// redisInt converts an integer reply
+func redisInt(reply any) (int, error) {
+	n, ok := reply.(int64)
+	if !ok {
+		return 0, fmt.Errorf("unexpected Redis reply %v, want an integer", reply)
+	}
+	return int(n), nil
+}
+
+// redisArray converts an array reply
+func redisArray(reply any) ([]any, error) {
+	items, ok := reply.([]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected Redis reply %v, want an array", reply)
+	}
+	return items, nil
+}
+
+// revokedRedisKey is the Redis set of revoked credentials
+const revokedRedisKey = "routeguide:revoked-credentials"
+
+// redisRevocationSet is the Redis set of revoked credentials shared by
+// server replicas
+type redisRevocationSet struct {
+	redis *redis.Client
+}
+
+// newRedisRevocationSet creates a reader of the set in the Redis database
+// at a redis:// URL
+func newRedisRevocationSet(rawURL string) (*redisRevocationSet, error) {
+	c, err := newRedisClient(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	return &redisRevocationSet{redis: c}, nil
+}
+
+func (s *redisRevocationSet) name() string { return "redis" }
+
+func (s *redisRevocationSet) members(ctx context.Context) ([]string, error) {
+	return s.redis.SMembers(ctx, revokedRedisKey).Result()
+}
@@ -0,0 +1,149 @@
+//go:build !minimal && !no_redis
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+)
+
+// newTestRedisNoteStore creates a Redis note store on a fresh in-process
+// Redis server
+func newTestRedisNoteStore(t *testing.T, maxLive, maxArchived int) (*redisNoteStore, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	rs, err := newRedisNoteStore("redis://"+mr.Addr(), maxLive, maxArchived)
+	if err != nil {
+		t.Fatalf("newRedisNoteStore() failed: %v", err)
+	}
+	t.Cleanup(func() { rs.redis.Close() })
+	return rs, mr
+}
+
+// noteMessages returns the messages of notes
+func noteMessages(notes []*pb.RouteNote) []string {
+	var messages []string
+	for _, note := range notes {
+		messages = append(messages, note.Message)
+	}
+	return messages
+}
+
+func TestRedisNoteStoreMatchesMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	rs, _ := newTestRedisNoteStore(t, 2, 3)
+	ms := newMemoryNoteStore(2, 3)
+	point := &pb.Point{Latitude: 1, Longitude: 1}
+	key := "1,1"
+
+	for i := range 8 {
+		for name, store := range map[string]NoteStore{"redis": rs, "memory": ms} {
+			note := &pb.RouteNote{Location: point, Message: fmt.Sprintf("note %d", i)}
+			if _, _, _, err := store.Exchange(ctx, key, note); err != nil {
+				t.Fatalf("%s Exchange() failed: %v", name, err)
+			}
+		}
+	}
+
+	tests := []struct {
+		name string
+		call func(NoteStore) (string, error)
+	}{
+		{"exchange", func(s NoteStore) (string, error) {
+			prev, from, next, err := s.Exchange(ctx, key, &pb.RouteNote{Location: point, Message: "last"})
+			return fmt.Sprint(noteMessages(prev), from, next), err
+		}},
+		{"since", func(s NoteStore) (string, error) {
+			notes, next, err := s.Since(ctx, key, 0)
+			return fmt.Sprint(noteMessages(notes), next), err
+		}},
+		{"since unknown location", func(s NoteStore) (string, error) {
+			notes, next, err := s.Since(ctx, "2,2", 4)
+			return fmt.Sprint(noteMessages(notes), next), err
+		}},
+		{"history", func(s NoteStore) (string, error) {
+			notes, next, total, err := s.History(ctx, key, 0, 3, time.Time{})
+			return fmt.Sprint(noteMessages(notes), next, total), err
+		}},
+		{"dump", func(s NoteStore) (string, error) {
+			notes, err := s.Dump(ctx)
+			return fmt.Sprint(noteMessages(notes)), err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.call(rs)
+			if err != nil {
+				t.Fatalf("redis store failed: %v", err)
+			}
+			want, err := tt.call(ms)
+			if err != nil {
+				t.Fatalf("memory store failed: %v", err)
+			}
+			if got != want {
+				t.Errorf("redis store = %s, memory store = %s", got, want)
+			}
+		})
+	}
+}
+
+func TestRedisNoteStoreClearAndDeleteBefore(t *testing.T) {
+	ctx := context.Background()
+	rs, _ := newTestRedisNoteStore(t, 0, 0)
+	for lat := range int32(3) {
+		for i := range 2 {
+			note := &pb.RouteNote{Location: &pb.Point{Latitude: lat, Longitude: 1}, Message: fmt.Sprintf("note %d", i)}
+			if _, _, _, err := rs.Exchange(ctx, fmt.Sprintf("%d,1", lat), note); err != nil {
+				t.Fatalf("Exchange() failed: %v", err)
+			}
+		}
+	}
+
+	if n, err := rs.Clear(ctx, "0,1"); err != nil || n != 2 {
+		t.Fatalf("Clear() at a location = %d, %v, want 2", n, err)
+	}
+	if n, err := rs.DeleteBefore(ctx, time.Now().Add(time.Second)); err != nil || n != 4 {
+		t.Fatalf("DeleteBefore() = %d, %v, want 4", n, err)
+	}
+	// Deleted notes still count towards the positions of later ones
+	if _, next, err := rs.Since(ctx, "1,1", 0); err != nil || next != 2 {
+		t.Errorf("Since() after deleting = next %d, %v, want 2", next, err)
+	}
+	if n, err := rs.Clear(ctx, ""); err != nil || n != 0 {
+		t.Errorf("Clear() everywhere = %d, %v, want nothing left to clear", n, err)
+	}
+}
+
+func TestRedisRevocationSet(t *testing.T) {
+	mr := miniredis.RunT(t)
+	set, err := newRedisRevocationSet("redis://" + mr.Addr())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer set.redis.Close()
+	l, err := newRevocationList("", set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	principal := &Principal{Name: "alice", TokenID: "t1"}
+
+	if err := l.check(ctx, principal); err == nil {
+		t.Error("check() before the set loads accepted the call")
+	}
+	mr.SAdd(revokedRedisKey, "jti:t1", "not-an-entry")
+	if err := l.checkShared(ctx); err != nil {
+		t.Fatalf("checkShared() failed: %v", err)
+	}
+	if err := l.check(ctx, principal); err == nil {
+		t.Error("check() accepted a revoked token")
+	}
+	if err := l.check(ctx, &Principal{Name: "bob"}); err != nil {
+		t.Errorf("check() rejected a credential that isn't revoked: %v", err)
+	}
+}
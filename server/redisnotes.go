@@ -0,0 +1,330 @@
+//go:build !minimal && !no_redis
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// redisNotesPrefix prefixes the Redis keys of route notes. The notes at a
+// location are the list prefix:<location>, with the number of notes evicted
+// before them in prefix:<location>:dropped; prefix:locations is the set of
+// locations with notes.
+const redisNotesPrefix = "routeguide:notes:"
+
+// redisNoteStore keeps route notes in Redis, shared by every server using
+// the same Redis database. Each operation runs as a Lua script, so it is
+// atomic across servers just like the memory store's operations are under
+// its lock. Notes are stamped with the receiving server's clock, so as_of
+// queries assume the servers' clocks agree.
+type redisNoteStore struct {
+	redis       *redis.Client
+	maxLive     int // notes replayed per location, 0 for unlimited
+	maxArchived int // archived notes kept per location, 0 for unlimited
+}
+
+// newRedisNoteStore creates a note store in the Redis database at a
+// redis:// URL
+func newRedisNoteStore(rawURL string, maxLive, maxArchived int) (*redisNoteStore, error) {
+	c, err := newRedisClient(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	return &redisNoteStore{redis: c, maxLive: maxLive, maxArchived: maxArchived}, nil
+}
+
+// ping checks the Redis server responds
+func (rs *redisNoteStore) ping(ctx context.Context) error {
+	return rs.redis.Ping(ctx).Err()
+}
+
+// maxRetained returns how many notes are kept per location, 0 for unlimited
+func (rs *redisNoteStore) maxRetained() int {
+	if rs.maxLive == 0 || rs.maxArchived == 0 {
+		return 0
+	}
+	return rs.maxLive + rs.maxArchived
+}
+
+// redisExchangeScript returns the live notes at a location, appends a note
+// and trims the list to the notes retained.
+// KEYS: list, dropped, locations; ARGV: note, max live, max retained, location.
+var redisExchangeScript = redis.NewScript(`
+local n = redis.call('LLEN', KEYS[1])
+local dropped = tonumber(redis.call('GET', KEYS[2]) or '0')
+local first = 0
+local live = tonumber(ARGV[2])
+if live > 0 and n > live then first = n - live end
+local prev = redis.call('LRANGE', KEYS[1], first, -1)
+n = redis.call('RPUSH', KEYS[1], ARGV[1])
+redis.call('SADD', KEYS[3], ARGV[4])
+local cap = tonumber(ARGV[3])
+if cap > 0 and n > cap then
+  redis.call('LTRIM', KEYS[1], n - cap, -1)
+  redis.call('INCRBY', KEYS[2], n - cap)
+end
+return {dropped + first, dropped + n, prev}
+`)
+
+func (rs *redisNoteStore) Exchange(ctx context.Context, key string, note *pb.RouteNote) (prev []*pb.RouteNote, from, next int, err error) {
+	note.ReceivedAt = timestamppb.Now()
+	data, err := proto.Marshal(note)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	list := redisNotesPrefix + key
+	reply, err := redisExchangeScript.Run(ctx, rs.redis, []string{list, list + ":dropped", redisNotesPrefix + "locations"},
+		data, rs.maxLive, rs.maxRetained(), key).Result()
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	items, err := redisArray(reply)
+	if err != nil || len(items) != 3 {
+		return nil, 0, 0, fmt.Errorf("unexpected Redis reply %v", reply)
+	}
+	if from, err = redisInt(items[0]); err != nil {
+		return nil, 0, 0, err
+	}
+	if next, err = redisInt(items[1]); err != nil {
+		return nil, 0, 0, err
+	}
+	if prev, err = decodeRedisNotes(items[2]); err != nil {
+		return nil, 0, 0, err
+	}
+	return prev, from, next, nil
+}
+
+// redisSinceScript returns the position following the notes at a location
+// and its live notes from a position on.
+// KEYS: list, dropped, locations; ARGV: offset, max live, location.
+var redisSinceScript = redis.NewScript(`
+local offset = tonumber(ARGV[1])
+if redis.call('SISMEMBER', KEYS[3], ARGV[3]) == 0 then return {offset, {}} end
+local n = redis.call('LLEN', KEYS[1])
+local dropped = tonumber(redis.call('GET', KEYS[2]) or '0')
+local liveStart = dropped
+local live = tonumber(ARGV[2])
+if live > 0 and n > live then liveStart = dropped + n - live end
+if offset < liveStart then offset = liveStart end
+local notes = {}
+if offset < dropped + n then notes = redis.call('LRANGE', KEYS[1], offset - dropped, -1) end
+return {dropped + n, notes}
+`)
+
+func (rs *redisNoteStore) Since(ctx context.Context, key string, offset int) (notes []*pb.RouteNote, next int, err error) {
+	list := redisNotesPrefix + key
+	reply, err := redisSinceScript.Run(ctx, rs.redis, []string{list, list + ":dropped", redisNotesPrefix + "locations"},
+		offset, rs.maxLive, key).Result()
+	if err != nil {
+		return nil, 0, err
+	}
+	items, err := redisArray(reply)
+	if err != nil || len(items) != 2 {
+		return nil, 0, fmt.Errorf("unexpected Redis reply %v", reply)
+	}
+	if next, err = redisInt(items[0]); err != nil {
+		return nil, 0, err
+	}
+	if notes, err = decodeRedisNotes(items[1]); err != nil {
+		return nil, 0, err
+	}
+	return notes, next, nil
+}
+
+// redisHistoryScript returns the number of notes evicted at a location, the
+// number retained and count retained notes from index start on (all of
+// them if count is negative).
+// KEYS: list, dropped; ARGV: start, count.
+var redisHistoryScript = redis.NewScript(`
+local n = redis.call('LLEN', KEYS[1])
+local dropped = tonumber(redis.call('GET', KEYS[2]) or '0')
+local start = math.max(tonumber(ARGV[1]) - dropped, 0)
+local stop = -1
+local count = tonumber(ARGV[2])
+if count >= 0 then stop = start + count - 1 end
+return {dropped, n, start, redis.call('LRANGE', KEYS[1], start, stop)}
+`)
+
+// History only fetches the requested page, unless asOf is set: finding the
+// notes received by then takes the whole history.
+func (rs *redisNoteStore) History(ctx context.Context, key string, offset, limit int, asOf time.Time) (notes []*pb.RouteNote, next, total int, err error) {
+	if limit <= 0 {
+		return nil, 0, 0, nil
+	}
+	start, count := offset, limit
+	if !asOf.IsZero() {
+		start, count = 0, -1
+	}
+	list := redisNotesPrefix + key
+	reply, err := redisHistoryScript.Run(ctx, rs.redis, []string{list, list + ":dropped"}, start, count).Result()
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	items, err := redisArray(reply)
+	if err != nil || len(items) != 4 {
+		return nil, 0, 0, fmt.Errorf("unexpected Redis reply %v", reply)
+	}
+	var dropped, retained, first int
+	for i, n := range []*int{&dropped, &retained, &first} {
+		if *n, err = redisInt(items[i]); err != nil {
+			return nil, 0, 0, err
+		}
+	}
+	fetched, err := decodeRedisNotes(items[3])
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	notes, next, total = historyPage(retained, dropped, func(i int) *pb.RouteNote {
+		return fetched[i-first]
+	}, offset, limit, asOf)
+	return notes, next, total, nil
+}
+
+func (rs *redisNoteStore) Dump(ctx context.Context) ([]*pb.RouteNote, error) {
+	keys, err := rs.redis.SMembers(ctx, redisNotesPrefix+"locations").Result()
+	if err != nil {
+		return nil, err
+	}
+	slices.Sort(keys)
+
+	var notes []*pb.RouteNote
+	for _, key := range keys {
+		values, err := rs.redis.LRange(ctx, redisNotesPrefix+key, 0, -1).Result()
+		if err != nil {
+			return nil, err
+		}
+		retained, err := decodeRedisNoteList(values)
+		if err != nil {
+			return nil, err
+		}
+		notes = append(notes, retained...)
+	}
+	return notes, nil
+}
+
+// redisClearScript deletes the notes at a location, or at every location if
+// none is given, counting them as evicted, and returns how many it deleted.
+// KEYS: locations; ARGV: prefix, location.
+var redisClearScript = redis.NewScript(`
+local locations = {ARGV[2]}
+if ARGV[2] == '' then locations = redis.call('SMEMBERS', KEYS[1]) end
+local cleared = 0
+for _, location in ipairs(locations) do
+  local list = ARGV[1] .. location
+  local n = redis.call('LLEN', list)
+  if n > 0 then
+    redis.call('INCRBY', list .. ':dropped', n)
+    redis.call('DEL', list)
+    cleared = cleared + n
+  end
+end
+return cleared
+`)
+
+func (rs *redisNoteStore) Clear(ctx context.Context, key string) (int, error) {
+	reply, err := redisClearScript.Run(ctx, rs.redis, []string{redisNotesPrefix + "locations"}, redisNotesPrefix, key).Result()
+	if err != nil {
+		return 0, err
+	}
+	return redisInt(reply)
+}
+
+// redisTrimScript deletes the first notes of a location, counting them as
+// evicted, unless the last of them is no longer where it was read, e.g.
+// because another server trimmed the list meanwhile.
+// KEYS: list, dropped; ARGV: count, last note.
+var redisTrimScript = redis.NewScript(`
+local n = tonumber(ARGV[1])
+if redis.call('LINDEX', KEYS[1], n - 1) ~= ARGV[2] then return 0 end
+redis.call('LTRIM', KEYS[1], n, -1)
+redis.call('INCRBY', KEYS[2], n)
+return n
+`)
+
+// DeleteBefore reads each location's notes and trims the old ones off the
+// front of its list. A location whose list changed in between is left for
+// the next call.
+func (rs *redisNoteStore) DeleteBefore(ctx context.Context, cutoff time.Time) (int, error) {
+	keys, err := rs.redis.SMembers(ctx, redisNotesPrefix+"locations").Result()
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for _, key := range keys {
+		list := redisNotesPrefix + key
+		values, err := rs.redis.LRange(ctx, list, 0, -1).Result()
+		if err != nil {
+			return deleted, err
+		}
+		retained, err := decodeRedisNoteList(values)
+		if err != nil {
+			return deleted, err
+		}
+		n := len(retained)
+		if i := slices.IndexFunc(retained, func(note *pb.RouteNote) bool { return !note.ReceivedAt.AsTime().Before(cutoff) }); i >= 0 {
+			n = i
+		}
+		if n == 0 {
+			continue
+		}
+		last, err := proto.Marshal(retained[n-1])
+		if err != nil {
+			return deleted, err
+		}
+		reply, err := redisTrimScript.Run(ctx, rs.redis, []string{list, list + ":dropped"}, n, last).Result()
+		if err != nil {
+			return deleted, err
+		}
+		trimmed, err := redisInt(reply)
+		if err != nil {
+			return deleted, err
+		}
+		deleted += trimmed
+	}
+	return deleted, nil
+}
+
+// DropArchived evicts nothing: the notes are in Redis, not in this server's
+// memory
+func (rs *redisNoteStore) DropArchived(ctx context.Context) (int, error) {
+	return 0, nil
+}
+
+// decodeRedisNotes decodes an array reply of serialized notes
+func decodeRedisNotes(reply any) ([]*pb.RouteNote, error) {
+	items, err := redisArray(reply)
+	if err != nil {
+		return nil, err
+	}
+	values := make([]string, len(items))
+	for i, item := range items {
+		data, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("unexpected Redis reply %v, want a note", item)
+		}
+		values[i] = data
+	}
+	return decodeRedisNoteList(values)
+}
+
+// decodeRedisNoteList decodes serialized notes
+func decodeRedisNoteList(values []string) ([]*pb.RouteNote, error) {
+	notes := make([]*pb.RouteNote, 0, len(values))
+	for _, data := range values {
+		note := &pb.RouteNote{}
+		if err := proto.Unmarshal([]byte(data), note); err != nil {
+			return nil, fmt.Errorf("invalid note in Redis: %v", err)
+		}
+		notes = append(notes, note)
+	}
+	return notes, nil
+}
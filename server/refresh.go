@@ -0,0 +1,183 @@
+//go:build !minimal && !no_refresh
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// maxRemoteDatasetSize caps how much a refresh downloads
+	maxRemoteDatasetSize = 64 << 20
+	// refreshTimeout bounds a single refresh attempt
+	refreshTimeout = time.Minute
+)
+
+var (
+	featuresURL = flag.String("features-url", "", "Remote features source (http(s):// or s3://bucket/key) to refresh the dataset from")
+	refreshSpec = flag.String("refresh-schedule", "@every 15m", "When to refresh from --features-url: \"@every <duration>\", @hourly, @daily or a 5-field cron expression")
+)
+
+func init() {
+	registerExtension(extension{name: "dataset refresh", start: startRefresh})
+}
+
+// startRefresh refreshes the dataset on schedule if --features-url is set
+func startRefresh(h *extensionHost) error {
+	if *featuresURL == "" {
+		return nil
+	}
+	refresher, err := newDatasetRefresher(h.server, *featuresURL, *refreshSpec, h.breakers)
+	if err != nil {
+		return err
+	}
+	go refresher.run(h.ctx)
+	slog.Info("Refreshing features", "url", *featuresURL, "schedule", *refreshSpec)
+	return nil
+}
+
+// datasetRefresher periodically pulls the features from a remote source and
+// swaps them in when they change
+type datasetRefresher struct {
+	server   *routeGuideServer
+	source   string // the configured source, as given by the operator
+	url      string // the HTTP(S) URL the source is fetched from
+	schedule schedule
+	client   *http.Client
+	breaker  *circuitBreaker // skips fetches while the source keeps failing
+}
+
+// newDatasetRefresher creates a refresher for an http(s):// or s3:// source
+func newDatasetRefresher(s *routeGuideServer, source, spec string, breaker breakerConfig) (*datasetRefresher, error) {
+	fetchURL, err := resolveSourceURL(source)
+	if err != nil {
+		return nil, err
+	}
+
+	sched, err := parseSchedule(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &datasetRefresher{
+		server:   s,
+		source:   source,
+		url:      fetchURL,
+		schedule: sched,
+		client:   &http.Client{Timeout: refreshTimeout},
+		breaker:  breaker.new("features_source"),
+	}, nil
+}
+
+// resolveSourceURL maps a source to the HTTP(S) URL it is fetched from.
+// s3://bucket/key is read through the bucket's virtual-hosted HTTPS endpoint,
+// which works for public objects; use a presigned https:// URL for private ones.
+func resolveSourceURL(source string) (string, error) {
+	u, err := url.Parse(source)
+	if err != nil {
+		return "", fmt.Errorf("invalid features source %q: %v", source, err)
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+		return source, nil
+	case "s3":
+		key := strings.TrimPrefix(u.Path, "/")
+		if u.Host == "" || key == "" {
+			return "", fmt.Errorf("invalid features source %q: expected s3://bucket/key", source)
+		}
+		return fmt.Sprintf("https://%s.s3.amazonaws.com/%s", u.Host, key), nil
+	default:
+		return "", fmt.Errorf("unsupported features source scheme %q", u.Scheme)
+	}
+}
+
+// run refreshes once immediately, then on every scheduled tick until ctx is cancelled
+func (r *datasetRefresher) run(ctx context.Context) {
+	for {
+		if err := r.refresh(ctx); err != nil {
+			slog.Error("Dataset refresh failed", "source", r.source, "error", err)
+		}
+
+		next := r.schedule.next(time.Now())
+		if next.IsZero() {
+			slog.Warn("Dataset refresh schedule has no future runs, stopping")
+			return
+		}
+		slog.Info("Next dataset refresh scheduled", "at", next.Format(time.RFC3339))
+
+		select {
+		case <-time.After(time.Until(next)):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// refresh downloads, validates and, if it changed, swaps in the remote dataset.
+// An invalid download leaves the current dataset in place.
+func (r *datasetRefresher) refresh(ctx context.Context) error {
+	if err := r.server.checkWritable(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, refreshTimeout)
+	defer cancel()
+
+	start := time.Now()
+	if err := r.breaker.allow(); err != nil {
+		return err
+	}
+	data, err := r.fetch(ctx)
+	r.breaker.done(err)
+	if err != nil {
+		return err
+	}
+
+	next, err := parseDataset(data, r.source, r.server.strictLoad)
+	if err != nil {
+		return fmt.Errorf("invalid dataset: %v", err)
+	}
+	next.loadDuration = time.Since(start)
+	next.buildIndex(nil)
+
+	if !r.server.swapDataset(next) {
+		slog.Info("Dataset unchanged", "source", r.source)
+	}
+	return nil
+}
+
+// fetch downloads the remote dataset
+func (r *datasetRefresher) fetch(ctx context.Context) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %s", resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxRemoteDatasetSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxRemoteDatasetSize {
+		return nil, fmt.Errorf("dataset larger than %d bytes", maxRemoteDatasetSize)
+	}
+	return data, nil
+}
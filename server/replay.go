@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxReplayPoints caps the points of a replayed route file
+const maxReplayPoints = 100000
+
+// timedPoint is a point of a route file, with the time it was reached if known
+type timedPoint struct {
+	point *pb.Point
+	time  time.Time
+}
+
+// gpxDocument is the part of a GPX document holding track points
+type gpxDocument struct {
+	Tracks []struct {
+		Segments []struct {
+			Points []struct {
+				Lat  float64   `xml:"lat,attr"`
+				Lon  float64   `xml:"lon,attr"`
+				Time time.Time `xml:"time"`
+			} `xml:"trkpt"`
+		} `xml:"trkseg"`
+	} `xml:"trk"`
+}
+
+// jsonRoutePoint is a point of a JSON route file
+type jsonRoutePoint struct {
+	Latitude  int32     `json:"latitude"`
+	Longitude int32     `json:"longitude"`
+	Time      time.Time `json:"time"`
+}
+
+// parseRouteFile decodes the points of a GPX or JSON route file
+func parseRouteFile(data []byte, format pb.RouteFileFormat) ([]timedPoint, error) {
+	if format == pb.RouteFileFormat_ROUTE_FILE_FORMAT_UNSPECIFIED {
+		format = pb.RouteFileFormat_ROUTE_FILE_FORMAT_GPX
+		if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+			format = pb.RouteFileFormat_ROUTE_FILE_FORMAT_JSON
+		}
+	}
+
+	var points []timedPoint
+	switch format {
+	case pb.RouteFileFormat_ROUTE_FILE_FORMAT_GPX:
+		var doc gpxDocument
+		if err := xml.Unmarshal(data, &doc); err != nil {
+			return nil, fmt.Errorf("invalid GPX: %v", err)
+		}
+		for _, track := range doc.Tracks {
+			for _, segment := range track.Segments {
+				for _, p := range segment.Points {
+					point := &pb.Point{Latitude: geo.E7(p.Lat), Longitude: geo.E7(p.Lon)}
+					points = append(points, timedPoint{point: point, time: p.Time})
+				}
+			}
+		}
+	case pb.RouteFileFormat_ROUTE_FILE_FORMAT_JSON:
+		var raw []jsonRoutePoint
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, fmt.Errorf("invalid JSON: %v", err)
+		}
+		for _, p := range raw {
+			points = append(points, timedPoint{point: &pb.Point{Latitude: p.Latitude, Longitude: p.Longitude}, time: p.Time})
+		}
+	default:
+		return nil, fmt.Errorf("unknown format %v", format)
+	}
+
+	if len(points) == 0 {
+		return nil, fmt.Errorf("the file has no points")
+	}
+	for i, p := range points {
+		if err := geo.ValidatePoint(p.point); err != nil {
+			return nil, fmt.Errorf("point %d: %v", i, err)
+		}
+	}
+	return points, nil
+}
+
+// ReplayRoute replays a stored route file through the RecordRoute logic (unary RPC)
+func (a *adminServer) ReplayRoute(ctx context.Context, req *pb.ReplayRouteRequest) (*pb.RouteSummary, error) {
+	loggerFrom(ctx).Info("ReplayRoute called", "bytes", len(req.Data), "format", req.Format, "profile", req.Profile, "strict", req.Strict)
+
+	points, err := parseRouteFile(req.Data, req.Format)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid route file: %v", err)
+	}
+	if len(points) > maxReplayPoints {
+		return nil, status.Errorf(codes.InvalidArgument, "route files may contain at most %d points", maxReplayPoints)
+	}
+
+	s := a.server
+	recorder := newRouteRecorder(s.featuresOf(s.current()), time.Now(), s.routeLimits(req.Profile), req.Strict, loggerFrom(ctx))
+	// Points without a time are taken to be reached with the previous one,
+	// so they don't count towards speeds
+	var first, last time.Time
+	for _, p := range points {
+		if !p.time.IsZero() {
+			if first.IsZero() {
+				first = p.time
+			}
+			last = p.time
+		}
+		if err := recorder.add(ctx, p.point, last); err != nil {
+			return nil, err
+		}
+	}
+
+	return s.finishRoute(recorder, last.Sub(first), req.Profile, time.Now()), nil
+}
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"bufio"
+	"cmp"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// dailyUsage aggregates a day of access log records (UTC)
+type dailyUsage struct {
+	Date          string         `json:"date"`
+	Calls         int            `json:"calls"`
+	Errors        int            `json:"errors"`
+	Methods       map[string]int `json:"methods"` // calls by method
+	TopFeatures   []usageCount   `json:"top_features"`
+	UniqueClients int            `json:"unique_clients"`
+
+	features map[string]int
+	clients  map[string]bool
+}
+
+// usageCount is how many times something was used
+type usageCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// usageReport builds daily usage from access log records
+type usageReport struct {
+	days    map[string]*dailyUsage
+	skipped int // malformed lines
+}
+
+func newUsageReport() *usageReport {
+	return &usageReport{days: make(map[string]*dailyUsage)}
+}
+
+// read adds the records of an access log, skipping malformed lines such as
+// one cut short by a crash
+func (r *usageReport) read(in io.Reader) error {
+	scanner := bufio.NewScanner(in)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var rec accessRecord
+		if err := json.Unmarshal(scanner.Bytes(), &rec); err != nil || rec.Method == "" {
+			r.skipped++
+			continue
+		}
+		r.add(rec)
+	}
+	return scanner.Err()
+}
+
+// add counts a record in its day
+func (r *usageReport) add(rec accessRecord) {
+	date := rec.Time.UTC().Format("2006-01-02")
+	day := r.days[date]
+	if day == nil {
+		day = &dailyUsage{Date: date, Methods: make(map[string]int), features: make(map[string]int), clients: make(map[string]bool)}
+		r.days[date] = day
+	}
+	day.Calls++
+	if rec.Code != "OK" {
+		day.Errors++
+	}
+	day.Methods[strings.TrimPrefix(rec.Method, "/")]++
+	if rec.Feature != "" {
+		day.features[rec.Feature]++
+	}
+	day.clients[rec.Client] = true
+}
+
+// summary returns the days in order, each with its top features, most used
+// first
+func (r *usageReport) summary(top int) []*dailyUsage {
+	var days []*dailyUsage
+	for _, date := range slices.Sorted(maps.Keys(r.days)) {
+		day := r.days[date]
+		day.UniqueClients = len(day.clients)
+		day.TopFeatures = []usageCount{}
+		for name, count := range day.features {
+			day.TopFeatures = append(day.TopFeatures, usageCount{Name: name, Count: count})
+		}
+		slices.SortFunc(day.TopFeatures, func(a, b usageCount) int {
+			return cmp.Or(b.Count-a.Count, strings.Compare(a.Name, b.Name))
+		})
+		if len(day.TopFeatures) > top {
+			day.TopFeatures = day.TopFeatures[:top]
+		}
+		days = append(days, day)
+	}
+	return days
+}
+
+// writeUsageCSV writes days as date,metric,name,count rows: one each for the
+// calls, errors and unique clients, then one per method and top feature
+func writeUsageCSV(w io.Writer, days []*dailyUsage) error {
+	out := csv.NewWriter(w)
+	out.Write([]string{"date", "metric", "name", "count"})
+	for _, day := range days {
+		out.Write([]string{day.Date, "calls", "", strconv.Itoa(day.Calls)})
+		out.Write([]string{day.Date, "errors", "", strconv.Itoa(day.Errors)})
+		out.Write([]string{day.Date, "unique_clients", "", strconv.Itoa(day.UniqueClients)})
+		for _, method := range slices.Sorted(maps.Keys(day.Methods)) {
+			out.Write([]string{day.Date, "method", method, strconv.Itoa(day.Methods[method])})
+		}
+		for _, feature := range day.TopFeatures {
+			out.Write([]string{day.Date, "feature", feature.Name, strconv.Itoa(feature.Count)})
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
+
+// runReport aggregates access logs written with --access-log into a daily
+// usage report
+func runReport(args []string) error {
+	fs := flag.NewFlagSet("report", flag.ExitOnError)
+	format := fs.String("format", "json", "Report format: json or csv")
+	top := fs.Int("top", 10, "How many of the most looked up features to list per day")
+	output := fs.String("o", "", "File to write the report to (standard output when empty)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: server [flags] report [options] [ACCESS_LOG...]")
+		fmt.Fprintln(fs.Output(), "Reads standard input when no access log is given.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if *format != "json" && *format != "csv" {
+		return fmt.Errorf("unknown report format %q (available: json, csv)", *format)
+	}
+
+	report := newUsageReport()
+	if fs.NArg() == 0 {
+		if err := report.read(os.Stdin); err != nil {
+			return err
+		}
+	}
+	for _, path := range fs.Args() {
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		err = report.read(file)
+		file.Close()
+		if err != nil {
+			return fmt.Errorf("reading %s: %v", path, err)
+		}
+	}
+	if report.skipped > 0 {
+		fmt.Fprintf(os.Stderr, "Skipped %d malformed lines\n", report.skipped)
+	}
+
+	w := os.Stdout
+	if *output != "" {
+		file, err := os.Create(*output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+	days := report.summary(*top)
+	if *format == "csv" {
+		return writeUsageCSV(w, days)
+	}
+	if days == nil {
+		days = []*dailyUsage{}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(days)
+}
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// requestIDKey is the metadata key tagging a call with a request ID, sent
+	// by the client or generated by the server, and echoed in the response
+	// headers so clients can correlate failures with the server's logs
+	requestIDKey = "x-request-id"
+	// legacyRequestIDKey is the key clients tagged calls with before
+	// requestIDKey, still accepted
+	legacyRequestIDKey = "request-id"
+	// maxRequestIDLength bounds the request IDs accepted from clients;
+	// longer ones are replaced
+	maxRequestIDLength = 128
+)
+
+// requestIDContextKey is the context key of a call's request ID
+type requestIDContextKey struct{}
+
+// requestID returns the request ID of the call ctx belongs to, or "" outside calls
+func requestID(ctx context.Context) string {
+	if id, ok := ctx.Value(requestIDContextKey{}).(string); ok {
+		return id
+	}
+	return ""
+}
+
+// incomingRequestID returns the request ID the client tagged its call with,
+// or a new one
+func incomingRequestID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	for _, key := range []string{requestIDKey, legacyRequestIDKey} {
+		if values := md.Get(key); len(values) > 0 && values[0] != "" && len(values[0]) <= maxRequestIDLength {
+			return values[0]
+		}
+	}
+	return randomIDs{}.NewID()
+}
+
+// requestIDUnaryInterceptor attaches a request ID to unary calls and returns
+// it in the response headers
+func requestIDUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	id := incomingRequestID(ctx)
+	grpc.SetHeader(ctx, metadata.Pairs(requestIDKey, id))
+	return handler(context.WithValue(ctx, requestIDContextKey{}, id), req)
+}
+
+// requestIDStreamInterceptor attaches a request ID to streams and returns it
+// in the response headers
+func requestIDStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	id := incomingRequestID(ss.Context())
+	ss.SetHeader(metadata.Pairs(requestIDKey, id))
+	return handler(srv, &contextStream{ServerStream: ss, ctx: context.WithValue(ss.Context(), requestIDContextKey{}, id)})
+}
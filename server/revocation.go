@@ -0,0 +1,228 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"maps"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// revocationSet is a set of revoked credentials shared by server replicas,
+// such as the Redis set --revoked-credentials-redis names
+type revocationSet interface {
+	name() string                                  // the backend, naming its dependency
+	members(ctx context.Context) ([]string, error) // the entries in the set
+}
+
+// revocationList holds the credentials that no longer authenticate, even
+// though they are valid, read from a file, a shared set or both. Entries are
+// one of:
+//
+//	sha256:<hex>     the SHA-256 of a bearer token or API key
+//	jti:<id>         the JWT with this "jti" claim
+//	principal:<name> every credential of a principal
+//
+// Both sources are polled for changes, and a source that no longer loads
+// keeps its last entries.
+type revocationList struct {
+	path   string        // file of entries, one per line, or empty
+	shared revocationSet // shared set of entries, or nil
+
+	mu           sync.Mutex // serializes reloads
+	modTime      time.Time
+	fromFile     map[string]bool
+	fromShared   map[string]bool // nil until the set is first loaded
+	revoked      atomic.Pointer[map[string]bool]
+	sharedLoaded atomic.Bool
+}
+
+// newRevocationList reads the revoked credentials in the file at path, if
+// any. The shared set, if any, is loaded by watch or checkShared.
+func newRevocationList(path string, shared revocationSet) (*revocationList, error) {
+	l := &revocationList{path: path, shared: shared}
+	if path != "" {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if l.fromFile, err = readRevocationFile(path); err != nil {
+			return nil, err
+		}
+		l.modTime = info.ModTime()
+	}
+	l.publish()
+	return l, nil
+}
+
+// readRevocationFile reads revocation entries, one per line. Blank lines and
+// lines starting with # are ignored.
+func readRevocationFile(path string) (map[string]bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	entries := make(map[string]bool)
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		entry := strings.TrimSpace(scanner.Text())
+		if entry == "" || strings.HasPrefix(entry, "#") {
+			continue
+		}
+		if err := validateRevocation(entry); err != nil {
+			return nil, fmt.Errorf("%s:%d: %v", path, line, err)
+		}
+		entries[entry] = true
+	}
+	return entries, scanner.Err()
+}
+
+// validateRevocation checks an entry has a known kind and a value
+func validateRevocation(entry string) error {
+	kind, value, _ := strings.Cut(entry, ":")
+	switch {
+	case value == "":
+		return fmt.Errorf("revocation %q has no value", entry)
+	case kind == "sha256":
+		if b, err := hex.DecodeString(value); err != nil || len(b) != sha256.Size || value != strings.ToLower(value) {
+			return fmt.Errorf("revocation %q is not a lowercase hex SHA-256", entry)
+		}
+	case kind != "jti" && kind != "principal":
+		return fmt.Errorf("revocation %q is not sha256:, jti: or principal:", entry)
+	}
+	return nil
+}
+
+// publish makes the union of the sources' entries the revoked set, and logs
+// its size when it changed
+func (l *revocationList) publish() {
+	revoked := maps.Clone(l.fromFile)
+	if revoked == nil {
+		revoked = make(map[string]bool, len(l.fromShared))
+	}
+	maps.Copy(revoked, l.fromShared)
+	if previous := l.revoked.Swap(&revoked); previous == nil || !maps.Equal(*previous, revoked) {
+		slog.Info("Revoked credentials changed", "count", len(revoked))
+	}
+}
+
+// reloadFile reads the file again if it changed. A file that no longer
+// loads is logged and its current entries kept.
+func (l *revocationList) reloadFile() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	info, err := os.Stat(l.path)
+	if err != nil {
+		slog.Error("Keeping the current revoked credentials", "error", err)
+		return
+	}
+	if info.ModTime().Equal(l.modTime) {
+		return
+	}
+	entries, err := readRevocationFile(l.path)
+	if err != nil {
+		slog.Error("Keeping the current revoked credentials", "error", err)
+		return
+	}
+	l.modTime = info.ModTime()
+	l.fromFile = entries
+	l.publish()
+}
+
+// checkShared reads the shared set of revoked credentials. Invalid members
+// are logged and skipped, so one bad entry can't void the others.
+func (l *revocationList) checkShared(ctx context.Context) error {
+	members, err := l.shared.members(ctx)
+	if err != nil {
+		return err
+	}
+	entries := make(map[string]bool, len(members))
+	for _, entry := range members {
+		if err := validateRevocation(entry); err != nil {
+			slog.Warn("Ignoring a malformed revoked credential", "source", l.shared.name(), "error", err)
+			continue
+		}
+		entries[entry] = true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.fromShared = entries
+	l.publish()
+	l.sharedLoaded.Store(true)
+	return nil
+}
+
+// watch polls the sources for changes every interval until ctx is done
+func (l *revocationList) watch(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if l.path != "" {
+				l.reloadFile()
+			}
+			if l.shared != nil {
+				if err := l.checkShared(ctx); err != nil && ctx.Err() == nil {
+					slog.Error("Keeping the current revoked credentials, reading the shared set failed", "source", l.shared.name(), "error", err)
+				}
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// check rejects a principal authenticated with revoked credentials. Until
+// the shared set first loads, authenticated calls are rejected as
+// UNAVAILABLE rather than risk accepting revoked credentials. A nil list
+// revokes nothing.
+func (l *revocationList) check(ctx context.Context, principal *Principal) error {
+	if l == nil || principal == nil {
+		return nil
+	}
+	if l.shared != nil && !l.sharedLoaded.Load() {
+		return status.Error(codes.Unavailable, "the revoked credentials aren't loaded yet")
+	}
+	revoked := *l.revoked.Load()
+	if len(revoked) == 0 {
+		return nil
+	}
+
+	entries := []string{"principal:" + principal.Name}
+	if principal.TokenID != "" {
+		entries = append(entries, "jti:"+principal.TokenID)
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if token, ok := bearerToken(md); ok {
+		entries = append(entries, credentialHash(token))
+	}
+	if keys := md.Get(apiKeyHeader); len(keys) > 0 {
+		entries = append(entries, credentialHash(keys[0]))
+	}
+	for _, entry := range entries {
+		if revoked[entry] {
+			return reasonError(codes.Unauthenticated, "CREDENTIALS_REVOKED", nil)
+		}
+	}
+	return nil
+}
+
+// credentialHash returns the sha256: revocation entry of a credential
+func credentialHash(credential string) string {
+	sum := sha256.Sum256([]byte(credential))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,102 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// routeRecorder accumulates the points of a route and the statistics of its
+// RouteSummary, whether they arrive on a RecordRoute stream or are replayed
+// from a file
+type routeRecorder struct {
+	at       time.Time    // when features are evaluated
+	features FeatureStore // features points are matched against
+	limits   anomalyLimits
+	strict   bool         // reject routes with anomalies
+	logger   *slog.Logger // logger of the call recording the route
+
+	pointCount, featureCount, distance int32
+	lastPoint                          *pb.Point
+	lastTime                           time.Time
+	anomalies                          []*pb.RouteAnomaly
+	points                             []*pb.Point
+	times                              []time.Time
+}
+
+// newRouteRecorder starts recording a route against the features valid at
+// time at, logging what it finds to logger
+func newRouteRecorder(features FeatureStore, at time.Time, limits anomalyLimits, strict bool, logger *slog.Logger) *routeRecorder {
+	return &routeRecorder{at: at, features: features, limits: limits, strict: strict, logger: logger}
+}
+
+// add records the next point of the route, reached at time t. In strict mode
+// it fails if the segment ending at the point is physically impossible.
+func (r *routeRecorder) add(ctx context.Context, point *pb.Point, t time.Time) error {
+	r.pointCount++
+
+	// Check if this point is a known feature
+	atPoint, err := r.features.FeaturesIn(ctx, &pb.Rectangle{Lo: point, Hi: point})
+	if err != nil {
+		return err
+	}
+	for _, feature := range atPoint {
+		if feature.activeAt(r.at) {
+			r.featureCount++
+			r.logger.Info("Point matches feature", "name", feature.Name)
+		}
+	}
+
+	// Calculate distance from last point and flag impossible segments
+	if r.lastPoint != nil {
+		segment := geo.Distance(r.lastPoint, point)
+		r.distance += segment
+
+		if anomaly := r.limits.check(r.pointCount, r.lastPoint, point, segment, t.Sub(r.lastTime)); anomaly != nil {
+			r.logger.Warn("Route anomaly", "point", r.pointCount, "kind", anomaly.Kind,
+				"distance_meters", anomaly.Distance, "speed_kmh", anomaly.SpeedKmh)
+			if r.strict {
+				return status.Errorf(codes.InvalidArgument,
+					"route rejected: segment ending at point %d is physically impossible (%s, %d meters, %.1f km/h)",
+					r.pointCount, anomaly.Kind, anomaly.Distance, anomaly.SpeedKmh)
+			}
+			r.anomalies = append(r.anomalies, anomaly)
+		}
+	}
+	r.lastPoint = point
+	r.lastTime = t
+	r.points = append(r.points, point)
+	r.times = append(r.times, t)
+	return nil
+}
+
+// finishRoute summarizes a recorded route, which took elapsed, and stores it
+// for CompareRoutes and EstimateTravelTime
+func (s *routeGuideServer) finishRoute(r *routeRecorder, elapsed time.Duration, profile pb.TravelProfile, recordedAt time.Time) *pb.RouteSummary {
+	summary := &pb.RouteSummary{
+		PointCount:   r.pointCount,
+		FeatureCount: r.featureCount,
+		Distance:     r.distance,
+		ElapsedTime:  int32(elapsed.Seconds()),
+		Anomalies:    r.anomalies,
+		RouteId:      s.ids.NewID(),
+	}
+	applyTravelProfile(summary, profile)
+
+	s.routes.add(&recordedRoute{
+		id:         summary.RouteId,
+		points:     r.points,
+		times:      r.times,
+		summary:    summary,
+		recordedAt: recordedAt,
+	})
+
+	r.logger.Info("RecordRoute completed", "route_id", summary.RouteId, "points", r.pointCount, "features", r.featureCount,
+		"distance_meters", r.distance, "elapsed_seconds", summary.ElapsedTime, "anomalies", len(r.anomalies), "profile", profile)
+	return summary
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"sync"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+)
+
+// recordedRoute is a route received through RecordRoute
+type recordedRoute struct {
+	id         string
+	points     []*pb.Point
+	times      []time.Time // when each point was received
+	summary    *pb.RouteSummary
+	recordedAt time.Time
+}
+
+// routeStore keeps the most recently recorded routes in memory
+type routeStore struct {
+	mu     sync.Mutex
+	max    int
+	routes map[string]*recordedRoute
+	order  []string // route IDs, oldest first
+}
+
+// newRouteStore creates a store holding at most max routes
+func newRouteStore(max int) *routeStore {
+	return &routeStore{
+		max:    max,
+		routes: make(map[string]*recordedRoute),
+	}
+}
+
+// add stores a route, evicting the oldest ones beyond capacity
+func (rs *routeStore) add(route *recordedRoute) {
+	if rs.max <= 0 {
+		return
+	}
+
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	rs.routes[route.id] = route
+	rs.order = append(rs.order, route.id)
+	for len(rs.order) > rs.max {
+		delete(rs.routes, rs.order[0])
+		rs.order = rs.order[1:]
+	}
+}
+
+// get returns the route with the given ID, or nil if it isn't stored
+func (rs *routeStore) get(id string) *recordedRoute {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	return rs.routes[id]
+}
+
+// list returns the stored routes, oldest first
+func (rs *routeStore) list() []*recordedRoute {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	routes := make([]*recordedRoute, 0, len(rs.order))
+	for _, id := range rs.order {
+		routes = append(routes, rs.routes[id])
+	}
+	return routes
+}
+
+// deleteBefore deletes the routes recorded before cutoff and returns how
+// many it deleted
+func (rs *routeStore) deleteBefore(cutoff time.Time) int {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	n := 0
+	for n < len(rs.order) && rs.routes[rs.order[n]].recordedAt.Before(cutoff) {
+		delete(rs.routes, rs.order[n])
+		n++
+	}
+	rs.order = rs.order[n:]
+	return n
+}
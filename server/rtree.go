@@ -0,0 +1,133 @@
+package main
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+)
+
+// rtreeNodeSize is the number of entries in a node of the feature R-tree
+const rtreeNodeSize = 16
+
+// rtree is a static R-tree over the locations of a dataset's features,
+// bulk-loaded with Sort-Tile-Recursive packing so that every node but the
+// last of each level is full. Leaves hold positions in the dataset.
+type rtree struct {
+	features []*featureRecord
+	root     *rtreeNode // nil when there are no features
+}
+
+// rtreeNode is a node of the R-tree, with the bounds of everything under it
+type rtreeNode struct {
+	bounds    geo.Bounds
+	children  []*rtreeNode // nil in leaves
+	positions []int        // in leaves, the features' positions in the dataset
+}
+
+// buildRTree packs the locations of features into an R-tree
+func buildRTree(features []*featureRecord) *rtree {
+	t := &rtree{features: features}
+	if len(features) == 0 {
+		return t
+	}
+
+	lat := func(pos int) int64 { return int64(features[pos].Location.Latitude) }
+	lon := func(pos int) int64 { return int64(features[pos].Location.Longitude) }
+	positions := make([]int, len(features))
+	for i := range positions {
+		positions[i] = i
+	}
+	var level []*rtreeNode
+	for _, group := range strGroups(positions, lon, lat) {
+		leaf := &rtreeNode{positions: group, bounds: pointBounds(features[group[0]].Location)}
+		for _, pos := range group {
+			leaf.bounds = unionBounds(leaf.bounds, pointBounds(features[pos].Location))
+		}
+		level = append(level, leaf)
+	}
+
+	for len(level) > 1 {
+		nodes := level
+		indexes := make([]int, len(nodes))
+		for i := range indexes {
+			indexes[i] = i
+		}
+		// Centers are doubled rather than halved to stay exact
+		x := func(i int) int64 { return int64(nodes[i].bounds.West) + int64(nodes[i].bounds.East) }
+		y := func(i int) int64 { return int64(nodes[i].bounds.South) + int64(nodes[i].bounds.North) }
+		level = nil
+		for _, group := range strGroups(indexes, x, y) {
+			parent := &rtreeNode{bounds: nodes[group[0]].bounds}
+			for _, i := range group {
+				parent.children = append(parent.children, nodes[i])
+				parent.bounds = unionBounds(parent.bounds, nodes[i].bounds)
+			}
+			level = append(level, parent)
+		}
+	}
+	t.root = level[0]
+	return t
+}
+
+// pointBounds returns the bounds holding exactly a point
+func pointBounds(p *pb.Point) geo.Bounds {
+	return geo.Bounds{South: p.Latitude, West: p.Longitude, North: p.Latitude, East: p.Longitude}
+}
+
+// unionBounds returns the smallest bounds holding both a and b
+func unionBounds(a, b geo.Bounds) geo.Bounds {
+	return geo.Bounds{
+		South: min(a.South, b.South),
+		West:  min(a.West, b.West),
+		North: max(a.North, b.North),
+		East:  max(a.East, b.East),
+	}
+}
+
+// strGroups orders items by x, cuts them into vertical slices, orders each
+// slice by y and cuts it into groups of rtreeNodeSize, so that each group
+// covers a compact area. It reorders items in place.
+func strGroups(items []int, x, y func(int) int64) [][]int {
+	slices.SortFunc(items, func(a, b int) int { return cmp.Compare(x(a), x(b)) })
+
+	nodes := (len(items) + rtreeNodeSize - 1) / rtreeNodeSize
+	sliceSize := int(math.Ceil(math.Sqrt(float64(nodes)))) * rtreeNodeSize
+
+	var groups [][]int
+	for start := 0; start < len(items); start += sliceSize {
+		slice := items[start:min(start+sliceSize, len(items))]
+		slices.SortFunc(slice, func(a, b int) int { return cmp.Compare(y(a), y(b)) })
+		for i := 0; i < len(slice); i += rtreeNodeSize {
+			groups = append(groups, slice[i:min(i+rtreeNodeSize, len(slice))])
+		}
+	}
+	return groups
+}
+
+// search returns, in ascending order, the positions of the features within
+// bounds, edges included
+func (t *rtree) search(bounds geo.Bounds) []int {
+	var positions []int
+	var visit func(n *rtreeNode)
+	visit = func(n *rtreeNode) {
+		if !n.bounds.Intersects(bounds) {
+			return
+		}
+		for _, child := range n.children {
+			visit(child)
+		}
+		for _, pos := range n.positions {
+			if bounds.Contains(t.features[pos].Location) {
+				positions = append(positions, pos)
+			}
+		}
+	}
+	if t.root != nil {
+		visit(t.root)
+	}
+	slices.Sort(positions)
+	return positions
+}
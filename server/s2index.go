@@ -0,0 +1,52 @@
+package main
+
+import (
+	"cmp"
+	"slices"
+	"sort"
+
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"github.com/golang/geo/s2"
+)
+
+// s2Cells sorts a dataset's features by the S2 cell of their location, so a
+// rectangle query only scans the runs of features within the cells covering
+// it. Cells nest along the S2 curve, so each covering cell is one run.
+type s2Cells struct {
+	features  []*featureRecord
+	maxCells  int         // cells per covering
+	cells     []s2.CellID // leaf cells of the features, ascending
+	positions []int       // positions in the dataset, in the order of cells
+}
+
+// buildS2Cells sorts features by S2 cell, for coverings of maxCells cells
+func buildS2Cells(features []*featureRecord, maxCells int) *s2Cells {
+	c := &s2Cells{features: features, maxCells: maxCells, positions: make([]int, len(features))}
+	cells := make([]s2.CellID, len(features))
+	for i, feature := range features {
+		cells[i] = geo.CellID(feature.Location)
+		c.positions[i] = i
+	}
+	slices.SortFunc(c.positions, func(a, b int) int { return cmp.Compare(cells[a], cells[b]) })
+	c.cells = make([]s2.CellID, len(features))
+	for i, pos := range c.positions {
+		c.cells[i] = cells[pos]
+	}
+	return c
+}
+
+// search returns, in ascending order, the positions of the features within
+// bounds, edges included
+func (c *s2Cells) search(bounds geo.Bounds) []int {
+	var positions []int
+	for _, cell := range bounds.Covering(c.maxCells, geo.MaxCellLevel) {
+		last := cell.RangeMax()
+		for i := sort.Search(len(c.cells), func(i int) bool { return c.cells[i] >= cell.RangeMin() }); i < len(c.cells) && c.cells[i] <= last; i++ {
+			if pos := c.positions[i]; bounds.Contains(c.features[pos].Location) {
+				positions = append(positions, pos)
+			}
+		}
+	}
+	slices.Sort(positions)
+	return positions
+}
@@ -2,241 +2,415 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
-	"log"
-	"math"
-	"os"
+	"log/slog"
+	"slices"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 // routeGuideServer implements the RouteGuide service
 type routeGuideServer struct {
 	pb.UnimplementedRouteGuideServer
-	savedFeatures []*pb.Feature // pre-loaded features from JSON
-	mu            sync.Mutex    // protects routeNotes
-	routeNotes    map[string][]*pb.RouteNote
+	data       atomic.Pointer[dataset] // features currently served
+	previous   atomic.Pointer[dataset] // dataset replaced by the last swap, for DiffDatasets
+	ab         abSplit                 // candidate dataset served to a share of traffic
+	swapMu     sync.Mutex              // serializes dataset swaps
+	strictLoad bool                    // fail loads with malformed features instead of skipping them
+	features   string                  // path of the features file loaded at startup
+	watchers   *watchHub               // WatchFeatures subscribers
+	deltas     deltaLog                // recent dataset changes, for SyncFeatures
+	notes      NoteStore               // route notes per location
+	chat       *chatBroadcaster        // RouteChat streams by location, for broadcast_chat
+	activity   *chatActivity           // notes recently posted per location, for GetChatActivity
+	flags      *featureFlags           // experimental behaviors turned on
+	sessions   *sessionStore           // client state restored on reconnect
+	onChange   changeHook              // called with every dataset swap, e.g. to deliver webhooks; nil if unused
+	moderation *moderationQueue        // feature submissions awaiting review
+	ids        IDGenerator             // identifies routes, notes and feature submissions
+
+	heartbeatInterval time.Duration       // how often WatchFeatures sends heartbeats
+	clientConfig      *clientConfigSource // settings recommended to clients
+
+	startedAt time.Time                     // when the server was created
+	locality  locality                      // region and zone the server runs in
+	readOnly  atomic.Pointer[readOnlyState] // set while the dataset is frozen
+	health    *health.Server                // health service, nil until registered
+
+	defaultLocale string             // locale used when the caller's accept-language has no match
+	anomalyLimits anomalyLimits      // thresholds for flagging impossible RecordRoute segments
+	routes        *routeStore        // recently recorded routes
+	commits       *commitStore       // RecordRoute summaries by idempotency key, nil if disabled
+	popularity    *popularityTracker // decaying lookup counts per feature
+	tiles         *tileCache         // encoded vector tiles served on the admin HTTP port
+	rects         *rectCache         // features of the rectangles ListFeatures was recently asked for
+	featureStore  FeatureStore       // finds the features GetFeature and ListFeatures serve
+	connections   *connTracker       // open connections and streams, for the Admin service
+	streamLimits  streamLimits       // bounds on client-streaming calls
+	chatReplay    chatReplay         // pacing of the notes RouteChat replays
+	jobs          *jobScheduler      // background maintenance jobs, for the Admin service
+
+	replicas   []*featureReplica // simulated replicas used by GetFeatureFast
+	hedgeDelay time.Duration     // delay before hedging to the next replica
 }
 
-// newServer creates a new RouteGuide server and loads features from JSON file
-func newServer(featuresFile string) (*routeGuideServer, error) {
+// newServer creates a new RouteGuide server and loads features from JSON file.
+// With strictLoad, malformed features fail this and later loads instead of
+// being skipped.
+func newServer(featuresFile string, strictLoad bool) (*routeGuideServer, error) {
 	s := &routeGuideServer{
-		routeNotes: make(map[string][]*pb.RouteNote),
+		watchers:   newWatchHub(),
+		moderation: newModerationQueue(),
+		chat:       newChatBroadcaster(),
+		activity:   newChatActivity(),
+		ids:        randomIDs{},
+		startedAt:  time.Now(),
+		strictLoad: strictLoad,
+		features:   featuresFile,
 	}
+	s.featureStore = datasetFeatureStore{server: s}
 
-	if err := s.loadFeatures(featuresFile); err != nil {
+	d, err := loadDatasetFile(featuresFile, strictLoad)
+	if err != nil {
 		return nil, fmt.Errorf("failed to load features: %v", err)
 	}
+	s.swapDataset(d)
 
-	log.Printf("Loaded %d features from %s", len(s.savedFeatures), featuresFile)
+	slog.Info("Loaded features", "count", len(d.features), "file", featuresFile)
 	return s, nil
 }
 
-// loadFeatures loads features from a JSON file
-func (s *routeGuideServer) loadFeatures(filePath string) error {
-	data, err := os.ReadFile(filePath)
+// GetFeature returns the feature at the given point (unary RPC)
+func (s *routeGuideServer) GetFeature(ctx context.Context, point *pb.Point) (*pb.Feature, error) {
+	logger := loggerFrom(ctx)
+	logger.Info("GetFeature called", "lat", point.Latitude, "lon", point.Longitude)
+
+	at, err := queryTime(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if err := json.Unmarshal(data, &s.savedFeatures); err != nil {
-		return err
+	feature, err := s.featureStore.FeatureAt(ctx, point, at)
+	if err != nil {
+		return nil, err
 	}
-
-	return nil
-}
-
-// GetFeature returns the feature at the given point (unary RPC)
-func (s *routeGuideServer) GetFeature(ctx context.Context, point *pb.Point) (*pb.Feature, error) {
-	log.Printf("GetFeature called with point: lat=%d, lon=%d", point.Latitude, point.Longitude)
-
-	for _, feature := range s.savedFeatures {
-		if feature.Location.Latitude == point.Latitude &&
-			feature.Location.Longitude == point.Longitude {
-			log.Printf("Found feature: %s", feature.Name)
-			return feature, nil
-		}
+	if feature != nil {
+		logger.Info("Found feature", "name", feature.Name)
+		s.popularity.hit(geo.Key(feature.Location), time.Now())
+		return feature.localized(s.requestLocales(ctx)), nil
 	}
 
 	// No feature found, return unnamed feature
-	log.Printf("No feature found at location")
+	logger.Info("No feature found at location")
 	return &pb.Feature{
 		Location: point,
 		Name:     "",
 	}, nil
 }
 
-// ListFeatures lists all features within the given bounding rectangle (server streaming RPC)
+// ListFeatures lists all features within the given bounding rectangle (server streaming RPC),
+// from the dataset the call started with, whatever datasets are swapped in meanwhile
 func (s *routeGuideServer) ListFeatures(rect *pb.Rectangle, stream pb.RouteGuide_ListFeaturesServer) error {
-	log.Printf("ListFeatures called with rectangle: lo(%d,%d) hi(%d,%d)",
-		rect.Lo.Latitude, rect.Lo.Longitude,
-		rect.Hi.Latitude, rect.Hi.Longitude)
+	if err := geo.ValidateRectangle(rect); err != nil {
+		return reasonError(codes.InvalidArgument, "INVALID_RECTANGLE", map[string]string{"detail": err.Error()})
+	}
+	logger := loggerFrom(stream.Context())
+	logger.Info("ListFeatures called",
+		"lo_lat", rect.Lo.Latitude, "lo_lon", rect.Lo.Longitude,
+		"hi_lat", rect.Hi.Latitude, "hi_lon", rect.Hi.Longitude)
+	filter, err := newNameFilter(rect.NameFilter)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid name_filter: %v", err)
+	}
+
+	at, err := queryTime(stream.Context())
+	if err != nil {
+		return err
+	}
 
+	order, err := requestOrder(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	features, err := s.featureStore.FeaturesIn(stream.Context(), rect)
+	if err != nil {
+		return err
+	}
+	if order == orderByPopularity {
+		// The cached slice is shared, so reorder a copy
+		features = slices.Clone(features)
+		s.popularity.sortByPopularity(features)
+	}
+
+	locales := s.requestLocales(stream.Context())
 	count := 0
-	for _, feature := range s.savedFeatures {
-		if inRange(feature.Location, rect) {
-			if err := stream.Send(feature); err != nil {
-				return err
-			}
-			count++
-			log.Printf("Sent feature: %s", feature.Name)
+	for _, feature := range features {
+		if !feature.activeAt(at) {
+			continue
+		}
+		// Names are matched as the caller sees them
+		localized := feature.localized(locales)
+		if !filter.matches(localized.Name) {
+			continue
+		}
+		if err := stream.Send(localized); err != nil {
+			return err
 		}
+		count++
+		logger.Debug("Sent feature", "name", feature.Name)
 	}
 
-	log.Printf("ListFeatures completed: sent %d features", count)
+	logger.Info("ListFeatures completed", "sent", count)
 	return nil
 }
 
 // RecordRoute records a route and returns statistics (client streaming RPC)
 func (s *routeGuideServer) RecordRoute(stream pb.RouteGuide_RecordRouteServer) error {
-	log.Printf("RecordRoute called")
+	logger := loggerFrom(stream.Context())
+	logger.Info("RecordRoute called")
 
-	var pointCount, featureCount, distance int32
-	var lastPoint *pb.Point
+	at, err := queryTime(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	profile, err := requestTravelProfile(stream.Context())
+	if err != nil {
+		return err
+	}
+	limits := s.routeLimits(profile)
+	strict := strictRouteValidation(stream.Context())
+	key, err := idempotencyKey(stream.Context())
+	if err != nil {
+		return err
+	}
+	var fingerprint *routeFingerprint
+	if key != "" && s.commits != nil {
+		fingerprint = newRouteFingerprint()
+	}
+	features, err := s.featuresFor(stream.Context())
+	if err != nil {
+		return err
+	}
+
+	recorder := newRouteRecorder(features, at, limits, strict, logger)
 	startTime := time.Now()
+	received, done := ingest.start()
+	defer done()
+
+	// Receive on a separate goroutine so the stream duration can be enforced
+	// even while the client is idle
+	incoming := receiveAll(stream.Context(), stream.Recv)
+	var expired <-chan time.Time
+	if d := s.streamLimits.maxRouteDuration; d > 0 {
+		timer := time.NewTimer(d)
+		defer timer.Stop()
+		expired = timer.C
+	}
 
 	for {
-		point, err := stream.Recv()
+		var point *pb.Point
+		select {
+		case msg := <-incoming:
+			point, err = msg.value, msg.err
+		case <-expired:
+			logger.Warn("RecordRoute aborted: stream exceeded its maximum duration", "max_duration", s.streamLimits.maxRouteDuration)
+			return resourceExhausted("route-duration",
+				fmt.Sprintf("RecordRoute streams may last at most %s", s.streamLimits.maxRouteDuration),
+				s.streamLimits.retryDelay)
+		}
+
 		if err == io.EOF {
 			// Client has finished sending points
 			endTime := time.Now()
-			elapsedTime := int32(endTime.Sub(startTime).Seconds())
-
-			summary := &pb.RouteSummary{
-				PointCount:   pointCount,
-				FeatureCount: featureCount,
-				Distance:     distance,
-				ElapsedTime:  elapsedTime,
+			finish := func() *pb.RouteSummary {
+				return s.finishRoute(recorder, endTime.Sub(startTime), profile, endTime)
+			}
+			if fingerprint == nil {
+				return stream.SendAndClose(finish())
+			}
+			summary, replayed, err := s.commits.commit(stream.Context(), key, fingerprint.sum(), finish)
+			if err != nil {
+				return err
+			}
+			if replayed {
+				logger.Info("RecordRoute retried: returning the summary already committed", "route_id", summary.RouteId)
 			}
-
-			log.Printf("RecordRoute completed: points=%d, features=%d, distance=%d meters, time=%d seconds",
-				pointCount, featureCount, distance, elapsedTime)
-
 			return stream.SendAndClose(summary)
 		}
 		if err != nil {
 			return err
 		}
 
-		pointCount++
-		log.Printf("Received point %d: lat=%d, lon=%d", pointCount, point.Latitude, point.Longitude)
-
-		// Check if this point is a known feature
-		for _, feature := range s.savedFeatures {
-			if feature.Location.Latitude == point.Latitude &&
-				feature.Location.Longitude == point.Longitude {
-				featureCount++
-				log.Printf("Point matches feature: %s", feature.Name)
-			}
+		if max := s.streamLimits.maxRoutePoints; max > 0 && recorder.pointCount >= max {
+			logger.Warn("RecordRoute aborted: stream exceeded its maximum points", "max_points", max)
+			return resourceExhausted("route-points",
+				fmt.Sprintf("RecordRoute streams may contain at most %d points", max),
+				s.streamLimits.retryDelay)
 		}
 
-		// Calculate distance from last point
-		if lastPoint != nil {
-			distance += calcDistance(lastPoint, point)
+		received(proto.Size(point))
+		if fingerprint != nil {
+			fingerprint.add(point)
+		}
+		logger.Debug("Received point", "n", recorder.pointCount+1, "lat", point.Latitude, "lon", point.Longitude)
+		if err := recorder.add(stream.Context(), point, time.Now()); err != nil {
+			return err
 		}
-		lastPoint = point
 	}
 }
 
 // RouteChat receives and sends route notes (bidirectional streaming RPC)
 func (s *routeGuideServer) RouteChat(stream pb.RouteGuide_RouteChatServer) error {
-	log.Printf("RouteChat called")
+	sess, resumed, err := s.sessions.attach(stream)
+	if err != nil {
+		return err
+	}
+	defer s.sessions.detach(sess)
+	logger := loggerFrom(stream.Context())
+	logger.Info("RouteChat called", "resumed_session", resumed)
+
+	var author string
+	if principal := principalFromContext(stream.Context()); principal != nil {
+		author = principal.Name
+	}
+
+	// Forward the notes other streams post at this stream's locations. Sends
+	// to the stream must not interleave.
+	var sendMu sync.Mutex
+	send := func(note *pb.RouteNote) error {
+		sendMu.Lock()
+		defer sendMu.Unlock()
+		return stream.Send(note)
+	}
+	listener := newChatListener()
+	defer s.chat.leave(listener)
+	stop := make(chan struct{})
+	var forwarding sync.WaitGroup
+	forwarding.Add(1)
+	go func() {
+		defer forwarding.Done()
+		for {
+			select {
+			case note := <-listener.notes:
+				if err := send(note); err != nil {
+					return
+				}
+				logger.Debug("Sent broadcast note", "message", note.Message)
+			case <-stop:
+				return
+			}
+		}
+	}()
+	defer forwarding.Wait()
+	defer close(stop)
+
+	if resumed {
+		if err := s.restoreChat(stream.Context(), sess, listener, send); err != nil {
+			return err
+		}
+	}
+
+	sendPrev := func(prevNote *pb.RouteNote) error {
+		if err := send(prevNote); err != nil {
+			return err
+		}
+		logger.Debug("Sent previous note", "message", prevNote.Message)
+		return nil
+	}
 
 	for {
 		note, err := stream.Recv()
 		if err == io.EOF {
-			log.Printf("RouteChat completed")
+			logger.Info("RouteChat completed")
 			return nil
 		}
 		if err != nil {
 			return err
 		}
 
-		key := serialize(note.Location)
-		log.Printf("Received note at %s: %s", key, note.Message)
+		key := geo.Key(note.Location)
+		if note.HistoryToken != "" {
+			// Load more of a capped replay rather than post a note
+			if _, err := s.loadMoreNotes(stream.Context(), key, note.HistoryToken, sendPrev); err != nil {
+				return err
+			}
+			continue
+		}
 
-		s.mu.Lock()
+		observeNoteSchema(note)
 
-		// Send all previously received notes at this location
-		if notes, ok := s.routeNotes[key]; ok {
-			for _, prevNote := range notes {
-				if err := stream.Send(prevNote); err != nil {
-					s.mu.Unlock()
-					return err
-				}
-				log.Printf("Sent previous note: %s", prevNote.Message)
+		// Attribute the note to its sender, whatever the client claimed
+		note.Id = s.ids.NewID()
+		note.Author = author
+		logger.Debug("Received note", "location", key, "author", author, "message", note.Message)
+
+		// Store the new note, then replay the ones previously received at
+		// this location
+		prev, from, next, err := s.notes.Exchange(stream.Context(), key, note)
+		if err != nil {
+			if status.Code(err) == codes.FailedPrecondition {
+				return err
 			}
+			logger.Error("Failed to store note", "location", key, "error", err)
+			return status.Error(codes.Unavailable, "the note couldn't be stored")
 		}
+		if _, err := s.chatReplay.replay(stream.Context(), prev, from, sendPrev); err != nil {
+			return err
+		}
+		sess.sawNotes(key, next)
+		s.activity.record(note.Location, time.Now())
 
-		// Store the new note
-		s.routeNotes[key] = append(s.routeNotes[key], note)
-
-		s.mu.Unlock()
+		s.chat.join(listener, key)
+		if s.flags.enabled(flagBroadcastChat) {
+			s.chat.publish(listener, key, note)
+		}
 	}
 }
 
 // Helper functions
 
-// inRange checks if a point is within a rectangle
-func inRange(point *pb.Point, rect *pb.Rectangle) bool {
-	left := min(rect.Lo.Longitude, rect.Hi.Longitude)
-	right := max(rect.Lo.Longitude, rect.Hi.Longitude)
-	top := max(rect.Lo.Latitude, rect.Hi.Latitude)
-	bottom := min(rect.Lo.Latitude, rect.Hi.Latitude)
-
-	return point.Longitude >= left &&
-		point.Longitude <= right &&
-		point.Latitude >= bottom &&
-		point.Latitude <= top
-}
-
-// serialize converts a point to a string key for the map
-func serialize(point *pb.Point) string {
-	return fmt.Sprintf("%d,%d", point.Latitude, point.Longitude)
+// findFeature returns the saved feature at the exact given point that is valid
+// at time at, or nil if there is none
+func (d *dataset) findFeature(point *pb.Point, at time.Time) *featureRecord {
+	for _, feature := range d.atPoint(point) {
+		if feature.activeAt(at) {
+			return feature
+		}
+	}
+	return nil
 }
 
-// calcDistance calculates the distance between two points using the Haversine formula
-// Returns distance in meters
-func calcDistance(p1, p2 *pb.Point) int32 {
-	const earthRadiusMeters = 6371000 // Earth radius in meters
-
-	lat1 := toRadians(float64(p1.Latitude) / 1e7)
-	lat2 := toRadians(float64(p2.Latitude) / 1e7)
-	lon1 := toRadians(float64(p1.Longitude) / 1e7)
-	lon2 := toRadians(float64(p2.Longitude) / 1e7)
-
-	dlat := lat2 - lat1
-	dlon := lon2 - lon1
-
-	a := math.Sin(dlat/2)*math.Sin(dlat/2) +
-		math.Cos(lat1)*math.Cos(lat2)*
-			math.Sin(dlon/2)*math.Sin(dlon/2)
-
-	c := 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+// asOfKey is the metadata key clients use to query features at a given RFC 3339 time
+const asOfKey = "as-of"
 
-	distance := earthRadiusMeters * c
-	return int32(distance)
-}
-
-// toRadians converts degrees to radians
-func toRadians(degrees float64) float64 {
-	return degrees * math.Pi / 180
-}
+// queryTime returns the time features should be evaluated at: the caller's
+// as-of metadata when present, the current server time otherwise
+func queryTime(ctx context.Context) (time.Time, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return time.Now(), nil
+	}
 
-func min(a, b int32) int32 {
-	if a < b {
-		return a
+	values := md.Get(asOfKey)
+	if len(values) == 0 {
+		return time.Now(), nil
 	}
-	return b
-}
 
-func max(a, b int32) int32 {
-	if a > b {
-		return a
+	at, err := time.Parse(time.RFC3339, values[0])
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "invalid %s metadata %q: expected RFC 3339 time", asOfKey, values[0])
 	}
-	return b
+	return at, nil
 }
@@ -0,0 +1,464 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// listFeaturesStream collects the features a ListFeatures call sends,
+// calling onSend (if not nil) before each one
+type listFeaturesStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	sent   []string
+	onSend func()
+}
+
+func (s *listFeaturesStream) Context() context.Context { return s.ctx }
+
+func (s *listFeaturesStream) Send(feature *pb.Feature) error {
+	if s.onSend != nil {
+		s.onSend()
+	}
+	s.sent = append(s.sent, feature.Name)
+	return nil
+}
+
+// listFeatures runs ListFeatures over rect and returns the names sent
+func listFeatures(t *testing.T, s *routeGuideServer, rect *pb.Rectangle, onSend func()) []string {
+	t.Helper()
+	stream := &listFeaturesStream{ctx: context.Background(), onSend: onSend}
+	if err := s.ListFeatures(rect, stream); err != nil {
+		t.Fatalf("ListFeatures() failed: %v", err)
+	}
+	return stream.sent
+}
+
+// recordRouteStream sends points to a RecordRoute call and keeps its summary
+type recordRouteStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	points  []*pb.Point
+	summary *pb.RouteSummary
+}
+
+func (s *recordRouteStream) Context() context.Context { return s.ctx }
+
+func (s *recordRouteStream) Recv() (*pb.Point, error) {
+	if len(s.points) == 0 {
+		return nil, io.EOF
+	}
+	point := s.points[0]
+	s.points = s.points[1:]
+	return point, nil
+}
+
+func (s *recordRouteStream) SendAndClose(summary *pb.RouteSummary) error {
+	s.summary = summary
+	return nil
+}
+
+// recordRoute runs RecordRoute with points in one burst and returns the summary
+func recordRoute(s *routeGuideServer, ctx context.Context, points ...*pb.Point) (*pb.RouteSummary, error) {
+	stream := &recordRouteStream{ctx: ctx, points: points}
+	if err := s.RecordRoute(stream); err != nil {
+		return nil, err
+	}
+	return stream.summary, nil
+}
+
+func TestListFeaturesSnapshotDuringMutations(t *testing.T) {
+	s, err := newServer("features.json", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.rects = newRectCache(16, time.Minute)
+	ctx := context.Background()
+	rect := &pb.Rectangle{
+		Lo: &pb.Point{Latitude: 400000000, Longitude: -750000000},
+		Hi: &pb.Point{Latitude: 420000000, Longitude: -730000000},
+	}
+
+	before := listFeatures(t, s, rect, nil)
+	if len(before) < 3 {
+		t.Fatalf("ListFeatures() sent %d features, want a few to mutate", len(before))
+	}
+	version := s.current().version
+
+	// Delete, update and create features in the rectangle while the stream
+	// is halfway through
+	inRect := s.current().inRect(rect)
+	last := inRect[len(inRect)-1]
+	sends := 0
+	during := listFeatures(t, s, rect, func() {
+		if sends++; sends != len(before)/2 {
+			return
+		}
+		if _, err := s.DeleteFeature(ctx, &pb.DeleteFeatureRequest{Location: last.Location}); err != nil {
+			t.Fatalf("DeleteFeature() failed: %v", err)
+		}
+		first := s.current().inRect(rect)[0]
+		if _, err := s.UpdateFeature(ctx, &pb.UpdateFeatureRequest{Feature: &pb.Feature{Name: "Renamed", Location: first.Location}}); err != nil {
+			t.Fatalf("UpdateFeature() failed: %v", err)
+		}
+		created := &pb.Feature{Name: "Created", Location: &pb.Point{Latitude: 410000000, Longitude: -740000000}}
+		if _, err := s.CreateFeature(ctx, &pb.CreateFeatureRequest{Feature: created}); err != nil {
+			t.Fatalf("CreateFeature() failed: %v", err)
+		}
+	})
+	if !slices.Equal(during, before) {
+		t.Errorf("ListFeatures() during mutations sent %q, want the snapshot it started with, %q", during, before)
+	}
+	if got := s.current().version; got != version+3 {
+		t.Fatalf("dataset version = %d after the mutations, want %d", got, version+3)
+	}
+
+	after := listFeatures(t, s, rect, nil)
+	for _, name := range []string{"Renamed", "Created"} {
+		if !slices.Contains(after, name) {
+			t.Errorf("ListFeatures() after mutations sent %q, missing %q", after, name)
+		}
+	}
+	if slices.Contains(after, last.Name) {
+		t.Errorf("ListFeatures() after mutations sent deleted feature %q", last.Name)
+	}
+}
+
+// syncStream collects what a SyncFeatures call sends into a copy of the
+// features, recording the copy whenever it is complete
+type syncStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	mu       sync.Mutex
+	features map[string]string // name by location key
+	building map[string]string // snapshot being received
+	token    string            // the last resume token received
+	seen     []versionedFeatures
+}
+
+// versionedFeatures is a reader's view of the features of a dataset version
+type versionedFeatures struct {
+	version  int64
+	features string
+}
+
+func (s *syncStream) Context() context.Context { return s.ctx }
+
+func (s *syncStream) SendHeader(metadata.MD) error { return nil }
+
+func (s *syncStream) Send(msg *pb.SyncMessage) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if msg.ResumeToken != "" {
+		s.token = msg.ResumeToken
+	}
+	switch payload := msg.Payload.(type) {
+	case *pb.SyncMessage_Snapshot:
+		if payload.Snapshot.First {
+			s.building = make(map[string]string)
+		}
+		for _, feature := range payload.Snapshot.Features {
+			s.building[geo.Key(feature.Location)] = feature.Name
+		}
+		if !payload.Snapshot.Last {
+			return nil
+		}
+		s.features = s.building
+	case *pb.SyncMessage_Delta:
+		for _, feature := range payload.Delta.Upserted {
+			s.features[geo.Key(feature.Location)] = feature.Name
+		}
+		for _, location := range payload.Delta.Removed {
+			delete(s.features, geo.Key(location))
+		}
+	}
+	var features []string
+	for key, name := range s.features {
+		features = append(features, key+" "+name)
+	}
+	slices.Sort(features)
+	s.seen = append(s.seen, versionedFeatures{msg.Version, strings.Join(features, "|")})
+	return nil
+}
+
+// describeFeatures lists the features of a dataset the same way readers do
+func describeFeatures(features []*featureRecord, keep func(*featureRecord) bool) string {
+	var described []string
+	for _, feature := range features {
+		if keep(feature) {
+			described = append(described, geo.Key(feature.Location)+" "+feature.Name)
+		}
+	}
+	slices.Sort(described)
+	return strings.Join(described, "|")
+}
+
+func TestConcurrentReadsDuringMutations(t *testing.T) {
+	s, err := newServer("features.json", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Every dataset version, to compare reads against
+	var mu sync.Mutex
+	versions := map[int64]*dataset{s.current().version: s.current()}
+	s.onChange = func(next *dataset, _ *featureDelta) {
+		mu.Lock()
+		defer mu.Unlock()
+		versions[next.version] = next
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	world := &pb.Rectangle{
+		Lo: &pb.Point{Latitude: -900000000, Longitude: -1800000000},
+		Hi: &pb.Point{Latitude: 900000000, Longitude: 1800000000},
+	}
+
+	// Writers create, rename and delete a feature each, over and over
+	var writers sync.WaitGroup
+	for w := range 3 {
+		writers.Go(func() {
+			location := &pb.Point{Latitude: int32(w+1) * 10000000, Longitude: 10000000}
+			for i := range 30 {
+				name := fmt.Sprintf("writer %d round %d", w, i)
+				if _, err := s.CreateFeature(ctx, &pb.CreateFeatureRequest{Feature: &pb.Feature{Name: name, Location: location}}); err != nil {
+					t.Errorf("CreateFeature() failed: %v", err)
+					return
+				}
+				if _, err := s.UpdateFeature(ctx, &pb.UpdateFeatureRequest{Feature: &pb.Feature{Name: name + " renamed", Location: location}}); err != nil {
+					t.Errorf("UpdateFeature() failed: %v", err)
+					return
+				}
+				if _, err := s.DeleteFeature(ctx, &pb.DeleteFeatureRequest{Location: location}); err != nil {
+					t.Errorf("DeleteFeature() failed: %v", err)
+					return
+				}
+			}
+		})
+	}
+
+	// Readers page through every feature in one page, recording what each
+	// version looked like
+	var readers sync.WaitGroup
+	pages := make([][]versionedFeatures, 4)
+	done := make(chan struct{})
+	for r := range pages {
+		readers.Go(func() {
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				page, err := s.ListFeaturesPage(ctx, &pb.ListFeaturesPageRequest{Rectangle: world, PageSize: maxFeaturePageSize})
+				if err != nil {
+					t.Errorf("ListFeaturesPage() failed: %v", err)
+					return
+				}
+				var features []string
+				for _, feature := range page.Features {
+					features = append(features, geo.Key(feature.Location)+" "+feature.Name)
+				}
+				slices.Sort(features)
+				pages[r] = append(pages[r], versionedFeatures{page.Version, strings.Join(features, "|")})
+			}
+		})
+	}
+	stream := &syncStream{ctx: ctx}
+	syncDone := make(chan error, 1)
+	go func() {
+		for {
+			// A sync that falls behind is dropped; resume it like a client would
+			stream.mu.Lock()
+			token := stream.token
+			stream.mu.Unlock()
+			if err := s.SyncFeatures(&pb.SyncRequest{PageSize: 7, ResumeToken: token}, stream); status.Code(err) != codes.ResourceExhausted {
+				syncDone <- err
+				return
+			}
+		}
+	}()
+
+	writers.Wait()
+	close(done)
+	readers.Wait()
+	// Let the sync catch up with the last version before stopping it
+	last := s.current().version
+	for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		stream.mu.Lock()
+		caughtUp := len(stream.seen) > 0 && stream.seen[len(stream.seen)-1].version == last
+		stream.mu.Unlock()
+		if caughtUp {
+			break
+		}
+	}
+	cancel()
+	if err := <-syncDone; err != nil {
+		t.Fatalf("SyncFeatures() failed: %v", err)
+	}
+	if len(stream.seen) == 0 || stream.seen[len(stream.seen)-1].version != last {
+		t.Fatalf("SyncFeatures() never reached dataset version %d", last)
+	}
+
+	now := time.Now()
+	check := func(reader string, seen []versionedFeatures, keep func(*featureRecord) bool) {
+		t.Helper()
+		var prev int64
+		for _, v := range seen {
+			d, ok := versions[v.version]
+			if !ok {
+				t.Errorf("%s read unknown dataset version %d", reader, v.version)
+				continue
+			}
+			if want := describeFeatures(d.features, keep); v.features != want {
+				t.Errorf("%s read at version %d:\n%s\nwant:\n%s", reader, v.version, v.features, want)
+			}
+			if v.version < prev {
+				t.Errorf("%s read version %d after %d", reader, v.version, prev)
+			}
+			prev = v.version
+		}
+	}
+	for r, seen := range pages {
+		check(fmt.Sprintf("ListFeaturesPage reader %d", r), seen, func(f *featureRecord) bool { return f.activeAt(now) })
+	}
+	check("SyncFeatures", stream.seen, func(*featureRecord) bool { return true })
+}
+
+func TestListFeaturesNameFilter(t *testing.T) {
+	s, err := newServer("features.json", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.rects = newRectCache(16, time.Minute)
+	world := func(filter *pb.NameFilter) *pb.Rectangle {
+		return &pb.Rectangle{
+			Lo:         &pb.Point{Latitude: -900000000, Longitude: -1800000000},
+			Hi:         &pb.Point{Latitude: 900000000, Longitude: 1800000000},
+			NameFilter: filter,
+		}
+	}
+
+	all := listFeatures(t, s, world(nil), nil)
+	named := listFeatures(t, s, world(&pb.NameFilter{NamedOnly: true}), nil)
+	if len(named) == 0 || len(named) >= len(all) || slices.Contains(named, "") {
+		t.Errorf("named_only sent %d of %d features, want every named one: %q", len(named), len(all), named)
+	}
+
+	tests := []struct {
+		filter *pb.NameFilter
+		want   []string
+	}{
+		{&pb.NameFilter{Contains: "Drake Lane"}, []string{"3 Drake Lane, Pennington, NJ 08534, USA"}},
+		{&pb.NameFilter{Contains: "drake lane"}, nil},
+		{&pb.NameFilter{Contains: "drake lane", Collation: pb.NameCollation_NAME_COLLATION_CASE_INSENSITIVE}, []string{"3 Drake Lane, Pennington, NJ 08534, USA"}},
+		{&pb.NameFilter{Prefix: "3 ", Contains: "NJ"}, []string{"3 Drake Lane, Pennington, NJ 08534, USA", "3 Hasta Way, Newton, NJ 07860, USA"}},
+		{&pb.NameFilter{Prefix: "Drake"}, nil},
+	}
+	for _, tt := range tests {
+		got := listFeatures(t, s, world(tt.filter), nil)
+		slices.Sort(got)
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("ListFeatures() with %v sent %q, want %q", tt.filter, got, tt.want)
+		}
+	}
+
+	stream := &listFeaturesStream{ctx: context.Background()}
+	if err := s.ListFeatures(world(&pb.NameFilter{Collation: 42}), stream); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListFeatures() with an unknown collation = %v, want INVALID_ARGUMENT", err)
+	}
+}
+
+func TestReadOnlyNotesKeepDatasetWritable(t *testing.T) {
+	s, err := newServer("features.json", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.flags, err = newFeatureFlags(""); err != nil {
+		t.Fatal(err)
+	}
+	notes := newMemoryNoteStore(0, 0)
+	notes.readOnly = "the notes database is locked by process 1"
+	s.notes = notes
+	ctx := context.Background()
+
+	note := &pb.RouteNote{Location: &pb.Point{Latitude: 1, Longitude: 1}, Message: "hello"}
+	if _, _, _, err := notes.Exchange(ctx, "1,1", note); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Exchange() = %v, want FAILED_PRECONDITION", err)
+	}
+	if _, err := notes.Clear(ctx, ""); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("Clear() = %v, want FAILED_PRECONDITION", err)
+	}
+	if err := s.checkWritable(); err != nil {
+		t.Errorf("checkWritable() = %v, want the dataset writable", err)
+	}
+	if info := s.serverInfo(); info.ReadOnly || info.NotesReadOnlyReason != notes.readOnly {
+		t.Errorf("serverInfo() = read_only %v, notes_read_only_reason %q", info.ReadOnly, info.NotesReadOnlyReason)
+	}
+}
+
+func TestCandidateAssignmentIsSticky(t *testing.T) {
+	s, err := newServer("features.json", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.loadCandidate("features.json", 50); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.ab.candidate.Load().version; got == s.current().version {
+		t.Fatalf("candidate version = %d, the same as the stable dataset's", got)
+	}
+
+	candidates := 0
+	for i := range 200 {
+		ctx := context.WithValue(context.Background(), principalKey{}, &Principal{Name: fmt.Sprint("caller", i)})
+		first, err := s.datasetFor(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for range 5 {
+			if d, _ := s.datasetFor(ctx); d != first {
+				t.Fatalf("caller%d got dataset version %d, then %d", i, first.version, d.version)
+			}
+		}
+		if first.version == candidateVersion {
+			candidates++
+		}
+	}
+	if candidates < 60 || candidates > 140 {
+		t.Errorf("%d of 200 callers got the candidate, want about half", candidates)
+	}
+}
+
+func TestSubsystemDegradesAfterRepeatedFailures(t *testing.T) {
+	g := &subsystemGuard{}
+	g.enable("webhooks")
+	failure := fmt.Errorf("connection refused")
+
+	for range subsystemMaxFailures - 1 {
+		g.fail("webhooks", failure)
+	}
+	g.succeed("webhooks")
+	for i := range subsystemMaxFailures {
+		if active := g.fail("webhooks", failure); active != (i < subsystemMaxFailures-1) {
+			t.Fatalf("after %d failures in a row, active = %v", i+1, active)
+		}
+	}
+	if degraded := g.snapshot(); len(degraded) != 1 || degraded[0].Name != "webhooks" {
+		t.Errorf("snapshot() = %v, want webhooks degraded", degraded)
+	}
+}
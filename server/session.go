@@ -0,0 +1,167 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"maps"
+	"sync"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// sessionTokenKey is the metadata key of session tokens. The server sends a
+// token in the response headers of RouteChat and WatchFeatures; clients echo
+// it when they reconnect so the server can restore their session.
+const sessionTokenKey = "session-token"
+
+// session is the state of a client that survives reconnects
+type session struct {
+	principal string // owner, so a leaked token can't be used by another caller
+
+	mu          sync.Mutex
+	chat        map[string]int // RouteChat locations, with the position of the next unseen note
+	watched     int64          // last dataset version WatchFeatures sent, 0 if none
+	lastActive  time.Time
+	openStreams int
+}
+
+// sessionStore keeps sessions until they have been idle for ttl
+type sessionStore struct {
+	mu       sync.Mutex
+	sessions map[string]*session
+	ttl      time.Duration
+}
+
+// newSessionStore creates an empty session store
+func newSessionStore(ttl time.Duration) *sessionStore {
+	return &sessionStore{sessions: make(map[string]*session), ttl: ttl}
+}
+
+// attach returns the session of a stream, restoring the one named by the
+// caller's session token or starting a new one, and sends its token in the
+// response headers right away so the client has it even if the stream breaks
+// before any message. Callers must call detach when the stream ends.
+func (ss *sessionStore) attach(stream grpc.ServerStream) (sess *session, resumed bool, err error) {
+	ctx := stream.Context()
+	principal := ""
+	if p := principalFromContext(ctx); p != nil {
+		principal = p.Name
+	}
+	now := time.Now()
+
+	ss.mu.Lock()
+	ss.expire(now)
+	var token string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(sessionTokenKey); len(values) > 0 {
+			token = values[0]
+		}
+	}
+	sess = ss.sessions[token]
+	if sess != nil && sess.principal == principal {
+		resumed = true
+	} else {
+		token = newSessionToken()
+		sess = &session{principal: principal, chat: make(map[string]int)}
+		ss.sessions[token] = sess
+	}
+	ss.mu.Unlock()
+
+	sess.mu.Lock()
+	sess.openStreams++
+	sess.lastActive = now
+	sess.mu.Unlock()
+
+	if err := stream.SendHeader(metadata.Pairs(sessionTokenKey, token)); err != nil {
+		ss.detach(sess)
+		return nil, false, err
+	}
+	return sess, resumed, nil
+}
+
+// detach marks the end of a stream attached to a session, starting its idle time
+func (ss *sessionStore) detach(sess *session) {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	sess.openStreams--
+	sess.lastActive = time.Now()
+}
+
+// expire forgets sessions without open streams that have been idle for the
+// store's ttl. ss.mu must be held.
+func (ss *sessionStore) expire(now time.Time) {
+	for token, sess := range ss.sessions {
+		sess.mu.Lock()
+		idle := sess.openStreams == 0 && now.Sub(sess.lastActive) > ss.ttl
+		sess.mu.Unlock()
+		if idle {
+			delete(ss.sessions, token)
+		}
+	}
+}
+
+// chatLocations returns the RouteChat locations of the session, with the
+// position of the first note it hasn't seen at each
+func (sess *session) chatLocations() map[string]int {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	return maps.Clone(sess.chat)
+}
+
+// sawNotes records that the session has seen the notes at key before position next
+func (sess *session) sawNotes(key string, next int) {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	if next > sess.chat[key] {
+		sess.chat[key] = next
+	}
+	sess.lastActive = time.Now()
+}
+
+// watchedVersion returns the last dataset version WatchFeatures sent the session
+func (sess *session) watchedVersion() int64 {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	return sess.watched
+}
+
+// sawVersion records the last dataset version WatchFeatures sent the session
+func (sess *session) sawVersion(version int64) {
+	sess.mu.Lock()
+	defer sess.mu.Unlock()
+	sess.watched = version
+	sess.lastActive = time.Now()
+}
+
+// restoreChat replays to a resumed RouteChat session the live notes posted
+// at its locations since it disconnected, then has listener receive the
+// notes broadcast there, as if the stream had posted at them
+func (s *routeGuideServer) restoreChat(ctx context.Context, sess *session, listener *chatListener, send func(*pb.RouteNote) error) error {
+	replayed := 0
+	for key, seen := range sess.chatLocations() {
+		notes, next, err := s.notes.Since(ctx, key, seen)
+		if err != nil {
+			return notesError(err)
+		}
+		sent, err := s.chatReplay.replay(ctx, notes, next-len(notes), send)
+		if err != nil {
+			return err
+		}
+		sess.sawNotes(key, next)
+		replayed += sent
+		s.chat.join(listener, key)
+	}
+	loggerFrom(ctx).Info("RouteChat session restored", "replayed", replayed)
+	return nil
+}
+
+// newSessionToken returns a random session token
+func newSessionToken() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// headerStream is a stream that keeps the headers sent on it
+type headerStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	header metadata.MD
+}
+
+func (s *headerStream) Context() context.Context { return s.ctx }
+
+func (s *headerStream) SendHeader(md metadata.MD) error {
+	s.header = md
+	return nil
+}
+
+// attachSession attaches a stream of caller, sending token if not empty, and
+// returns the session and the token the server sent back
+func attachSession(t *testing.T, ss *sessionStore, caller, token string) (*session, bool, string) {
+	t.Helper()
+	ctx := context.WithValue(context.Background(), principalKey{}, &Principal{Name: caller})
+	if token != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(sessionTokenKey, token))
+	}
+	stream := &headerStream{ctx: ctx}
+	sess, resumed, err := ss.attach(stream)
+	if err != nil {
+		t.Fatalf("attach() failed: %v", err)
+	}
+	sent := stream.header.Get(sessionTokenKey)
+	if len(sent) != 1 || sent[0] == "" {
+		t.Fatalf("attach() sent %s headers %v, want a token", sessionTokenKey, stream.header)
+	}
+	return sess, resumed, sent[0]
+}
+
+func TestSessionTokens(t *testing.T) {
+	ss := newSessionStore(time.Minute)
+
+	// A session with state to restore, one idle for longer than the TTL and
+	// one as old but with a stream still open
+	sess, _, saved := attachSession(t, ss, "alice", "")
+	sess.sawNotes("1,1", 3)
+	sess.sawVersion(7)
+	ss.detach(sess)
+	sess, _, expired := attachSession(t, ss, "carol", "")
+	ss.detach(sess)
+	sess.lastActive = time.Now().Add(-2 * time.Minute)
+	sess, _, open := attachSession(t, ss, "dave", "")
+	sess.lastActive = time.Now().Add(-2 * time.Minute)
+
+	tests := []struct {
+		name    string
+		caller  string
+		token   string
+		resumed bool
+	}{
+		{"no token", "alice", "", false},
+		{"own token", "alice", saved, true},
+		{"own token again", "alice", saved, true},
+		{"another caller's token", "bob", saved, false},
+		{"unknown token", "alice", "0123456789abcdef", false},
+		{"expired token", "carol", expired, false},
+		{"idle token with an open stream", "dave", open, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sess, resumed, token := attachSession(t, ss, tt.caller, tt.token)
+			defer ss.detach(sess)
+			if resumed != tt.resumed {
+				t.Fatalf("attach() resumed = %v, want %v", resumed, tt.resumed)
+			}
+			if resumed != (token == tt.token) {
+				t.Errorf("attach() sent token %q for %q, resumed %v", token, tt.token, resumed)
+			}
+
+			wantChat, wantVersion := "map[]", int64(0)
+			if tt.token == saved && resumed {
+				wantChat, wantVersion = "map[1,1:3]", 7
+			}
+			if got := fmt.Sprint(sess.chatLocations()); got != wantChat {
+				t.Errorf("chatLocations() = %s, want %s", got, wantChat)
+			}
+			if got := sess.watchedVersion(); got != wantVersion {
+				t.Errorf("watchedVersion() = %d, want %d", got, wantVersion)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package main
+
+import (
+	"context"
+	"math"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxSnapDistanceMeters caps how far SnapToNearestFeature may move a point
+const maxSnapDistanceMeters = 50000
+
+// SnapToNearestFeature moves a point to the nearest feature within a distance (unary RPC)
+func (s *routeGuideServer) SnapToNearestFeature(ctx context.Context, req *pb.SnapRequest) (*pb.SnapResult, error) {
+	logger := loggerFrom(ctx)
+	logger.Info("SnapToNearestFeature called", "max_distance_meters", req.MaxDistanceMeters, "named_only", req.NamedOnly)
+
+	if req.Point == nil {
+		return nil, status.Error(codes.InvalidArgument, "point is required")
+	}
+	if req.MaxDistanceMeters <= 0 || req.MaxDistanceMeters > maxSnapDistanceMeters {
+		return nil, status.Errorf(codes.InvalidArgument, "max_distance_meters must be between 1 and %d", maxSnapDistanceMeters)
+	}
+
+	at, err := queryTime(ctx)
+	if err != nil {
+		return nil, err
+	}
+	candidates, err := s.featureStore.FeaturesIn(ctx, snapBounds(req.Point, req.MaxDistanceMeters))
+	if err != nil {
+		return nil, err
+	}
+
+	var nearest *featureRecord
+	nearestDistance := req.MaxDistanceMeters
+	for _, feature := range candidates {
+		if !feature.activeAt(at) || (req.NamedOnly && feature.Name == "") {
+			continue
+		}
+		// Ties go to the first feature in dataset order
+		distance := geo.Distance(req.Point, feature.Location)
+		if distance < nearestDistance || (nearest == nil && distance == nearestDistance) {
+			nearest, nearestDistance = feature, distance
+		}
+	}
+
+	if nearest == nil {
+		logger.Info("SnapToNearestFeature found no feature", "max_distance_meters", req.MaxDistanceMeters)
+		return &pb.SnapResult{Point: req.Point}, nil
+	}
+	logger.Info("SnapToNearestFeature snapped", "name", nearest.Name, "distance_meters", nearestDistance)
+	s.popularity.hit(geo.Key(nearest.Location), time.Now())
+	return &pb.SnapResult{
+		Snapped:        true,
+		Point:          nearest.Location,
+		Feature:        nearest.localized(s.requestLocales(ctx)),
+		DistanceMeters: nearestDistance,
+	}, nil
+}
+
+// snapBounds returns a rectangle containing every point within meters of p,
+// clamped to valid coordinates
+func snapBounds(p *pb.Point, meters int32) *pb.Rectangle {
+	latDelta := float64(meters) / geo.MetersPerDegree
+	lonDelta := 180.0
+	if cos := math.Cos(geo.Radians(geo.Degrees(p.Latitude))); cos > 1e-6 {
+		lonDelta = math.Min(latDelta/cos, 180)
+	}
+
+	clamp := func(degrees, limit float64) int32 {
+		return geo.E7(math.Max(-limit, math.Min(limit, degrees)))
+	}
+	lat, lon := geo.Degrees(p.Latitude), geo.Degrees(p.Longitude)
+	return &pb.Rectangle{
+		Lo: &pb.Point{Latitude: clamp(lat-latDelta, 90), Longitude: clamp(lon-lonDelta, 180)},
+		Hi: &pb.Point{Latitude: clamp(lat+latDelta, 90), Longitude: clamp(lon+lonDelta, 180)},
+	}
+}
@@ -0,0 +1,240 @@
+//go:build !minimal && !no_sqlite
+
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"flag"
+	"log/slog"
+	"net/url"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	// Registers the sqlite3 driver, built with the R*-tree module
+	_ "github.com/mattn/go-sqlite3"
+)
+
+var sqliteDB = flag.String("sqlite-db", "", "SQLite database file features are read from instead of --features, and written to; created from --features if it doesn't exist (disabled when empty)")
+
+func init() {
+	registerExtension(extension{name: "sqlite", start: startSQLite})
+}
+
+// startSQLite serves features from SQLite if --sqlite-db is set
+func startSQLite(h *extensionHost) error {
+	if *sqliteDB == "" {
+		return nil
+	}
+	if _, ok := h.server.featureStore.(datasetFeatureStore); !ok {
+		return errors.New("--sqlite-db and --postgis-dsn are mutually exclusive")
+	}
+	store, err := openSQLiteFeatureStore(h.ctx, *sqliteDB, h.server.current().features)
+	if err != nil {
+		return err
+	}
+	h.server.featureStore = store
+	h.stops = append(h.stops, func() { store.db.Close() })
+	slog.Info("Serving features from SQLite", "database", *sqliteDB)
+	return nil
+}
+
+// sqliteSchema creates the tables of a feature database. Features are
+// stored in the shape of the features file, indexed by an R*-tree of their
+// E7 coordinates, which fit its 32-bit integer variant.
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS features (
+	id        INTEGER PRIMARY KEY,
+	latitude  INTEGER NOT NULL,
+	longitude INTEGER NOT NULL,
+	feature   TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS features_location ON features (latitude, longitude);
+CREATE VIRTUAL TABLE IF NOT EXISTS features_rtree USING rtree_i32(id, min_lat, max_lat, min_lon, max_lon);`
+
+// sqliteInRect selects the features within the E7 latitudes ?1 to ?2 and
+// the longitudes ?3 to ?4 or ?5 to ?6, edges included. A rectangle crossing
+// the antimeridian is split in two; others pass the same longitudes twice.
+// Each half is looked up in the R*-tree.
+const sqliteInRect = `SELECT feature FROM features WHERE id IN (
+	SELECT id FROM features_rtree WHERE min_lat >= ?1 AND max_lat <= ?2 AND min_lon >= ?3 AND max_lon <= ?4
+	UNION
+	SELECT id FROM features_rtree WHERE min_lat >= ?1 AND max_lat <= ?2 AND min_lon >= ?5 AND max_lon <= ?6
+) ORDER BY id`
+
+// sqliteFeatureStore keeps features in a SQLite database file, so features
+// the server creates, updates, deletes or approves survive restarts without
+// an external database. Features are listed in id order; written features
+// have no names or validity windows.
+type sqliteFeatureStore struct {
+	db *sql.DB
+}
+
+// openSQLiteFeatureStore opens the database at path, creating its tables.
+// An empty database is filled with seed.
+func openSQLiteFeatureStore(ctx context.Context, path string, seed []*featureRecord) (*sqliteFeatureStore, error) {
+	// WAL lets reads run while a feature is written; the busy timeout makes
+	// writers queue rather than fail
+	dsn := "file:" + path + "?" + url.Values{"_journal_mode": {"WAL"}, "_busy_timeout": {"5000"}}.Encode()
+	db, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		return nil, err
+	}
+	store := &sqliteFeatureStore{db: db}
+	if err := store.init(ctx, seed); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return store, nil
+}
+
+// init creates the tables and seeds them if they're empty
+func (ss *sqliteFeatureStore) init(ctx context.Context, seed []*featureRecord) error {
+	if _, err := ss.db.ExecContext(ctx, sqliteSchema); err != nil {
+		return err
+	}
+	var count int
+	if err := ss.db.QueryRowContext(ctx, `SELECT count(*) FROM features`).Scan(&count); err != nil {
+		return err
+	}
+	if count > 0 || len(seed) == 0 {
+		return nil
+	}
+
+	tx, err := ss.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, feature := range seed {
+		record := featureJSON{Location: feature.Location, Name: feature.Name, Names: feature.names, Windows: feature.windows}
+		if err := sqliteInsert(ctx, tx, record); err != nil {
+			return err
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	slog.Info("Seeded SQLite database", "count", len(seed))
+	return nil
+}
+
+func (ss *sqliteFeatureStore) FeatureAt(ctx context.Context, point *pb.Point, at time.Time) (*featureRecord, error) {
+	features, err := ss.query(ctx, `SELECT feature FROM features WHERE latitude = ? AND longitude = ? ORDER BY id`,
+		point.Latitude, point.Longitude)
+	if err != nil {
+		return nil, err
+	}
+	for _, feature := range features {
+		if feature.activeAt(at) {
+			return feature, nil
+		}
+	}
+	return nil, nil
+}
+
+func (ss *sqliteFeatureStore) FeaturesIn(ctx context.Context, rect *pb.Rectangle) ([]*featureRecord, error) {
+	parts := geo.Canonical(rect).Split()
+	first, last := parts[0], parts[len(parts)-1]
+	return ss.query(ctx, sqliteInRect, first.South, first.North, first.West, first.East, last.West, last.East)
+}
+
+// PutFeature replaces the features at the feature's location with it
+func (ss *sqliteFeatureStore) PutFeature(ctx context.Context, feature *pb.Feature) error {
+	return ss.write(ctx, func(tx *sql.Tx) error {
+		if err := sqliteDelete(ctx, tx, feature.Location); err != nil {
+			return err
+		}
+		return sqliteInsert(ctx, tx, featureJSON{Location: feature.Location, Name: feature.Name})
+	})
+}
+
+// DeleteFeature deletes the features at location
+func (ss *sqliteFeatureStore) DeleteFeature(ctx context.Context, location *pb.Point) error {
+	return ss.write(ctx, func(tx *sql.Tx) error { return sqliteDelete(ctx, tx, location) })
+}
+
+// write runs f in a transaction. Failures are UNAVAILABLE.
+func (ss *sqliteFeatureStore) write(ctx context.Context, f func(tx *sql.Tx) error) error {
+	tx, err := ss.db.BeginTx(ctx, nil)
+	if err == nil {
+		defer tx.Rollback()
+		err = f(tx)
+	}
+	if err == nil {
+		err = tx.Commit()
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		return status.Errorf(codes.Unavailable, "feature database: %v", err)
+	}
+	return nil
+}
+
+// sqliteInsert adds a feature and its R*-tree entry
+func sqliteInsert(ctx context.Context, tx *sql.Tx, record featureJSON) error {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	lat, lon := record.Location.GetLatitude(), record.Location.GetLongitude()
+	result, err := tx.ExecContext(ctx, `INSERT INTO features (latitude, longitude, feature) VALUES (?, ?, ?)`, lat, lon, string(data))
+	if err != nil {
+		return err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `INSERT INTO features_rtree VALUES (?, ?, ?, ?, ?)`, id, lat, lat, lon, lon)
+	return err
+}
+
+// sqliteDelete removes the features at location and their R*-tree entries
+func sqliteDelete(ctx context.Context, tx *sql.Tx, location *pb.Point) error {
+	_, err := tx.ExecContext(ctx, `DELETE FROM features_rtree WHERE id IN
+		(SELECT id FROM features WHERE latitude = ? AND longitude = ?)`, location.Latitude, location.Longitude)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, `DELETE FROM features WHERE latitude = ? AND longitude = ?`, location.Latitude, location.Longitude)
+	return err
+}
+
+// query runs a query selecting features. Failures are UNAVAILABLE, except
+// for a malformed feature in the database.
+func (ss *sqliteFeatureStore) query(ctx context.Context, query string, args ...any) ([]*featureRecord, error) {
+	rows, err := ss.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, status.FromContextError(ctx.Err()).Err()
+		}
+		return nil, status.Errorf(codes.Unavailable, "feature database: %v", err)
+	}
+	defer rows.Close()
+
+	var features []*featureRecord
+	for rows.Next() {
+		var data string
+		if err := rows.Scan(&data); err != nil {
+			return nil, status.Errorf(codes.Unavailable, "feature database: %v", err)
+		}
+		feature := &featureRecord{}
+		if err := json.Unmarshal([]byte(data), feature); err != nil {
+			return nil, status.Errorf(codes.Internal, "invalid feature in the database: %v", err)
+		}
+		features = append(features, feature)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, status.Errorf(codes.Unavailable, "feature database: %v", err)
+	}
+	return features, nil
+}
@@ -0,0 +1,176 @@
+//go:build !minimal && !no_sqlite
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// sqliteSeed is seeded into the test databases: two features at one
+// location, one of them expired, and features on either side of the
+// antimeridian and on it
+const sqliteSeed = `[
+	{"location": {"latitude": 10000000, "longitude": 20000000}, "name": "Expired", "windows": [{"until": "2000-01-01T00:00:00Z"}]},
+	{"location": {"latitude": 10000000, "longitude": 20000000}, "name": "Current", "names": {"es": "Actual"}},
+	{"location": {"latitude": 30000000, "longitude": 40000000}, "name": "Corner"},
+	{"location": {"latitude": -170000000, "longitude": 1790000000}, "name": "Fiji"},
+	{"location": {"latitude": -140000000, "longitude": -1715000000}, "name": "Samoa"},
+	{"location": {"latitude": -150000000, "longitude": 0}, "name": "Greenwich"},
+	{"location": {"latitude": -160000000, "longitude": -1800000000}, "name": "Antimeridian"}
+]`
+
+// openTestSQLite opens a seeded feature database in a directory removed
+// when the test ends, returning the store and the seed
+func openTestSQLite(t *testing.T, path string) (*sqliteFeatureStore, []*featureRecord) {
+	t.Helper()
+	var seed []*featureRecord
+	if err := json.Unmarshal([]byte(sqliteSeed), &seed); err != nil {
+		t.Fatal(err)
+	}
+	store, err := openSQLiteFeatureStore(context.Background(), path, seed)
+	if err != nil {
+		t.Fatalf("openSQLiteFeatureStore() failed: %v", err)
+	}
+	t.Cleanup(func() { store.db.Close() })
+	return store, seed
+}
+
+// featureNames returns the names of features, in order
+func featureNames(features []*featureRecord) []string {
+	var names []string
+	for _, feature := range features {
+		names = append(names, feature.Name)
+	}
+	return names
+}
+
+func TestSQLiteFeatureReads(t *testing.T) {
+	store, seed := openTestSQLite(t, filepath.Join(t.TempDir(), "features.db"))
+	ctx := context.Background()
+
+	feature, err := store.FeatureAt(ctx, &pb.Point{Latitude: 10000000, Longitude: 20000000}, time.Now())
+	if err != nil || feature == nil || feature.Name != "Current" || feature.names["es"] != "Actual" {
+		t.Errorf("FeatureAt() = %v, %v, want the feature valid now, with its names", feature, err)
+	}
+	if feature, err := store.FeatureAt(ctx, &pb.Point{Latitude: 10000000, Longitude: 20000001}, time.Now()); err != nil || feature != nil {
+		t.Errorf("FeatureAt() next to a feature = %v, %v, want none", feature, err)
+	}
+
+	tests := []struct {
+		name string
+		rect *pb.Rectangle
+	}{
+		{"corners either way", &pb.Rectangle{Lo: &pb.Point{Latitude: 30000000, Longitude: 40000000}, Hi: &pb.Point{Latitude: 0, Longitude: 0}}},
+		{"single location", &pb.Rectangle{Lo: &pb.Point{Latitude: 10000000, Longitude: 20000000}, Hi: &pb.Point{Latitude: 10000000, Longitude: 20000000}}},
+		{"through Greenwich", &pb.Rectangle{Lo: &pb.Point{Latitude: -200000000, Longitude: 1780000000}, Hi: &pb.Point{Latitude: -100000000, Longitude: -1700000000}}},
+		{"across the antimeridian", &pb.Rectangle{Lo: &pb.Point{Latitude: -200000000, Longitude: 1780000000}, Hi: &pb.Point{Latitude: -100000000, Longitude: -1700000000}, CrossesAntimeridian: true}},
+		{"up to 180 degrees", &pb.Rectangle{Lo: &pb.Point{Latitude: -200000000, Longitude: 1780000000}, Hi: &pb.Point{Latitude: -100000000, Longitude: 1800000000}}},
+		{"the world", worldRectangle},
+		{"empty", &pb.Rectangle{Lo: &pb.Point{Latitude: 500000000, Longitude: 500000000}, Hi: &pb.Point{Latitude: 600000000, Longitude: 600000000}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var want []string
+			for _, feature := range seed {
+				if geo.Canonical(tt.rect).Contains(feature.Location) {
+					want = append(want, feature.Name)
+				}
+			}
+			features, err := store.FeaturesIn(ctx, tt.rect)
+			if err != nil {
+				t.Fatalf("FeaturesIn() failed: %v", err)
+			}
+			if got := featureNames(features); !slices.Equal(got, want) {
+				t.Errorf("FeaturesIn() = %v, want %v", got, want)
+			}
+		})
+	}
+
+	// Both halves of a rectangle are searched in the R*-tree, with all four
+	// constraints, rather than by scanning it
+	plan, err := store.db.Query(`EXPLAIN QUERY PLAN `+sqliteInRect, 0, 0, 0, 0, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	searches := 0
+	for plan.Next() {
+		var id, parent, unused int
+		var detail string
+		if err := plan.Scan(&id, &parent, &unused, &detail); err != nil {
+			t.Fatal(err)
+		}
+		if strings.Contains(detail, "features_rtree VIRTUAL TABLE INDEX 2:D0B1D2B3") {
+			searches++
+		}
+	}
+	plan.Close()
+	if searches != 2 {
+		t.Errorf("FeaturesIn() searches the R*-tree %d times, want 2", searches)
+	}
+
+	if _, err := store.db.Exec(`UPDATE features SET feature = '{"location": 1}' WHERE id = 3`); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.FeaturesIn(ctx, worldRectangle); status.Code(err) != codes.Internal {
+		t.Errorf("FeaturesIn() of a malformed row = %v, want INTERNAL", err)
+	}
+}
+
+func TestSQLiteFeatureWrites(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "features.db")
+	store, _ := openTestSQLite(t, path)
+	ctx := context.Background()
+	location := &pb.Point{Latitude: 10000000, Longitude: 20000000}
+	added := &pb.Point{Latitude: 409146138, Longitude: -746188906}
+
+	if err := store.PutFeature(ctx, &pb.Feature{Name: "Replaced", Location: location}); err != nil {
+		t.Fatalf("PutFeature() failed: %v", err)
+	}
+	if err := store.PutFeature(ctx, &pb.Feature{Name: "Berkshire Valley", Location: added}); err != nil {
+		t.Fatalf("PutFeature() failed: %v", err)
+	}
+	if err := store.DeleteFeature(ctx, &pb.Point{Latitude: 30000000, Longitude: 40000000}); err != nil {
+		t.Fatalf("DeleteFeature() failed: %v", err)
+	}
+
+	// The changes are kept when the database is opened again, rather than
+	// seeded over
+	store.db.Close()
+	store, _ = openTestSQLite(t, path)
+	tests := []struct {
+		location *pb.Point
+		want     []string
+	}{
+		{location, []string{"Replaced"}},
+		{added, []string{"Berkshire Valley"}},
+		{&pb.Point{Latitude: 30000000, Longitude: 40000000}, nil},
+	}
+	for _, tt := range tests {
+		features, err := store.FeaturesIn(ctx, &pb.Rectangle{Lo: tt.location, Hi: tt.location})
+		if err != nil {
+			t.Fatalf("FeaturesIn() failed: %v", err)
+		}
+		if got := featureNames(features); !slices.Equal(got, tt.want) {
+			t.Errorf("features at %s = %v, want %v", geo.Key(tt.location), got, tt.want)
+		}
+	}
+	if features, err := store.FeaturesIn(ctx, worldRectangle); err != nil || len(features) != 6 {
+		t.Errorf("FeaturesIn() of the world = %v, %v, want 6 features", featureNames(features), err)
+	}
+
+	store.db.Close()
+	if err := store.PutFeature(ctx, &pb.Feature{Name: "x", Location: location}); status.Code(err) != codes.Unavailable {
+		t.Errorf("PutFeature() to a closed database = %v, want UNAVAILABLE", err)
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// defaultGeohashPrecision is used when a stats request has no precision
+	defaultGeohashPrecision = 4
+	// maxGeohashPrecision caps the precision of a stats request
+	maxGeohashPrecision = 8
+)
+
+// GetDatasetStats summarizes the dataset being served (unary RPC)
+func (s *routeGuideServer) GetDatasetStats(ctx context.Context, req *pb.DatasetStatsRequest) (*pb.DatasetStats, error) {
+	loggerFrom(ctx).Info("GetDatasetStats called", "geohash_precision", req.GeohashPrecision)
+
+	precision := int(req.GeohashPrecision)
+	if precision == 0 {
+		precision = defaultGeohashPrecision
+	}
+	if precision < 1 || precision > maxGeohashPrecision {
+		return nil, status.Errorf(codes.InvalidArgument, "geohash_precision must be between 1 and %d", maxGeohashPrecision)
+	}
+
+	d, err := s.datasetFor(ctx)
+	if err != nil {
+		return nil, err
+	}
+	features, err := s.featuresOf(d).FeaturesIn(ctx, worldRectangle)
+	if err != nil {
+		return nil, err
+	}
+	return datasetStats(d, features, precision), nil
+}
+
+// datasetStats computes the statistics of the features served, loaded with
+// dataset d
+func datasetStats(d *dataset, features []*featureRecord, precision int) *pb.DatasetStats {
+	stats := &pb.DatasetStats{
+		Version:        d.version,
+		Source:         d.source,
+		LoadedAt:       timestamppb.New(d.loadedAt),
+		LoadDurationMs: d.loadDuration.Milliseconds(),
+		FeatureCount:   int32(len(features)),
+		SkippedCount:   int32(d.skipped),
+		LoadErrors:     d.loadErrors,
+	}
+
+	seen := make(map[string]bool, len(features))
+	buckets := make(map[string]int32)
+	for i, feature := range features {
+		loc := feature.Location
+		if i == 0 {
+			stats.Bounds = &pb.Rectangle{
+				Lo: &pb.Point{Latitude: loc.Latitude, Longitude: loc.Longitude},
+				Hi: &pb.Point{Latitude: loc.Latitude, Longitude: loc.Longitude},
+			}
+		}
+		stats.Bounds.Lo.Latitude = min(stats.Bounds.Lo.Latitude, loc.Latitude)
+		stats.Bounds.Lo.Longitude = min(stats.Bounds.Lo.Longitude, loc.Longitude)
+		stats.Bounds.Hi.Latitude = max(stats.Bounds.Hi.Latitude, loc.Latitude)
+		stats.Bounds.Hi.Longitude = max(stats.Bounds.Hi.Longitude, loc.Longitude)
+
+		key := geo.Key(loc)
+		if seen[key] {
+			stats.DuplicateCount++
+		}
+		seen[key] = true
+
+		buckets[encodeGeohash(geo.Degrees(loc.Latitude), geo.Degrees(loc.Longitude), precision)]++
+	}
+
+	for hash, count := range buckets {
+		stats.Density = append(stats.Density, &pb.GeohashBucket{Geohash: hash, Count: count})
+	}
+	slices.SortFunc(stats.Density, func(a, b *pb.GeohashBucket) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Geohash, b.Geohash))
+	})
+	return stats
+}
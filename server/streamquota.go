@@ -0,0 +1,180 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// Response metadata describing a caller's stream quota
+const (
+	rateLimitLimitKey     = "ratelimit-limit"     // streams the caller may have open at once
+	rateLimitRemainingKey = "ratelimit-remaining" // streams the caller may still open
+	rateLimitResetKey     = "ratelimit-reset"     // seconds to wait before retrying a rejected stream
+)
+
+// streamQuota caps the streams each principal may have open at once, so a
+// leaky client can't pile up streams it forgot to close
+type streamQuota struct {
+	total      int            // streams per principal across all methods, 0 for unlimited
+	methods    map[string]int // streams per principal by full method name
+	retryDelay time.Duration  // backoff suggested to rejected clients
+
+	mu   sync.Mutex
+	open map[streamQuotaKey]int
+}
+
+// streamQuotaKey counts a principal's open streams of a method; method is
+// empty for the principal's total
+type streamQuotaKey struct {
+	principal string
+	method    string
+}
+
+// parseStreamQuota parses a comma-separated list of Method=N limits on
+// RouteGuide streaming methods; "*=N" limits a principal's streams in total.
+// An empty list sets no limits.
+func parseStreamQuota(list string, retryDelay time.Duration) (*streamQuota, error) {
+	q := &streamQuota{
+		methods:    make(map[string]int),
+		retryDelay: retryDelay,
+		open:       make(map[streamQuotaKey]int),
+	}
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("%q is not Method=N", entry)
+		}
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			return nil, fmt.Errorf("invalid limit in %q", entry)
+		}
+		switch {
+		case name == "*":
+			q.total = limit
+		case !isRouteGuideStream(name):
+			return nil, fmt.Errorf("unknown RouteGuide streaming method %q", name)
+		default:
+			q.methods["/"+pb.RouteGuide_ServiceDesc.ServiceName+"/"+name] = limit
+		}
+	}
+	return q, nil
+}
+
+// isRouteGuideStream reports whether name is a streaming RouteGuide method
+func isRouteGuideStream(name string) bool {
+	for _, s := range pb.RouteGuide_ServiceDesc.Streams {
+		if s.StreamName == name {
+			return true
+		}
+	}
+	return false
+}
+
+// quotaHint describes the tightest stream limit applying to a caller
+type quotaHint struct {
+	limit     int // 0 if no limit applies
+	remaining int // streams the caller may still open
+}
+
+// acquire counts a new stream of principal, failing if it would exceed a
+// limit. Successful calls must be paired with release. The returned hint
+// describes the tightest limit after the stream is counted, or the limit
+// that was hit.
+func (q *streamQuota) acquire(principal, fullMethod string) (quotaHint, error) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	total := streamQuotaKey{principal: principal}
+	method := streamQuotaKey{principal: principal, method: fullMethod}
+	if limit := q.methods[fullMethod]; limit > 0 && q.open[method] >= limit {
+		return quotaHint{limit: limit}, resourceExhausted("principal:"+principal,
+			fmt.Sprintf("at most %d concurrent %s streams are allowed per caller", limit, fullMethod), q.retryDelay)
+	}
+	if q.total > 0 && q.open[total] >= q.total {
+		return quotaHint{limit: q.total}, resourceExhausted("principal:"+principal,
+			fmt.Sprintf("at most %d concurrent streams are allowed per caller", q.total), q.retryDelay)
+	}
+	q.open[total]++
+	q.open[method]++
+
+	var hint quotaHint
+	for key, limit := range map[streamQuotaKey]int{method: q.methods[fullMethod], total: q.total} {
+		if remaining := limit - q.open[key]; limit > 0 && (hint.limit == 0 || remaining < hint.remaining) {
+			hint = quotaHint{limit: limit, remaining: remaining}
+		}
+	}
+	return hint, nil
+}
+
+// metadata encodes the hint as ratelimit-limit and ratelimit-remaining
+// response metadata, plus ratelimit-reset, the seconds to wait before
+// retrying, if reset is positive
+func (h quotaHint) metadata(reset time.Duration) metadata.MD {
+	md := metadata.Pairs(
+		rateLimitLimitKey, strconv.Itoa(h.limit),
+		rateLimitRemainingKey, strconv.Itoa(h.remaining),
+	)
+	if reset > 0 {
+		md.Set(rateLimitResetKey, strconv.Itoa(int(math.Ceil(reset.Seconds()))))
+	}
+	return md
+}
+
+// release forgets a stream counted by acquire
+func (q *streamQuota) release(principal, fullMethod string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, key := range []streamQuotaKey{{principal: principal}, {principal: principal, method: fullMethod}} {
+		if q.open[key]--; q.open[key] <= 0 {
+			delete(q.open, key)
+		}
+	}
+}
+
+// streamInterceptor enforces the quota. It must run after authentication;
+// anonymous callers are told apart by their address.
+func (q *streamQuota) streamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	var principal string
+	if p := principalFromContext(ss.Context()); p != nil {
+		principal = p.Name
+	} else if p, ok := peer.FromContext(ss.Context()); ok {
+		principal = "anonymous@" + peerHost(p)
+	}
+
+	hint, err := q.acquire(principal, info.FullMethod)
+	if err != nil {
+		loggerFrom(ss.Context()).Warn("Rejected stream: too many open streams", "caller", principal)
+		ss.SetTrailer(hint.metadata(q.retryDelay))
+		return err
+	}
+	defer q.release(principal, info.FullMethod)
+	if hint.limit > 0 {
+		// Sent with the first response or the status, whichever comes first
+		ss.SetHeader(hint.metadata(0))
+	}
+	return handler(srv, ss)
+}
+
+// peerHost returns the host part of a peer's address
+func peerHost(p *peer.Peer) string {
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
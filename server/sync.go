@@ -0,0 +1,250 @@
+package main
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// defaultSyncPageSize is used when a sync request has no page size
+	defaultSyncPageSize = 100
+	// maxSyncPageSize caps the page size of a sync request
+	maxSyncPageSize = 1000
+	// maxSyncDeltas is how many dataset changes are kept for resuming syncs
+	maxSyncDeltas = 32
+)
+
+// syncEpoch identifies this server process in sync resume tokens
+var syncEpoch = time.Now().UnixNano()
+
+// featureDelta is the difference between two consecutive dataset versions.
+// Features are identified by their location.
+type featureDelta struct {
+	from, to int64
+	upserted []*featureRecord
+	removed  []*pb.Point
+}
+
+// diffDatasets computes the changes that turn prev into next
+func diffDatasets(prev, next *dataset) *featureDelta {
+	delta := &featureDelta{from: prev.version, to: next.version}
+
+	before := make(map[string]*featureRecord, len(prev.features))
+	for _, feature := range prev.features {
+		before[geo.Key(feature.Location)] = feature
+	}
+
+	after := make(map[string]bool, len(next.features))
+	for _, feature := range next.features {
+		key := geo.Key(feature.Location)
+		after[key] = true
+		if old, ok := before[key]; !ok || !sameFeature(old, feature) {
+			delta.upserted = append(delta.upserted, feature)
+		}
+	}
+
+	for _, feature := range prev.features {
+		if !after[geo.Key(feature.Location)] {
+			delta.removed = append(delta.removed, feature.Location)
+		}
+	}
+	return delta
+}
+
+// sameFeature reports whether two feature records hold the same data
+func sameFeature(a, b *featureRecord) bool {
+	return proto.Equal(a.Feature, b.Feature) &&
+		maps.Equal(a.names, b.names) &&
+		slices.EqualFunc(a.windows, b.windows, func(x, y timeWindow) bool {
+			return x.From.Equal(y.From) && x.Until.Equal(y.Until)
+		})
+}
+
+// deltaLog keeps the most recent dataset changes
+type deltaLog struct {
+	mu     sync.Mutex
+	deltas []*featureDelta // ordered by version
+}
+
+// add records a dataset change, forgetting the oldest beyond maxSyncDeltas
+func (l *deltaLog) add(delta *featureDelta) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.deltas = append(l.deltas, delta)
+	if len(l.deltas) > maxSyncDeltas {
+		l.deltas = slices.Delete(l.deltas, 0, len(l.deltas)-maxSyncDeltas)
+	}
+}
+
+// since returns the chain of deltas from version from up to version to, or
+// false if part of it has been forgotten
+func (l *deltaLog) since(from, to int64) ([]*featureDelta, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var chain []*featureDelta
+	for _, delta := range l.deltas {
+		if delta.from >= from && delta.to <= to {
+			chain = append(chain, delta)
+		}
+	}
+	if len(chain) == 0 || chain[0].from != from || chain[len(chain)-1].to != to {
+		return nil, false
+	}
+	return chain, true
+}
+
+// SyncFeatures streams a snapshot of the features followed by deltas (server streaming RPC)
+func (s *routeGuideServer) SyncFeatures(req *pb.SyncRequest, stream pb.RouteGuide_SyncFeaturesServer) error {
+	logger := loggerFrom(stream.Context())
+	logger.Info("SyncFeatures called", "resuming", req.ResumeToken != "")
+
+	pageSize := int(req.PageSize)
+	switch {
+	case pageSize <= 0:
+		pageSize = defaultSyncPageSize
+	case pageSize > maxSyncPageSize:
+		pageSize = maxSyncPageSize
+	}
+
+	var synced int64 // version of the client's copy, 0 if it has none
+	if req.ResumeToken != "" {
+		version, epoch, err := decodeSyncToken(req.ResumeToken)
+		if err != nil {
+			return reasonError(codes.InvalidArgument, "INVALID_RESUME_TOKEN", nil)
+		}
+		// Versions restart with the process, so older tokens can't be trusted
+		if epoch == syncEpoch {
+			synced = version
+		}
+	}
+
+	// Subscribe before reading the dataset so no swap goes unnoticed
+	events, unsubscribe := s.watchers.subscribe()
+	defer unsubscribe()
+
+	locales := s.requestLocales(stream.Context())
+	localize := func(features []*featureRecord) []*pb.Feature {
+		out := make([]*pb.Feature, len(features))
+		for i, feature := range features {
+			out[i] = feature.localized(locales)
+		}
+		return out
+	}
+
+	// catchUp brings the client's copy to d, with deltas if possible
+	catchUp := func(d *dataset) error {
+		if synced == d.version {
+			return nil
+		}
+		if chain, ok := s.deltas.since(synced, d.version); ok && synced > 0 {
+			for _, delta := range chain {
+				msg := &pb.SyncMessage{
+					Payload: &pb.SyncMessage_Delta{Delta: &pb.FeatureDelta{
+						Upserted: localize(delta.upserted),
+						Removed:  delta.removed,
+					}},
+					Version:     delta.to,
+					ResumeToken: encodeSyncToken(delta.to),
+				}
+				if err := stream.Send(msg); err != nil {
+					return err
+				}
+			}
+			logger.Info("SyncFeatures sent deltas", "deltas", len(chain), "dataset_version", d.version)
+			synced = d.version
+			return nil
+		}
+
+		for start := 0; start == 0 || start < len(d.features); start += pageSize {
+			end := start + pageSize
+			if end > len(d.features) {
+				end = len(d.features)
+			}
+			page := &pb.SnapshotPage{
+				Features: localize(d.features[start:end]),
+				First:    start == 0,
+				Last:     end == len(d.features),
+			}
+			msg := &pb.SyncMessage{
+				Payload: &pb.SyncMessage_Snapshot{Snapshot: page},
+				Version: d.version,
+			}
+			if page.Last {
+				msg.ResumeToken = encodeSyncToken(d.version)
+			}
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+		}
+		logger.Info("SyncFeatures sent snapshot", "dataset_version", d.version, "count", len(d.features))
+		synced = d.version
+		return nil
+	}
+
+	resumed := synced
+	if err := catchUp(s.current()); err != nil {
+		return err
+	}
+	if synced == resumed {
+		// Already up to date; still send headers so the client knows the sync is live
+		if err := stream.SendHeader(nil); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case _, ok := <-events:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "sync fell too far behind")
+			}
+			if err := catchUp(s.current()); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			logger.Info("SyncFeatures completed", "dataset_version", synced)
+			return nil
+		}
+	}
+}
+
+// recordDelta remembers how next differs from prev for resuming syncs, and
+// returns the difference
+func (s *routeGuideServer) recordDelta(prev, next *dataset) *featureDelta {
+	start := time.Now()
+	delta := diffDatasets(prev, next)
+	s.deltas.add(delta)
+	slog.Info("Dataset diffed", "dataset_version", next.version, "changed", len(delta.upserted),
+		"removed", len(delta.removed), "elapsed", time.Since(start).Round(time.Microsecond))
+	return delta
+}
+
+// encodeSyncToken turns a synced dataset version into an opaque resume token
+func encodeSyncToken(version int64) string {
+	return base64.RawURLEncoding.EncodeToString(fmt.Appendf(nil, "%d.%d", version, syncEpoch))
+}
+
+// decodeSyncToken is the inverse of encodeSyncToken
+func decodeSyncToken(token string) (version, epoch int64, err error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, 0, err
+	}
+	if _, err := fmt.Sscanf(string(raw), "%d.%d", &version, &epoch); err != nil {
+		return 0, 0, err
+	}
+	return version, epoch, nil
+}
@@ -0,0 +1,308 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"github.com/dvaldivia/grpc-swift-2-example/server/geo"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const (
+	// tileExtent is the coordinate range of a tile, as recommended by the MVT spec
+	tileExtent = 4096
+	// tileBuffer is how far outside the tile, in tile units, features are still included
+	tileBuffer = 64
+	// maxTileZoom is the deepest zoom level served
+	maxTileZoom = 22
+	// tileLayerName is the name of the layer holding the features
+	tileLayerName = "features"
+)
+
+// tileKey identifies a tile in the z/x/y scheme of a dataset version
+type tileKey struct {
+	z, x, y int
+	version int64
+}
+
+// tileCacheEntry is an encoded tile and when it goes stale: after the cache
+// TTL, or sooner when a feature in the tile becomes valid or expires
+type tileCacheEntry struct {
+	data    []byte
+	expires time.Time
+}
+
+// tileCache caches encoded tiles for a limited time, up to a maximum count
+type tileCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	max     int
+	entries map[tileKey]tileCacheEntry
+}
+
+// newTileCache creates a tile cache
+func newTileCache(max int, ttl time.Duration) *tileCache {
+	return &tileCache{
+		ttl:     ttl,
+		max:     max,
+		entries: make(map[tileKey]tileCacheEntry),
+	}
+}
+
+// get returns a cached tile if it is present and still fresh at time at
+func (c *tileCache) get(key tileKey, at time.Time) (tileCacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || !at.Before(entry.expires) {
+		return tileCacheEntry{}, false
+	}
+	return entry, true
+}
+
+// put stores a tile, dropping expired entries (or, failing that, an arbitrary one) when full
+func (c *tileCache) put(key tileKey, entry tileCacheEntry) {
+	if c.max <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= c.max {
+		now := time.Now()
+		for k, e := range c.entries {
+			if !now.Before(e.expires) {
+				delete(c.entries, k)
+			}
+		}
+		for k := range c.entries {
+			if len(c.entries) < c.max {
+				break
+			}
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = entry
+}
+
+// purge empties the cache, returning how many tiles it dropped
+func (c *tileCache) purge() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	n := len(c.entries)
+	c.entries = make(map[tileKey]tileCacheEntry)
+	return n
+}
+
+// handleTile serves GET /tiles/{z}/{x}/{y}.mvt
+func (s *routeGuideServer) handleTile(w http.ResponseWriter, r *http.Request) {
+	key, err := parseTileKey(r.PathValue("z"), r.PathValue("x"), r.PathValue("y"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Keying on the version keeps tiles of a replaced dataset from being served
+	d := s.current()
+	key.version = d.version
+
+	now := time.Now()
+	entry, hit, err := s.tile(r.Context(), s.featuresOf(d), key, now)
+	if err != nil {
+		slog.Error("Failed to render tile", "z", key.z, "x", key.x, "y", key.y, "error", err)
+		http.Error(w, status.Convert(err).Message(), http.StatusServiceUnavailable)
+		return
+	}
+	slog.Info("Served tile", "z", key.z, "x", key.x, "y", key.y, "bytes", len(entry.data), "cached", hit, "peer", r.RemoteAddr)
+
+	w.Header().Set("Content-Type", "application/vnd.mapbox-vector-tile")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	// Clients may keep the tile only as long as the cache does
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", max(0, int(entry.expires.Sub(now).Seconds()))))
+	w.Write(entry.data)
+}
+
+// parseTileKey validates the z, x and y path segments of a tile request
+func parseTileKey(zs, xs, ys string) (tileKey, error) {
+	z, err := strconv.Atoi(zs)
+	if err != nil || z < 0 || z > maxTileZoom {
+		return tileKey{}, fmt.Errorf("invalid zoom %q", zs)
+	}
+
+	n := 1 << z
+	x, err := strconv.Atoi(xs)
+	if err != nil || x < 0 || x >= n {
+		return tileKey{}, fmt.Errorf("invalid tile column %q", xs)
+	}
+	y, err := strconv.Atoi(strings.TrimSuffix(ys, ".mvt"))
+	if err != nil || y < 0 || y >= n {
+		return tileKey{}, fmt.Errorf("invalid tile row %q", ys)
+	}
+
+	return tileKey{z: z, x: x, y: y}, nil
+}
+
+// tile returns the tile at key, from the cache or else drawn from the
+// features valid at time at, and whether it was cached. A drawn tile is
+// cached until the TTL runs out or a feature in it becomes valid or expires,
+// whichever comes first, so a cached tile never shows a stale set of features.
+func (s *routeGuideServer) tile(ctx context.Context, features FeatureStore, key tileKey, at time.Time) (tileCacheEntry, bool, error) {
+	if entry, hit := s.tiles.get(key, at); hit {
+		return entry, true, nil
+	}
+	inTile, err := features.FeaturesIn(ctx, tileRect(key))
+	if err != nil {
+		return tileCacheEntry{}, false, err
+	}
+	entry := tileCacheEntry{data: encodeTile(inTile, key, at), expires: at.Add(s.tiles.ttl)}
+	for _, feature := range inTile {
+		if next := feature.nextChange(at); !next.IsZero() && next.Before(entry.expires) {
+			entry.expires = next
+		}
+	}
+	s.tiles.put(key, entry)
+	return entry, false, nil
+}
+
+// tileRect returns a rectangle holding every location a tile draws,
+// including its buffer. The top and bottom rows reach the poles, as
+// locations beyond Web Mercator's range are drawn on their edge.
+func tileRect(key tileKey) *pb.Rectangle {
+	n := float64(int(1) << key.z)
+	margin := float64(tileBuffer) / tileExtent
+	lon := func(x float64) float64 { return math.Max(-180, math.Min(180, x/n*360-180)) }
+	lat := func(y float64) float64 { return math.Atan(math.Sinh(math.Pi*(1-2*y/n))) * 180 / math.Pi }
+	north, south := lat(float64(key.y)-margin), lat(float64(key.y+1)+margin)
+	if key.y == 0 {
+		north = 90
+	}
+	if key.y == int(n)-1 {
+		south = -90
+	}
+	// One E7 unit of slack absorbs rounding
+	return &pb.Rectangle{
+		Lo: &pb.Point{Latitude: max(-geo.MaxLatitude, geo.E7(south)-1), Longitude: max(-geo.MaxLongitude, geo.E7(lon(float64(key.x)-margin))-1)},
+		Hi: &pb.Point{Latitude: min(geo.MaxLatitude, geo.E7(north)+1), Longitude: min(geo.MaxLongitude, geo.E7(lon(float64(key.x+1)+margin))+1)},
+	}
+}
+
+// encodeTile renders the features valid at time at that fall in a tile as a
+// Mapbox Vector Tile with a single point layer carrying a "name" property
+func encodeTile(features []*featureRecord, key tileKey, at time.Time) []byte {
+	n := float64(int(1) << key.z)
+
+	var layer []byte
+	layer = protowire.AppendTag(layer, 15, protowire.VarintType) // version
+	layer = protowire.AppendVarint(layer, 2)
+	layer = protowire.AppendTag(layer, 1, protowire.BytesType) // name
+	layer = protowire.AppendString(layer, tileLayerName)
+
+	var values []string
+	count := 0
+	for _, feature := range features {
+		if !feature.activeAt(at) {
+			continue
+		}
+
+		lat := geo.Degrees(feature.Location.Latitude)
+		lon := geo.Degrees(feature.Location.Longitude)
+		px, py := mercatorTile(lat, lon, n)
+		x := int64(math.Round((px - float64(key.x)) * tileExtent))
+		y := int64(math.Round((py - float64(key.y)) * tileExtent))
+		if x < -tileBuffer || x > tileExtent+tileBuffer || y < -tileBuffer || y > tileExtent+tileBuffer {
+			continue
+		}
+
+		var f []byte
+		f = protowire.AppendTag(f, 1, protowire.VarintType) // id
+		f = protowire.AppendVarint(f, uint64(count+1))
+		if feature.Name != "" {
+			// tags: key 0 ("name") -> value index
+			f = protowire.AppendTag(f, 2, protowire.BytesType)
+			f = protowire.AppendBytes(f, protowire.AppendVarint(protowire.AppendVarint(nil, 0), uint64(len(values))))
+			values = append(values, feature.Name)
+		}
+		f = protowire.AppendTag(f, 3, protowire.VarintType) // type = POINT
+		f = protowire.AppendVarint(f, 1)
+
+		// geometry: MoveTo(1) followed by zigzag-encoded x, y
+		var geom []byte
+		geom = protowire.AppendVarint(geom, 1|1<<3)
+		geom = protowire.AppendVarint(geom, protowire.EncodeZigZag(x))
+		geom = protowire.AppendVarint(geom, protowire.EncodeZigZag(y))
+		f = protowire.AppendTag(f, 4, protowire.BytesType)
+		f = protowire.AppendBytes(f, geom)
+
+		layer = protowire.AppendTag(layer, 2, protowire.BytesType) // features
+		layer = protowire.AppendBytes(layer, f)
+		count++
+	}
+
+	if count == 0 {
+		return []byte{}
+	}
+
+	layer = protowire.AppendTag(layer, 3, protowire.BytesType) // keys
+	layer = protowire.AppendString(layer, "name")
+	for _, v := range values {
+		var value []byte
+		value = protowire.AppendTag(value, 1, protowire.BytesType) // string_value
+		value = protowire.AppendString(value, v)
+		layer = protowire.AppendTag(layer, 4, protowire.BytesType) // values
+		layer = protowire.AppendBytes(layer, value)
+	}
+	layer = protowire.AppendTag(layer, 5, protowire.VarintType) // extent
+	layer = protowire.AppendVarint(layer, tileExtent)
+
+	var tile []byte
+	tile = protowire.AppendTag(tile, 3, protowire.BytesType) // layers
+	tile = protowire.AppendBytes(tile, layer)
+	return tile
+}
+
+// mercatorTile converts degrees to fractional tile coordinates at a zoom with n tiles per side
+func mercatorTile(lat, lon, n float64) (x, y float64) {
+	// Web Mercator is undefined at the poles
+	const maxLat = 85.05112878
+	lat = math.Max(-maxLat, math.Min(maxLat, lat))
+
+	latRad := geo.Radians(lat)
+	x = (lon + 180) / 360 * n
+	y = (1 - math.Log(math.Tan(latRad)+1/math.Cos(latRad))/math.Pi) / 2 * n
+	return x, y
+}
+
+// featureTiles lists, zoom by zoom up to maxZoom, the tiles of a dataset that
+// contain at least one feature
+func featureTiles(d *dataset, maxZoom int) []tileKey {
+	var keys []tileKey
+	seen := make(map[tileKey]bool)
+	for z := 0; z <= maxZoom && z <= maxTileZoom; z++ {
+		n := 1 << z
+		for _, feature := range d.features {
+			fx, fy := mercatorTile(geo.Degrees(feature.Location.Latitude), geo.Degrees(feature.Location.Longitude), float64(n))
+			key := tileKey{
+				z:       z,
+				x:       int(math.Min(math.Floor(fx), float64(n-1))),
+				y:       int(math.Min(math.Floor(fy), float64(n-1))),
+				version: d.version,
+			}
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	return keys
+}
@@ -0,0 +1,211 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// tilePoint is a point feature decoded from a vector tile
+type tilePoint struct {
+	name string
+	x, y int64
+}
+
+// decodeTile decodes the single layer of a tile drawn by encodeTile
+func decodeTile(t *testing.T, data []byte) (name string, extent uint64, points []tilePoint) {
+	t.Helper()
+	fields := func(b []byte, each func(num protowire.Number, v uint64, bytes []byte)) {
+		for len(b) > 0 {
+			num, typ, n := protowire.ConsumeTag(b)
+			if n < 0 {
+				t.Fatalf("bad tag: %v", protowire.ParseError(n))
+			}
+			b = b[n:]
+			switch typ {
+			case protowire.VarintType:
+				v, n := protowire.ConsumeVarint(b)
+				if n < 0 {
+					t.Fatalf("bad varint: %v", protowire.ParseError(n))
+				}
+				each(num, v, nil)
+				b = b[n:]
+			case protowire.BytesType:
+				v, n := protowire.ConsumeBytes(b)
+				if n < 0 {
+					t.Fatalf("bad bytes: %v", protowire.ParseError(n))
+				}
+				each(num, 0, v)
+				b = b[n:]
+			default:
+				t.Fatalf("unexpected wire type %d", typ)
+			}
+		}
+	}
+	varints := func(b []byte) []uint64 {
+		var vs []uint64
+		for len(b) > 0 {
+			v, n := protowire.ConsumeVarint(b)
+			if n < 0 {
+				t.Fatalf("bad packed varint: %v", protowire.ParseError(n))
+			}
+			vs = append(vs, v)
+			b = b[n:]
+		}
+		return vs
+	}
+
+	var layers [][]byte
+	fields(data, func(num protowire.Number, _ uint64, b []byte) {
+		if num == 3 {
+			layers = append(layers, b)
+		}
+	})
+	if len(layers) != 1 {
+		t.Fatalf("tile has %d layers, want 1", len(layers))
+	}
+
+	type rawFeature struct {
+		tags, geometry []uint64
+	}
+	var features []rawFeature
+	var values []string
+	fields(layers[0], func(num protowire.Number, v uint64, b []byte) {
+		switch num {
+		case 1:
+			name = string(b)
+		case 2:
+			var f rawFeature
+			fields(b, func(num protowire.Number, _ uint64, b []byte) {
+				switch num {
+				case 2:
+					f.tags = varints(b)
+				case 4:
+					f.geometry = varints(b)
+				}
+			})
+			features = append(features, f)
+		case 4:
+			fields(b, func(num protowire.Number, _ uint64, b []byte) {
+				if num == 1 {
+					values = append(values, string(b))
+				}
+			})
+		case 5:
+			extent = v
+		}
+	})
+
+	for _, f := range features {
+		if len(f.geometry) != 3 || f.geometry[0] != 1|1<<3 {
+			t.Fatalf("feature geometry = %v, want a single MoveTo", f.geometry)
+		}
+		p := tilePoint{x: protowire.DecodeZigZag(f.geometry[1]), y: protowire.DecodeZigZag(f.geometry[2])}
+		if len(f.tags) == 2 && f.tags[0] == 0 && int(f.tags[1]) < len(values) {
+			p.name = values[f.tags[1]]
+		}
+		points = append(points, p)
+	}
+	return name, extent, points
+}
+
+func TestEncodeTile(t *testing.T) {
+	at := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	features := []*featureRecord{
+		// The origin sits on the corner of the four zoom 1 tiles
+		{Feature: &pb.Feature{Name: "Origin", Location: &pb.Point{}}},
+		{Feature: &pb.Feature{Location: &pb.Point{Latitude: -10000000, Longitude: 10000000}}},
+		{
+			Feature: &pb.Feature{Name: "Expired", Location: &pb.Point{}},
+			windows: []timeWindow{{Until: at}},
+		},
+	}
+
+	if data := encodeTile(features[2:], tileKey{z: 1, x: 1, y: 1}, at); len(data) != 0 {
+		t.Errorf("tile with no valid features = %d bytes, want empty", len(data))
+	}
+
+	name, extent, points := decodeTile(t, encodeTile(features, tileKey{z: 1, x: 1, y: 1}, at))
+	if name != tileLayerName || extent != tileExtent {
+		t.Errorf("layer %q with extent %d, want %q with extent %d", name, extent, tileLayerName, tileExtent)
+	}
+	if len(points) != 2 {
+		t.Fatalf("tile has %d features, want 2: %v", len(points), points)
+	}
+	if want := (tilePoint{name: "Origin"}); points[0] != want {
+		t.Errorf("first feature = %+v, want %+v", points[0], want)
+	}
+	// 10°E, 10°S is inside the south-east tile
+	if p := points[1]; p.name != "" || p.x <= 0 || p.x >= tileExtent || p.y <= 0 || p.y >= tileExtent {
+		t.Errorf("second feature = %+v, want an unnamed point inside the tile", p)
+	}
+
+	// The same origin is on the left edge of the tile to its east
+	_, _, points = decodeTile(t, encodeTile(features[:1], tileKey{z: 1, x: 1, y: 0}, at))
+	if want := (tilePoint{name: "Origin", y: tileExtent}); len(points) != 1 || points[0] != want {
+		t.Errorf("north-east tile features = %+v, want %+v", points, want)
+	}
+}
+
+func TestTileCacheExpiresAtValidityChange(t *testing.T) {
+	s, err := newServer("features.json", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.tiles = newTileCache(16, time.Minute)
+	now := time.Now()
+	opens := now.Add(10 * time.Second)
+	store := &stubFeatureStore{features: []*featureRecord{
+		{Feature: &pb.Feature{Name: "Always", Location: &pb.Point{}}},
+		{
+			Feature: &pb.Feature{Name: "Opening soon", Location: &pb.Point{Latitude: 10000, Longitude: 10000}},
+			windows: []timeWindow{{From: opens}},
+		},
+	}}
+	ctx := context.Background()
+	key := tileKey{z: 0}
+
+	entry, hit, err := s.tile(ctx, store, key, now)
+	if err != nil || hit {
+		t.Fatalf("tile() = hit %v, %v, want a fresh render", hit, err)
+	}
+	if !entry.expires.Equal(opens) {
+		t.Errorf("tile expires at %v, want %v when the second feature opens", entry.expires, opens)
+	}
+	if _, _, points := decodeTile(t, entry.data); len(points) != 1 {
+		t.Errorf("tile before the opening has %d features, want 1", len(points))
+	}
+
+	if _, hit, _ := s.tile(ctx, store, key, opens.Add(-time.Millisecond)); !hit {
+		t.Error("tile() just before the opening missed the cache")
+	}
+	entry, hit, err = s.tile(ctx, store, key, opens)
+	if err != nil || hit {
+		t.Fatalf("tile() at the opening = hit %v, %v, want a fresh render", hit, err)
+	}
+	if _, _, points := decodeTile(t, entry.data); len(points) != 2 {
+		t.Errorf("tile after the opening has %d features, want 2", len(points))
+	}
+	if want := opens.Add(time.Minute); !entry.expires.Equal(want) {
+		t.Errorf("tile expires at %v, want the TTL %v", entry.expires, want)
+	}
+
+	// The HTTP cache lifetime follows the entry's
+	s.tiles.purge()
+	s.featureStore = store
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest("GET", "/tiles/0/0/0.mvt", nil)
+	req.SetPathValue("z", "0")
+	req.SetPathValue("x", "0")
+	req.SetPathValue("y", "0.mvt")
+	s.handleTile(rec, req)
+	var maxAge int
+	if _, err := fmt.Sscanf(rec.Header().Get("Cache-Control"), "public, max-age=%d", &maxAge); err != nil || maxAge > 10 {
+		t.Errorf("Cache-Control = %q, want a max-age of at most 10 seconds", rec.Header().Get("Cache-Control"))
+	}
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// methodTimeouts caps how long calls to each method may run on the server,
+// whatever deadline the client set
+type methodTimeouts struct {
+	methods  map[string]time.Duration // by full method name
+	fallback time.Duration            // for other methods, 0 for no limit
+}
+
+// parseMethodTimeouts parses a comma-separated list of Method=duration
+// limits, e.g. "GetFeature=5s,RouteChat=1h". Methods are RouteGuide or Admin
+// method names; "*=duration" limits every other method.
+func parseMethodTimeouts(list string) (*methodTimeouts, error) {
+	t := &methodTimeouts{methods: make(map[string]time.Duration)}
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("%q is not Method=duration", entry)
+		}
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout <= 0 {
+			return nil, fmt.Errorf("invalid duration in %q", entry)
+		}
+		if name == "*" {
+			t.fallback = timeout
+			continue
+		}
+		fullMethod, ok := lookupMethod(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown RouteGuide or Admin method %q", name)
+		}
+		t.methods[fullMethod] = timeout
+	}
+	return t, nil
+}
+
+// lookupMethod returns the full name of a RouteGuide or Admin method
+func lookupMethod(name string) (string, bool) {
+	for _, desc := range []grpc.ServiceDesc{pb.RouteGuide_ServiceDesc, pb.Admin_ServiceDesc} {
+		for _, m := range desc.Methods {
+			if m.MethodName == name {
+				return "/" + desc.ServiceName + "/" + name, true
+			}
+		}
+		for _, s := range desc.Streams {
+			if s.StreamName == name {
+				return "/" + desc.ServiceName + "/" + name, true
+			}
+		}
+	}
+	return "", false
+}
+
+// timeout returns the maximum duration of calls to a method, or 0
+func (t *methodTimeouts) timeout(fullMethod string) time.Duration {
+	if timeout, ok := t.methods[fullMethod]; ok {
+		return timeout
+	}
+	return t.fallback
+}
+
+// unaryInterceptor gives unary calls a context that expires after their
+// method's maximum duration
+func (t *methodTimeouts) unaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	timeout := t.timeout(info.FullMethod)
+	if timeout == 0 {
+		return handler(ctx, req)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	return handler(ctx, req)
+}
+
+// streamInterceptor ends streams once their method's maximum duration has
+// passed: the stream's context expires and sends and receives fail with
+// DEADLINE_EXCEEDED, including receives already waiting for a message
+func (t *methodTimeouts) streamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	timeout := t.timeout(info.FullMethod)
+	if timeout == 0 {
+		return handler(srv, ss)
+	}
+	ctx, cancel := context.WithTimeout(ss.Context(), timeout)
+	defer cancel()
+	stream := &deadlineStream{
+		contextStream: contextStream{ServerStream: ss, ctx: ctx},
+		method:        info.FullMethod,
+		timeout:       timeout,
+	}
+	err := handler(srv, stream)
+	if err == nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		// Handlers end streams quietly when their context is done
+		err = stream.err()
+	}
+	return err
+}
+
+// deadlineStream is a stream whose sends and receives fail once its context
+// is done
+type deadlineStream struct {
+	contextStream
+	method  string
+	timeout time.Duration
+}
+
+// err returns the status ending the stream once its context is done, or nil
+func (d *deadlineStream) err() error {
+	switch err := d.ctx.Err(); {
+	case err == nil:
+		return nil
+	case errors.Is(err, context.DeadlineExceeded) && d.ServerStream.Context().Err() == nil:
+		return reasonError(codes.DeadlineExceeded, "METHOD_TIMEOUT", map[string]string{"method": d.method, "timeout": d.timeout.String()})
+	default:
+		return status.FromContextError(err).Err()
+	}
+}
+
+func (d *deadlineStream) SendMsg(m any) error {
+	if err := d.err(); err != nil {
+		return err
+	}
+	return d.ServerStream.SendMsg(m)
+}
+
+func (d *deadlineStream) RecvMsg(m any) error {
+	if err := d.err(); err != nil {
+		return err
+	}
+	// Receive in the background so an expiring context can interrupt the
+	// wait. An abandoned receive returns once the handler has returned, which
+	// ends the stream.
+	done := make(chan error, 1)
+	go func() { done <- d.ServerStream.RecvMsg(m) }()
+	select {
+	case err := <-done:
+		return err
+	case <-d.ctx.Done():
+		return d.err()
+	}
+}
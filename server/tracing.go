@@ -0,0 +1,202 @@
+//go:build !minimal && !no_tracing
+
+package main
+
+import (
+	"context"
+	"expvar"
+	"flag"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/stats"
+)
+
+const (
+	// traceparentKey is the W3C Trace Context metadata key callers propagate
+	// their trace in, and the server returns its span in
+	traceparentKey = "traceparent"
+	// maxSpanEvents caps the message events recorded per span, so long-lived
+	// streams don't grow their span without bound
+	maxSpanEvents = 128
+	// traceBatchSize is the number of spans exported per request
+	traceBatchSize = 512
+	// traceFlushInterval is how long finished spans wait to be exported
+	traceFlushInterval = 5 * time.Second
+	// traceQueueSize caps the finished spans waiting for export; more are dropped
+	traceQueueSize = 4096
+	// traceExportTimeout bounds each export request, and the final flush
+	traceExportTimeout = 10 * time.Second
+)
+
+var (
+	otlpEndpoint = flag.String("otlp-endpoint", "", "OTLP/HTTP collector URL traces are exported to, e.g. http://localhost:4318 (tracing is disabled when empty)")
+	otlpService  = flag.String("otlp-service-name", "routeguide-server", "service.name resource attribute of exported traces")
+	traceSample  = flag.Float64("trace-sample-percent", 100, "Percentage of calls without a sampled traceparent that start a trace")
+)
+
+func init() {
+	registerExtension(extension{name: "tracing", start: startTracing})
+}
+
+// traceContext propagates traces in W3C traceparent headers
+var traceContext = propagation.TraceContext{}
+
+// startTracing traces calls if --otlp-endpoint is set
+func startTracing(h *extensionHost) error {
+	if *otlpEndpoint == "" {
+		return nil
+	}
+	endpoint, err := tracesURL(*otlpEndpoint)
+	if err != nil {
+		return err
+	}
+	// Failed batches are dropped rather than retried: traces are
+	// diagnostics and mustn't hold up the server
+	exporter, err := otlptracehttp.New(h.ctx,
+		otlptracehttp.WithEndpointURL(endpoint),
+		otlptracehttp.WithTimeout(traceExportTimeout),
+		otlptracehttp.WithRetry(otlptracehttp.RetryConfig{Enabled: false}))
+	if err != nil {
+		return err
+	}
+	tp := newTracerProvider(guardedExporter{exporter}, *otlpService, *traceSample)
+	h.statsHandlers = append(h.statsHandlers, newTracingStatsHandler(tp))
+	h.unary = append(h.unary, traceUnaryInterceptor)
+	h.stream = append(h.stream, traceStreamInterceptor)
+	h.stops = append(h.stops, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), traceExportTimeout)
+		defer cancel()
+		if err := tp.Shutdown(ctx); err != nil {
+			slog.Warn("Gave up exporting the last spans", "error", err)
+		}
+	})
+	subsystems.enable("tracing")
+	slog.Info("Exporting traces", "endpoint", endpoint)
+	return nil
+}
+
+// tracesURL returns the traces endpoint of the OTLP/HTTP collector at
+// endpoint, e.g. http://localhost:4318
+func tracesURL(endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return "", fmt.Errorf("--otlp-endpoint must be an http:// or https:// URL, got %q", endpoint)
+	}
+	return strings.TrimSuffix(endpoint, "/") + "/v1/traces", nil
+}
+
+// newTracerProvider creates a tracer provider exporting spans in batches.
+// Calls carrying a traceparent join the caller's trace and follow its
+// sampling decision; others start a trace, sampled at samplePercent.
+func newTracerProvider(exporter sdktrace.SpanExporter, service string, samplePercent float64) *sdktrace.TracerProvider {
+	limits := sdktrace.NewSpanLimits()
+	limits.EventCountLimit = maxSpanEvents
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter,
+			sdktrace.WithMaxExportBatchSize(traceBatchSize),
+			sdktrace.WithBatchTimeout(traceFlushInterval),
+			sdktrace.WithMaxQueueSize(traceQueueSize)),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(samplePercent/100))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", service))),
+		sdktrace.WithRawSpanLimits(limits),
+	)
+}
+
+// newTracingStatsHandler creates the stats handler recording a server span
+// for every call, with an event per message
+func newTracingStatsHandler(tp trace.TracerProvider) stats.Handler {
+	return guardedStatsHandler{otelgrpc.NewServerHandler(
+		otelgrpc.WithTracerProvider(tp),
+		otelgrpc.WithPropagators(traceContext),
+		otelgrpc.WithMessageEvents(otelgrpc.ReceivedEvents, otelgrpc.SentEvents),
+	)}
+}
+
+// guardedStatsHandler runs the tracing stats handler through the subsystem
+// guard, so that a panic turns tracing off instead of failing the call
+type guardedStatsHandler struct {
+	stats.Handler
+}
+
+func (g guardedStatsHandler) TagRPC(ctx context.Context, info *stats.RPCTagInfo) context.Context {
+	tagged := ctx
+	subsystems.run("tracing", func() { tagged = g.Handler.TagRPC(ctx, info) })
+	return tagged
+}
+
+func (g guardedStatsHandler) HandleRPC(ctx context.Context, s stats.RPCStats) {
+	subsystems.run("tracing", func() { g.Handler.HandleRPC(ctx, s) })
+}
+
+// tracingMetrics publishes span export counters on /debug/vars
+var tracingMetrics = expvar.NewMap("tracing")
+
+// guardedExporter counts the spans exported and dropped, and turns tracing
+// off once exports keep failing
+type guardedExporter struct {
+	sdktrace.SpanExporter
+}
+
+func (g guardedExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if !subsystems.active("tracing") {
+		return nil
+	}
+	if err := g.SpanExporter.ExportSpans(ctx, spans); err != nil {
+		// Reported here, so the batch processor has nothing more to log
+		slog.Error("Failed to export spans", "count", len(spans), "error", err)
+		tracingMetrics.Add("spans_dropped", int64(len(spans)))
+		subsystems.fail("tracing", err)
+		return nil
+	}
+	tracingMetrics.Add("spans_exported", int64(len(spans)))
+	subsystems.succeed("tracing")
+	return nil
+}
+
+// traceHeader tags the span of the call ctx belongs to with its request ID
+// and returns the span's traceparent header, or nil if the call isn't traced
+func traceHeader(ctx context.Context) metadata.MD {
+	span := trace.SpanFromContext(ctx)
+	if !span.SpanContext().IsSampled() {
+		return nil
+	}
+	if id := requestID(ctx); id != "" {
+		span.SetAttributes(attribute.String("request_id", id))
+	}
+	carrier := propagation.MapCarrier{}
+	traceContext.Inject(ctx, carrier)
+	return metadata.Pairs(traceparentKey, carrier.Get(traceparentKey))
+}
+
+// traceUnaryInterceptor returns the server span of unary calls to the caller
+func traceUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	subsystems.run("tracing", func() {
+		if md := traceHeader(ctx); md != nil {
+			grpc.SetHeader(ctx, md)
+		}
+	})
+	return handler(ctx, req)
+}
+
+// traceStreamInterceptor returns the server span of streams to the caller
+func traceStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	subsystems.run("tracing", func() {
+		if md := traceHeader(ss.Context()); md != nil {
+			ss.SetHeader(md)
+		}
+	})
+	return handler(srv, ss)
+}
@@ -0,0 +1,272 @@
+//go:build !minimal && !no_tracing
+
+package main
+
+import (
+	"context"
+	"expvar"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+)
+
+// startTracedServer serves the route guide with tracing into exporter until
+// the test ends, and returns a client of it and the tracer provider, which
+// exports spans once flushed
+func startTracedServer(t *testing.T, exporter sdktrace.SpanExporter) (pb.RouteGuideClient, *sdktrace.TracerProvider) {
+	t.Helper()
+	s, err := newServer("features.json", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tp := newTracerProvider(exporter, "routeguide-test", 100)
+	t.Cleanup(func() { tp.Shutdown(context.Background()) })
+	server := grpc.NewServer(
+		grpc.StatsHandler(newTracingStatsHandler(tp)),
+		grpc.ChainUnaryInterceptor(requestIDUnaryInterceptor, traceUnaryInterceptor),
+		grpc.ChainStreamInterceptor(requestIDStreamInterceptor, traceStreamInterceptor),
+	)
+	pb.RegisterRouteGuideServer(server, s)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	return pb.NewRouteGuideClient(conn), tp
+}
+
+// spanAttributes returns the attributes of a span by key
+func spanAttributes(span tracetest.SpanStub) map[string]string {
+	attrs := make(map[string]string)
+	for _, kv := range span.Attributes {
+		attrs[string(kv.Key)] = kv.Value.Emit()
+	}
+	return attrs
+}
+
+func TestTracingRecordsServerSpans(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	client, tp := startTracedServer(t, exporter)
+	ctx := metadata.AppendToOutgoingContext(context.Background(), requestIDKey, "req-1")
+
+	var header metadata.MD
+	if _, err := client.GetFeature(ctx, &pb.Point{Latitude: 409146138, Longitude: -746188906}, grpc.Header(&header)); err != nil {
+		t.Fatal(err)
+	}
+	stream, err := client.ListFeatures(ctx, &pb.Rectangle{
+		Lo: &pb.Point{Latitude: 400000000, Longitude: -750000000},
+		Hi: &pb.Point{Latitude: 420000000, Longitude: -730000000},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sent := 0
+	for {
+		if _, err := stream.Recv(); err == io.EOF {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+		sent++
+	}
+	if err := tp.ForceFlush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("exported %d spans, want 2", len(spans))
+	}
+	tests := []struct {
+		name   string
+		events int // messages received and sent
+	}{
+		{"routeguide.RouteGuide/GetFeature", 2},
+		{"routeguide.RouteGuide/ListFeatures", 1 + sent},
+	}
+	for i, tt := range tests {
+		span := spans[i]
+		if span.Name != tt.name || span.SpanKind != trace.SpanKindServer {
+			t.Errorf("span %d = %s of kind %v, want a server span %s", i, span.Name, span.SpanKind, tt.name)
+		}
+		attrs := spanAttributes(span)
+		if attrs["rpc.system"] != "grpc" || attrs["rpc.service"] != "routeguide.RouteGuide" || attrs["request_id"] != "req-1" {
+			t.Errorf("span %s has attributes %v, want rpc.* and request_id", span.Name, attrs)
+		}
+		if len(span.Events) != tt.events {
+			t.Errorf("span %s has %d events, want %d", span.Name, len(span.Events), tt.events)
+		}
+		if service, _ := span.Resource.Set().Value("service.name"); service.AsString() != "routeguide-test" {
+			t.Errorf("span %s has resource %v, want service.name routeguide-test", span.Name, span.Resource)
+		}
+	}
+
+	// The response carries the span of the call
+	want := "00-" + spans[0].SpanContext.TraceID().String() + "-" + spans[0].SpanContext.SpanID().String() + "-01"
+	if got := header.Get(traceparentKey); len(got) != 1 || got[0] != want {
+		t.Errorf("%s header = %v, want %s", traceparentKey, got, want)
+	}
+}
+
+func TestTracingPropagatesTraceparent(t *testing.T) {
+	const (
+		traceID = "4bf92f3577b34da6a3ce929d0e0e4736"
+		spanID  = "00f067aa0ba902b7"
+	)
+	tests := []struct {
+		name        string
+		traceparent string
+		traced      bool
+		joins       bool // the span continues the caller's trace
+	}{
+		{"no traceparent", "", true, false},
+		{"sampled", "00-" + traceID + "-" + spanID + "-01", true, true},
+		{"not sampled", "00-" + traceID + "-" + spanID + "-00", false, false},
+		{"malformed", "00-" + traceID + "-01", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter := tracetest.NewInMemoryExporter()
+			client, tp := startTracedServer(t, exporter)
+			ctx := context.Background()
+			if tt.traceparent != "" {
+				ctx = metadata.AppendToOutgoingContext(ctx, traceparentKey, tt.traceparent)
+			}
+			var header metadata.MD
+			if _, err := client.GetFeature(ctx, &pb.Point{}, grpc.Header(&header)); err != nil {
+				t.Fatal(err)
+			}
+			if err := tp.ForceFlush(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+
+			spans := exporter.GetSpans()
+			if !tt.traced {
+				if len(spans) != 0 || len(header.Get(traceparentKey)) != 0 {
+					t.Errorf("exported %v with header %v, want no span", spans, header)
+				}
+				return
+			}
+			if len(spans) != 1 {
+				t.Fatalf("exported %d spans, want 1", len(spans))
+			}
+			span := spans[0]
+			joined := span.SpanContext.TraceID().String() == traceID && span.Parent.SpanID().String() == spanID
+			if joined != tt.joins || span.Parent.IsValid() != tt.joins {
+				t.Errorf("span in trace %s with parent %s, joined = %v, want %v", span.SpanContext.TraceID(), span.Parent.SpanID(), joined, tt.joins)
+			}
+			if got := header.Get(traceparentKey); len(got) != 1 || got[0] != "00-"+span.SpanContext.TraceID().String()+"-"+span.SpanContext.SpanID().String()+"-01" {
+				t.Errorf("%s header = %v, want the span of the call", traceparentKey, got)
+			}
+		})
+	}
+}
+
+func TestTracingExportsOTLP(t *testing.T) {
+	var requests atomic.Int32
+	fail := atomic.Bool{}
+	collector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v1/traces" || r.Header.Get("Content-Type") != "application/x-protobuf" {
+			t.Errorf("collector got %s %s (%s), want an OTLP/HTTP export", r.Method, r.URL.Path, r.Header.Get("Content-Type"))
+		}
+		requests.Add(1)
+		if fail.Load() {
+			http.Error(w, "unavailable", http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/x-protobuf")
+	}))
+	defer collector.Close()
+
+	endpoint, err := tracesURL(collector.URL + "/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	otlp, err := otlptracehttp.New(context.Background(),
+		otlptracehttp.WithEndpointURL(endpoint),
+		otlptracehttp.WithTimeout(time.Second),
+		otlptracehttp.WithRetry(otlptracehttp.RetryConfig{Enabled: false}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, tp := startTracedServer(t, guardedExporter{otlp})
+
+	counter := func(name string) int64 {
+		if v, ok := tracingMetrics.Get(name).(*expvar.Int); ok {
+			return v.Value()
+		}
+		return 0
+	}
+	exported := func() int64 { return counter("spans_exported") }
+	dropped := func() int64 { return counter("spans_dropped") }
+	tests := []struct {
+		name     string
+		fail     bool
+		exported int64
+		dropped  int64
+	}{
+		{"exported", false, 2, 0},
+		{"collector failing", true, 0, 2},
+	}
+	for _, tt := range tests {
+		fail.Store(tt.fail)
+		before, beforeDropped, beforeRequests := exported(), dropped(), requests.Load()
+		for range 2 {
+			if _, err := client.GetFeature(context.Background(), &pb.Point{}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := tp.ForceFlush(context.Background()); err != nil {
+			t.Fatalf("%s: ForceFlush() = %v, want failures dropped", tt.name, err)
+		}
+		if requests.Load() == beforeRequests {
+			t.Errorf("%s: the collector got no export", tt.name)
+		}
+		if got := exported() - before; got != tt.exported {
+			t.Errorf("%s: %d spans exported, want %d", tt.name, got, tt.exported)
+		}
+		if got := dropped() - beforeDropped; got != tt.dropped {
+			t.Errorf("%s: %d spans dropped, want %d", tt.name, got, tt.dropped)
+		}
+	}
+	subsystems.succeed("tracing")
+}
+
+func TestTracesURL(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		want     string // "" for an error
+	}{
+		{"http://localhost:4318", "http://localhost:4318/v1/traces"},
+		{"https://collector.example.com/", "https://collector.example.com/v1/traces"},
+		{"http://gateway:8080/otlp", "http://gateway:8080/otlp/v1/traces"},
+		{"localhost:4318", ""},
+		{"grpc://localhost:4317", ""},
+	}
+	for _, tt := range tests {
+		got, err := tracesURL(tt.endpoint)
+		if (err != nil) != (tt.want == "") || got != tt.want {
+			t.Errorf("tracesURL(%q) = %q, %v; want %q", tt.endpoint, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"expvar"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// warmupLogInterval is how often warm-up progress is logged
+const warmupLogInterval = 2 * time.Second
+
+// warmupMetrics publishes warm-up progress and build times on /debug/vars
+var warmupMetrics = expvar.NewMap("warmup")
+
+// warmupTask is a unit of startup work, such as building an index, that must
+// finish before the server reports itself as serving
+type warmupTask struct {
+	name  string
+	total int                      // units of work, used to report progress
+	run   func(progress func(int)) // does the work, reporting units done so far
+
+	done atomic.Int64
+}
+
+// runWarmup runs tasks concurrently, logging their progress, and calls ready
+// once all of them have finished
+func runWarmup(tasks []*warmupTask, ready func()) {
+	start := time.Now()
+	slog.Info("Warm-up started", "tasks", len(tasks))
+
+	var wg sync.WaitGroup
+	for _, task := range tasks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			task.execute()
+		}()
+	}
+
+	finished := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(finished)
+	}()
+
+	ticker := time.NewTicker(warmupLogInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			logWarmupProgress(tasks)
+		case <-finished:
+			elapsed := time.Since(start)
+			warmupMetrics.Set("total_seconds", floatVar(elapsed.Seconds()))
+			slog.Info("Warm-up completed", "elapsed", elapsed.Round(time.Millisecond))
+			ready()
+			return
+		}
+	}
+}
+
+// execute runs the task and records its build time
+func (t *warmupTask) execute() {
+	start := time.Now()
+	t.run(func(done int) {
+		t.done.Store(int64(done))
+		warmupMetrics.Set(t.name+"_done", intVar(int64(done)))
+	})
+	elapsed := time.Since(start)
+
+	warmupMetrics.Set(t.name+"_total", intVar(int64(t.total)))
+	warmupMetrics.Set(t.name+"_seconds", floatVar(elapsed.Seconds()))
+	slog.Info("Warm-up task finished", "task", t.name, "elapsed", elapsed.Round(time.Millisecond), "items", t.total)
+}
+
+// logWarmupProgress logs how far along each task is
+func logWarmupProgress(tasks []*warmupTask) {
+	parts := make([]string, len(tasks))
+	for i, task := range tasks {
+		done := task.done.Load()
+		percent := 100.0
+		if task.total > 0 {
+			percent = float64(done) * 100 / float64(task.total)
+		}
+		parts[i] = fmt.Sprintf("%s %.0f%% (%d/%d)", task.name, percent, done, task.total)
+	}
+	slog.Info("Warm-up progress", "tasks", strings.Join(parts, ", "))
+}
+
+// intVar wraps a value for an expvar.Map
+func intVar(v int64) *expvar.Int {
+	i := new(expvar.Int)
+	i.Set(v)
+	return i
+}
+
+// floatVar wraps a value for an expvar.Map
+func floatVar(v float64) *expvar.Float {
+	f := new(expvar.Float)
+	f.Set(v)
+	return f
+}
+
+// indexWarmupTask builds the spatial index of a dataset
+func indexWarmupTask(name string, d *dataset) *warmupTask {
+	return &warmupTask{
+		name:  name,
+		total: len(d.features),
+		run:   d.buildIndex,
+	}
+}
+
+// tileWarmupTask pre-renders every tile holding a feature of the current
+// dataset, from zoom 0 up to maxZoom
+func (s *routeGuideServer) tileWarmupTask(maxZoom int) *warmupTask {
+	d := s.current()
+	keys := featureTiles(d, maxZoom)
+	if len(keys) > s.tiles.max {
+		slog.Warn("Warm-up tiles exceed the tile cache size, some will be evicted", "tiles", len(keys), "cache_size", s.tiles.max)
+	}
+
+	return &warmupTask{
+		name:  "tiles",
+		total: len(keys),
+		run: func(progress func(int)) {
+			at := time.Now()
+			features := s.featuresOf(d)
+			for i, key := range keys {
+				if _, _, err := s.tile(context.Background(), features, key, at); err != nil {
+					slog.Warn("Failed to warm up tile", "z", key.z, "x", key.x, "y", key.y, "error", err)
+				}
+				progress(i + 1)
+			}
+		},
+	}
+}
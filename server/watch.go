@@ -0,0 +1,159 @@
+package main
+
+import (
+	"log/slog"
+	"sync"
+	"time"
+
+	pb "github.com/dvaldivia/grpc-swift-2-example/server/gen/protos"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// watcherBuffer is how many events a watcher may fall behind before it is dropped
+const watcherBuffer = 16
+
+// watchHub fans dataset events out to WatchFeatures streams
+type watchHub struct {
+	mu       sync.Mutex
+	watchers map[chan *pb.FeatureEvent]struct{}
+}
+
+// newWatchHub creates an empty hub
+func newWatchHub() *watchHub {
+	return &watchHub{watchers: make(map[chan *pb.FeatureEvent]struct{})}
+}
+
+// subscribe registers a new watcher. The channel is closed if the watcher
+// falls too far behind or when unsubscribe is called.
+func (h *watchHub) subscribe() (events <-chan *pb.FeatureEvent, unsubscribe func()) {
+	ch := make(chan *pb.FeatureEvent, watcherBuffer)
+
+	h.mu.Lock()
+	h.watchers[ch] = struct{}{}
+	h.mu.Unlock()
+
+	return ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if _, ok := h.watchers[ch]; ok {
+			delete(h.watchers, ch)
+			close(ch)
+		}
+	}
+}
+
+// publish sends an event to every watcher without blocking, dropping watchers
+// whose buffer is full so a stalled client can't hold up dataset swaps
+func (h *watchHub) publish(event *pb.FeatureEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.watchers {
+		select {
+		case ch <- event:
+		default:
+			slog.Warn("Dropping slow WatchFeatures subscriber")
+			delete(h.watchers, ch)
+			close(ch)
+		}
+	}
+}
+
+// datasetEvent describes a dataset as a FeatureEvent
+func datasetEvent(d *dataset, replaced bool) *pb.FeatureEvent {
+	eventType := pb.FeatureEvent_DATASET_CURRENT
+	if replaced {
+		eventType = pb.FeatureEvent_DATASET_REPLACED
+	}
+	return &pb.FeatureEvent{
+		Type:         eventType,
+		Version:      d.version,
+		FeatureCount: int32(len(d.features)),
+		Source:       d.source,
+		LoadedAt:     timestamppb.New(d.loadedAt),
+	}
+}
+
+// WatchFeatures streams dataset change events (server streaming RPC). Clients
+// that negotiated supports-resume get resume tokens and can pick up where a
+// previous watch left off; those that negotiated supports-heartbeats get a
+// HEARTBEAT event every heartbeat interval.
+func (s *routeGuideServer) WatchFeatures(req *pb.WatchFeaturesRequest, stream pb.RouteGuide_WatchFeaturesServer) error {
+	caps := clientCapabilities(stream.Context())
+	sess, resumed, err := s.sessions.attach(stream)
+	if err != nil {
+		return err
+	}
+	defer s.sessions.detach(sess)
+	logger := loggerFrom(stream.Context())
+	logger.Info("WatchFeatures called", "resume", caps[capResume], "heartbeats", caps[capHeartbeats], "resumed_session", resumed)
+
+	events, unsubscribe := s.watchers.subscribe()
+	defer unsubscribe()
+
+	// send stamps an event with a resume token when the client can use one
+	lastVersion := int64(0)
+	send := func(event *pb.FeatureEvent) error {
+		if caps[capResume] {
+			event = proto.Clone(event).(*pb.FeatureEvent)
+			event.ResumeToken = encodeResumeToken(event.Version)
+		}
+		lastVersion = event.Version
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+		sess.sawVersion(event.Version)
+		return nil
+	}
+
+	// A resume token, or else a restored session, tells which version the
+	// client already has; only report what changed since
+	current := datasetEvent(s.current(), false)
+	seen := sess.watchedVersion()
+	if caps[capResume] && req.ResumeToken != "" {
+		if seen, err = decodeResumeToken(req.ResumeToken); err != nil {
+			return reasonError(codes.InvalidArgument, "INVALID_RESUME_TOKEN", nil)
+		}
+	}
+	if seen > 0 {
+		lastVersion = seen
+		if seen != current.Version {
+			current.Type = pb.FeatureEvent_DATASET_REPLACED
+		}
+	}
+	if lastVersion != current.Version {
+		if err := send(current); err != nil {
+			return err
+		}
+	}
+
+	var heartbeat <-chan time.Time
+	if caps[capHeartbeats] && s.heartbeatInterval > 0 {
+		ticker := time.NewTicker(s.heartbeatInterval)
+		defer ticker.Stop()
+		heartbeat = ticker.C
+	}
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "watcher fell too far behind")
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+			logger.Debug("Sent dataset event", "dataset_version", event.Version)
+		case <-heartbeat:
+			if err := send(&pb.FeatureEvent{Type: pb.FeatureEvent_HEARTBEAT, Version: lastVersion}); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			logger.Info("WatchFeatures completed")
+			return nil
+		}
+	}
+}